@@ -0,0 +1,177 @@
+/*
+Copyright (c) 2024 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package apply
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+	"github.com/spf13/cobra"
+
+	"github.com/openshift/rosa/pkg/interactive/confirm"
+	"github.com/openshift/rosa/pkg/ocm"
+	"github.com/openshift/rosa/pkg/rosa"
+)
+
+const (
+	use   = "apply"
+	short = "Apply a manifest of cluster resources"
+	long  = "Reconcile the machine pools, node pools, identity providers, ingresses, tuning configs, " +
+		"kubelet config and autoscaler of a cluster with the ones declared in a manifest.\n\n" +
+		"The manifest is a multi-document YAML file where every document uses the same format as " +
+		"the output of 'rosa describe ... -o yaml' or 'rosa list ... -o yaml', and must set 'kind'. " +
+		"Only the fields set in the manifest are compared with the cluster, except for secrets that " +
+		"the API never returns. Ingresses are identified by their route selectors, or by " +
+		"'default: true' for the default ingress. A plan with the changes is printed before anything " +
+		"is modified."
+	example = `  # Show what would change on cluster 'mycluster' without applying it
+  rosa apply --cluster=mycluster -f resources.yaml --dry-run
+
+  # Apply a manifest, deleting resources of the same kinds that are not declared in it
+  rosa apply --cluster=mycluster -f resources.yaml --prune`
+)
+
+var args struct {
+	file   string
+	prune  bool
+	dryRun bool
+}
+
+func NewApplyCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     use,
+		Short:   short,
+		Long:    long,
+		Example: example,
+		Args:    cobra.NoArgs,
+		Run:     rosa.DefaultRunner(rosa.RuntimeWithOCMAndAWS(), ApplyRunner()),
+	}
+
+	flags := cmd.Flags()
+	ocm.AddClusterFlag(cmd)
+	flags.StringVarP(
+		&args.file,
+		"file",
+		"f",
+		"",
+		"Path to the manifest with the resources to apply.",
+	)
+	cmd.MarkFlagRequired("file")
+	flags.BoolVar(
+		&args.prune,
+		"prune",
+		false,
+		"Delete resources of the kinds declared in the manifest that exist on the cluster but are "+
+			"not declared in the manifest. The default ingress is never deleted.",
+	)
+	flags.BoolVar(
+		&args.dryRun,
+		"dry-run",
+		false,
+		"Print the plan without applying it.",
+	)
+	confirm.AddFlag(flags)
+	return cmd
+}
+
+func ApplyRunner() rosa.CommandRunner {
+	return func(_ context.Context, r *rosa.Runtime, _ *cobra.Command, _ []string) error {
+		data, err := os.ReadFile(args.file)
+		if err != nil {
+			return fmt.Errorf("Failed to read manifest '%s': %v", args.file, err)
+		}
+		resources, err := parseManifest(data)
+		if err != nil {
+			return fmt.Errorf("Invalid manifest '%s': %v", args.file, err)
+		}
+		if len(resources) == 0 {
+			return fmt.Errorf("Manifest '%s' does not declare any resources", args.file)
+		}
+
+		clusterKey := r.GetClusterKey()
+		cluster, err := r.OCMClient.GetCluster(clusterKey, r.Creator)
+		if err != nil {
+			return err
+		}
+		err = validateKinds(resources, cluster)
+		if err != nil {
+			return err
+		}
+
+		existing := map[string][]object{}
+		for _, resource := range resources {
+			if _, ok := existing[resource.kind]; ok {
+				continue
+			}
+			r.Reporter.Debugf("Loading %s resources for cluster '%s'", resource.kind, clusterKey)
+			objects, err := handlers[resource.kind].list(r, cluster.ID())
+			if err != nil {
+				return fmt.Errorf("Failed to get %s resources for cluster '%s': %v", resource.kind, clusterKey, err)
+			}
+			existing[resource.kind] = objects
+		}
+
+		changes := buildPlan(resources, existing, args.prune)
+		if len(changes) == 0 {
+			r.Reporter.Infof("Cluster '%s' already matches the manifest", clusterKey)
+			return nil
+		}
+		fmt.Printf("Changes to cluster '%s':\n\n%s", clusterKey, printPlan(changes))
+		if args.dryRun {
+			return nil
+		}
+		if !confirm.Confirm("apply these changes to cluster '%s'", clusterKey) {
+			return nil
+		}
+
+		for _, c := range changes {
+			handler := handlers[c.kind]
+			done := ""
+			switch c.action {
+			case createAction:
+				err = handler.create(r, cluster.ID(), c.desired)
+				done = "created"
+			case updateAction:
+				err = handler.update(r, cluster.ID(), c.desired, c.current)
+				done = "updated"
+			case deleteAction:
+				err = handler.delete(r, cluster.ID(), c.current)
+				done = "deleted"
+			}
+			if err != nil {
+				return fmt.Errorf("Failed to %s %s on cluster '%s': %v", c.action, c, clusterKey, err)
+			}
+			r.Reporter.Infof("Successfully %s %s on cluster '%s'", done, c, clusterKey)
+		}
+		return nil
+	}
+}
+
+// validateKinds checks that the cluster supports all the kinds of resources of the manifest.
+func validateKinds(resources []resource, cluster *cmv1.Cluster) error {
+	if !cluster.Hypershift().Enabled() {
+		return nil
+	}
+	for _, resource := range resources {
+		if handlers[resource.kind].classicOnly {
+			return fmt.Errorf("%s is not supported for Hosted Control Plane clusters", resource)
+		}
+	}
+	return nil
+}
@@ -0,0 +1,251 @@
+package apply
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+	"github.com/spf13/cobra"
+
+	. "github.com/openshift/rosa/pkg/test"
+)
+
+var _ = Describe("rosa apply", func() {
+	Context("ApplyRunner", func() {
+		var t *TestingRuntime
+		var cmd *cobra.Command
+
+		BeforeEach(func() {
+			t = NewTestRuntime()
+			cmd = NewApplyCommand()
+		})
+
+		run := func(manifest string) error {
+			file := filepath.Join(GinkgoT().TempDir(), "manifest.yaml")
+			Expect(os.WriteFile(file, []byte(manifest), 0600)).To(Succeed())
+			Expect(cmd.Flags().Set("file", file)).To(Succeed())
+			return ApplyRunner()(context.Background(), t.RosaRuntime, cmd, nil)
+		}
+
+		It("KO: fails on an invalid manifest before calling OCM", func() {
+			err := run("kind: Route\nid: foo\n")
+			Expect(err).To(MatchError(ContainSubstring("Invalid manifest")))
+			Expect(t.ApiServer.ReceivedRequests()).To(BeEmpty())
+		})
+
+		It("KO: fails on a manifest without resources", func() {
+			err := run("# nothing to apply\n")
+			Expect(err).To(MatchError(HaveSuffix("does not declare any resources")))
+			Expect(t.ApiServer.ReceivedRequests()).To(BeEmpty())
+		})
+	})
+
+	Context("parseManifest", func() {
+		It("OK: parses multiple documents", func() {
+			resources, err := parseManifest([]byte(`kind: MachinePool
+id: workers
+replicas: 3
+---
+kind: TuningConfig
+name: tuned
+---
+kind: KubeletConfig
+pod_pids_limit: 5000
+`))
+			Expect(err).NotTo(HaveOccurred())
+			Expect(resources).To(HaveLen(3))
+			Expect(resources[0].String()).To(Equal("MachinePool 'workers'"))
+			Expect(resources[0].fields["replicas"]).To(Equal(float64(3)))
+			Expect(resources[1].String()).To(Equal("TuningConfig 'tuned'"))
+			Expect(resources[2].String()).To(Equal("KubeletConfig"))
+		})
+
+		It("KO: fails on unsupported kinds", func() {
+			_, err := parseManifest([]byte("kind: Cluster\nid: foo\n"))
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("unsupported kind 'Cluster'"))
+		})
+
+		It("KO: fails when the identifier is missing", func() {
+			_, err := parseManifest([]byte("kind: IdentityProvider\ntype: GithubIdentityProvider\n"))
+			Expect(err).To(MatchError("Document 1 of kind 'IdentityProvider' must set 'name'"))
+		})
+
+		It("KO: fails on duplicated resources", func() {
+			_, err := parseManifest([]byte("kind: MachinePool\nid: a\n---\nkind: MachinePool\nid: a\n"))
+			Expect(err).To(MatchError("MachinePool 'a' is declared more than once"))
+		})
+	})
+
+	Context("buildPlan", func() {
+		var existing map[string][]object
+
+		BeforeEach(func() {
+			existing = map[string][]object{
+				machinePoolKind: {
+					{id: "workers", name: "workers", fields: map[string]interface{}{
+						"kind": "MachinePool", "id": "workers", "replicas": float64(3),
+						"instance_type": "m5.xlarge", "labels": map[string]interface{}{"a": "b", "c": "d"},
+					}},
+					{id: "old", name: "old", fields: map[string]interface{}{"id": "old"}},
+				},
+				tuningConfigKind: {},
+				ingressKind: {
+					{id: "abc1", name: "default", protected: true, fields: map[string]interface{}{
+						"id": "abc1", "default": true,
+					}},
+					{id: "def2", name: "tier=apps", fields: map[string]interface{}{
+						"id": "def2", "default": false, "route_selectors": map[string]interface{}{"tier": "apps"},
+						"listening": "external",
+					}},
+				},
+			}
+		})
+
+		It("OK: creates, updates and ignores unmanaged resources", func() {
+			resources, err := parseManifest([]byte(`kind: MachinePool
+id: workers
+replicas: 5
+instance_type: m5.xlarge
+labels:
+  a: b
+---
+kind: MachinePool
+id: gpu
+---
+kind: TuningConfig
+name: tuned
+`))
+			Expect(err).NotTo(HaveOccurred())
+
+			changes := buildPlan(resources, existing, false)
+			Expect(changes).To(HaveLen(3))
+			Expect(changes[0].action).To(Equal(createAction))
+			Expect(changes[0].String()).To(Equal("TuningConfig 'tuned'"))
+			Expect(changes[1].action).To(Equal(updateAction))
+			Expect(changes[1].String()).To(Equal("MachinePool 'workers'"))
+			Expect(changes[1].fields).To(HaveLen(2))
+			Expect(changes[1].fields[0].Path).To(Equal("labels"))
+			Expect(changes[1].fields[1].Path).To(Equal("replicas"))
+			Expect(changes[2].action).To(Equal(createAction))
+			Expect(changes[2].String()).To(Equal("MachinePool 'gpu'"))
+
+			Expect(printPlan(changes)).To(Equal(`  + TuningConfig 'tuned'
+  ~ MachinePool 'workers'
+      labels: {"a":"b","c":"d"} -> {"a":"b"}
+      replicas: 3 -> 5
+  + MachinePool 'gpu'
+
+Plan: 2 to create, 1 to update, 0 to delete.
+`))
+		})
+
+		It("OK: prunes undeclared resources but never protected ones", func() {
+			resources, err := parseManifest([]byte(`kind: MachinePool
+id: workers
+replicas: 3
+---
+kind: Ingress
+route_selectors:
+  tier: internal
+`))
+			Expect(err).NotTo(HaveOccurred())
+
+			changes := buildPlan(resources, existing, true)
+			Expect(changes).To(HaveLen(3))
+			Expect(changes[0].action).To(Equal(createAction))
+			Expect(changes[0].String()).To(Equal("Ingress 'tier=internal'"))
+			Expect(changes[1].action).To(Equal(deleteAction))
+			Expect(changes[1].String()).To(Equal("MachinePool 'old'"))
+			Expect(changes[2].action).To(Equal(deleteAction))
+			Expect(changes[2].String()).To(Equal("Ingress 'tier=apps'"))
+		})
+
+		It("OK: matches ingresses by their route selectors or as the default ingress", func() {
+			resources, err := parseManifest([]byte(`kind: Ingress
+default: true
+---
+kind: Ingress
+route_selectors:
+  tier: apps
+listening: internal
+`))
+			Expect(err).NotTo(HaveOccurred())
+
+			changes := buildPlan(resources, existing, false)
+			Expect(changes).To(HaveLen(1))
+			Expect(changes[0].action).To(Equal(updateAction))
+			Expect(changes[0].String()).To(Equal("Ingress 'tier=apps'"))
+			Expect(changes[0].current.id).To(Equal("def2"))
+			Expect(changes[0].fields).To(HaveLen(1))
+			Expect(changes[0].fields[0].Path).To(Equal("listening"))
+		})
+
+		It("OK: doesn't compare secrets that the API never returns", func() {
+			resources, err := parseManifest([]byte(`kind: IdentityProvider
+name: github
+type: GithubIdentityProvider
+github:
+  client_id: abc
+  client_secret: xyz
+  organizations: [org]
+`))
+			Expect(err).NotTo(HaveOccurred())
+			existing[identityProviderKind] = []object{
+				{id: "idp1", name: "github", fields: map[string]interface{}{
+					"id": "idp1", "name": "github", "type": "GithubIdentityProvider",
+					"github": map[string]interface{}{"client_id": "abc", "organizations": []interface{}{"org"}},
+				}},
+			}
+
+			Expect(buildPlan(resources, existing, false)).To(BeEmpty())
+		})
+	})
+
+	Context("patch", func() {
+		It("OK: only sends the fields that changed and the identifier", func() {
+			resources, err := parseManifest([]byte(`kind: MachinePool
+id: workers
+replicas: 5
+instance_type: m5.xlarge
+`))
+			Expect(err).NotTo(HaveOccurred())
+			body, err := resources[0].patch(object{id: "workers", fields: map[string]interface{}{
+				"id": "workers", "replicas": float64(3), "instance_type": "m5.xlarge",
+			}})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(string(body)).To(Equal(`{"id":"workers","replicas":5}`))
+		})
+	})
+
+	Context("validateKinds", func() {
+		It("KO: rejects the kubelet config for Hosted Control Plane clusters", func() {
+			resources, err := parseManifest([]byte("kind: KubeletConfig\npod_pids_limit: 5000\n"))
+			Expect(err).NotTo(HaveOccurred())
+			classic, err := cmv1.NewCluster().Build()
+			Expect(err).NotTo(HaveOccurred())
+			hostedCP, err := cmv1.NewCluster().Hypershift(cmv1.NewHypershift().Enabled(true)).Build()
+			Expect(err).NotTo(HaveOccurred())
+
+			Expect(validateKinds(resources, classic)).To(Succeed())
+			Expect(validateKinds(resources, hostedCP)).To(MatchError(
+				"KubeletConfig is not supported for Hosted Control Plane clusters"))
+		})
+	})
+
+	Context("mergeFields", func() {
+		It("OK: overrides nested fields", func() {
+			merged := mergeFields(
+				map[string]interface{}{"a": float64(1), "scale_down": map[string]interface{}{"enabled": true, "x": "y"}},
+				map[string]interface{}{"scale_down": map[string]interface{}{"enabled": false}},
+			)
+			Expect(merged).To(Equal(map[string]interface{}{
+				"a":          float64(1),
+				"scale_down": map[string]interface{}{"enabled": false, "x": "y"},
+			}))
+		})
+	})
+})
@@ -0,0 +1,93 @@
+package apply
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+
+	"gopkg.in/yaml.v3"
+
+	"github.com/openshift/rosa/pkg/diff"
+)
+
+// resource is a single document of a manifest, kept in the same JSON representation that the OCM
+// API uses so that the output of 'rosa describe ... -o yaml' can be used as input.
+type resource struct {
+	kind   string
+	name   string
+	fields map[string]interface{}
+}
+
+func (r resource) String() string {
+	if r.name == "" {
+		return r.kind
+	}
+	return fmt.Sprintf("%s '%s'", r.kind, r.name)
+}
+
+func (r resource) json() ([]byte, error) {
+	return json.Marshal(r.fields)
+}
+
+// patch returns the JSON of the fields of the resource that differ from the existing object, with the
+// identifier of the object, so that updates don't send the fields that haven't changed, like the
+// immutable ones.
+func (r resource) patch(current object) ([]byte, error) {
+	fields := diff.Changed(r.fields, current.fields)
+	if current.id != "" {
+		fields["id"] = current.id
+	}
+	return json.Marshal(fields)
+}
+
+// parseManifest reads a multi-document YAML manifest and returns its resources in order.
+func parseManifest(data []byte) ([]resource, error) {
+	resources := []resource{}
+	seen := map[string]bool{}
+	decoder := yaml.NewDecoder(bytes.NewReader(data))
+	for index := 1; ; index++ {
+		var doc interface{}
+		err := decoder.Decode(&doc)
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, fmt.Errorf("Failed to parse document %d: %v", index, err)
+		}
+		if doc == nil {
+			continue
+		}
+
+		// Round trip through JSON so that values compare equal to the ones returned by the API
+		raw, err := json.Marshal(doc)
+		if err != nil {
+			return nil, fmt.Errorf("Failed to parse document %d: %v", index, err)
+		}
+		fields := map[string]interface{}{}
+		err = json.Unmarshal(raw, &fields)
+		if err != nil {
+			return nil, fmt.Errorf("Document %d must be a mapping: %v", index, err)
+		}
+
+		kind, _ := fields["kind"].(string)
+		handler, ok := handlers[kind]
+		if !ok {
+			return nil, fmt.Errorf("Document %d has unsupported kind '%s'. Supported kinds are: %s",
+				index, kind, supportedKinds())
+		}
+		name := handler.name(fields)
+		if handler.key != "" && name == "" {
+			return nil, fmt.Errorf("Document %d of kind '%s' must set '%s'", index, kind, handler.key)
+		}
+
+		r := resource{kind: kind, name: name, fields: fields}
+		if seen[r.String()] {
+			return nil, fmt.Errorf("%s is declared more than once", r)
+		}
+		seen[r.String()] = true
+		resources = append(resources, r)
+	}
+	return resources, nil
+}
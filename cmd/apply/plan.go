@@ -0,0 +1,118 @@
+package apply
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/openshift/rosa/pkg/diff"
+)
+
+const (
+	createAction = "create"
+	updateAction = "update"
+	deleteAction = "delete"
+)
+
+// object is a resource that currently exists on the cluster.
+type object struct {
+	id     string
+	name   string
+	fields map[string]interface{}
+	// Protected objects, like the default ingress, are never deleted when pruning
+	protected bool
+}
+
+type change struct {
+	action  string
+	kind    string
+	name    string
+	desired resource
+	current object
+	fields  []diff.Field
+}
+
+func (c change) String() string {
+	if c.name == "" {
+		return c.kind
+	}
+	return fmt.Sprintf("%s '%s'", c.kind, c.name)
+}
+
+// buildPlan compares the desired resources with the ones that exist on the cluster. Creations and
+// updates follow the order of 'kinds' so that dependencies like tuning configs exist before the
+// pools that reference them, and deletions go in the reverse order.
+func buildPlan(desired []resource, existing map[string][]object, prune bool) []change {
+	changes := []change{}
+	matched := map[string]map[string]bool{}
+
+	for _, kind := range kinds {
+		matched[kind] = map[string]bool{}
+		for _, d := range desired {
+			if d.kind != kind {
+				continue
+			}
+			current, found := findObject(existing[kind], d.name)
+			if !found {
+				changes = append(changes, change{action: createAction, kind: kind, name: d.name, desired: d})
+				continue
+			}
+			matched[kind][current.id] = true
+			fields := diff.Fields(withoutFields(d.fields, handlers[kind].writeOnly), current.fields)
+			if len(fields) > 0 {
+				changes = append(changes, change{
+					action:  updateAction,
+					kind:    kind,
+					name:    d.name,
+					desired: d,
+					current: current,
+					fields:  fields,
+				})
+			}
+		}
+	}
+
+	if !prune {
+		return changes
+	}
+	for i := len(kinds) - 1; i >= 0; i-- {
+		kind := kinds[i]
+		for _, current := range existing[kind] {
+			if matched[kind][current.id] || current.protected {
+				continue
+			}
+			changes = append(changes, change{action: deleteAction, kind: kind, name: current.name, current: current})
+		}
+	}
+	return changes
+}
+
+// findObject looks for an existing object by name. Kinds that exist at most once per cluster have
+// no name, so any existing object matches.
+func findObject(objects []object, name string) (object, bool) {
+	for _, o := range objects {
+		if name == "" || o.name == name {
+			return o, true
+		}
+	}
+	return object{}, false
+}
+
+func printPlan(changes []change) string {
+	var b strings.Builder
+	counts := map[string]int{}
+	for _, c := range changes {
+		counts[c.action]++
+		switch c.action {
+		case createAction:
+			fmt.Fprintf(&b, "  + %s\n", c)
+		case updateAction:
+			fmt.Fprintf(&b, "  ~ %s\n", c)
+			b.WriteString(diff.Format(c.fields, "      "))
+		case deleteAction:
+			fmt.Fprintf(&b, "  - %s\n", c)
+		}
+	}
+	fmt.Fprintf(&b, "\nPlan: %d to create, %d to update, %d to delete.\n",
+		counts[createAction], counts[updateAction], counts[deleteAction])
+	return b.String()
+}
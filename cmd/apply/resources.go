@@ -0,0 +1,455 @@
+package apply
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+
+	"github.com/openshift/rosa/pkg/diff"
+	"github.com/openshift/rosa/pkg/ocm"
+	"github.com/openshift/rosa/pkg/rosa"
+)
+
+const (
+	tuningConfigKind      = "TuningConfig"
+	kubeletConfigKind     = "KubeletConfig"
+	clusterAutoscalerKind = "ClusterAutoscaler"
+	identityProviderKind  = "IdentityProvider"
+	ingressKind           = "Ingress"
+	machinePoolKind       = "MachinePool"
+	nodePoolKind          = "NodePool"
+)
+
+// kinds lists the supported kinds in the order in which they are created.
+var kinds = []string{
+	tuningConfigKind,
+	kubeletConfigKind,
+	clusterAutoscalerKind,
+	identityProviderKind,
+	ingressKind,
+	machinePoolKind,
+	nodePoolKind,
+}
+
+type kindHandler struct {
+	// Field that identifies objects of this kind, empty for kinds that exist at most once per cluster
+	key string
+	// Returns the name of an object when it isn't the value of the key, like for ingresses whose
+	// identifier is generated by the server
+	identify func(fields map[string]interface{}) string
+	// Fields that the API never returns, like secrets, so they aren't compared with the cluster
+	writeOnly []string
+	// Kinds that are only supported by classic clusters
+	classicOnly bool
+	list        func(r *rosa.Runtime, clusterID string) ([]object, error)
+	create      func(r *rosa.Runtime, clusterID string, desired resource) error
+	update      func(r *rosa.Runtime, clusterID string, desired resource, current object) error
+	delete      func(r *rosa.Runtime, clusterID string, current object) error
+}
+
+var handlers = map[string]kindHandler{
+	tuningConfigKind: {
+		key: "name",
+		list: func(r *rosa.Runtime, clusterID string) ([]object, error) {
+			tuningConfigs, err := r.OCMClient.GetTuningConfigs(clusterID)
+			if err != nil {
+				return nil, err
+			}
+			objects := []object{}
+			for _, tuningConfig := range tuningConfigs {
+				fields, err := diff.Of(func(w io.Writer) error { return cmv1.MarshalTuningConfig(tuningConfig, w) })
+				if err != nil {
+					return nil, err
+				}
+				objects = append(objects, object{id: tuningConfig.ID(), name: tuningConfig.Name(), fields: fields})
+			}
+			return objects, nil
+		},
+		create: func(r *rosa.Runtime, clusterID string, desired resource) error {
+			body, err := desired.json()
+			if err != nil {
+				return err
+			}
+			tuningConfig, err := cmv1.UnmarshalTuningConfig(body)
+			if err != nil {
+				return err
+			}
+			_, err = r.OCMClient.CreateTuningConfig(clusterID, tuningConfig)
+			return err
+		},
+		update: func(r *rosa.Runtime, clusterID string, desired resource, current object) error {
+			body, err := desired.patch(current)
+			if err != nil {
+				return err
+			}
+			tuningConfig, err := cmv1.UnmarshalTuningConfig(body)
+			if err != nil {
+				return err
+			}
+			_, err = r.OCMClient.UpdateTuningConfig(clusterID, tuningConfig)
+			return err
+		},
+		delete: func(r *rosa.Runtime, clusterID string, current object) error {
+			return r.OCMClient.DeleteTuningConfig(clusterID, current.id)
+		},
+	},
+	kubeletConfigKind: {
+		classicOnly: true,
+		list: func(r *rosa.Runtime, clusterID string) ([]object, error) {
+			kubeletConfig, err := r.OCMClient.GetClusterKubeletConfig(clusterID)
+			if err != nil || kubeletConfig == nil {
+				return nil, err
+			}
+			fields, err := diff.Of(func(w io.Writer) error { return cmv1.MarshalKubeletConfig(kubeletConfig, w) })
+			if err != nil {
+				return nil, err
+			}
+			return []object{{id: kubeletConfig.ID(), fields: fields}}, nil
+		},
+		create: func(r *rosa.Runtime, clusterID string, desired resource) error {
+			kubeletConfig, err := kubeletConfigArgs(desired)
+			if err != nil {
+				return err
+			}
+			_, err = r.OCMClient.CreateKubeletConfig(clusterID, kubeletConfig)
+			return err
+		},
+		update: func(r *rosa.Runtime, clusterID string, desired resource, _ object) error {
+			kubeletConfig, err := kubeletConfigArgs(desired)
+			if err != nil {
+				return err
+			}
+			_, err = r.OCMClient.UpdateKubeletConfig(clusterID, kubeletConfig)
+			return err
+		},
+		delete: func(r *rosa.Runtime, clusterID string, _ object) error {
+			return r.OCMClient.DeleteKubeletConfig(clusterID)
+		},
+	},
+	clusterAutoscalerKind: {
+		list: func(r *rosa.Runtime, clusterID string) ([]object, error) {
+			autoscaler, err := r.OCMClient.GetClusterAutoscaler(clusterID)
+			if err != nil || autoscaler == nil {
+				return nil, err
+			}
+			fields, err := diff.Of(func(w io.Writer) error { return cmv1.MarshalClusterAutoscaler(autoscaler, w) })
+			if err != nil {
+				return nil, err
+			}
+			return []object{{fields: fields}}, nil
+		},
+		create: func(r *rosa.Runtime, clusterID string, desired resource) error {
+			config, err := autoscalerConfig(desired.fields)
+			if err != nil {
+				return err
+			}
+			_, err = r.OCMClient.CreateClusterAutoscaler(clusterID, config)
+			return err
+		},
+		update: func(r *rosa.Runtime, clusterID string, desired resource, current object) error {
+			// The autoscaler is replaced as a whole, so keep the fields that the manifest doesn't set
+			config, err := autoscalerConfig(mergeFields(current.fields, desired.fields))
+			if err != nil {
+				return err
+			}
+			_, err = r.OCMClient.UpdateClusterAutoscaler(clusterID, config)
+			return err
+		},
+		delete: func(r *rosa.Runtime, clusterID string, _ object) error {
+			return r.OCMClient.DeleteClusterAutoscaler(clusterID)
+		},
+	},
+	identityProviderKind: {
+		key: "name",
+		writeOnly: []string{
+			"github.client_secret",
+			"gitlab.client_secret",
+			"google.client_secret",
+			"open_id.client_secret",
+			"ldap.bind_password",
+			"htpasswd.password",
+			"htpasswd.users",
+		},
+		list: func(r *rosa.Runtime, clusterID string) ([]object, error) {
+			idps, err := r.OCMClient.GetIdentityProviders(clusterID)
+			if err != nil {
+				return nil, err
+			}
+			objects := []object{}
+			for _, idp := range idps {
+				fields, err := diff.Of(func(w io.Writer) error { return cmv1.MarshalIdentityProvider(idp, w) })
+				if err != nil {
+					return nil, err
+				}
+				objects = append(objects, object{id: idp.ID(), name: idp.Name(), fields: fields})
+			}
+			return objects, nil
+		},
+		create: func(r *rosa.Runtime, clusterID string, desired resource) error {
+			body, err := desired.json()
+			if err != nil {
+				return err
+			}
+			idp, err := cmv1.UnmarshalIdentityProvider(body)
+			if err != nil {
+				return err
+			}
+			_, err = r.OCMClient.CreateIdentityProvider(clusterID, idp)
+			return err
+		},
+		update: func(r *rosa.Runtime, clusterID string, desired resource, current object) error {
+			body, err := desired.patch(current)
+			if err != nil {
+				return err
+			}
+			idp, err := cmv1.UnmarshalIdentityProvider(body)
+			if err != nil {
+				return err
+			}
+			_, err = r.OCMClient.UpdateIdentityProvider(clusterID, idp)
+			return err
+		},
+		delete: func(r *rosa.Runtime, clusterID string, current object) error {
+			return r.OCMClient.DeleteIdentityProvider(clusterID, current.id)
+		},
+	},
+	ingressKind: {
+		key:      "route_selectors",
+		identify: ingressName,
+		list: func(r *rosa.Runtime, clusterID string) ([]object, error) {
+			ingresses, err := r.OCMClient.GetIngresses(clusterID)
+			if err != nil {
+				return nil, err
+			}
+			objects := []object{}
+			for _, ingress := range ingresses {
+				fields, err := diff.Of(func(w io.Writer) error { return cmv1.MarshalIngress(ingress, w) })
+				if err != nil {
+					return nil, err
+				}
+				objects = append(objects, object{
+					id:        ingress.ID(),
+					name:      ingressName(fields),
+					fields:    fields,
+					protected: ingress.Default(),
+				})
+			}
+			return objects, nil
+		},
+		create: func(r *rosa.Runtime, clusterID string, desired resource) error {
+			body, err := desired.json()
+			if err != nil {
+				return err
+			}
+			ingress, err := cmv1.UnmarshalIngress(body)
+			if err != nil {
+				return err
+			}
+			_, err = r.OCMClient.CreateIngress(clusterID, ingress)
+			return err
+		},
+		update: func(r *rosa.Runtime, clusterID string, desired resource, current object) error {
+			body, err := desired.patch(current)
+			if err != nil {
+				return err
+			}
+			ingress, err := cmv1.UnmarshalIngress(body)
+			if err != nil {
+				return err
+			}
+			_, err = r.OCMClient.UpdateIngress(clusterID, ingress)
+			return err
+		},
+		delete: func(r *rosa.Runtime, clusterID string, current object) error {
+			return r.OCMClient.DeleteIngress(clusterID, current.id)
+		},
+	},
+	machinePoolKind: {
+		key: "id",
+		list: func(r *rosa.Runtime, clusterID string) ([]object, error) {
+			machinePools, err := r.OCMClient.GetMachinePools(clusterID)
+			if err != nil {
+				return nil, err
+			}
+			objects := []object{}
+			for _, machinePool := range machinePools {
+				fields, err := diff.Of(func(w io.Writer) error { return cmv1.MarshalMachinePool(machinePool, w) })
+				if err != nil {
+					return nil, err
+				}
+				objects = append(objects, object{id: machinePool.ID(), name: machinePool.ID(), fields: fields})
+			}
+			return objects, nil
+		},
+		create: func(r *rosa.Runtime, clusterID string, desired resource) error {
+			body, err := desired.json()
+			if err != nil {
+				return err
+			}
+			machinePool, err := cmv1.UnmarshalMachinePool(body)
+			if err != nil {
+				return err
+			}
+			_, err = r.OCMClient.CreateMachinePool(clusterID, machinePool)
+			return err
+		},
+		update: func(r *rosa.Runtime, clusterID string, desired resource, current object) error {
+			body, err := desired.patch(current)
+			if err != nil {
+				return err
+			}
+			machinePool, err := cmv1.UnmarshalMachinePool(body)
+			if err != nil {
+				return err
+			}
+			_, err = r.OCMClient.UpdateMachinePool(clusterID, machinePool)
+			return err
+		},
+		delete: func(r *rosa.Runtime, clusterID string, current object) error {
+			return r.OCMClient.DeleteMachinePool(clusterID, current.id)
+		},
+	},
+	nodePoolKind: {
+		key: "id",
+		list: func(r *rosa.Runtime, clusterID string) ([]object, error) {
+			nodePools, err := r.OCMClient.GetNodePools(clusterID)
+			if err != nil {
+				return nil, err
+			}
+			objects := []object{}
+			for _, nodePool := range nodePools {
+				fields, err := diff.Of(func(w io.Writer) error { return cmv1.MarshalNodePool(nodePool, w) })
+				if err != nil {
+					return nil, err
+				}
+				objects = append(objects, object{id: nodePool.ID(), name: nodePool.ID(), fields: fields})
+			}
+			return objects, nil
+		},
+		create: func(r *rosa.Runtime, clusterID string, desired resource) error {
+			body, err := desired.json()
+			if err != nil {
+				return err
+			}
+			nodePool, err := cmv1.UnmarshalNodePool(body)
+			if err != nil {
+				return err
+			}
+			_, err = r.OCMClient.CreateNodePool(clusterID, nodePool)
+			return err
+		},
+		update: func(r *rosa.Runtime, clusterID string, desired resource, current object) error {
+			body, err := desired.patch(current)
+			if err != nil {
+				return err
+			}
+			nodePool, err := cmv1.UnmarshalNodePool(body)
+			if err != nil {
+				return err
+			}
+			_, err = r.OCMClient.UpdateNodePool(clusterID, nodePool)
+			return err
+		},
+		delete: func(r *rosa.Runtime, clusterID string, current object) error {
+			return r.OCMClient.DeleteNodePool(clusterID, current.id)
+		},
+	},
+}
+
+// name returns the name of the resource or object with the given fields, or an empty string if it
+// doesn't have one.
+func (h kindHandler) name(fields map[string]interface{}) string {
+	if h.identify != nil {
+		return h.identify(fields)
+	}
+	if h.key == "" {
+		return ""
+	}
+	name, _ := fields[h.key].(string)
+	return name
+}
+
+// ingressName identifies an ingress by the fields that users set, since the identifier is generated by
+// the server: the default ingress is called 'default' and the others are named after their route
+// selectors.
+func ingressName(fields map[string]interface{}) string {
+	if isDefault, _ := fields["default"].(bool); isDefault {
+		return "default"
+	}
+	routeSelectors, _ := fields["route_selectors"].(map[string]interface{})
+	selectors := []string{}
+	for key, value := range routeSelectors {
+		selectors = append(selectors, fmt.Sprintf("%s=%v", key, value))
+	}
+	sort.Strings(selectors)
+	return strings.Join(selectors, ",")
+}
+
+// withoutFields returns a copy of the fields without the given paths, like 'github.client_secret'.
+func withoutFields(fields map[string]interface{}, paths []string) map[string]interface{} {
+	result := map[string]interface{}{}
+	for key, value := range fields {
+		result[key] = value
+	}
+	for _, path := range paths {
+		key, rest, nested := strings.Cut(path, ".")
+		if !nested {
+			delete(result, key)
+			continue
+		}
+		if child, ok := result[key].(map[string]interface{}); ok {
+			result[key] = withoutFields(child, []string{rest})
+		}
+	}
+	return result
+}
+
+func supportedKinds() string {
+	return strings.Join(kinds, ", ")
+}
+
+// mergeFields returns the current fields overridden by the desired ones.
+func mergeFields(current, desired map[string]interface{}) map[string]interface{} {
+	merged := map[string]interface{}{}
+	for k, v := range current {
+		merged[k] = v
+	}
+	for k, v := range desired {
+		desiredMap, desiredIsMap := v.(map[string]interface{})
+		currentMap, currentIsMap := merged[k].(map[string]interface{})
+		if desiredIsMap && currentIsMap && !diff.IsReplaced(k) {
+			merged[k] = mergeFields(currentMap, desiredMap)
+			continue
+		}
+		merged[k] = v
+	}
+	return merged
+}
+
+func kubeletConfigArgs(desired resource) (ocm.KubeletConfigArgs, error) {
+	body, err := desired.json()
+	if err != nil {
+		return ocm.KubeletConfigArgs{}, err
+	}
+	kubeletConfig, err := cmv1.UnmarshalKubeletConfig(body)
+	if err != nil {
+		return ocm.KubeletConfigArgs{}, err
+	}
+	return ocm.KubeletConfigArgs{PodPidsLimit: kubeletConfig.PodPidsLimit()}, nil
+}
+
+func autoscalerConfig(fields map[string]interface{}) (*ocm.AutoscalerConfig, error) {
+	body, err := json.Marshal(fields)
+	if err != nil {
+		return nil, err
+	}
+	autoscaler, err := cmv1.UnmarshalClusterAutoscaler(body)
+	if err != nil {
+		return nil, err
+	}
+	return ocm.AutoscalerConfigFrom(autoscaler)
+}
@@ -0,0 +1,636 @@
+/*
+Copyright (c) 2024 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package orphans
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+	"github.com/spf13/cobra"
+	errors "github.com/zgalor/weberr"
+
+	"github.com/openshift/rosa/cmd/dlt/oidcconfig"
+	"github.com/openshift/rosa/cmd/dlt/oidcprovider"
+	"github.com/openshift/rosa/cmd/dlt/operatorrole"
+	"github.com/openshift/rosa/pkg/aws"
+	"github.com/openshift/rosa/pkg/aws/tags"
+	"github.com/openshift/rosa/pkg/interactive"
+	"github.com/openshift/rosa/pkg/interactive/confirm"
+	"github.com/openshift/rosa/pkg/output"
+	"github.com/openshift/rosa/pkg/rosa"
+)
+
+const (
+	use   = "orphans"
+	short = "Delete the IAM and OIDC resources of clusters that no longer exist"
+	long  = "Find the operator roles, OIDC providers and OIDC configs of the current AWS account that " +
+		"aren't used by any cluster that still exists, for example after a failed install or after a " +
+		"cluster was deleted, and list them with their age.\n\n" +
+		"The check is scoped to the current OCM organization and environment: a resource is only " +
+		"considered orphaned when it can be proven that no cluster uses it. Operator roles must have the '" +
+		tags.RedHatManaged + "', '" + tags.OperatorNamespace + "' and '" + tags.OperatorName + "' tags. " +
+		"Operator roles and OIDC providers with the '" + tags.ClusterID + "' tag are orphaned when OCM " +
+		"has a record of the deletion of that cluster. Operator roles with the '" + tags.RolePrefix +
+		"' tag are orphaned when no cluster uses the prefix and the OIDC provider that they trust no " +
+		"longer exists. OIDC providers and configs of a reusable OIDC config are orphaned when the config " +
+		"is registered in the organization and no cluster uses it. The operator roles that trust such a " +
+		"provider are kept, because they are created before the cluster that uses them. Resources that may belong to clusters " +
+		"of another organization or environment are skipped. The policies attached to an operator role " +
+		"are deleted with it when no other role uses them.\n\n" +
+		"The resources are only listed unless the '--mode' flag is used, and only the resources created " +
+		"more than a day ago are included by default."
+	example = `  # List the orphaned resources that are older than a week
+  rosa cleanup orphans --older-than 168h
+
+  # Delete the orphaned resources
+  rosa cleanup orphans --mode auto
+
+  # Print the commands to delete the orphaned resources
+  rosa cleanup orphans --mode manual`
+
+	olderThanFlag = "older-than"
+
+	// defaultOlderThan skips the resources of clusters that are still being installed, and the OIDC
+	// configs and operator roles created before the cluster that uses them.
+	defaultOlderThan = 24 * time.Hour
+
+	operatorRoleType = "operator-role"
+	oidcProviderType = "oidc-provider"
+	oidcConfigType   = "oidc-config"
+)
+
+var typeDescriptions = map[string]string{
+	operatorRoleType: "operator role",
+	oidcProviderType: "OIDC provider",
+	oidcConfigType:   "OIDC config",
+}
+
+var args struct {
+	olderThan time.Duration
+}
+
+// now returns the current time, it is used to calculate the age of the resources.
+var now = time.Now
+
+func NewCleanupOrphansCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     use,
+		Short:   short,
+		Long:    long,
+		Example: example,
+		Args:    cobra.NoArgs,
+		Run:     rosa.DefaultRunner(rosa.RuntimeWithOCMAndAWS(), CleanupOrphansRunner()),
+	}
+
+	flags := cmd.Flags()
+	flags.SortFlags = false
+	flags.DurationVar(
+		&args.olderThan,
+		olderThanFlag,
+		defaultOlderThan,
+		"Only include the resources that were created at least this long ago, to skip the resources of "+
+			"clusters that are still being installed.",
+	)
+	interactive.AddModeFlag(cmd)
+	confirm.AddFlag(flags)
+	output.AddFlag(cmd)
+	return cmd
+}
+
+// Orphan is a resource that isn't used by any cluster that still exists.
+type Orphan struct {
+	Type       string    `json:"type"`
+	Name       string    `json:"name"`
+	ARN        string    `json:"arn,omitempty"`
+	CreateDate time.Time `json:"create_date"`
+	Reason     string    `json:"reason"`
+
+	managedPolicies bool
+	oidcConfig      *cmv1.OidcConfig
+}
+
+func CleanupOrphansRunner() rosa.CommandRunner {
+	return func(_ context.Context, r *rosa.Runtime, cmd *cobra.Command, _ []string) error {
+		mode, err := interactive.GetMode()
+		if err != nil {
+			return errors.BadRequest.Errorf("%v", err)
+		}
+		if mode != "" && output.HasFlag() {
+			return errors.BadRequest.Errorf("The '--output' flag can't be used with '--mode'")
+		}
+		if args.olderThan < 0 {
+			return errors.BadRequest.Errorf("The '--%s' flag must not be negative", olderThanFlag)
+		}
+
+		// Clusters in other AWS accounts of the organization can use the managed OIDC configs
+		clusters, err := r.OCMClient.ListClusters(nil, nil, "")
+		if err != nil {
+			return fmt.Errorf("Failed to list clusters: %v", err)
+		}
+		orphans, err := newOrphanFinder(r, clusters).find()
+		if err != nil {
+			return err
+		}
+
+		if output.HasFlag() {
+			return output.Print(orphans)
+		}
+		if len(orphans) == 0 {
+			r.Reporter.Infof("There are no orphaned resources")
+			return nil
+		}
+		printOrphans(orphans)
+
+		switch mode {
+		case interactive.ModeAuto:
+			return deleteOrphans(r, orphans)
+		case interactive.ModeManual:
+			commands, err := buildCommands(r, orphans)
+			if err != nil {
+				return err
+			}
+			if commands != "" {
+				if r.Reporter.IsTerminal() {
+					r.Reporter.Infof("Run the following commands to delete the orphaned resources:\n")
+				}
+				fmt.Println(commands)
+			}
+			// As with 'rosa delete oidc-config' the OIDC configs are removed from OCM in both modes
+			return deregisterOidcConfigs(r, orphans)
+		default:
+			r.Reporter.Infof("Run the command with '--mode auto' to delete the orphaned resources, or with " +
+				"'--mode manual' to print the commands to delete them")
+		}
+		return nil
+	}
+}
+
+// orphanFinder finds the resources that can be proven to be orphaned. A resource is only orphaned when
+// its ownership tags or its OIDC config tie it to a cluster that was deleted, or to an OIDC config of the
+// organization that no cluster uses. Resources that may belong to clusters of other organizations or
+// environments are skipped.
+type orphanFinder struct {
+	r *rosa.Runtime
+
+	// Identifiers of the resources used by the clusters that still exist
+	clusterIDs           map[string]bool
+	operatorRolePrefixes map[string]bool
+	oidcEndpoints        map[string]bool
+	oidcConfigIDs        map[string]bool
+
+	oidcConfigsByIssuer map[string]*cmv1.OidcConfig
+	deletedClusters     map[string]bool
+	orphanedProviders   map[string]bool
+	missingProviders    map[string]bool
+}
+
+func newOrphanFinder(r *rosa.Runtime, clusters []*cmv1.Cluster) *orphanFinder {
+	finder := &orphanFinder{
+		r:                    r,
+		clusterIDs:           map[string]bool{},
+		operatorRolePrefixes: map[string]bool{},
+		oidcEndpoints:        map[string]bool{},
+		oidcConfigIDs:        map[string]bool{},
+		oidcConfigsByIssuer:  map[string]*cmv1.OidcConfig{},
+		deletedClusters:      map[string]bool{},
+		orphanedProviders:    map[string]bool{},
+		missingProviders:     map[string]bool{},
+	}
+	for _, cluster := range clusters {
+		finder.clusterIDs[cluster.ID()] = true
+		sts := cluster.AWS().STS()
+		if prefix := sts.OperatorRolePrefix(); prefix != "" {
+			finder.operatorRolePrefixes[strings.ToLower(prefix)] = true
+		}
+		if endpoint := sts.OIDCEndpointURL(); endpoint != "" {
+			finder.oidcEndpoints[normalizeIssuerURL(endpoint)] = true
+		}
+		if id := sts.OidcConfig().ID(); id != "" {
+			finder.oidcConfigIDs[id] = true
+		}
+	}
+	return finder
+}
+
+// normalizeIssuerURL removes the scheme and the trailing slash of an issuer URL, so that it can be
+// compared with the URL in the ARN of the OIDC provider.
+func normalizeIssuerURL(issuerURL string) string {
+	return strings.TrimSuffix(strings.TrimPrefix(issuerURL, "https://"), "/")
+}
+
+// find returns the orphaned resources that are older than the '--older-than' flag. The OIDC configs and
+// providers are found first, because the operator roles that trust an orphaned provider are orphaned too.
+func (f *orphanFinder) find() ([]*Orphan, error) {
+	configs, err := f.findOidcConfigs()
+	if err != nil {
+		return nil, err
+	}
+	providers, err := f.findOidcProviders()
+	if err != nil {
+		return nil, err
+	}
+	roles, err := f.findOperatorRoles()
+	if err != nil {
+		return nil, err
+	}
+
+	result := []*Orphan{}
+	for _, orphan := range append(append(roles, providers...), configs...) {
+		if now().Sub(orphan.CreateDate) >= args.olderThan {
+			result = append(result, orphan)
+		}
+	}
+	return result, nil
+}
+
+// isClusterDeleted returns true when OCM has a record of the deletion of the cluster. A cluster that OCM
+// doesn't know about may belong to another organization or environment, so it isn't considered deleted.
+func (f *orphanFinder) isClusterDeleted(clusterID string) (bool, error) {
+	deleted, ok := f.deletedClusters[clusterID]
+	if ok {
+		return deleted, nil
+	}
+	subscription, err := f.r.OCMClient.GetClusterUsingSubscription(clusterID, f.r.Creator)
+	if err != nil {
+		return false, fmt.Errorf("Failed to check if cluster '%s' was deleted: %v", clusterID, err)
+	}
+	f.deletedClusters[clusterID] = subscription != nil
+	return subscription != nil, nil
+}
+
+// isProviderMissing returns true if the OIDC provider doesn't exist in the AWS account, or if it belongs
+// to a deleted cluster and will be deleted with the other resources.
+func (f *orphanFinder) isProviderMissing(providerARN string) (bool, error) {
+	if f.orphanedProviders[providerARN] {
+		return true, nil
+	}
+	missing, ok := f.missingProviders[providerARN]
+	if ok {
+		return missing, nil
+	}
+	parsedARN, err := arn.Parse(providerARN)
+	if err != nil {
+		return false, fmt.Errorf("Failed to parse OIDC provider ARN '%s': %v", providerARN, err)
+	}
+	issuerURL := "https://" + strings.TrimPrefix(parsedARN.Resource, "oidc-provider/")
+	exists, err := f.r.AWSClient.HasOpenIDConnectProvider(issuerURL, parsedARN.Partition, parsedARN.AccountID)
+	if err != nil {
+		return false, fmt.Errorf("Failed to check if OIDC provider '%s' exists: %v", providerARN, err)
+	}
+	f.missingProviders[providerARN] = !exists
+	return !exists, nil
+}
+
+// findOperatorRoles returns the orphaned operator roles. Only the roles tagged as operator roles managed
+// by Red Hat are considered, and they are grouped by their role prefix tag.
+func (f *orphanFinder) findOperatorRoles() ([]*Orphan, error) {
+	f.r.Reporter.Debugf("Loading operator roles")
+	rolesByPrefix, err := f.r.AWSClient.ListOperatorRoles("", "")
+	if err != nil {
+		return nil, fmt.Errorf("Failed to list operator roles: %v", err)
+	}
+	roles := []aws.OperatorRoleDetail{}
+	found := map[string]bool{}
+	for _, prefixRoles := range rolesByPrefix {
+		for _, role := range prefixRoles {
+			// A role with several policies is listed once for each of them
+			if found[role.RoleName] {
+				continue
+			}
+			found[role.RoleName] = true
+			if !role.RedHatManaged || role.OperatorNamespace == "" || role.OperatorName == "" {
+				f.r.Reporter.Debugf("Skipping role '%s', it isn't tagged as an operator role", role.RoleName)
+				continue
+			}
+			roles = append(roles, role)
+		}
+	}
+	sort.Slice(roles, func(i, j int) bool {
+		if roles[i].RolePrefix != roles[j].RolePrefix {
+			return roles[i].RolePrefix < roles[j].RolePrefix
+		}
+		return roles[i].RoleName < roles[j].RoleName
+	})
+
+	orphans := []*Orphan{}
+	for _, role := range roles {
+		reason, err := f.getOperatorRoleReason(role)
+		if err != nil {
+			return nil, err
+		}
+		if reason == "" {
+			continue
+		}
+		orphans = append(orphans, &Orphan{
+			Type:            operatorRoleType,
+			Name:            role.RoleName,
+			ARN:             role.RoleARN,
+			CreateDate:      role.CreateDate,
+			Reason:          reason,
+			managedPolicies: role.ManagedPolicy,
+		})
+	}
+	return orphans, nil
+}
+
+// getOperatorRoleReason returns why the operator role is orphaned, or an empty string if it isn't or if
+// that can't be proven.
+func (f *orphanFinder) getOperatorRoleReason(role aws.OperatorRoleDetail) (string, error) {
+	if role.ClusterID != "" {
+		if f.clusterIDs[role.ClusterID] {
+			return "", nil
+		}
+		deleted, err := f.isClusterDeleted(role.ClusterID)
+		if err != nil {
+			return "", err
+		}
+		if deleted {
+			return fmt.Sprintf("Cluster '%s' was deleted", role.ClusterID), nil
+		}
+	} else {
+		if role.RolePrefix == "" {
+			f.r.Reporter.Debugf("Skipping role '%s', it doesn't have the '%s' tag", role.RoleName, tags.RolePrefix)
+			return "", nil
+		}
+		if f.operatorRolePrefixes[strings.ToLower(role.RolePrefix)] {
+			return "", nil
+		}
+	}
+
+	// The role can't be used by any cluster when the OIDC provider that it trusts is gone
+	providerARN := getTrustedProvider(role.TrustPolicy)
+	if providerARN == "" {
+		return "", nil
+	}
+	missing, err := f.isProviderMissing(providerARN)
+	if err != nil || !missing {
+		return "", err
+	}
+	if role.ClusterID != "" {
+		return fmt.Sprintf("The OIDC provider of cluster '%s' doesn't exist", role.ClusterID), nil
+	}
+	return fmt.Sprintf("No cluster uses prefix '%s' and its OIDC provider was deleted", role.RolePrefix), nil
+}
+
+// getTrustedProvider returns the ARN of the OIDC provider that the trust policy of an operator role
+// trusts, or an empty string if it can't be determined.
+func getTrustedProvider(trustPolicy string) string {
+	document, err := aws.ParsePolicyDocument(trustPolicy)
+	if err != nil {
+		return ""
+	}
+	for _, statement := range document.Statement {
+		if statement.Principal != nil && strings.Contains(statement.Principal.Federated, ":oidc-provider/") {
+			return statement.Principal.Federated
+		}
+	}
+	return ""
+}
+
+func (f *orphanFinder) findOidcProviders() ([]*Orphan, error) {
+	f.r.Reporter.Debugf("Loading OIDC providers")
+	providers, err := f.r.AWSClient.ListOidcProviders("", nil)
+	if err != nil {
+		return nil, fmt.Errorf("Failed to list OIDC providers: %v", err)
+	}
+	orphans := []*Orphan{}
+	for _, provider := range providers {
+		issuerURL := provider.Arn
+		if index := strings.Index(provider.Arn, ":oidc-provider/"); index != -1 {
+			issuerURL = normalizeIssuerURL(provider.Arn[index+len(":oidc-provider/"):])
+		}
+		if f.oidcEndpoints[issuerURL] {
+			continue
+		}
+		reason := ""
+		if provider.ClusterId != "" {
+			if f.clusterIDs[provider.ClusterId] {
+				continue
+			}
+			deleted, err := f.isClusterDeleted(provider.ClusterId)
+			if err != nil {
+				return nil, err
+			}
+			if deleted {
+				reason = fmt.Sprintf("Cluster '%s' was deleted", provider.ClusterId)
+			}
+		} else if config := f.oidcConfigsByIssuer[issuerURL]; config != nil && !f.oidcConfigIDs[config.ID()] {
+			reason = fmt.Sprintf("No cluster uses OIDC config '%s'", config.ID())
+		}
+		if reason == "" {
+			f.r.Reporter.Debugf("Skipping OIDC provider '%s', it may be used by a cluster of another "+
+				"organization or environment", provider.Arn)
+			continue
+		}
+		createDate, err := f.r.AWSClient.GetOpenIDConnectProviderCreateDate(provider.Arn)
+		if err != nil {
+			return nil, err
+		}
+		// The operator roles of an OIDC config that no cluster uses yet may be waiting for their cluster,
+		// so only the providers of deleted clusters make the roles that trust them orphaned
+		if provider.ClusterId != "" {
+			f.orphanedProviders[provider.Arn] = true
+		}
+		orphans = append(orphans, &Orphan{
+			Type:       oidcProviderType,
+			Name:       issuerURL,
+			ARN:        provider.Arn,
+			CreateDate: createDate,
+			Reason:     reason,
+		})
+	}
+	return orphans, nil
+}
+
+// findOidcConfigs returns the OIDC configs registered in the organization for the AWS account that no
+// cluster uses.
+func (f *orphanFinder) findOidcConfigs() ([]*Orphan, error) {
+	f.r.Reporter.Debugf("Loading OIDC configs")
+	configs, err := f.r.OCMClient.ListOidcConfigs(f.r.Creator.AccountID)
+	if err != nil {
+		return nil, fmt.Errorf("Failed to list OIDC configs: %v", err)
+	}
+	orphans := []*Orphan{}
+	for _, config := range configs {
+		issuerURL := normalizeIssuerURL(config.IssuerUrl())
+		f.oidcConfigsByIssuer[issuerURL] = config
+		if f.oidcConfigIDs[config.ID()] || f.oidcEndpoints[issuerURL] {
+			continue
+		}
+		orphans = append(orphans, &Orphan{
+			Type:       oidcConfigType,
+			Name:       config.ID(),
+			CreateDate: config.CreationTimestamp(),
+			Reason:     fmt.Sprintf("No cluster uses OIDC config '%s'", config.ID()),
+			oidcConfig: config,
+		})
+	}
+	return orphans, nil
+}
+
+func printOrphans(orphans []*Orphan) {
+	writer := tabwriter.NewWriter(os.Stdout, 0, 4, 2, ' ', 0)
+	fmt.Fprintf(writer, "TYPE\tNAME\tAGE\tREASON\n")
+	for _, orphan := range orphans {
+		fmt.Fprintf(writer, "%s\t%s\t%s\t%s\n", orphan.Type, orphan.Name, formatAge(orphan.CreateDate),
+			orphan.Reason)
+	}
+	writer.Flush()
+}
+
+// formatAge returns the time since the date in the largest unit that fits, for example '3d'.
+func formatAge(date time.Time) string {
+	if date.IsZero() {
+		return "-"
+	}
+	age := now().Sub(date)
+	switch {
+	case age >= 24*time.Hour:
+		return fmt.Sprintf("%dd", int(age/(24*time.Hour)))
+	case age >= time.Hour:
+		return fmt.Sprintf("%dh", int(age/time.Hour))
+	}
+	return fmt.Sprintf("%dm", int(age/time.Minute))
+}
+
+func deleteOrphans(r *rosa.Runtime, orphans []*Orphan) error {
+	failed := 0
+	for _, orphan := range orphans {
+		description := typeDescriptions[orphan.Type]
+		if !confirm.Prompt(false, "Delete the %s '%s'?", description, orphan.Name) {
+			continue
+		}
+		r.Reporter.Infof("Deleting %s '%s'", description, orphan.Name)
+		err := deleteOrphan(r, orphan)
+		if err != nil {
+			r.Reporter.Warnf("Failed to delete %s '%s': %v", description, orphan.Name, err)
+			failed++
+		}
+	}
+	if failed > 0 {
+		return fmt.Errorf("Failed to delete %d of the %d orphaned resources", failed, len(orphans))
+	}
+	r.Reporter.Infof("Successfully deleted the orphaned resources")
+	return nil
+}
+
+func deleteOrphan(r *rosa.Runtime, orphan *Orphan) error {
+	switch orphan.Type {
+	case operatorRoleType:
+		return r.AWSClient.DeleteOperatorRole(orphan.Name, orphan.managedPolicies)
+	case oidcProviderType:
+		return r.AWSClient.DeleteOpenIDConnectProvider(orphan.ARN)
+	}
+
+	config := orphan.oidcConfig
+	if !config.Managed() {
+		bucketName, region, err := getOidcConfigBucket(config)
+		if err != nil {
+			return err
+		}
+		if region != r.AWSClient.GetRegion() {
+			return fmt.Errorf("The private key secret is in region '%s', run the command with '--region %s'",
+				region, region)
+		}
+		err = r.AWSClient.DeleteSecretInSecretsManager(config.SecretArn())
+		if err != nil {
+			return err
+		}
+		err = r.AWSClient.DeleteS3Bucket(bucketName)
+		if err != nil {
+			return err
+		}
+	}
+	return r.OCMClient.DeleteOidcConfig(config.ID())
+}
+
+// getOidcConfigBucket returns the name and the region of the S3 bucket of an unmanaged OIDC config.
+func getOidcConfigBucket(config *cmv1.OidcConfig) (string, string, error) {
+	secretArn, err := arn.Parse(config.SecretArn())
+	if err != nil {
+		return "", "", fmt.Errorf("Failed to parse secret ARN '%s': %v", config.SecretArn(), err)
+	}
+	bucketName, err := oidcconfig.GetBucketNameFromSecretArn(config.SecretArn())
+	if err != nil {
+		return "", "", fmt.Errorf("Failed to parse secret ARN '%s': %v", config.SecretArn(), err)
+	}
+	return bucketName, secretArn.Region, nil
+}
+
+// buildCommands returns the AWS CLI commands that delete the orphaned AWS resources.
+func buildCommands(r *rosa.Runtime, orphans []*Orphan) (string, error) {
+	roleNames := []string{}
+	for _, orphan := range orphans {
+		if orphan.Type == operatorRoleType {
+			roleNames = append(roleNames, orphan.Name)
+		}
+	}
+	policies := map[string][]string{}
+	if len(roleNames) > 0 {
+		var err error
+		policies, err = r.AWSClient.GetPolicies(roleNames)
+		if err != nil {
+			return "", fmt.Errorf("Failed to get the policies of the operator roles: %v", err)
+		}
+	}
+
+	commands := []string{}
+	for _, orphan := range orphans {
+		switch orphan.Type {
+		case operatorRoleType:
+			commands = append(commands,
+				operatorrole.BuildCommand([]string{orphan.Name}, policies, orphan.managedPolicies))
+		case oidcProviderType:
+			commands = append(commands, oidcprovider.BuildCommand(orphan.ARN))
+		case oidcConfigType:
+			if orphan.oidcConfig.Managed() {
+				continue
+			}
+			bucketName, region, err := getOidcConfigBucket(orphan.oidcConfig)
+			if err != nil {
+				return "", err
+			}
+			commands = append(commands, oidcconfig.BuildCommands(bucketName, orphan.oidcConfig.SecretArn(), region))
+		}
+	}
+	return strings.Join(commands, "\n"), nil
+}
+
+// deregisterOidcConfigs removes the orphaned OIDC configs from OCM.
+func deregisterOidcConfigs(r *rosa.Runtime, orphans []*Orphan) error {
+	for _, orphan := range orphans {
+		if orphan.Type != oidcConfigType {
+			continue
+		}
+		if !confirm.Prompt(false, "Remove the OIDC config '%s' from OCM?", orphan.Name) {
+			continue
+		}
+		err := r.OCMClient.DeleteOidcConfig(orphan.Name)
+		if err != nil {
+			return fmt.Errorf("Failed to remove the OIDC config '%s' from OCM: %v", orphan.Name, err)
+		}
+		r.Reporter.Infof("Removed the OIDC config '%s' from OCM", orphan.Name)
+	}
+	return nil
+}
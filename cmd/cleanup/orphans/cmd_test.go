@@ -0,0 +1,250 @@
+/*
+Copyright (c) 2024 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package orphans
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/ghttp"
+	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+	. "github.com/openshift-online/ocm-sdk-go/testing"
+	"github.com/spf13/cobra"
+	"go.uber.org/mock/gomock"
+
+	"github.com/openshift/rosa/pkg/aws"
+	"github.com/openshift/rosa/pkg/rosa"
+	. "github.com/openshift/rosa/pkg/test"
+)
+
+func TestCleanupOrphans(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "rosa cleanup orphans")
+}
+
+const (
+	liveProviderARN          = "arn:aws:iam::123:oidc-provider/oidc.example.com/live"
+	oldProviderARN           = "arn:aws:iam::123:oidc-provider/oidc.example.com/old"
+	otherProviderARN         = "arn:aws:iam::123:oidc-provider/oidc.example.com/other"
+	oldConfigProviderARN     = "arn:aws:iam::123:oidc-provider/oidc.example.com/old-config"
+	unknownConfigProviderARN = "arn:aws:iam::123:oidc-provider/oidc.example.com/unknown-config"
+)
+
+var _ = Describe("rosa cleanup orphans", func() {
+	var t *TestingRuntime
+	var awsClient *aws.MockClient
+	var cmd *cobra.Command
+	current := time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+
+	BeforeEach(func() {
+		cmd = NewCleanupOrphansCommand()
+		t = NewTestRuntime()
+		awsClient = aws.NewMockClient(gomock.NewController(GinkgoT()))
+		t.RosaRuntime.AWSClient = awsClient
+		t.RosaRuntime.Creator.AccountID = "123"
+		now = func() time.Time {
+			return current
+		}
+	})
+
+	AfterEach(func() {
+		now = time.Now
+	})
+
+	run := func() (string, error) {
+		stdout, _, err := RunWithOutputCapture(func(r *rosa.Runtime, cmd *cobra.Command) error {
+			return CleanupOrphansRunner()(context.Background(), r, cmd, nil)
+		}, t.RosaRuntime, cmd)
+		return stdout, err
+	}
+
+	Context("Validation", func() {
+		It("Fails with '--output' and '--mode'", func() {
+			Expect(cmd.Flags().Set("mode", "auto")).To(Succeed())
+			Expect(cmd.Flags().Set("output", "json")).To(Succeed())
+			_, err := run()
+			Expect(err).To(MatchError("The '--output' flag can't be used with '--mode'"))
+		})
+
+		It("Fails with a negative '--older-than'", func() {
+			Expect(cmd.Flags().Set(olderThanFlag, "-1h")).To(Succeed())
+			_, err := run()
+			Expect(err).To(MatchError("The '--older-than' flag must not be negative"))
+		})
+	})
+
+	Context("Runner", func() {
+		BeforeEach(func() {
+			cluster := MockCluster(func(c *cmv1.ClusterBuilder) {
+				c.ID("live-cluster")
+				c.AWS(cmv1.NewAWS().STS(cmv1.NewSTS().
+					OperatorRolePrefix("Live").
+					OIDCEndpointURL("https://oidc.example.com/live").
+					OidcConfig(cmv1.NewOidcConfig().ID("live-config"))))
+			})
+			liveConfig, err := cmv1.NewOidcConfig().ID("live-config").
+				IssuerUrl("https://oidc.example.com/live").Build()
+			Expect(err).NotTo(HaveOccurred())
+			oldConfig, err := cmv1.NewOidcConfig().ID("old-config").Managed(true).
+				IssuerUrl("https://oidc.example.com/old-config").
+				CreationTimestamp(current.Add(-50 * time.Hour)).Build()
+			Expect(err).NotTo(HaveOccurred())
+			t.ApiServer.AppendHandlers(
+				RespondWithJSON(http.StatusOK, FormatClusterList([]*cmv1.Cluster{cluster})),
+				RespondWithJSON(http.StatusOK, FormatOidcConfigList([]*cmv1.OidcConfig{liveConfig, oldConfig})),
+				// OCM has a record of the deletion of 'deleted-cluster'
+				RespondWithJSON(http.StatusOK, `{"kind": "SubscriptionList", "page": 1, "size": 1, "total": 1,
+					"items": [{"id": "123", "cluster_id": "deleted-cluster", "status": "Deprovisioned"}]}`),
+				// 'other-cluster' may belong to another organization or environment
+				RespondWithJSON(http.StatusOK, `{"kind": "SubscriptionList", "page": 1, "size": 0, "total": 0,
+					"items": []}`),
+			)
+
+			awsClient.EXPECT().ListOidcProviders("", nil).Return([]aws.OidcProviderOutput{
+				{Arn: liveProviderARN},
+				{Arn: oldProviderARN, ClusterId: "deleted-cluster"},
+				{Arn: otherProviderARN, ClusterId: "other-cluster"},
+				{Arn: oldConfigProviderARN},
+				{Arn: unknownConfigProviderARN},
+			}, nil)
+			awsClient.EXPECT().GetOpenIDConnectProviderCreateDate(oldProviderARN).Return(
+				current.Add(-30*time.Minute), nil)
+			awsClient.EXPECT().GetOpenIDConnectProviderCreateDate(oldConfigProviderARN).Return(
+				current.Add(-50*time.Hour), nil)
+
+			deletedRole := operatorRole("old-openshift-ingress-operator-cloud-credentials", "old",
+				oldProviderARN)
+			deletedRole.ClusterID = "deleted-cluster"
+			deletedRole.CreateDate = current.Add(-72 * time.Hour)
+			unusedRole := operatorRole("unused-kube-system-capa-controller-manager", "unused", oldConfigProviderARN)
+			unusedRole.CreateDate = current.Add(-2 * time.Hour)
+			unusedRole.ManagedPolicy = true
+			otherRole := operatorRole("other-openshift-image-registry-installer-cloud-credentials", "other",
+				otherProviderARN)
+			otherRole.ClusterID = "other-cluster"
+			awsClient.EXPECT().ListOperatorRoles("", "").Return(map[string][]aws.OperatorRoleDetail{
+				"live": {operatorRole("Live-openshift-ingress-operator-cloud-credentials", "Live", liveProviderARN)},
+				// Roles with several policies are listed once for each policy
+				"old":    {deletedRole, deletedRole},
+				"unused": {unusedRole},
+				"other":  {otherRole},
+				"shared": {operatorRole("shared-openshift-cloud-credential-operator-cloud-credential-operato",
+					"shared", unknownConfigProviderARN)},
+				// Roles that aren't tagged as operator roles are never deleted
+				"my": {{RoleName: "my-openshift-Installer-Role", RoleARN: "arn:aws:iam::123:role/my-openshift-Installer-Role"}},
+			}, nil)
+			awsClient.EXPECT().HasOpenIDConnectProvider("https://oidc.example.com/other", "aws", "123").
+				Return(true, nil)
+			awsClient.EXPECT().HasOpenIDConnectProvider("https://oidc.example.com/unknown-config", "aws", "123").
+				Return(true, nil)
+			// The roles that trust the provider of an unused OIDC config may be waiting for their cluster
+			awsClient.EXPECT().HasOpenIDConnectProvider("https://oidc.example.com/old-config", "aws", "123").
+				Return(true, nil)
+		})
+
+		It("Lists the resources that aren't used by any cluster", func() {
+			Expect(cmd.Flags().Set(olderThanFlag, "0")).To(Succeed())
+			stdout, err := run()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(stdout).To(Equal("" +
+				"TYPE           NAME                                              AGE  REASON\n" +
+				"operator-role  old-openshift-ingress-operator-cloud-credentials  3d   " +
+				"Cluster 'deleted-cluster' was deleted\n" +
+				"oidc-provider  oidc.example.com/old                              30m  " +
+				"Cluster 'deleted-cluster' was deleted\n" +
+				"oidc-provider  oidc.example.com/old-config                       2d   " +
+				"No cluster uses OIDC config 'old-config'\n" +
+				"oidc-config    old-config                                        2d   " +
+				"No cluster uses OIDC config 'old-config'\n" +
+				"INFO: Run the command with '--mode auto' to delete the orphaned resources, or with " +
+				"'--mode manual' to print the commands to delete them\n"))
+		})
+
+		It("Skips the resources created less than a day ago by default", func() {
+			stdout, err := run()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(stdout).To(ContainSubstring("old-openshift-ingress-operator-cloud-credentials"))
+			Expect(stdout).To(ContainSubstring("oidc-config    old-config"))
+			Expect(stdout).NotTo(ContainSubstring("oidc.example.com/old "))
+		})
+
+		It("Skips the resources that are newer than '--older-than'", func() {
+			Expect(cmd.Flags().Set(olderThanFlag, "60h")).To(Succeed())
+			stdout, err := run()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(stdout).To(ContainSubstring("old-openshift-ingress-operator-cloud-credentials"))
+			Expect(stdout).NotTo(ContainSubstring("old-config"))
+		})
+
+		It("Deletes the resources in auto mode", func() {
+			Expect(cmd.Flags().Set("mode", "auto")).To(Succeed())
+			Expect(cmd.Flags().Set("yes", "true")).To(Succeed())
+			awsClient.EXPECT().DeleteOperatorRole("old-openshift-ingress-operator-cloud-credentials", false)
+			awsClient.EXPECT().DeleteOpenIDConnectProvider(oldConfigProviderARN)
+			t.ApiServer.AppendHandlers(ghttp.CombineHandlers(
+				ghttp.VerifyRequest(http.MethodDelete, "/api/clusters_mgmt/v1/oidc_configs/old-config"),
+				ghttp.RespondWith(http.StatusNoContent, nil),
+			))
+			_, err := run()
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("Prints the commands in manual mode", func() {
+			Expect(cmd.Flags().Set("mode", "manual")).To(Succeed())
+			Expect(cmd.Flags().Set("yes", "true")).To(Succeed())
+			awsClient.EXPECT().GetPolicies([]string{
+				"old-openshift-ingress-operator-cloud-credentials",
+			}).Return(map[string][]string{
+				"old-openshift-ingress-operator-cloud-credentials": {"arn:aws:iam::123:policy/old-ingress"},
+			}, nil)
+			t.ApiServer.AppendHandlers(ghttp.CombineHandlers(
+				ghttp.VerifyRequest(http.MethodDelete, "/api/clusters_mgmt/v1/oidc_configs/old-config"),
+				ghttp.RespondWith(http.StatusNoContent, nil),
+			))
+			stdout, err := run()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(stdout).To(ContainSubstring("aws iam detach-role-policy \\\n" +
+				"\t--policy-arn arn:aws:iam::123:policy/old-ingress \\\n" +
+				"\t--role-name old-openshift-ingress-operator-cloud-credentials\n"))
+			Expect(stdout).To(ContainSubstring("aws iam delete-policy \\\n" +
+				"\t--policy-arn arn:aws:iam::123:policy/old-ingress\n"))
+			Expect(stdout).To(ContainSubstring("aws iam delete-open-id-connect-provider \\\n" +
+				"\t--open-id-connect-provider-arn " + oldConfigProviderARN + "\n"))
+			Expect(stdout).NotTo(ContainSubstring("unused-kube-system-capa-controller-manager"))
+			Expect(stdout).To(ContainSubstring("INFO: Removed the OIDC config 'old-config' from OCM"))
+		})
+	})
+})
+
+// operatorRole returns an operator role with the tags of the roles created by rosa, that trusts the OIDC
+// provider.
+func operatorRole(name string, prefix string, providerARN string) aws.OperatorRoleDetail {
+	return aws.OperatorRoleDetail{
+		RoleName:          name,
+		RoleARN:           "arn:aws:iam::123:role/" + name,
+		OperatorNamespace: "openshift-ingress-operator",
+		OperatorName:      "cloud-credentials",
+		RedHatManaged:     true,
+		RolePrefix:        prefix,
+		TrustPolicy: `{"Version": "2012-10-17", "Statement": [{"Effect": "Allow", ` +
+			`"Principal": {"Federated": "` + providerARN + `"}, "Action": "sts:AssumeRoleWithWebIdentity"}]}`,
+	}
+}
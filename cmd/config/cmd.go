@@ -23,7 +23,10 @@ import (
 	"github.com/spf13/cobra"
 
 	"github.com/openshift/rosa/cmd/config/get"
+	"github.com/openshift/rosa/cmd/config/getcontexts"
 	"github.com/openshift/rosa/cmd/config/set"
+	"github.com/openshift/rosa/cmd/config/setcontext"
+	"github.com/openshift/rosa/cmd/config/usecontext"
 	"github.com/openshift/rosa/pkg/config"
 	"github.com/openshift/rosa/pkg/properties"
 )
@@ -43,6 +46,11 @@ The following variables are supported:
 
 %s
 
+Contexts store several sets of these variables, like logins to different environments or
+organizations, together with default AWS profiles and regions. Use "rosa config set-context",
+"rosa config use-context" and "rosa config get-contexts" to manage them, and the '--context'
+flag to use a context other than the current one.
+
 Note that "rosa config get access_token" gives whatever the file contains - may be missing or expired;
 you probably want "rosa token" command instead which will obtain a fresh token if needed.
 
@@ -66,6 +74,9 @@ func NewConfigCommand() *cobra.Command {
 	}
 	Cmd.AddCommand(get.Cmd)
 	Cmd.AddCommand(set.Cmd)
+	Cmd.AddCommand(getcontexts.Cmd)
+	Cmd.AddCommand(setcontext.Cmd)
+	Cmd.AddCommand(usecontext.Cmd)
 	return Cmd
 }
 
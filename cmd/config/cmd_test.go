@@ -11,6 +11,7 @@ import (
 	. "github.com/onsi/gomega"
 
 	"github.com/openshift/rosa/cmd/config/get"
+	"github.com/openshift/rosa/cmd/config/getcontexts"
 	"github.com/openshift/rosa/cmd/config/set"
 	"github.com/openshift/rosa/pkg/config"
 	"github.com/openshift/rosa/pkg/test"
@@ -151,6 +152,40 @@ var _ = Describe("Run Command", Ordered, func() {
 		})
 	})
 
+	When("There are contexts", Ordered, func() {
+		var contextsBuf *bytes.Buffer
+
+		BeforeAll(func() {
+			contextsBuf = new(bytes.Buffer)
+			getcontexts.Writer = contextsBuf
+			tmpdir, err = os.MkdirTemp("/tmp", ".ocm-config-*")
+			os.Setenv("OCM_CONFIG", tmpdir+"/ocm_config.json")
+		})
+
+		AfterAll(func() {
+			os.Setenv("OCM_CONFIG", "")
+		})
+
+		It("Prints the contexts without credentials", func() {
+			err = config.Save(&config.Config{URL: "https://api.stage.openshift.com", AccessToken: "secret"})
+			Expect(err).To(BeNil())
+			_, err = config.SetContext("stage", func(context *config.ContextConfig) {
+				context.AWSRegion = "us-west-2"
+			})
+			Expect(err).To(BeNil())
+			_, err = config.SetContext("prod", func(context *config.ContextConfig) {})
+			Expect(err).To(BeNil())
+
+			err = getcontexts.PrintContexts()
+			Expect(err).To(BeNil())
+			Expect(contextsBuf.String()).To(Equal(
+				"CURRENT  NAME   URL                              FEDRAMP  AWS PROFILE  AWS REGION\n" +
+					"         prod   https://api.openshift.com        false                 \n" +
+					"*        stage  https://api.stage.openshift.com  false                 us-west-2\n"))
+			Expect(contextsBuf.String()).NotTo(ContainSubstring("secret"))
+		})
+	})
+
 	When("Config file doesn't exist", func() {
 		AfterEach(func() {
 			os.Setenv("OCM_CONFIG", "")
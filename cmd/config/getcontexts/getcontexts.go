@@ -0,0 +1,120 @@
+/*
+Copyright (c) 2024 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package getcontexts
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"text/tabwriter"
+
+	sdk "github.com/openshift-online/ocm-sdk-go"
+	"github.com/spf13/cobra"
+
+	"github.com/openshift/rosa/pkg/config"
+	"github.com/openshift/rosa/pkg/output"
+	"github.com/openshift/rosa/pkg/reporter"
+	"github.com/openshift/rosa/pkg/rosa"
+)
+
+var (
+	Writer io.Writer = os.Stdout
+)
+
+var Cmd = NewGetContextsCommand()
+
+func NewGetContextsCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     "get-contexts",
+		Aliases: []string{"get-context"},
+		Short:   "List contexts",
+		Long: "Lists the contexts, marking the current one with '*'. Commands use the current context " +
+			"unless another one is selected with the '--context' flag.",
+		Example: `  # List the contexts
+  rosa config get-contexts`,
+		Args: cobra.NoArgs,
+		Run:  run,
+	}
+	output.AddFlag(cmd)
+	return cmd
+}
+
+// contextSummary contains the details of a context that can be printed, which never include
+// credentials.
+type contextSummary struct {
+	Name       string `json:"name"`
+	Current    bool   `json:"current"`
+	URL        string `json:"url"`
+	FedRAMP    bool   `json:"fedramp"`
+	AWSProfile string `json:"aws_profile,omitempty"`
+	AWSRegion  string `json:"aws_region,omitempty"`
+}
+
+func run(_ *cobra.Command, _ []string) {
+	r := rosa.NewRuntime()
+
+	err := PrintContexts()
+	if err != nil {
+		r.Reporter.Errorf("Failed to list contexts: %v", err)
+		os.Exit(reporter.ExitCode())
+	}
+}
+
+func PrintContexts() error {
+	contexts, current, err := config.GetContexts()
+	if err != nil {
+		return err
+	}
+
+	summaries := []contextSummary{}
+	for _, name := range config.SortedContextNames(contexts) {
+		context := contexts[name]
+		url := context.URL
+		if url == "" {
+			url = sdk.DefaultURL
+		}
+		summaries = append(summaries, contextSummary{
+			Name:       name,
+			Current:    name == current,
+			URL:        url,
+			FedRAMP:    context.FedRAMP,
+			AWSProfile: context.AWSProfile,
+			AWSRegion:  context.AWSRegion,
+		})
+	}
+
+	if output.HasFlag() {
+		return output.Print(summaries)
+	}
+
+	if len(summaries) == 0 {
+		fmt.Fprintf(Writer, "There are no contexts, create one with 'rosa config set-context'\n")
+		return nil
+	}
+
+	writer := tabwriter.NewWriter(Writer, 0, 4, 2, ' ', 0)
+	fmt.Fprintf(writer, "CURRENT\tNAME\tURL\tFEDRAMP\tAWS PROFILE\tAWS REGION\n")
+	for _, summary := range summaries {
+		marker := ""
+		if summary.Current {
+			marker = "*"
+		}
+		fmt.Fprintf(writer, "%s\t%s\t%s\t%t\t%s\t%s\n",
+			marker, summary.Name, summary.URL, summary.FedRAMP, summary.AWSProfile, summary.AWSRegion)
+	}
+	return writer.Flush()
+}
@@ -0,0 +1,94 @@
+/*
+Copyright (c) 2024 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package setcontext
+
+import (
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/openshift/rosa/pkg/config"
+	"github.com/openshift/rosa/pkg/reporter"
+	"github.com/openshift/rosa/pkg/rosa"
+)
+
+var args struct {
+	awsProfile string
+	awsRegion  string
+}
+
+var Cmd = NewSetContextCommand()
+
+func NewSetContextCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:   "set-context NAME",
+		Short: "Create or update a context",
+		Long: "Creates a context with the given name, or updates it if it already exists.\n\n" +
+			"If there is no current context, the current login becomes the given context. Otherwise the " +
+			"context is created empty, and 'rosa login --context NAME' stores the credentials in it.",
+		Example: `  # Name the current login 'production' and use the 'prod' AWS profile with it
+  rosa config set-context production --aws-profile prod
+
+  # Create a context for the staging environment and log in to it
+  rosa config set-context staging --aws-region us-west-2
+  rosa login --context staging --env staging`,
+		Args: cobra.ExactArgs(1),
+		Run:  run,
+	}
+
+	flags := cmd.Flags()
+	flags.StringVar(
+		&args.awsProfile,
+		"aws-profile",
+		"",
+		"AWS profile used by default in this context. Use an empty value to remove it.",
+	)
+	flags.StringVar(
+		&args.awsRegion,
+		"aws-region",
+		"",
+		"AWS region used by default in this context. Use an empty value to remove it.",
+	)
+	return cmd
+}
+
+func run(cmd *cobra.Command, argv []string) {
+	r := rosa.NewRuntime()
+
+	created, err := SetContext(argv[0], cmd)
+	if err != nil {
+		r.Reporter.Errorf("Failed to set context: %v", err)
+		os.Exit(reporter.ExitCode())
+	}
+	if created {
+		r.Reporter.Infof("Context '%s' created", argv[0])
+	} else {
+		r.Reporter.Infof("Context '%s' updated", argv[0])
+	}
+}
+
+// SetContext creates or updates the given context with the AWS defaults set in the command line.
+func SetContext(name string, cmd *cobra.Command) (bool, error) {
+	return config.SetContext(name, func(context *config.ContextConfig) {
+		if cmd.Flags().Changed("aws-profile") {
+			context.AWSProfile = args.awsProfile
+		}
+		if cmd.Flags().Changed("aws-region") {
+			context.AWSRegion = args.awsRegion
+		}
+	})
+}
@@ -0,0 +1,59 @@
+/*
+Copyright (c) 2024 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package usecontext
+
+import (
+	"os"
+
+	"github.com/spf13/cobra"
+
+	"github.com/openshift/rosa/pkg/config"
+	"github.com/openshift/rosa/pkg/reporter"
+	"github.com/openshift/rosa/pkg/rosa"
+)
+
+var Cmd = NewUseContextCommand()
+
+func NewUseContextCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:   "use-context NAME",
+		Short: "Switch to another context",
+		Long: "Makes the given context the current one, so that the following commands use its " +
+			"credentials, OCM environment and AWS defaults without logging in again.\n\n" +
+			"If the current login doesn't belong to a context it is saved as the '" +
+			config.DefaultContextName + "' context.",
+		Example: `  # Switch to the 'staging' context
+  rosa config use-context staging
+
+  # Log in to a new context without switching to it, and switch later
+  rosa login --context staging --env staging
+  rosa config use-context staging`,
+		Args: cobra.ExactArgs(1),
+		Run:  run,
+	}
+}
+
+func run(_ *cobra.Command, argv []string) {
+	r := rosa.NewRuntime()
+
+	err := config.UseContext(argv[0])
+	if err != nil {
+		r.Reporter.Errorf("Failed to switch context: %v", err)
+		os.Exit(reporter.ExitCode())
+	}
+	r.Reporter.Infof("Switched to context '%s'", argv[0])
+}
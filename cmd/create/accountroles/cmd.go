@@ -26,9 +26,11 @@ import (
 	"github.com/openshift/rosa/cmd/verify/oc"
 	"github.com/openshift/rosa/cmd/verify/quota"
 	"github.com/openshift/rosa/pkg/aws"
+	"github.com/openshift/rosa/pkg/aws/iac"
 	"github.com/openshift/rosa/pkg/interactive"
 	"github.com/openshift/rosa/pkg/interactive/confirm"
 	"github.com/openshift/rosa/pkg/ocm"
+	"github.com/openshift/rosa/pkg/reporter"
 	"github.com/openshift/rosa/pkg/rosa"
 )
 
@@ -53,7 +55,10 @@ var Cmd = &cobra.Command{
   rosa create account-roles
 
   # Create account roles with a specific permissions boundary
-  rosa create account-roles --permissions-boundary arn:aws:iam::123456789012:policy/perm-boundary`,
+  rosa create account-roles --permissions-boundary arn:aws:iam::123456789012:policy/perm-boundary
+
+  # Generate a Terraform configuration for the account roles instead of AWS CLI commands
+  rosa create account-roles --mode manual --output-format terraform > account-roles.tf`,
 	Run:  run,
 	Args: cobra.NoArgs,
 }
@@ -134,6 +139,7 @@ func init() {
 	)
 
 	interactive.AddModeFlag(Cmd)
+	iac.AddFlag(Cmd)
 
 	confirm.AddFlag(flags)
 	interactive.AddFlag(flags)
@@ -145,7 +151,7 @@ func run(cmd *cobra.Command, argv []string) {
 	mode, err := interactive.GetMode()
 	if err != nil {
 		r.Reporter.Errorf("%s", err)
-		os.Exit(1)
+		os.Exit(reporter.ExitCode())
 	}
 
 	// If necessary, call `login` as part of `init`. We do this before
@@ -154,7 +160,7 @@ func run(cmd *cobra.Command, argv []string) {
 	err = login.Call(cmd, argv, r.Reporter)
 	if err != nil {
 		r.Reporter.Errorf("Failed to login to OCM: %v", err)
-		os.Exit(1)
+		os.Exit(reporter.ExitCode())
 	}
 	r.WithOCM()
 	defer r.Cleanup()
@@ -162,13 +168,13 @@ func run(cmd *cobra.Command, argv []string) {
 	env, err := ocm.GetEnv()
 	if err != nil {
 		r.Reporter.Errorf("Failed to determine OCM environment: %v", err)
-		os.Exit(1)
+		os.Exit(reporter.ExitCode())
 	}
 
 	managedPolicies := args.managed
 	if args.forcePolicyCreation && managedPolicies {
 		r.Reporter.Warnf("Forcing creation of policies only works for unmanaged policies")
-		os.Exit(1)
+		os.Exit(reporter.ExitCode())
 	}
 
 	if args.hostedCP && cmd.Flags().Changed("version") {
@@ -191,18 +197,18 @@ func run(cmd *cobra.Command, argv []string) {
 			managedPolicies = false
 		} else {
 			r.Reporter.Errorf("Setting `hosted-cp` as unmanaged policies is not supported")
-			os.Exit(1)
+			os.Exit(reporter.ExitCode())
 		}
 	}
 
 	if isManagedSet && env == ocm.Production {
 		r.Reporter.Errorf("Classic ROSA managed policies are not supported in this environment")
-		os.Exit(1)
+		os.Exit(reporter.ExitCode())
 	}
 
 	if isHostedCPValueSet && r.Creator.IsGovcloud {
 		r.Reporter.Errorf("Setting `hosted-cp` is not supported for Govcloud AWS accounts")
-		os.Exit(1)
+		os.Exit(reporter.ExitCode())
 	}
 
 	// Validate AWS credentials for current user
@@ -213,12 +219,12 @@ func run(cmd *cobra.Command, argv []string) {
 	if err != nil {
 		r.OCMClient.LogEvent("ROSAInitCredentialsFailed", nil)
 		r.Reporter.Errorf("Error validating AWS credentials: %v", err)
-		os.Exit(1)
+		os.Exit(reporter.ExitCode())
 	}
 	if !ok {
 		r.OCMClient.LogEvent("ROSAInitCredentialsInvalid", nil)
 		r.Reporter.Errorf("AWS credentials are invalid")
-		os.Exit(1)
+		os.Exit(reporter.ExitCode())
 	}
 	if r.Reporter.IsTerminal() {
 		r.Reporter.Infof("AWS credentials are valid!")
@@ -244,7 +250,7 @@ func run(cmd *cobra.Command, argv []string) {
 	policyVersion, err := r.OCMClient.GetPolicyVersion(version, channelGroup)
 	if err != nil {
 		r.Reporter.Errorf("Error getting version: %s", err)
-		os.Exit(1)
+		os.Exit(reporter.ExitCode())
 	}
 
 	r.Reporter.Debugf("Creating account roles compatible with OpenShift versions up to %s", policyVersion)
@@ -263,20 +269,20 @@ func run(cmd *cobra.Command, argv []string) {
 		})
 		if err != nil {
 			r.Reporter.Errorf("Expected a valid role prefix: %s", err)
-			os.Exit(1)
+			os.Exit(reporter.ExitCode())
 		}
 	}
 	if len(prefix) > 32 {
 		r.Reporter.Errorf("Expected a prefix with no more than 32 characters")
-		os.Exit(1)
+		os.Exit(reporter.ExitCode())
 	}
 	if !aws.RoleNameRE.MatchString(prefix) {
 		r.Reporter.Errorf("Expected a valid role prefix matching %s", aws.RoleNameRE.String())
-		os.Exit(1)
+		os.Exit(reporter.ExitCode())
 	}
 	if !args.hostedCP && strings.HasSuffix(prefix, "-HCP") {
 		r.Reporter.Errorf("The '-HCP' suffix is reserved for hosted CP managed policies")
-		os.Exit(1)
+		os.Exit(reporter.ExitCode())
 	}
 
 	permissionsBoundary := args.permissionsBoundary
@@ -291,7 +297,7 @@ func run(cmd *cobra.Command, argv []string) {
 		})
 		if err != nil {
 			r.Reporter.Errorf("Expected a valid policy ARN for permissions boundary: %s", err)
-			os.Exit(1)
+			os.Exit(reporter.ExitCode())
 		}
 	}
 
@@ -299,7 +305,7 @@ func run(cmd *cobra.Command, argv []string) {
 		err = aws.ARNValidator(permissionsBoundary)
 		if err != nil {
 			r.Reporter.Errorf("Expected a valid policy ARN for permissions boundary: %s", err)
-			os.Exit(1)
+			os.Exit(reporter.ExitCode())
 		}
 	}
 
@@ -315,33 +321,39 @@ func run(cmd *cobra.Command, argv []string) {
 		})
 		if err != nil {
 			r.Reporter.Errorf("Expected a valid path: %s", err)
-			os.Exit(1)
+			os.Exit(reporter.ExitCode())
 		}
 	}
 
 	if path != "" && !aws.ARNPath.MatchString(path) {
 		r.Reporter.Errorf("The specified value for path is invalid. " +
 			"It must begin and end with '/' and contain only alphanumeric characters and/or '/' characters.")
-		os.Exit(1)
+		os.Exit(reporter.ExitCode())
 	}
 
 	if interactive.Enabled() {
 		mode, err = interactive.GetOptionMode(cmd, mode, "Role creation mode")
 		if err != nil {
 			r.Reporter.Errorf("Expected a valid role creation mode: %s", err)
-			os.Exit(1)
+			os.Exit(reporter.ExitCode())
 		}
 	}
 
 	if args.forcePolicyCreation && mode != interactive.ModeAuto {
 		r.Reporter.Warnf("Forcing creation of policies only works in auto mode")
-		os.Exit(1)
+		os.Exit(reporter.ExitCode())
+	}
+
+	err = iac.Validate(mode)
+	if err != nil {
+		r.Reporter.Errorf("%s", err)
+		os.Exit(reporter.ExitCode())
 	}
 
 	policies, err := r.OCMClient.GetPolicies("AccountRole")
 	if err != nil {
 		r.Reporter.Errorf("Expected a valid role creation mode: %s", err)
-		os.Exit(1)
+		os.Exit(reporter.ExitCode())
 	}
 
 	createClassic := args.classic
@@ -356,7 +368,7 @@ func run(cmd *cobra.Command, argv []string) {
 		})
 		if err != nil {
 			r.Reporter.Errorf("Expected a valid value: %s", err)
-			os.Exit(1)
+			os.Exit(reporter.ExitCode())
 		}
 		isClassicValueSet = true
 	}
@@ -371,7 +383,7 @@ func run(cmd *cobra.Command, argv []string) {
 		})
 		if err != nil {
 			r.Reporter.Errorf("Expected a valid value: %s", err)
-			os.Exit(1)
+			os.Exit(reporter.ExitCode())
 		}
 		isHostedCPValueSet = true
 	}
@@ -379,7 +391,7 @@ func run(cmd *cobra.Command, argv []string) {
 	rolesCreator, createRoles := initCreator(r, managedPolicies, createClassic, createHostedCP,
 		isClassicValueSet, isHostedCPValueSet)
 	if !createRoles {
-		os.Exit(1)
+		os.Exit(reporter.ExitCode())
 	}
 
 	input := buildRolesCreationInput(prefix, permissionsBoundary, r.Creator.AccountID, env, policies,
@@ -396,33 +408,42 @@ func run(cmd *cobra.Command, argv []string) {
 					ocm.Version:    policyVersion,
 					ocm.IsThrottle: "true",
 				})
-				os.Exit(1)
+				os.Exit(reporter.ExitCode())
 			}
 			r.OCMClient.LogEvent("ROSACreateAccountRolesModeAuto", map[string]string{
 				ocm.Response: ocm.Failure,
 			})
-			os.Exit(1)
+			os.Exit(reporter.ExitCode())
 		}
 		r.OCMClient.LogEvent("ROSACreateAccountRolesModeAuto", map[string]string{
 			ocm.Response: ocm.Success,
 			ocm.Version:  policyVersion,
 		})
 	case interactive.ModeManual:
-		err = aws.GenerateAccountRolePolicyFiles(r.Reporter, env, policies, rolesCreator.skipPermissionFiles(),
-			rolesCreator.getAccountRolesMap(), r.Creator.Partition)
-		if err != nil {
-			r.Reporter.Errorf("There was an error generating the policy files: %s", err)
-			r.OCMClient.LogEvent("ROSACreateAccountRolesModeManual", map[string]string{
-				ocm.Response: ocm.Failure,
-			})
-			os.Exit(1)
+		// Templates include the policy documents, so they don't need the files
+		if !iac.Enabled() {
+			err = aws.GenerateAccountRolePolicyFiles(r.Reporter, env, policies, rolesCreator.skipPermissionFiles(),
+				rolesCreator.getAccountRolesMap(), r.Creator.Partition)
+			if err != nil {
+				r.Reporter.Errorf("There was an error generating the policy files: %s", err)
+				r.OCMClient.LogEvent("ROSACreateAccountRolesModeManual", map[string]string{
+					ocm.Response: ocm.Failure,
+				})
+				os.Exit(reporter.ExitCode())
+			}
 		}
 		err = rolesCreator.printCommands(r, input)
 		if err != nil {
 			r.Reporter.Errorf("%s", err)
-			os.Exit(1)
+			os.Exit(reporter.ExitCode())
 		}
-		if r.Reporter.IsTerminal() {
+		if iac.Enabled() {
+			err = iac.Print()
+			if err != nil {
+				r.Reporter.Errorf("%s", err)
+				os.Exit(reporter.ExitCode())
+			}
+		} else if r.Reporter.IsTerminal() {
 			r.Reporter.Infof("All policy files saved to the current directory")
 		}
 		r.OCMClient.LogEvent("ROSACreateAccountRolesModeManual", map[string]string{
@@ -430,6 +451,6 @@ func run(cmd *cobra.Command, argv []string) {
 		})
 	default:
 		r.Reporter.Errorf("Invalid mode. Allowed values are %s", interactive.Modes)
-		os.Exit(1)
+		os.Exit(reporter.ExitCode())
 	}
 }
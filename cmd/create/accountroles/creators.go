@@ -8,6 +8,7 @@ import (
 
 	"github.com/openshift/rosa/pkg/aws"
 	awscb "github.com/openshift/rosa/pkg/aws/commandbuilder"
+	"github.com/openshift/rosa/pkg/aws/iac"
 	"github.com/openshift/rosa/pkg/aws/tags"
 	"github.com/openshift/rosa/pkg/rosa"
 )
@@ -132,6 +133,7 @@ func (mp *managedPoliciesCreator) printCommands(r *rosa.Runtime, input *accountR
 		createRole := buildCreateRoleCommand(accRoleName, file, iamTags, input)
 		commands = append(commands, createRole)
 
+		policyARNs := []string{}
 		policyKeys := aws.GetAccountRolePolicyKeys(file)
 		for _, policyKey := range policyKeys {
 			policyARN, err := aws.GetManagedPolicyARN(input.policies, policyKey)
@@ -141,13 +143,16 @@ func (mp *managedPoliciesCreator) printCommands(r *rosa.Runtime, input *accountR
 
 			attachRolePolicy := buildAttachRolePolicyCommand(accRoleName, policyARN)
 			commands = append(commands, attachRolePolicy)
+			policyARNs = append(policyARNs, policyARN)
 		}
-	}
 
-	r.Reporter.Infof("Run the following commands to create the classic account roles and policies:\n")
-	fmt.Println(awscb.JoinCommands(commands) + "\n")
+		err := addToTemplate(r, input, file, accRoleName, iamTags, "", policyARNs)
+		if err != nil {
+			return err
+		}
+	}
 
-	return nil
+	return printCommands(r, "classic", commands)
 }
 
 func (mp *managedPoliciesCreator) getRoleTags(roleType string, input *accountRolesCreationInput) map[string]string {
@@ -203,12 +208,15 @@ func (up *unmanagedPoliciesCreator) printCommands(r *rosa.Runtime, input *accoun
 		attachRolePolicy := buildAttachRolePolicyCommand(accRoleName, policyARN)
 
 		commands = append(commands, createRole, createPolicy, attachRolePolicy)
-	}
 
-	r.Reporter.Infof("Run the following commands to create the classic account roles and policies:\n")
-	fmt.Println(awscb.JoinCommands(commands) + "\n")
+		err := addToTemplate(r, input, file, accRoleName, iamTags,
+			aws.GetPolicyDetails(input.policies, fmt.Sprintf("sts_%s_permission_policy", file)), []string{policyARN})
+		if err != nil {
+			return err
+		}
+	}
 
-	return nil
+	return printCommands(r, "classic", commands)
 }
 
 func (up *unmanagedPoliciesCreator) getRoleTags(roleType string, input *accountRolesCreationInput) map[string]string {
@@ -353,12 +361,14 @@ func (hcp *hcpManagedPoliciesCreator) printCommands(r *rosa.Runtime, input *acco
 
 		attachRolePolicy := buildAttachRolePolicyCommand(accRoleName, policyARN)
 		commands = append(commands, createRole, attachRolePolicy)
-	}
 
-	r.Reporter.Infof("Run the following commands to create the hosted CP account roles and policies:\n")
-	fmt.Println(awscb.JoinCommands(commands) + "\n")
+		err = addToTemplate(r, input, file, accRoleName, iamTags, "", []string{policyARN})
+		if err != nil {
+			return err
+		}
+	}
 
-	return nil
+	return printCommands(r, "hosted CP", commands)
 }
 
 func (hcp *hcpManagedPoliciesCreator) getRoleTags(roleType string, input *accountRolesCreationInput) map[string]string {
@@ -377,6 +387,42 @@ func (hcp *hcpManagedPoliciesCreator) getAccountRolesMap() map[string]aws.Accoun
 	return aws.HCPAccountRoles
 }
 
+// printCommands prints the commands that create the account roles of the given topology. When an output
+// format is selected the roles are printed as a template instead, see addToTemplate.
+func printCommands(r *rosa.Runtime, topology string, commands []string) error {
+	if iac.Enabled() {
+		return nil
+	}
+	r.Reporter.Infof("Run the following commands to create the %s account roles and policies:\n", topology)
+	fmt.Println(awscb.JoinCommands(commands) + "\n")
+	return nil
+}
+
+// addToTemplate adds an account role to the template printed when an output format is selected, with its
+// permission policy when it isn't managed by AWS, and the attachments of the given policies.
+func addToTemplate(r *rosa.Runtime, input *accountRolesCreationInput, file string, accRoleName string,
+	iamTags map[string]string, policyDocument string, policyARNs []string) error {
+	if !iac.Enabled() {
+		return nil
+	}
+	template := iac.Current()
+	err := template.AddRole(accRoleName, input.path, input.permissionsBoundary,
+		getAssumeRolePolicy(r.Creator.Partition, file, input), iamTags)
+	if err != nil {
+		return err
+	}
+	if policyDocument != "" {
+		err = template.AddPolicy(aws.GetPolicyName(accRoleName), input.path, policyDocument, iamTags)
+		if err != nil {
+			return err
+		}
+	}
+	for _, policyARN := range policyARNs {
+		template.AddAttachment(accRoleName, policyARN)
+	}
+	return nil
+}
+
 func getBaseRoleTags(roleType string, input *accountRolesCreationInput) map[string]string {
 	return map[string]string{
 		common.OpenShiftVersion: input.defaultPolicyVersion,
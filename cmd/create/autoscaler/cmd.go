@@ -25,6 +25,7 @@ import (
 	"github.com/openshift/rosa/pkg/clusterautoscaler"
 	"github.com/openshift/rosa/pkg/interactive"
 	"github.com/openshift/rosa/pkg/ocm"
+	"github.com/openshift/rosa/pkg/reporter"
 	"github.com/openshift/rosa/pkg/rosa"
 )
 
@@ -71,25 +72,25 @@ func run(cmd *cobra.Command, _ []string) {
 
 	if cluster.Hypershift().Enabled() {
 		r.Reporter.Errorf("Hosted Control Plane clusters do not support cluster-autoscaler configuration")
-		os.Exit(1)
+		os.Exit(reporter.ExitCode())
 	}
 
 	if cluster.State() != cmv1.ClusterStateReady {
 		r.Reporter.Errorf("Cluster '%s' is not yet ready. Current state is '%s'", clusterKey, cluster.State())
-		os.Exit(1)
+		os.Exit(reporter.ExitCode())
 	}
 
 	autoscaler, err := r.OCMClient.GetClusterAutoscaler(cluster.ID())
 	if err != nil {
 		r.Reporter.Errorf("Failed getting autoscaler configuration for cluster '%s': %s",
 			cluster.ID(), err)
-		os.Exit(1)
+		os.Exit(reporter.ExitCode())
 	}
 
 	if autoscaler != nil {
 		r.Reporter.Errorf("Autoscaler for cluster '%s' already exists. "+
 			"You should edit it via 'rosa edit autoscaler'", clusterKey)
-		os.Exit(1)
+		os.Exit(reporter.ExitCode())
 	}
 
 	if !clusterautoscaler.IsAutoscalerSetViaCLI(cmd.Flags(), argsPrefix) && !interactive.Enabled() {
@@ -103,21 +104,21 @@ func run(cmd *cobra.Command, _ []string) {
 	if err != nil {
 		r.Reporter.Errorf("Failed creating autoscaler configuration for cluster '%s': %s",
 			cluster.ID(), err)
-		os.Exit(1)
+		os.Exit(reporter.ExitCode())
 	}
 
 	autoscalerConfig, err := clusterautoscaler.CreateAutoscalerConfig(autoscalerArgs)
 	if err != nil {
 		r.Reporter.Errorf("Failed creating autoscaler configuration for cluster '%s': %s",
 			cluster.ID(), err)
-		os.Exit(1)
+		os.Exit(reporter.ExitCode())
 	}
 
 	_, err = r.OCMClient.CreateClusterAutoscaler(cluster.ID(), autoscalerConfig)
 	if err != nil {
 		r.Reporter.Errorf("Failed creating autoscaler configuration for cluster '%s': %s",
 			cluster.ID(), err)
-		os.Exit(1)
+		os.Exit(reporter.ExitCode())
 	}
 
 	r.Reporter.Infof("Successfully created autoscaler configuration for cluster '%s'", cluster.ID())
@@ -28,6 +28,7 @@ import (
 
 	awssdk "github.com/aws/aws-sdk-go-v2/aws"
 	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	"github.com/kballard/go-shellquote"
 	clustervalidations "github.com/openshift-online/ocm-common/pkg/cluster/validations"
 	idputils "github.com/openshift-online/ocm-common/pkg/idp/utils"
 	passwordValidator "github.com/openshift-online/ocm-common/pkg/idp/validations"
@@ -93,6 +94,10 @@ var args struct {
 
 	// Simulate creating a cluster
 	dryRun bool
+	// Read cluster options from a spec file
+	fromFile string
+	// Write the resolved cluster options to a spec file
+	exportSpec string
 	// Create a fake cluster with no AWS resources
 	fakeCluster bool
 	// Set custom properties in cluster spec
@@ -232,7 +237,13 @@ func makeCmd() *cobra.Command {
   rosa create cluster --cluster-name=mycluster
 
   # Create a cluster in the us-east-2 region
-  rosa create cluster --cluster-name=mycluster --region=us-east-2`,
+  rosa create cluster --cluster-name=mycluster --region=us-east-2
+
+  # Resolve the cluster options and save them to a spec file without creating the cluster
+  rosa create cluster --cluster-name=mycluster --dry-run --export-spec=cluster.yaml
+
+  # Create a cluster from a spec file
+  rosa create cluster --from-file=cluster.yaml`,
 		Run:  run,
 		Args: cobra.NoArgs,
 	}
@@ -657,6 +668,22 @@ func initFlags(cmd *cobra.Command) {
 		"Simulate creating the cluster.",
 	)
 
+	flags.StringVar(
+		&args.fromFile,
+		fromFileFlag,
+		"",
+		"Path to a YAML file with the cluster options, using the flag names of this command as keys. "+
+			"Options given on the command line take precedence over the ones in the file.",
+	)
+
+	flags.StringVar(
+		&args.exportSpec,
+		exportSpecFlag,
+		"",
+		"Write the resolved cluster options, including interactive answers, to the given file so "+
+			"that the same cluster can be created again with '--from-file'.",
+	)
+
 	flags.BoolVar(
 		&args.fakeCluster,
 		"fake-cluster",
@@ -840,21 +867,31 @@ func networkTypeCompletion(cmd *cobra.Command, args []string, toComplete string)
 }
 
 func run(cmd *cobra.Command, _ []string) {
-	r := rosa.NewRuntime().WithAWS().WithOCM()
+	r := rosa.NewRuntime()
 	defer r.Cleanup()
 
+	// The spec file is loaded before creating the clients, as it can set the AWS region and profile
+	if args.fromFile != "" {
+		err := loadSpecFile(cmd.Flags(), args.fromFile)
+		if err != nil {
+			r.Reporter.Errorf("%s", err)
+			os.Exit(reporter.ExitCode())
+		}
+	}
+	r = r.WithAWS().WithOCM()
+
 	// Validate mode
 	mode, err := interactive.GetMode()
 	if err != nil {
 		r.Reporter.Errorf("%s", err)
-		os.Exit(1)
+		os.Exit(reporter.ExitCode())
 	}
 
 	for _, val := range userSpecifiedAutoscalerValues {
 		if val.Changed && !args.autoscalingEnabled {
 			r.Reporter.Errorf("Using autoscaling flag '%s', requires flag '--enable-autoscaling'. "+
 				"Please try again with flag", val.Name)
-			os.Exit(1)
+			os.Exit(reporter.ExitCode())
 		}
 	}
 
@@ -862,7 +899,7 @@ func run(cmd *cobra.Command, _ []string) {
 	isHostedCP := args.hostedClusterEnabled
 	if isHostedCP && fedramp.Enabled() {
 		r.Reporter.Errorf("Fedramp does not currently support Hosted Control Plane clusters. Please use classic")
-		os.Exit(1)
+		os.Exit(reporter.ExitCode())
 	}
 
 	supportedRegions, err := r.OCMClient.GetDatabaseRegionList()
@@ -875,7 +912,7 @@ func run(cmd *cobra.Command, _ []string) {
 	awsCreator, err := awsClient.GetCreator()
 	if err != nil {
 		r.Reporter.Errorf("Unable to get IAM credentials: %v", err)
-		os.Exit(1)
+		os.Exit(reporter.ExitCode())
 	}
 
 	shardPinningEnabled := false
@@ -925,7 +962,7 @@ func run(cmd *cobra.Command, _ []string) {
 		})
 		if err != nil {
 			r.Reporter.Errorf("Expected a valid cluster name: %s", err)
-			os.Exit(1)
+			os.Exit(reporter.ExitCode())
 		}
 	}
 
@@ -936,7 +973,7 @@ func run(cmd *cobra.Command, _ []string) {
 		r.Reporter.Errorf("Cluster name must consist"+
 			" of no more than %d lowercase alphanumeric characters or '-', "+
 			"start with a letter, and end with an alphanumeric character.", ocm.MaxClusterNameLength)
-		os.Exit(1)
+		os.Exit(reporter.ExitCode())
 	}
 
 	// Get cluster domain prefix
@@ -954,7 +991,7 @@ func run(cmd *cobra.Command, _ []string) {
 		})
 		if err != nil {
 			r.Reporter.Errorf("Expected a valid domain prefix: %s", err)
-			os.Exit(1)
+			os.Exit(reporter.ExitCode())
 		}
 	}
 
@@ -965,7 +1002,7 @@ func run(cmd *cobra.Command, _ []string) {
 		r.Reporter.Errorf("Domain prefix must consist"+
 			" of no more than %d lowercase alphanumeric characters or '-', "+
 			"start with a letter, and end with an alphanumeric character.", ocm.MaxClusterDomainPrefixLength)
-		os.Exit(1)
+		os.Exit(reporter.ExitCode())
 	}
 
 	if clusterHasLongNameWithoutDomainPrefix(clusterName, domainPrefix) {
@@ -989,7 +1026,7 @@ func run(cmd *cobra.Command, _ []string) {
 		})
 		if err != nil {
 			r.Reporter.Errorf("Expected a valid --hosted-cp value: %s", err)
-			os.Exit(1)
+			os.Exit(reporter.ExitCode())
 		}
 	}
 
@@ -997,7 +1034,7 @@ func run(cmd *cobra.Command, _ []string) {
 		techPreviewMsg, err := r.OCMClient.GetTechnologyPreviewMessage(ocm.HcpProduct, time.Now())
 		if err != nil {
 			r.Reporter.Errorf("%s", err)
-			os.Exit(1)
+			os.Exit(reporter.ExitCode())
 		}
 		if techPreviewMsg != "" {
 			r.Reporter.Infof(techPreviewMsg)
@@ -1006,7 +1043,7 @@ func run(cmd *cobra.Command, _ []string) {
 
 	if isHostedCP && cmd.Flags().Changed(Ec2MetadataHttpTokensFlag) {
 		r.Reporter.Errorf("'%s' is not available for Hosted Control Plane clusters", Ec2MetadataHttpTokensFlag)
-		os.Exit(1)
+		os.Exit(reporter.ExitCode())
 	}
 
 	createAdminUser := args.createAdminUser
@@ -1023,20 +1060,20 @@ func run(cmd *cobra.Command, _ []string) {
 			clusterAdminPassword, err = idputils.GenerateRandomPassword()
 			if err != nil {
 				r.Reporter.Errorf("Failed to generate a random password")
-				os.Exit(1)
+				os.Exit(reporter.ExitCode())
 			}
 		}
 		// validates both user inputted custom password and randomly generated password
 		err = passwordValidator.PasswordValidator(clusterAdminPassword)
 		if err != nil {
 			r.Reporter.Errorf("%s", err)
-			os.Exit(1)
+			os.Exit(reporter.ExitCode())
 		}
 		if clusterAdminUser != "" {
 			err = idp.UsernameValidator(clusterAdminUser)
 			if err != nil {
 				r.Reporter.Errorf("%s", err)
-				os.Exit(1)
+				os.Exit(reporter.ExitCode())
 			}
 		} else {
 			clusterAdminUser = admin.ClusterAdminUsername
@@ -1052,7 +1089,7 @@ func run(cmd *cobra.Command, _ []string) {
 		})
 		if err != nil {
 			r.Reporter.Errorf("Expected a valid value: %s", err)
-			os.Exit(1)
+			os.Exit(reporter.ExitCode())
 		}
 		if isClusterAdmin {
 			//clusterAdminUser = idp.GetIdpUserNameFromPrompt(cmd, r, "cluster-admin-user", clusterAdminUser, true)
@@ -1063,13 +1100,13 @@ func run(cmd *cobra.Command, _ []string) {
 			})
 			if err != nil {
 				r.Reporter.Errorf("Expected a valid value: %s", err)
-				os.Exit(1)
+				os.Exit(reporter.ExitCode())
 			}
 			if !isCustomAdminPassword {
 				clusterAdminPassword, err = idputils.GenerateRandomPassword()
 				if err != nil {
 					r.Reporter.Errorf("Failed to generate a random password")
-					os.Exit(1)
+					os.Exit(reporter.ExitCode())
 				}
 			} else {
 				clusterAdminPassword = idp.GetIdpPasswordFromPrompt(cmd, r,
@@ -1082,7 +1119,7 @@ func run(cmd *cobra.Command, _ []string) {
 
 	if isHostedCP && cmd.Flags().Changed(arguments.NewDefaultMPLabelsFlag) {
 		r.Reporter.Errorf("Setting the worker machine pool labels is not supported for hosted clusters")
-		os.Exit(1)
+		os.Exit(reporter.ExitCode())
 	}
 
 	// Billing Account
@@ -1091,7 +1128,7 @@ func run(cmd *cobra.Command, _ []string) {
 		isHcpBillingTechPreview, err := r.OCMClient.IsTechnologyPreview(ocm.HcpBillingAccount, time.Now())
 		if err != nil {
 			r.Reporter.Errorf("%s", err)
-			os.Exit(1)
+			os.Exit(reporter.ExitCode())
 		}
 
 		if !isHcpBillingTechPreview {
@@ -1099,13 +1136,13 @@ func run(cmd *cobra.Command, _ []string) {
 			if billingAccount != "" && !ocm.IsValidAWSAccount(billingAccount) {
 				r.Reporter.Errorf("Billing account is invalid. Run the command again with a valid billing account. %s",
 					listBillingAccountMessage)
-				os.Exit(1)
+				os.Exit(reporter.ExitCode())
 			}
 
 			cloudAccounts, err := r.OCMClient.GetBillingAccounts()
 			if err != nil {
 				r.Reporter.Errorf("%s", err)
-				os.Exit(1)
+				os.Exit(reporter.ExitCode())
 			}
 
 			billingAccounts := ocm.GenerateBillingAccountsList(cloudAccounts)
@@ -1135,7 +1172,7 @@ func run(cmd *cobra.Command, _ []string) {
 
 					if err != nil {
 						r.Reporter.Errorf("Expected a valid billing account: '%s'", err)
-						os.Exit(1)
+						os.Exit(reporter.ExitCode())
 					}
 
 					billingAccount = aws.ParseOption(billingAccount)
@@ -1144,7 +1181,7 @@ func run(cmd *cobra.Command, _ []string) {
 				err := validateBillingAccount(billingAccount)
 				if err != nil {
 					r.Reporter.Errorf("%v", err)
-					os.Exit(1)
+					os.Exit(reporter.ExitCode())
 				}
 
 				// Get contract info
@@ -1172,14 +1209,14 @@ func run(cmd *cobra.Command, _ []string) {
 
 	if !isHostedCP && billingAccount != "" {
 		r.Reporter.Errorf("Billing accounts are only supported for Hosted Control Plane clusters")
-		os.Exit(1)
+		os.Exit(reporter.ExitCode())
 	}
 
 	externalAuthProvidersEnabled := args.externalAuthProvidersEnabled
 	if externalAuthProvidersEnabled {
 		if !isHostedCP {
 			r.Reporter.Errorf("External authentication configuration is only supported for a Hosted Control Plane cluster.")
-			os.Exit(1)
+			os.Exit(reporter.ExitCode())
 		}
 	}
 
@@ -1187,7 +1224,7 @@ func run(cmd *cobra.Command, _ []string) {
 
 	if etcdEncryptionKmsARN != "" && !isHostedCP {
 		r.Reporter.Errorf("etcd encryption kms arn is only allowed for hosted cp")
-		os.Exit(1)
+		os.Exit(reporter.ExitCode())
 	}
 
 	// all hosted clusters are sts
@@ -1196,7 +1233,7 @@ func run(cmd *cobra.Command, _ []string) {
 
 	if isSTS && isIAM {
 		r.Reporter.Errorf("Can't use both STS and mint mode at the same time.")
-		os.Exit(1)
+		os.Exit(reporter.ExitCode())
 	}
 
 	if interactive.Enabled() && (!isSTS && !isIAM) {
@@ -1208,7 +1245,7 @@ func run(cmd *cobra.Command, _ []string) {
 		})
 		if err != nil {
 			r.Reporter.Errorf("Expected a valid --sts value: %s", err)
-			os.Exit(1)
+			os.Exit(reporter.ExitCode())
 		}
 		isIAM = !isSTS
 	}
@@ -1226,7 +1263,7 @@ func run(cmd *cobra.Command, _ []string) {
 		err = aws.ARNValidator(permissionsBoundary)
 		if err != nil {
 			r.Reporter.Errorf("Expected a valid policy ARN for permissions boundary: %s", err)
-			os.Exit(1)
+			os.Exit(reporter.ExitCode())
 		}
 	}
 
@@ -1234,12 +1271,12 @@ func run(cmd *cobra.Command, _ []string) {
 		if awsCreator.IsSTS {
 			r.Reporter.Errorf("Since your AWS credentials are returning an STS ARN you can only " +
 				"create STS clusters. Otherwise, switch to IAM credentials.")
-			os.Exit(1)
+			os.Exit(reporter.ExitCode())
 		}
 		err := awsClient.CheckAdminUserExists(aws.AdminUserName)
 		if err != nil {
 			r.Reporter.Errorf("IAM user '%s' does not exist. Run `rosa init` first", aws.AdminUserName)
-			os.Exit(1)
+			os.Exit(reporter.ExitCode())
 		}
 		r.Reporter.Debugf("IAM user is valid!")
 	}
@@ -1256,7 +1293,7 @@ func run(cmd *cobra.Command, _ []string) {
 	defaultVersion, versionList, err := versions.GetVersionList(r, channelGroup, isSTS, isHostedCP, isHostedCP, true)
 	if err != nil {
 		r.Reporter.Errorf("%s", err)
-		os.Exit(1)
+		os.Exit(reporter.ExitCode())
 	}
 	if version == "" {
 		version = defaultVersion
@@ -1271,13 +1308,13 @@ func run(cmd *cobra.Command, _ []string) {
 		})
 		if err != nil {
 			r.Reporter.Errorf("Expected a valid OpenShift version: %s", err)
-			os.Exit(1)
+			os.Exit(reporter.ExitCode())
 		}
 	}
 	version, err = r.OCMClient.ValidateVersion(version, versionList, channelGroup, isSTS, isHostedCP)
 	if err != nil {
 		r.Reporter.Errorf("Expected a valid OpenShift version: %s", err)
-		os.Exit(1)
+		os.Exit(reporter.ExitCode())
 	}
 	if err := r.OCMClient.IsVersionCloseToEol(ocm.CloseToEolDays, version, channelGroup); err != nil {
 		r.Reporter.Warnf("%v", err)
@@ -1301,16 +1338,16 @@ func run(cmd *cobra.Command, _ []string) {
 			})
 			if err != nil {
 				r.Reporter.Errorf("Expected a valid http tokens value : %v", err)
-				os.Exit(1)
+				os.Exit(reporter.ExitCode())
 			}
 		}
 		if err = ocm.ValidateHttpTokensValue(httpTokens); err != nil {
 			r.Reporter.Errorf("Expected a valid http tokens value : %v", err)
-			os.Exit(1)
+			os.Exit(reporter.ExitCode())
 		}
 		if err := ocm.ValidateHttpTokensVersion(ocm.GetVersionMinor(version), httpTokens); err != nil {
 			r.Reporter.Errorf(err.Error())
-			os.Exit(1)
+			os.Exit(reporter.ExitCode())
 		}
 	}
 
@@ -1325,7 +1362,7 @@ func run(cmd *cobra.Command, _ []string) {
 		isValidMode := arguments.IsValidMode(interactive.Modes, mode)
 		if !isValidMode {
 			r.Reporter.Errorf("Invalid --mode '%s'. Allowed values are %s", mode, interactive.Modes)
-			os.Exit(1)
+			os.Exit(reporter.ExitCode())
 		}
 	}
 
@@ -1333,14 +1370,14 @@ func run(cmd *cobra.Command, _ []string) {
 		r.Reporter.Errorf("Cannot watch for STS cluster installation logs in mode 'auto' " +
 			"without also supplying '--yes' option." +
 			"To watch your cluster installation logs, run 'rosa logs install' instead after the cluster has began creating.")
-		os.Exit(1)
+		os.Exit(reporter.ExitCode())
 	}
 
 	if args.watch && isSTS && mode == interactive.ModeManual {
 		r.Reporter.Errorf("Cannot watch for STS cluster installation logs in mode 'manual'." +
 			"It requires manual commands to be performed as part of the process." +
 			"To watch your cluster installation logs, run 'rosa logs install' after the cluster has began creating.")
-		os.Exit(1)
+		os.Exit(reporter.ExitCode())
 	}
 
 	hasRoles := false
@@ -1357,7 +1394,7 @@ func run(cmd *cobra.Command, _ []string) {
 		}
 		if err != nil {
 			r.Reporter.Errorf("Failed to find %s role: %s", role.Name, err)
-			os.Exit(1)
+			os.Exit(reporter.ExitCode())
 		}
 
 		if len(roleARNs) > 1 {
@@ -1396,7 +1433,7 @@ func run(cmd *cobra.Command, _ []string) {
 				})
 				if err != nil {
 					r.Reporter.Errorf("Expected a valid role ARN: %s", err)
-					os.Exit(1)
+					os.Exit(reporter.ExitCode())
 				}
 			}
 		} else if len(roleARNs) == 1 {
@@ -1420,7 +1457,7 @@ func run(cmd *cobra.Command, _ []string) {
 			hostedCPPolicies, err := awsClient.HasHostedCPPolicies(roleARN)
 			if err != nil {
 				r.Reporter.Errorf("Failed to determine if cluster has hosted CP policies: %v", err)
-				os.Exit(1)
+				os.Exit(reporter.ExitCode())
 			}
 			hasRoles = true
 			for roleType, role := range aws.AccountRoles {
@@ -1439,14 +1476,14 @@ func run(cmd *cobra.Command, _ []string) {
 				}
 				if err != nil {
 					r.Reporter.Errorf("Failed to find %s role: %s", role.Name, err)
-					os.Exit(1)
+					os.Exit(reporter.ExitCode())
 				}
 				selectedARN := ""
 				expectedResourceIDForAccRole, rolePrefix, err := getExpectedResourceIDForAccRole(
 					hostedCPPolicies, roleARN, roleType)
 				if err != nil {
 					r.Reporter.Errorf("Failed to get the expected resource ID for role type: %s", roleType)
-					os.Exit(1)
+					os.Exit(reporter.ExitCode())
 				}
 				r.Reporter.Debugf(
 					"Using '%s' as the role prefix to retrieve the expected resource ID for role type '%s'",
@@ -1458,7 +1495,7 @@ func run(cmd *cobra.Command, _ []string) {
 					resourceId, err := aws.GetResourceIdFromARN(rARN)
 					if err != nil {
 						r.Reporter.Errorf("Failed to get resource ID from arn. %s", err)
-						os.Exit(1)
+						os.Exit(reporter.ExitCode())
 					}
 					lowerCaseResourceIdToCheck := strings.ToLower(resourceId)
 					if lowerCaseResourceIdToCheck == expectedResourceIDForAccRole {
@@ -1507,7 +1544,7 @@ func run(cmd *cobra.Command, _ []string) {
 		})
 		if err != nil {
 			r.Reporter.Errorf("Expected a valid ARN: %s", err)
-			os.Exit(1)
+			os.Exit(reporter.ExitCode())
 		}
 	}
 
@@ -1515,7 +1552,7 @@ func run(cmd *cobra.Command, _ []string) {
 		err = aws.ARNValidator(roleARN)
 		if err != nil {
 			r.Reporter.Errorf("Expected a valid Role ARN: %s", err)
-			os.Exit(1)
+			os.Exit(reporter.ExitCode())
 		}
 		isSTS = true
 	}
@@ -1536,7 +1573,7 @@ func run(cmd *cobra.Command, _ []string) {
 		})
 		if err != nil {
 			r.Reporter.Errorf("Expected a valid External ID: %s", err)
-			os.Exit(1)
+			os.Exit(reporter.ExitCode())
 		}
 	}
 
@@ -1556,18 +1593,18 @@ func run(cmd *cobra.Command, _ []string) {
 		})
 		if err != nil {
 			r.Reporter.Errorf("Expected a valid ARN: %s", err)
-			os.Exit(1)
+			os.Exit(reporter.ExitCode())
 		}
 	}
 	if supportRoleARN != "" {
 		err = aws.ARNValidator(supportRoleARN)
 		if err != nil {
 			r.Reporter.Errorf("Expected a valid Support Role ARN: %s", err)
-			os.Exit(1)
+			os.Exit(reporter.ExitCode())
 		}
 	} else if roleARN != "" {
 		r.Reporter.Errorf("Support Role ARN is required: %s", err)
-		os.Exit(1)
+		os.Exit(reporter.ExitCode())
 	}
 
 	// Instance IAM Roles
@@ -1588,18 +1625,18 @@ func run(cmd *cobra.Command, _ []string) {
 			})
 			if err != nil {
 				r.Reporter.Errorf("Expected a valid control plane IAM role ARN: %s", err)
-				os.Exit(1)
+				os.Exit(reporter.ExitCode())
 			}
 		}
 		if controlPlaneRoleARN != "" {
 			err = aws.ARNValidator(controlPlaneRoleARN)
 			if err != nil {
 				r.Reporter.Errorf("Expected a valid control plane instance IAM role ARN: %s", err)
-				os.Exit(1)
+				os.Exit(reporter.ExitCode())
 			}
 		} else if roleARN != "" {
 			r.Reporter.Errorf("Control plane instance IAM role ARN is required: %s", err)
-			os.Exit(1)
+			os.Exit(reporter.ExitCode())
 		}
 	}
 
@@ -1620,18 +1657,18 @@ func run(cmd *cobra.Command, _ []string) {
 		})
 		if err != nil {
 			r.Reporter.Errorf("Expected a valid worker IAM role ARN: %s", err)
-			os.Exit(1)
+			os.Exit(reporter.ExitCode())
 		}
 	}
 	if workerRoleARN != "" {
 		err = aws.ARNValidator(workerRoleARN)
 		if err != nil {
 			r.Reporter.Errorf("Expected a valid worker instance IAM role ARN: %s", err)
-			os.Exit(1)
+			os.Exit(reporter.ExitCode())
 		}
 	} else if roleARN != "" {
 		r.Reporter.Errorf("Worker instance IAM role ARN is required: %s", err)
-		os.Exit(1)
+		os.Exit(reporter.ExitCode())
 	}
 
 	// combine role arns to list
@@ -1645,32 +1682,32 @@ func run(cmd *cobra.Command, _ []string) {
 	managedPolicies, err := awsClient.HasManagedPolicies(roleARN)
 	if err != nil {
 		r.Reporter.Errorf("Failed to determine if cluster has managed policies: %v", err)
-		os.Exit(1)
+		os.Exit(reporter.ExitCode())
 	}
 	// check if role has hosted cp policy via AWS tag value
 	hostedCPPolicies, err := awsClient.HasHostedCPPolicies(roleARN)
 	if err != nil {
 		r.Reporter.Errorf("Failed to determine if cluster has hosted CP policies: %v", err)
-		os.Exit(1)
+		os.Exit(reporter.ExitCode())
 	}
 
 	if managedPolicies {
 		rolePrefix, err := getAccountRolePrefix(hostedCPPolicies, roleARN, aws.InstallerAccountRole)
 		if err != nil {
 			r.Reporter.Errorf("Failed to find prefix from account role: %s", err)
-			os.Exit(1)
+			os.Exit(reporter.ExitCode())
 		}
 
 		err = roles.ValidateAccountRolesManagedPolicies(r, rolePrefix, hostedCPPolicies)
 		if err != nil {
 			r.Reporter.Errorf("Failed while validating account roles: %s", err)
-			os.Exit(1)
+			os.Exit(reporter.ExitCode())
 		}
 	} else {
 		err = roles.ValidateUnmanagedAccountRoles(roleARNs, awsClient, version)
 		if err != nil {
 			r.Reporter.Errorf("Failed while validating account roles: %s", err)
-			os.Exit(1)
+			os.Exit(reporter.ExitCode())
 		}
 	}
 
@@ -1696,31 +1733,31 @@ func run(cmd *cobra.Command, _ []string) {
 			})
 			if err != nil {
 				r.Reporter.Errorf("Expected a prefix for the operator IAM roles: %s", err)
-				os.Exit(1)
+				os.Exit(reporter.ExitCode())
 			}
 		}
 		if len(operatorRolesPrefix) == 0 {
 			r.Reporter.Errorf("Expected a prefix for the operator IAM roles: %s", err)
-			os.Exit(1)
+			os.Exit(reporter.ExitCode())
 		}
 		if len(operatorRolesPrefix) > 32 {
 			r.Reporter.Errorf("Expected a prefix with no more than 32 characters")
-			os.Exit(1)
+			os.Exit(reporter.ExitCode())
 		}
 		if !aws.RoleNameRE.MatchString(operatorRolesPrefix) {
 			r.Reporter.Errorf("Expected valid operator roles prefix matching %s", aws.RoleNameRE.String())
-			os.Exit(1)
+			os.Exit(reporter.ExitCode())
 		}
 
 		credRequests, err := r.OCMClient.GetAllCredRequests()
 		if err != nil {
 			r.Reporter.Errorf("Error getting operator credential request from OCM %v", err)
-			os.Exit(1)
+			os.Exit(reporter.ExitCode())
 		}
 		operatorRoles, err = r.AWSClient.GetOperatorRolesFromAccountByPrefix(operatorRolesPrefix, credRequests)
 		if err != nil {
 			r.Reporter.Errorf("There was a problem retrieving the Operator Roles from AWS: %v", err)
-			os.Exit(1)
+			os.Exit(reporter.ExitCode())
 		}
 	}
 
@@ -1729,12 +1766,12 @@ func run(cmd *cobra.Command, _ []string) {
 		credRequests, err := r.OCMClient.GetCredRequests(isHostedCP)
 		if err != nil {
 			r.Reporter.Errorf("Error getting operator credential request from OCM %s", err)
-			os.Exit(1)
+			os.Exit(reporter.ExitCode())
 		}
 		accRolesPrefix, err := getAccountRolePrefix(hostedCPPolicies, roleARN, aws.InstallerAccountRole)
 		if err != nil {
 			r.Reporter.Errorf("Failed to find prefix from account role: %s", err)
-			os.Exit(1)
+			os.Exit(reporter.ExitCode())
 		}
 		if expectedOperatorRolePath != "" && !output.HasFlag() && r.Reporter.IsTerminal() {
 			r.Reporter.Infof("ARN path '%s' detected. This ARN path will be used for subsequent"+
@@ -1747,7 +1784,7 @@ func run(cmd *cobra.Command, _ []string) {
 				isSupported, err := ocm.CheckSupportedVersion(ocm.GetVersionMinor(version), operator.MinVersion())
 				if err != nil {
 					r.Reporter.Errorf("Error validating operator role '%s' version %s", operator.Name(), err)
-					os.Exit(1)
+					os.Exit(reporter.ExitCode())
 				}
 				if !isSupported {
 					continue
@@ -1768,13 +1805,13 @@ func run(cmd *cobra.Command, _ []string) {
 				if !strings.Contains(role, ",") {
 					r.Reporter.Errorf("Expected operator IAM roles to be a comma-separated " +
 						"list of name,namespace,role_arn")
-					os.Exit(1)
+					os.Exit(reporter.ExitCode())
 				}
 				roleData := strings.Split(role, ",")
 				if len(roleData) != 3 {
 					r.Reporter.Errorf("Expected operator IAM roles to be a comma-separated " +
 						"list of name,namespace,role_arn")
-					os.Exit(1)
+					os.Exit(reporter.ExitCode())
 				}
 				computedOperatorIamRoleList = append(computedOperatorIamRoleList, ocm.OperatorIAMRole{
 					Name:      roleData[0],
@@ -1788,13 +1825,13 @@ func run(cmd *cobra.Command, _ []string) {
 		if err != nil {
 			if !oidcConfig.Reusable() {
 				r.Reporter.Errorf("%v", err)
-				os.Exit(1)
+				os.Exit(reporter.ExitCode())
 			} else {
 				err = ocm.ValidateOperatorRolesMatchOidcProvider(r.Reporter, awsClient, computedOperatorIamRoleList,
 					oidcConfig.IssuerUrl(), ocm.GetVersionMinor(version), expectedOperatorRolePath, managedPolicies)
 				if err != nil {
 					r.Reporter.Errorf("%v", err)
-					os.Exit(1)
+					os.Exit(reporter.ExitCode())
 				}
 			}
 		}
@@ -1815,7 +1852,7 @@ func run(cmd *cobra.Command, _ []string) {
 		})
 		if err != nil {
 			r.Reporter.Errorf("Expected a valid set of tags: %s", err)
-			os.Exit(1)
+			os.Exit(reporter.ExitCode())
 		}
 		if len(tagsInput) > 0 {
 			_tags = strings.Split(tagsInput, ",")
@@ -1824,7 +1861,7 @@ func run(cmd *cobra.Command, _ []string) {
 	if len(_tags) > 0 {
 		if err := aws.UserTagValidator(_tags); err != nil {
 			r.Reporter.Errorf("%s", err)
-			os.Exit(1)
+			os.Exit(reporter.ExitCode())
 		}
 		delim := aws.GetTagsDelimiter(_tags)
 		for _, tag := range _tags {
@@ -1843,7 +1880,7 @@ func run(cmd *cobra.Command, _ []string) {
 		})
 		if err != nil {
 			r.Reporter.Errorf("Expected a valid multi-AZ value: %s", err)
-			os.Exit(1)
+			os.Exit(reporter.ExitCode())
 		}
 	}
 
@@ -1861,7 +1898,7 @@ func run(cmd *cobra.Command, _ []string) {
 	region, err := aws.GetRegion(arguments.GetRegion())
 	if err != nil {
 		r.Reporter.Errorf("Error getting region: %v", err)
-		os.Exit(1)
+		os.Exit(reporter.ExitCode())
 	}
 	// Filter regions by OCP version for displaying in interactive mode
 	var versionFilter string
@@ -1874,11 +1911,11 @@ func run(cmd *cobra.Command, _ []string) {
 		awsClient, isHostedCP, shardPinningEnabled)
 	if err != nil {
 		r.Reporter.Errorf(fmt.Sprintf("%s", err))
-		os.Exit(1)
+		os.Exit(reporter.ExitCode())
 	}
 	if region == "" {
 		r.Reporter.Errorf("Expected a valid AWS region")
-		os.Exit(1)
+		os.Exit(reporter.ExitCode())
 	} else if found := helper.Contains(regionList, region); isHostedCP && !shardPinningEnabled && !found {
 		r.Reporter.Warnf("Region '%s' not currently available for Hosted Control Plane cluster.", region)
 		interactive.Enable()
@@ -1894,17 +1931,17 @@ func run(cmd *cobra.Command, _ []string) {
 		})
 		if err != nil {
 			r.Reporter.Errorf("Expected a valid AWS region: %s", err)
-			os.Exit(1)
+			os.Exit(reporter.ExitCode())
 		}
 	}
 	if supportsMultiAZ, found := regionAZ[region]; found {
 		if !supportsMultiAZ && multiAZ {
 			r.Reporter.Errorf("Region '%s' does not support multiple availability zones", region)
-			os.Exit(1)
+			os.Exit(reporter.ExitCode())
 		}
 	} else {
 		r.Reporter.Errorf("Region '%s' is not supported for this AWS account", region)
-		os.Exit(1)
+		os.Exit(reporter.ExitCode())
 	}
 
 	awsClient, err = aws.NewClient().
@@ -1914,7 +1951,7 @@ func run(cmd *cobra.Command, _ []string) {
 		Build()
 	if err != nil {
 		r.Reporter.Errorf("Failed to create awsClient: %s", err)
-		os.Exit(1)
+		os.Exit(reporter.ExitCode())
 	}
 	r.AWSClient = awsClient
 
@@ -1937,7 +1974,7 @@ func run(cmd *cobra.Command, _ []string) {
 		})
 		if err != nil {
 			r.Reporter.Errorf("Expected a valid private-link value: %s", err)
-			os.Exit(1)
+			os.Exit(reporter.ExitCode())
 		}
 	} else if (privateLink || (isSTS && private)) && !fedramp.Enabled() && !isPrivateHostedCP {
 		// do not prompt users for privatelink if it is private hosted cluster
@@ -1952,7 +1989,7 @@ func run(cmd *cobra.Command, _ []string) {
 		private = true
 	} else if isSTS && private {
 		r.Reporter.Errorf("Private STS clusters are only supported through AWS PrivateLink")
-		os.Exit(1)
+		os.Exit(reporter.ExitCode())
 	} else if !isSTS {
 		privateWarning := "You will not be able to access your cluster until " +
 			"you edit network settings in your cloud provider."
@@ -1964,7 +2001,7 @@ func run(cmd *cobra.Command, _ []string) {
 			})
 			if err != nil {
 				r.Reporter.Errorf("Expected a valid private value: %s", err)
-				os.Exit(1)
+				os.Exit(reporter.ExitCode())
 			}
 		} else if private {
 			r.Reporter.Warnf("You are choosing to make your cluster private. %s", privateWarning)
@@ -1976,7 +2013,7 @@ func run(cmd *cobra.Command, _ []string) {
 
 	if isSTS && private && !privateLink {
 		r.Reporter.Errorf("Private STS clusters are only supported through AWS PrivateLink")
-		os.Exit(1)
+		os.Exit(reporter.ExitCode())
 	}
 
 	if privateLink || isHostedCP {
@@ -2004,7 +2041,7 @@ func run(cmd *cobra.Command, _ []string) {
 		GetDefaultClusterFlavors(args.flavour)
 	if dMachinecidr == nil || dPodcidr == nil || dServicecidr == nil {
 		r.Reporter.Errorf("Error retrieving default cluster flavors")
-		os.Exit(1)
+		os.Exit(reporter.ExitCode())
 	}
 
 	// Machine CIDR:
@@ -2020,7 +2057,7 @@ func run(cmd *cobra.Command, _ []string) {
 		})
 		if err != nil {
 			r.Reporter.Errorf("Expected a valid CIDR value: %s", err)
-			os.Exit(1)
+			os.Exit(reporter.ExitCode())
 		}
 	}
 
@@ -2037,7 +2074,7 @@ func run(cmd *cobra.Command, _ []string) {
 		})
 		if err != nil {
 			r.Reporter.Errorf("Expected a valid CIDR value: %s", err)
-			os.Exit(1)
+			os.Exit(reporter.ExitCode())
 		}
 	}
 	// Pod CIDR:
@@ -2053,7 +2090,7 @@ func run(cmd *cobra.Command, _ []string) {
 		})
 		if err != nil {
 			r.Reporter.Errorf("Expected a valid CIDR value: %s", err)
-			os.Exit(1)
+			os.Exit(reporter.ExitCode())
 		}
 	}
 
@@ -2076,13 +2113,13 @@ func run(cmd *cobra.Command, _ []string) {
 		})
 		if err != nil {
 			r.Reporter.Errorf("Expected a valid value: %s", err)
-			os.Exit(1)
+			os.Exit(reporter.ExitCode())
 		}
 	}
 
 	if isHostedCP && !subnetsProvided && !useExistingVPC {
 		r.Reporter.Errorf("All hosted clusters need a pre-configured VPC. Make sure to specify the subnet ids")
-		os.Exit(1)
+		os.Exit(reporter.ExitCode())
 	}
 
 	// For hosted cluster we will need the number of the private subnets the users has selected
@@ -2095,7 +2132,7 @@ func run(cmd *cobra.Command, _ []string) {
 		initialSubnets, err := getInitialValidSubnets(awsClient, args.subnetIDs, r.Reporter)
 		if err != nil {
 			r.Reporter.Errorf("Failed to get the list of subnets: %s", err)
-			os.Exit(1)
+			os.Exit(reporter.ExitCode())
 		}
 		if subnetsProvided {
 			useExistingVPC = true
@@ -2103,18 +2140,18 @@ func run(cmd *cobra.Command, _ []string) {
 		_, machineNetwork, err := net.ParseCIDR(machineCIDR.String())
 		if err != nil {
 			r.Reporter.Errorf("Unable to parse machine CIDR")
-			os.Exit(1)
+			os.Exit(reporter.ExitCode())
 		}
 		_, serviceNetwork, err := net.ParseCIDR(serviceCIDR.String())
 		if err != nil {
 			r.Reporter.Errorf("Unable to parse service CIDR")
-			os.Exit(1)
+			os.Exit(reporter.ExitCode())
 		}
 		var filterError error
 		subnets, filterError = filterCidrRangeSubnets(initialSubnets, machineNetwork, serviceNetwork, r)
 		if filterError != nil {
 			r.Reporter.Errorf("%s", filterError)
-			os.Exit(1)
+			os.Exit(reporter.ExitCode())
 		}
 		if privateLink {
 			subnets = filterPrivateSubnets(subnets, r)
@@ -2126,10 +2163,10 @@ func run(cmd *cobra.Command, _ []string) {
 					"All Hosted Control Plane clusters need a pre-configured VPC. Please check: %s",
 					createVpcForHcpDoc,
 				)
-				os.Exit(1)
+				os.Exit(reporter.ExitCode())
 			}
 			if ok := confirm.Prompt(false, "Continue with default? A new RH Managed VPC will be created for your cluster"); !ok {
-				os.Exit(1)
+				os.Exit(reporter.ExitCode())
 			}
 			useExistingVPC = false
 			subnetsProvided = false
@@ -2150,7 +2187,7 @@ func run(cmd *cobra.Command, _ []string) {
 				if !verifiedSubnet {
 					r.Reporter.Errorf("Could not find the following subnet provided in region '%s': %s",
 						r.AWSClient.GetRegion(), subnetArg)
-					os.Exit(1)
+					os.Exit(reporter.ExitCode())
 				}
 			}
 		}
@@ -2198,7 +2235,7 @@ func run(cmd *cobra.Command, _ []string) {
 			})
 			if err != nil {
 				r.Reporter.Errorf("Expected valid subnet IDs: %s", err)
-				os.Exit(1)
+				os.Exit(reporter.ExitCode())
 			}
 			for i, subnet := range subnetIDs {
 				subnetIDs[i] = aws.ParseOption(subnet)
@@ -2217,7 +2254,7 @@ func run(cmd *cobra.Command, _ []string) {
 			}
 			if err != nil {
 				r.Reporter.Errorf("%s", err)
-				os.Exit(1)
+				os.Exit(reporter.ExitCode())
 			}
 		}
 
@@ -2243,7 +2280,7 @@ func run(cmd *cobra.Command, _ []string) {
 
 	if len(subnetIDs) == 0 && isSharedVPC {
 		r.Reporter.Errorf("Installing a cluster into a shared VPC is only supported for BYO VPC clusters")
-		os.Exit(1)
+		os.Exit(reporter.ExitCode())
 	}
 
 	if isSubnetBelongToSharedVpc(r, awsCreator.AccountID, subnetIDs, mapSubnetIDToSubnet) {
@@ -2256,19 +2293,19 @@ func run(cmd *cobra.Command, _ []string) {
 			privateHostedZoneID, err = getPrivateHostedZoneID(cmd, privateHostedZoneID)
 			if err != nil {
 				r.Reporter.Errorf("%s", err)
-				os.Exit(1)
+				os.Exit(reporter.ExitCode())
 			}
 
 			sharedVPCRoleARN, err = getSharedVpcRoleArn(cmd, sharedVPCRoleARN)
 			if err != nil {
 				r.Reporter.Errorf("%s", err)
-				os.Exit(1)
+				os.Exit(reporter.ExitCode())
 			}
 
 			baseDomain, err = getBaseDomain(r, cmd, baseDomain)
 			if err != nil {
 				r.Reporter.Errorf("%s", err)
-				os.Exit(1)
+				os.Exit(reporter.ExitCode())
 			}
 		}
 	}
@@ -2289,20 +2326,20 @@ func run(cmd *cobra.Command, _ []string) {
 			})
 			if err != nil {
 				r.Reporter.Errorf("Expected a valid value for select-availability-zones: %s", err)
-				os.Exit(1)
+				os.Exit(reporter.ExitCode())
 			}
 
 			if selectAvailabilityZones {
 				optionsAvailabilityZones, err := awsClient.DescribeAvailabilityZones()
 				if err != nil {
 					r.Reporter.Errorf("Failed to get the list of the availability zone: %s", err)
-					os.Exit(1)
+					os.Exit(reporter.ExitCode())
 				}
 
 				availabilityZones, err = selectAvailabilityZonesInteractively(cmd, optionsAvailabilityZones, multiAZ)
 				if err != nil {
 					r.Reporter.Errorf("%s", err)
-					os.Exit(1)
+					os.Exit(reporter.ExitCode())
 				}
 			}
 		}
@@ -2311,7 +2348,7 @@ func run(cmd *cobra.Command, _ []string) {
 			err = validateAvailabilityZones(multiAZ, availabilityZones, awsClient)
 			if err != nil {
 				r.Reporter.Errorf(fmt.Sprintf("%s", err))
-				os.Exit(1)
+				os.Exit(reporter.ExitCode())
 			}
 		}
 	}
@@ -2331,7 +2368,7 @@ func run(cmd *cobra.Command, _ []string) {
 		})
 		if err != nil {
 			r.Reporter.Errorf("Expected a valid value for enable-customer-managed-key: %s", err)
-			os.Exit(1)
+			os.Exit(reporter.ExitCode())
 		}
 	}
 
@@ -2347,14 +2384,14 @@ func run(cmd *cobra.Command, _ []string) {
 		})
 		if err != nil {
 			r.Reporter.Errorf("Expected a valid value for kms-key-arn: %s", err)
-			os.Exit(1)
+			os.Exit(reporter.ExitCode())
 		}
 	}
 
 	err = kmsArnRegexpValidator.ValidateKMSKeyARN(&kmsKeyARN)
 	if err != nil {
 		r.Reporter.Errorf("Expected a valid value for kms-key-arn: %s", err)
-		os.Exit(1)
+		os.Exit(reporter.ExitCode())
 	}
 
 	// Compute node instance type:
@@ -2363,7 +2400,7 @@ func run(cmd *cobra.Command, _ []string) {
 		awsClient)
 	if err != nil {
 		r.Reporter.Errorf(fmt.Sprintf("%s", err))
-		os.Exit(1)
+		os.Exit(reporter.ExitCode())
 	}
 	if computeMachineType == "" {
 		computeMachineType = defaultComputeMachineType
@@ -2377,13 +2414,13 @@ func run(cmd *cobra.Command, _ []string) {
 		})
 		if err != nil {
 			r.Reporter.Errorf("Expected a valid machine type: %s", err)
-			os.Exit(1)
+			os.Exit(reporter.ExitCode())
 		}
 	}
 	err = computeMachineTypeList.ValidateMachineType(computeMachineType, multiAZ)
 	if err != nil {
 		r.Reporter.Errorf("Expected a valid machine type: %s", err)
-		os.Exit(1)
+		os.Exit(reporter.ExitCode())
 	}
 
 	isAutoscalingSet := cmd.Flags().Changed("enable-autoscaling")
@@ -2400,7 +2437,7 @@ func run(cmd *cobra.Command, _ []string) {
 		})
 		if err != nil {
 			r.Reporter.Errorf("Expected a valid value for enable-autoscaling: %s", err)
-			os.Exit(1)
+			os.Exit(reporter.ExitCode())
 		}
 	}
 
@@ -2423,7 +2460,7 @@ func run(cmd *cobra.Command, _ []string) {
 		// if the user set compute-nodes and enabled autoscaling
 		if isReplicasSet {
 			r.Reporter.Errorf("Compute-nodes can't be set when autoscaling is enabled")
-			os.Exit(1)
+			os.Exit(reporter.ExitCode())
 		}
 		if interactive.Enabled() || !isMinReplicasSet {
 			minReplicas, err = interactive.GetInt(interactive.Input{
@@ -2437,13 +2474,13 @@ func run(cmd *cobra.Command, _ []string) {
 			})
 			if err != nil {
 				r.Reporter.Errorf("Expected a valid number of min replicas: %s", err)
-				os.Exit(1)
+				os.Exit(reporter.ExitCode())
 			}
 		}
 		err = minReplicaValidator(multiAZ, isHostedCP, privateSubnetsCount)(minReplicas)
 		if err != nil {
 			r.Reporter.Errorf("%s", err)
-			os.Exit(1)
+			os.Exit(reporter.ExitCode())
 		}
 
 		if interactive.Enabled() || !isMaxReplicasSet {
@@ -2458,26 +2495,26 @@ func run(cmd *cobra.Command, _ []string) {
 			})
 			if err != nil {
 				r.Reporter.Errorf("Expected a valid number of max replicas: %s", err)
-				os.Exit(1)
+				os.Exit(reporter.ExitCode())
 			}
 		}
 		err = maxReplicaValidator(multiAZ, minReplicas, isHostedCP, privateSubnetsCount)(maxReplicas)
 		if err != nil {
 			r.Reporter.Errorf("%s", err)
-			os.Exit(1)
+			os.Exit(reporter.ExitCode())
 		}
 
 		if isHostedCP {
 			if clusterautoscaler.IsAutoscalerSetViaCLI(cmd.Flags(), clusterAutoscalerFlagsPrefix) {
 				r.Reporter.Errorf("Hosted Control Plane clusters do not support cluster-autoscaler configuration")
-				os.Exit(1)
+				os.Exit(reporter.ExitCode())
 			}
 		} else {
 			clusterAutoscaler, err = clusterautoscaler.GetAutoscalerOptions(
 				cmd.Flags(), clusterAutoscalerFlagsPrefix, true, autoscalerArgs)
 			if err != nil {
 				r.Reporter.Errorf("%s", err)
-				os.Exit(1)
+				os.Exit(reporter.ExitCode())
 			}
 		}
 	}
@@ -2492,7 +2529,7 @@ func run(cmd *cobra.Command, _ []string) {
 		// if the user set min/max replicas and hasn't enabled autoscaling
 		if isMinReplicasSet || isMaxReplicasSet {
 			r.Reporter.Errorf("Autoscaling must be enabled in order to set min and max replicas")
-			os.Exit(1)
+			os.Exit(reporter.ExitCode())
 		}
 
 		if interactive.Enabled() {
@@ -2506,13 +2543,13 @@ func run(cmd *cobra.Command, _ []string) {
 			})
 			if err != nil {
 				r.Reporter.Errorf("Expected a valid number of compute nodes: %s", err)
-				os.Exit(1)
+				os.Exit(reporter.ExitCode())
 			}
 		}
 		err = minReplicaValidator(multiAZ, isHostedCP, privateSubnetsCount)(computeNodes)
 		if err != nil {
 			r.Reporter.Errorf("%s", err)
-			os.Exit(1)
+			os.Exit(reporter.ExitCode())
 		}
 	}
 
@@ -2529,20 +2566,23 @@ func run(cmd *cobra.Command, _ []string) {
 		})
 		if err != nil {
 			r.Reporter.Errorf("Expected a valid comma-separated list of attributes: %s", err)
-			os.Exit(1)
+			os.Exit(reporter.ExitCode())
 		}
 	}
 	labelMap, err := mpHelpers.ParseLabels(labels)
+	if err == nil {
+		err = mpHelpers.ValidatePoolLabels(labelMap, nil)
+	}
 	if err != nil {
 		r.Reporter.Errorf("%s", err)
-		os.Exit(1)
+		os.Exit(reporter.ExitCode())
 	}
 
 	isVersionCompatibleComputeSgIds, err := versions.IsGreaterThanOrEqual(
 		version, ocm.MinVersionForAdditionalComputeSecurityGroupIdsDay1)
 	if err != nil {
 		r.Reporter.Errorf("There was a problem checking version compatibility: %v", err)
-		os.Exit(1)
+		os.Exit(reporter.ExitCode())
 	}
 	additionalComputeSecurityGroupIds := args.additionalComputeSecurityGroupIds
 	getSecurityGroups(r, cmd, isVersionCompatibleComputeSgIds,
@@ -2563,13 +2603,13 @@ func run(cmd *cobra.Command, _ []string) {
 	expiration, err := validateExpiration()
 	if err != nil {
 		r.Reporter.Errorf(fmt.Sprintf("%s", err))
-		os.Exit(1)
+		os.Exit(reporter.ExitCode())
 	}
 
 	// Network Type:
 	if err := validateNetworkType(args.networkType); err != nil {
 		r.Reporter.Errorf("%s", err)
-		os.Exit(1)
+		os.Exit(reporter.ExitCode())
 	}
 	if cmd.Flags().Changed("network-type") && interactive.Enabled() {
 		args.networkType, err = interactive.GetOption(interactive.Input{
@@ -2580,7 +2620,7 @@ func run(cmd *cobra.Command, _ []string) {
 		})
 		if err != nil {
 			r.Reporter.Errorf("Expected a valid network type: %s", err)
-			os.Exit(1)
+			os.Exit(reporter.ExitCode())
 		}
 	}
 
@@ -2600,30 +2640,30 @@ func run(cmd *cobra.Command, _ []string) {
 		})
 		if err != nil {
 			r.Reporter.Errorf("Expected a valid host prefix value: %s", err)
-			os.Exit(1)
+			os.Exit(reporter.ExitCode())
 		}
 	}
 	err = hostPrefixValidator(hostPrefix)
 	if err != nil {
 		r.Reporter.Errorf("%s", err)
-		os.Exit(1)
+		os.Exit(reporter.ExitCode())
 	}
 
 	machinePoolRootDisk, err := getMachinePoolRootDisk(r, cmd, version,
 		isHostedCP, defaultMachinePoolRootDiskSize)
 	if err != nil {
 		r.Reporter.Errorf(err.Error())
-		os.Exit(1)
+		os.Exit(reporter.ExitCode())
 	}
 
 	// No CNI
 	if cmd.Flags().Changed("no-cni") && !isHostedCP {
 		r.Reporter.Errorf("Disabling CNI is supported only for Hosted Control Planes")
-		os.Exit(1)
+		os.Exit(reporter.ExitCode())
 	}
 	if cmd.Flags().Changed("no-cni") && cmd.Flags().Changed("network-type") {
 		r.Reporter.Errorf("--no-cni and --network-type are mutually exclusive parameters")
-		os.Exit(1)
+		os.Exit(reporter.ExitCode())
 	}
 	noCni := args.noCni
 	if cmd.Flags().Changed("no-cni") && interactive.Enabled() {
@@ -2634,13 +2674,13 @@ func run(cmd *cobra.Command, _ []string) {
 		})
 		if err != nil {
 			r.Reporter.Errorf("Expected a valid value for no CNI: %s", err)
-			os.Exit(1)
+			os.Exit(reporter.ExitCode())
 		}
 	}
 
 	if cmd.Flags().Changed("fips") && isHostedCP {
 		r.Reporter.Errorf("FIPS support not available for Hosted Control Plane clusters")
-		os.Exit(1)
+		os.Exit(reporter.ExitCode())
 	}
 	fips := args.fips || fedramp.Enabled()
 	if interactive.Enabled() && !fedramp.Enabled() && !isHostedCP {
@@ -2651,7 +2691,7 @@ func run(cmd *cobra.Command, _ []string) {
 		})
 		if err != nil {
 			r.Reporter.Errorf("Expected a valid FIPS value: %v", err)
-			os.Exit(1)
+			os.Exit(reporter.ExitCode())
 		}
 	}
 
@@ -2661,7 +2701,7 @@ func run(cmd *cobra.Command, _ []string) {
 	if etcdEncryptionKmsARN != "" {
 		if cmd.Flags().Changed("etcd-encryption") && !etcdEncryption {
 			r.Reporter.Errorf("etcd encryption cannot be disabled when encryption kms arn is provided")
-			os.Exit(1)
+			os.Exit(reporter.ExitCode())
 		} else {
 			etcdEncryption = true
 		}
@@ -2675,13 +2715,13 @@ func run(cmd *cobra.Command, _ []string) {
 		})
 		if err != nil {
 			r.Reporter.Errorf("Expected a valid etcd-encryption value: %v", err)
-			os.Exit(1)
+			os.Exit(reporter.ExitCode())
 		}
 	}
 	if fips {
 		if cmd.Flags().Changed("etcd-encryption") && !etcdEncryption {
 			r.Reporter.Errorf("etcd encryption cannot be disabled on clusters with FIPS mode")
-			os.Exit(1)
+			os.Exit(reporter.ExitCode())
 		} else {
 			etcdEncryption = true
 		}
@@ -2699,7 +2739,7 @@ func run(cmd *cobra.Command, _ []string) {
 		})
 		if err != nil {
 			r.Reporter.Errorf("Expected a valid value for etcd-encryption-kms-arn: %s", err)
-			os.Exit(1)
+			os.Exit(reporter.ExitCode())
 		}
 	}
 
@@ -2709,7 +2749,7 @@ func run(cmd *cobra.Command, _ []string) {
 			"Expected a valid value for etcd-encryption-kms-arn matching %s",
 			kmsArnRegexpValidator.KmsArnRE,
 		)
-		os.Exit(1)
+		os.Exit(reporter.ExitCode())
 	}
 
 	disableWorkloadMonitoring := args.disableWorkloadMonitoring
@@ -2721,7 +2761,7 @@ func run(cmd *cobra.Command, _ []string) {
 		})
 		if err != nil {
 			r.Reporter.Errorf("Expected a valid disable-workload-monitoring value: %v", err)
-			os.Exit(1)
+			os.Exit(reporter.ExitCode())
 		}
 	}
 
@@ -2735,7 +2775,7 @@ func run(cmd *cobra.Command, _ []string) {
 		})
 		if err != nil {
 			r.Reporter.Errorf("Expected a valid proxy-enabled value: %s", err)
-			os.Exit(1)
+			os.Exit(reporter.ExitCode())
 		}
 	}
 
@@ -2750,13 +2790,13 @@ func run(cmd *cobra.Command, _ []string) {
 		})
 		if err != nil {
 			r.Reporter.Errorf("Expected a valid http proxy: %s", err)
-			os.Exit(1)
+			os.Exit(reporter.ExitCode())
 		}
 	}
 	err = ocm.ValidateHTTPProxy(httpProxy)
 	if err != nil {
 		r.Reporter.Errorf("%s", err)
-		os.Exit(1)
+		os.Exit(reporter.ExitCode())
 	}
 
 	if enableProxy && interactive.Enabled() {
@@ -2770,13 +2810,13 @@ func run(cmd *cobra.Command, _ []string) {
 		})
 		if err != nil {
 			r.Reporter.Errorf("Expected a valid https proxy: %s", err)
-			os.Exit(1)
+			os.Exit(reporter.ExitCode())
 		}
 	}
 	err = interactive.IsURL(httpsProxy)
 	if err != nil {
 		r.Reporter.Errorf("%s", err)
-		os.Exit(1)
+		os.Exit(reporter.ExitCode())
 	}
 
 	if enableProxy && interactive.Enabled() {
@@ -2791,7 +2831,7 @@ func run(cmd *cobra.Command, _ []string) {
 		})
 		if err != nil {
 			r.Reporter.Errorf("Expected a valid set of no proxy domains/CIDR's: %s", err)
-			os.Exit(1)
+			os.Exit(reporter.ExitCode())
 		}
 		noProxySlice = helper.HandleEmptyStringOnSlice(strings.Split(noProxyInput, ","))
 	}
@@ -2800,20 +2840,20 @@ func run(cmd *cobra.Command, _ []string) {
 		duplicate, found := aws.HasDuplicates(noProxySlice)
 		if found {
 			r.Reporter.Errorf("Invalid no-proxy list, duplicate key '%s' found", duplicate)
-			os.Exit(1)
+			os.Exit(reporter.ExitCode())
 		}
 		for _, domain := range noProxySlice {
 			err := aws.UserNoProxyValidator(domain)
 			if err != nil {
 				r.Reporter.Errorf("%s", err)
-				os.Exit(1)
+				os.Exit(reporter.ExitCode())
 			}
 		}
 	}
 
 	if httpProxy == "" && httpsProxy == "" && len(noProxySlice) > 0 {
 		r.Reporter.Errorf("Expected at least one of the following: http-proxy, https-proxy")
-		os.Exit(1)
+		os.Exit(reporter.ExitCode())
 	}
 
 	if useExistingVPC && interactive.Enabled() {
@@ -2827,13 +2867,13 @@ func run(cmd *cobra.Command, _ []string) {
 		})
 		if err != nil {
 			r.Reporter.Errorf("Expected a valid additional trust bundle file name: %s", err)
-			os.Exit(1)
+			os.Exit(reporter.ExitCode())
 		}
 	}
 	err = ocm.ValidateAdditionalTrustBundle(additionalTrustBundleFile)
 	if err != nil {
 		r.Reporter.Errorf("%s", err)
-		os.Exit(1)
+		os.Exit(reporter.ExitCode())
 	}
 
 	// Get certificate contents
@@ -2842,7 +2882,7 @@ func run(cmd *cobra.Command, _ []string) {
 		cert, err := os.ReadFile(additionalTrustBundleFile)
 		if err != nil {
 			r.Reporter.Errorf("Failed to read additional trust bundle file: %s", err)
-			os.Exit(1)
+			os.Exit(reporter.ExitCode())
 		}
 		additionalTrustBundle = new(string)
 		*additionalTrustBundle = string(cert)
@@ -2850,7 +2890,7 @@ func run(cmd *cobra.Command, _ []string) {
 
 	if enableProxy && httpProxy == "" && httpsProxy == "" && additionalTrustBundleFile == "" {
 		r.Reporter.Errorf("Expected at least one of the following: http-proxy, https-proxy, additional-trust-bundle")
-		os.Exit(1)
+		os.Exit(reporter.ExitCode())
 	}
 
 	// Audit Log Forwarding
@@ -2858,7 +2898,7 @@ func run(cmd *cobra.Command, _ []string) {
 
 	if auditLogRoleARN != "" && !isHostedCP {
 		r.Reporter.Errorf("Audit log forwarding to AWS CloudWatch is only supported for Hosted Control Plane clusters")
-		os.Exit(1)
+		os.Exit(reporter.ExitCode())
 	}
 
 	if interactive.Enabled() && isHostedCP {
@@ -2869,7 +2909,7 @@ func run(cmd *cobra.Command, _ []string) {
 		})
 		if err != nil {
 			r.Reporter.Errorf("Expected a valid value: %s", err)
-			os.Exit(1)
+			os.Exit(reporter.ExitCode())
 		}
 		if requestAuditLogForwarding {
 
@@ -2887,7 +2927,7 @@ func run(cmd *cobra.Command, _ []string) {
 			})
 			if err != nil {
 				r.Reporter.Errorf("Expected a valid value for audit-log-arn: %s", err)
-				os.Exit(1)
+				os.Exit(reporter.ExitCode())
 			}
 		} else {
 			auditLogRoleARN = ""
@@ -2896,31 +2936,31 @@ func run(cmd *cobra.Command, _ []string) {
 
 	if auditLogRoleARN != "" && !aws.RoleArnRE.MatchString(auditLogRoleARN) {
 		r.Reporter.Errorf("Expected a valid value for audit log arn matching %s", aws.RoleArnRE)
-		os.Exit(1)
+		os.Exit(reporter.ExitCode())
 	}
 
 	isVersionCompatibleManagedIngressV2, err := versions.IsGreaterThanOrEqual(
 		version, ocm.MinVersionForManagedIngressV2)
 	if err != nil {
 		r.Reporter.Errorf("There was a problem checking version compatibility: %v", err)
-		os.Exit(1)
+		os.Exit(reporter.ExitCode())
 	}
 	if ingress.IsDefaultIngressSetViaCLI(cmd.Flags()) {
 		if isHostedCP {
 			r.Reporter.Errorf("Updating default ingress settings is not supported for Hosted Control Plane clusters")
-			os.Exit(1)
+			os.Exit(reporter.ExitCode())
 		}
 		if !isVersionCompatibleManagedIngressV2 {
 			formattedVersion, err := versions.FormatMajorMinorPatch(ocm.MinVersionForManagedIngressV2)
 			if err != nil {
 				r.Reporter.Errorf(versions.MajorMinorPatchFormattedErrorOutput, err)
-				os.Exit(1)
+				os.Exit(reporter.ExitCode())
 			}
 			r.Reporter.Errorf(
 				"Updating default ingress settings is not supported for versions prior to '%s'",
 				formattedVersion,
 			)
-			os.Exit(1)
+			os.Exit(reporter.ExitCode())
 		}
 	}
 	routeSelector := ""
@@ -2937,7 +2977,7 @@ func run(cmd *cobra.Command, _ []string) {
 		if cmd.Flags().Changed(ingress.DefaultIngressRouteSelectorFlag) {
 			if isHostedCP {
 				r.Reporter.Errorf("Updating route selectors is not supported for Hosted Control Plane clusters")
-				os.Exit(1)
+				os.Exit(reporter.ExitCode())
 			}
 			routeSelector = args.defaultIngressRouteSelectors
 		} else if interactive.Enabled() && !isHostedCP && shouldAskCustomIngress {
@@ -2954,20 +2994,20 @@ func run(cmd *cobra.Command, _ []string) {
 			})
 			if err != nil {
 				r.Reporter.Errorf("Expected a valid comma-separated list of attributes: %s", err)
-				os.Exit(1)
+				os.Exit(reporter.ExitCode())
 			}
 			routeSelector = routeSelectorArg
 		}
 		routeSelectors, err = ingress.GetRouteSelector(routeSelector)
 		if err != nil {
 			r.Reporter.Errorf("%s", err)
-			os.Exit(1)
+			os.Exit(reporter.ExitCode())
 		}
 
 		if cmd.Flags().Changed(ingress.DefaultIngressExcludedNamespacesFlag) {
 			if isHostedCP {
 				r.Reporter.Errorf("Updating excluded namespace is not supported for Hosted Control Plane clusters")
-				os.Exit(1)
+				os.Exit(reporter.ExitCode())
 			}
 			excludedNamespaces = args.defaultIngressExcludedNamespaces
 		} else if interactive.Enabled() && !isHostedCP && shouldAskCustomIngress {
@@ -2978,7 +3018,7 @@ func run(cmd *cobra.Command, _ []string) {
 			})
 			if err != nil {
 				r.Reporter.Errorf("Expected a valid comma-separated list of attributes: %s", err)
-				os.Exit(1)
+				os.Exit(reporter.ExitCode())
 			}
 			excludedNamespaces = excludedNamespacesArg
 		}
@@ -2987,7 +3027,7 @@ func run(cmd *cobra.Command, _ []string) {
 		if cmd.Flags().Changed(ingress.DefaultIngressWildcardPolicyFlag) {
 			if isHostedCP {
 				r.Reporter.Errorf("Updating Wildcard Policy is not supported for Hosted Control Plane clusters")
-				os.Exit(1)
+				os.Exit(reporter.ExitCode())
 			}
 			wildcardPolicy = args.defaultIngressWildcardPolicy
 		} else {
@@ -3005,7 +3045,7 @@ func run(cmd *cobra.Command, _ []string) {
 				})
 				if err != nil {
 					r.Reporter.Errorf("Expected a valid Wildcard Policy: %s", err)
-					os.Exit(1)
+					os.Exit(reporter.ExitCode())
 				}
 				wildcardPolicy = wildcardPolicyArg
 			}
@@ -3016,7 +3056,7 @@ func run(cmd *cobra.Command, _ []string) {
 				r.Reporter.Errorf(
 					"Updating Namespace Ownership Policy is not supported for Hosted Control Plane clusters",
 				)
-				os.Exit(1)
+				os.Exit(reporter.ExitCode())
 			}
 			namespaceOwnershipPolicy = args.defaultIngressNamespaceOwnershipPolicy
 		} else {
@@ -3034,7 +3074,7 @@ func run(cmd *cobra.Command, _ []string) {
 				})
 				if err != nil {
 					r.Reporter.Errorf("Expected a valid Namespace Ownership Policy: %s", err)
-					os.Exit(1)
+					os.Exit(reporter.ExitCode())
 				}
 				namespaceOwnershipPolicy = namespaceOwnershipPolicyArg
 			}
@@ -3137,7 +3177,7 @@ func run(cmd *cobra.Command, _ []string) {
 		autoscalerConfig, err := clusterautoscaler.CreateAutoscalerConfig(clusterAutoscaler)
 		if err != nil {
 			r.Reporter.Errorf("Failed creating autoscaler configuration: %s", err)
-			os.Exit(1)
+			os.Exit(reporter.ExitCode())
 		}
 
 		clusterConfig.AutoscalerConfig = autoscalerConfig
@@ -3150,7 +3190,7 @@ func run(cmd *cobra.Command, _ []string) {
 	if args.useLocalCredentials {
 		if isSTS {
 			r.Reporter.Errorf("Local credentials are not supported for STS clusters")
-			os.Exit(1)
+			os.Exit(reporter.ExitCode())
 		}
 		props = append(props, properties.UseLocalCredentials)
 	}
@@ -3169,7 +3209,7 @@ func run(cmd *cobra.Command, _ []string) {
 	clusterConfig, err = clusterConfigFor(r.Reporter, clusterConfig, awsCreator, awsClient)
 	if err != nil {
 		r.Reporter.Errorf("%s", err)
-		os.Exit(1)
+		os.Exit(reporter.ExitCode())
 	}
 
 	if !output.HasFlag() || r.Reporter.IsTerminal() {
@@ -3182,10 +3222,26 @@ func run(cmd *cobra.Command, _ []string) {
 		r.Reporter.Infof("To view a list of clusters and their status, run 'rosa list clusters'")
 	}
 
+	if args.exportSpec != "" {
+		command := buildCommand(clusterConfig, operatorRolesPrefix, expectedOperatorRolePath,
+			isAvailabilityZonesSet || selectAvailabilityZones, labels, args.properties)
+		err = exportSpecFile(args.exportSpec, command)
+		if err != nil {
+			r.Reporter.Errorf("%s", err)
+			os.Exit(reporter.ExitCode())
+		}
+		if args.clusterAdminPassword != "" {
+			r.Reporter.Warnf("The cluster admin password isn't saved in the cluster spec, a random password " +
+				"is generated when the cluster is created from it")
+		}
+		r.Reporter.Infof("Cluster spec written to '%s'. To create this cluster again, run:\n"+
+			"   rosa create cluster --%s %s", args.exportSpec, fromFileFlag, args.exportSpec)
+	}
+
 	if !clusterConfig.IsSTS {
 		if err := r.OCMClient.EnsureNoPendingClusters(awsCreator); err != nil {
 			r.Reporter.Errorf("%v", err)
-			os.Exit(1)
+			os.Exit(reporter.ExitCode())
 		}
 	}
 
@@ -3196,7 +3252,7 @@ func run(cmd *cobra.Command, _ []string) {
 		} else {
 			r.Reporter.Errorf("Failed to create cluster: %s", err)
 		}
-		os.Exit(1)
+		os.Exit(reporter.ExitCode())
 	}
 
 	if args.dryRun {
@@ -3243,7 +3299,7 @@ func run(cmd *cobra.Command, _ []string) {
 					r.Reporter.Debugf("Failed to verify if OIDC provider exists: %s", err)
 				} else {
 					r.Reporter.Errorf("Failed to verify if OIDC provider exists: %s", err)
-					os.Exit(1)
+					os.Exit(reporter.ExitCode())
 				}
 			}
 			if !oidcProviderExists {
@@ -3396,7 +3452,7 @@ func handleOidcConfigOptions(r *rosa.Runtime, cmd *cobra.Command, isSTS bool, is
 			})
 			if err != nil {
 				r.Reporter.Errorf("Expected a valid value: %s", err)
-				os.Exit(1)
+				os.Exit(reporter.ExitCode())
 			}
 			isOidcConfig = _isOidcConfig
 		}
@@ -3416,12 +3472,12 @@ func handleOidcConfigOptions(r *rosa.Runtime, cmd *cobra.Command, isSTS bool, is
 		}
 		r.Reporter.Errorf("Hosted Control Plane requires an OIDC Configuration ID\n" +
 			"Please run `rosa create oidc-config -h` and create one.")
-		os.Exit(1)
+		os.Exit(reporter.ExitCode())
 	}
 	oidcConfig, err := r.OCMClient.GetOidcConfig(oidcConfigId)
 	if err != nil {
 		r.Reporter.Errorf("There was a problem retrieving OIDC Config '%s': %v", oidcConfigId, err)
-		os.Exit(1)
+		os.Exit(reporter.ExitCode())
 	}
 	return oidcConfig
 }
@@ -3432,7 +3488,7 @@ func filterPrivateSubnets(initialSubnets []ec2types.Subnet, r *rosa.Runtime) []e
 	publicSubnetMap, err := r.AWSClient.FetchPublicSubnetMap(initialSubnets)
 	if err != nil {
 		r.Reporter.Errorf("Unable to check if subnet have an IGW: %v", err)
-		os.Exit(1)
+		os.Exit(reporter.ExitCode())
 	}
 	for _, subnet := range initialSubnets {
 		skip := false
@@ -3669,26 +3725,26 @@ func buildCommand(spec ocm.Spec, operatorRolesPrefix string,
 	operatorRolePath string, userSelectedAvailabilityZones bool, labels string,
 	properties []string) string {
 	command := "rosa create cluster"
-	command += fmt.Sprintf(" --cluster-name %s", spec.Name)
+	command += fmt.Sprintf(" --cluster-name %s", shellquote.Join(spec.Name))
 	if spec.DomainPrefix != "" {
-		command += fmt.Sprintf(" --domain-prefix %s", spec.DomainPrefix)
+		command += fmt.Sprintf(" --domain-prefix %s", shellquote.Join(spec.DomainPrefix))
 	}
 
 	if spec.IsSTS {
 		command += " --sts"
 		if spec.Mode != "" {
-			command += fmt.Sprintf(" --mode %s", spec.Mode)
+			command += fmt.Sprintf(" --mode %s", shellquote.Join(spec.Mode))
 		}
 	}
 	if spec.ClusterAdminUser != "" {
 		argAdded := false
 		// Checks if admin password is from user (both flag and interactive)
 		if args.clusterAdminPassword != "" && spec.ClusterAdminPassword != "" {
-			command += fmt.Sprintf(" --cluster-admin-password %s", spec.ClusterAdminPassword)
+			command += fmt.Sprintf(" --cluster-admin-password %s", shellquote.Join(spec.ClusterAdminPassword))
 			argAdded = true
 		}
 		if spec.ClusterAdminUser != admin.ClusterAdminUsername {
-			command += fmt.Sprintf(" --cluster-admin-user %s", spec.ClusterAdminUser)
+			command += fmt.Sprintf(" --cluster-admin-user %s", shellquote.Join(spec.ClusterAdminUser))
 			argAdded = true
 		}
 		if !argAdded {
@@ -3696,21 +3752,21 @@ func buildCommand(spec ocm.Spec, operatorRolesPrefix string,
 		}
 	}
 	if spec.RoleARN != "" {
-		command += fmt.Sprintf(" --role-arn %s", spec.RoleARN)
-		command += fmt.Sprintf(" --support-role-arn %s", spec.SupportRoleARN)
+		command += fmt.Sprintf(" --role-arn %s", shellquote.Join(spec.RoleARN))
+		command += fmt.Sprintf(" --support-role-arn %s", shellquote.Join(spec.SupportRoleARN))
 		if !spec.Hypershift.Enabled {
-			command += fmt.Sprintf(" --controlplane-iam-role %s", spec.ControlPlaneRoleARN)
+			command += fmt.Sprintf(" --controlplane-iam-role %s", shellquote.Join(spec.ControlPlaneRoleARN))
 		}
-		command += fmt.Sprintf(" --worker-iam-role %s", spec.WorkerRoleARN)
+		command += fmt.Sprintf(" --worker-iam-role %s", shellquote.Join(spec.WorkerRoleARN))
 	}
 	if spec.ExternalID != "" {
-		command += fmt.Sprintf(" --external-id %s", spec.ExternalID)
+		command += fmt.Sprintf(" --external-id %s", shellquote.Join(spec.ExternalID))
 	}
 	if operatorRolesPrefix != "" {
-		command += fmt.Sprintf(" --operator-roles-prefix %s", operatorRolesPrefix)
+		command += fmt.Sprintf(" --operator-roles-prefix %s", shellquote.Join(operatorRolesPrefix))
 	}
 	if spec.OidcConfigId != "" {
-		command += fmt.Sprintf(" --%s %s", OidcConfigIdFlag, spec.OidcConfigId)
+		command += fmt.Sprintf(" --%s %s", OidcConfigIdFlag, shellquote.Join(spec.OidcConfigId))
 	}
 	if args.classicOidcConfig {
 		command += fmt.Sprintf(" --%s", ClassicOidcConfigFlag)
@@ -3719,13 +3775,13 @@ func buildCommand(spec ocm.Spec, operatorRolesPrefix string,
 		command += fmt.Sprintf(" --%s", ExternalAuthProvidersEnabledFlag)
 	}
 	if len(spec.Tags) > 0 {
-		command += fmt.Sprintf(" --tags \"%s\"", strings.Join(buildTagsCommand(spec.Tags), ","))
+		command += fmt.Sprintf(" --tags %s", shellquote.Join(strings.Join(buildTagsCommand(spec.Tags), ",")))
 	}
 	if spec.MultiAZ && !spec.Hypershift.Enabled {
 		command += " --multi-az"
 	}
 	if spec.Region != "" {
-		command += fmt.Sprintf(" --region %s", spec.Region)
+		command += fmt.Sprintf(" --region %s", shellquote.Join(spec.Region))
 	}
 	if spec.DisableSCPChecks != nil && *spec.DisableSCPChecks {
 		command += " --disable-scp-checks"
@@ -3733,18 +3789,18 @@ func buildCommand(spec ocm.Spec, operatorRolesPrefix string,
 	if spec.Version != "" {
 		commandVersion := ocm.GetRawVersionId(spec.Version)
 		if spec.ChannelGroup != ocm.DefaultChannelGroup {
-			command += fmt.Sprintf(" --channel-group %s", spec.ChannelGroup)
+			command += fmt.Sprintf(" --channel-group %s", shellquote.Join(spec.ChannelGroup))
 		}
-		command += fmt.Sprintf(" --version %s", commandVersion)
+		command += fmt.Sprintf(" --version %s", shellquote.Join(commandVersion))
 	}
 
 	if spec.Ec2MetadataHttpTokens != "" {
-		command += fmt.Sprintf(" --ec2-metadata-http-tokens %s", spec.Ec2MetadataHttpTokens)
+		command += fmt.Sprintf(" --ec2-metadata-http-tokens %s", shellquote.Join(string(spec.Ec2MetadataHttpTokens)))
 	}
 
 	// Only account for expiration duration, as a fixed date may be obsolete if command is re-run later
 	if args.expirationDuration != 0 {
-		command += fmt.Sprintf(" --expiration %s", args.expirationDuration)
+		command += fmt.Sprintf(" --expiration %s", shellquote.Join(args.expirationDuration.String()))
 	}
 
 	if spec.Autoscaling {
@@ -3761,24 +3817,24 @@ func buildCommand(spec ocm.Spec, operatorRolesPrefix string,
 		}
 	}
 	if spec.ComputeMachineType != "" {
-		command += fmt.Sprintf(" --compute-machine-type %s", spec.ComputeMachineType)
+		command += fmt.Sprintf(" --compute-machine-type %s", shellquote.Join(spec.ComputeMachineType))
 	}
 
 	if len(spec.ComputeLabels) != 0 {
-		command += fmt.Sprintf(" --%s \"%s\"", arguments.NewDefaultMPLabelsFlag, labels)
+		command += fmt.Sprintf(" --%s %s", arguments.NewDefaultMPLabelsFlag, shellquote.Join(labels))
 	}
 
 	if spec.NetworkType != "" {
-		command += fmt.Sprintf(" --network-type %s", spec.NetworkType)
+		command += fmt.Sprintf(" --network-type %s", shellquote.Join(spec.NetworkType))
 	}
 	if !ocm.IsEmptyCIDR(spec.MachineCIDR) {
-		command += fmt.Sprintf(" --machine-cidr %s", spec.MachineCIDR.String())
+		command += fmt.Sprintf(" --machine-cidr %s", shellquote.Join(spec.MachineCIDR.String()))
 	}
 	if !ocm.IsEmptyCIDR(spec.ServiceCIDR) {
-		command += fmt.Sprintf(" --service-cidr %s", spec.ServiceCIDR.String())
+		command += fmt.Sprintf(" --service-cidr %s", shellquote.Join(spec.ServiceCIDR.String()))
 	}
 	if !ocm.IsEmptyCIDR(spec.PodCIDR) {
-		command += fmt.Sprintf(" --pod-cidr %s", spec.PodCIDR.String())
+		command += fmt.Sprintf(" --pod-cidr %s", shellquote.Join(spec.PodCIDR.String()))
 	}
 	if spec.HostPrefix != 0 {
 		command += fmt.Sprintf(" --host-prefix %d", spec.HostPrefix)
@@ -3789,51 +3845,51 @@ func buildCommand(spec ocm.Spec, operatorRolesPrefix string,
 		command += " --private"
 	}
 	if len(spec.SubnetIds) > 0 {
-		command += fmt.Sprintf(" --subnet-ids %s", strings.Join(spec.SubnetIds, ","))
+		command += fmt.Sprintf(" --subnet-ids %s", shellquote.Join(strings.Join(spec.SubnetIds, ",")))
 	}
 	if spec.PrivateHostedZoneID != "" {
-		command += fmt.Sprintf(" --private-hosted-zone-id %s", spec.PrivateHostedZoneID)
-		command += fmt.Sprintf(" --shared-vpc-role-arn %s", spec.SharedVPCRoleArn)
-		command += fmt.Sprintf(" --base-domain %s", spec.BaseDomain)
+		command += fmt.Sprintf(" --private-hosted-zone-id %s", shellquote.Join(spec.PrivateHostedZoneID))
+		command += fmt.Sprintf(" --shared-vpc-role-arn %s", shellquote.Join(spec.SharedVPCRoleArn))
+		command += fmt.Sprintf(" --base-domain %s", shellquote.Join(spec.BaseDomain))
 	}
 	if spec.FIPS {
 		command += " --fips"
 	} else if spec.EtcdEncryption {
 		command += " --etcd-encryption"
 		if spec.EtcdEncryptionKMSArn != "" {
-			command += fmt.Sprintf(" --etcd-encryption-kms-arn %s", spec.EtcdEncryptionKMSArn)
+			command += fmt.Sprintf(" --etcd-encryption-kms-arn %s", shellquote.Join(spec.EtcdEncryptionKMSArn))
 		}
 	}
 
 	if spec.EnableProxy {
 		if spec.HTTPProxy != nil && *spec.HTTPProxy != "" {
-			command += fmt.Sprintf(" --http-proxy %s", *spec.HTTPProxy)
+			command += fmt.Sprintf(" --http-proxy %s", shellquote.Join(*spec.HTTPProxy))
 		}
 		if spec.HTTPSProxy != nil && *spec.HTTPSProxy != "" {
-			command += fmt.Sprintf(" --https-proxy %s", *spec.HTTPSProxy)
+			command += fmt.Sprintf(" --https-proxy %s", shellquote.Join(*spec.HTTPSProxy))
 		}
 		if spec.NoProxy != nil && *spec.NoProxy != "" {
-			command += fmt.Sprintf(" --no-proxy \"%s\"", *spec.NoProxy)
+			command += fmt.Sprintf(" --no-proxy %s", shellquote.Join(*spec.NoProxy))
 		}
 	}
 	if spec.AdditionalTrustBundleFile != nil && *spec.AdditionalTrustBundleFile != "" {
-		command += fmt.Sprintf(" --additional-trust-bundle-file %s", *spec.AdditionalTrustBundleFile)
+		command += fmt.Sprintf(" --additional-trust-bundle-file %s", shellquote.Join(*spec.AdditionalTrustBundleFile))
 	}
 	if spec.KMSKeyArn != "" {
-		command += fmt.Sprintf(" --kms-key-arn %s", spec.KMSKeyArn)
+		command += fmt.Sprintf(" --kms-key-arn %s", shellquote.Join(spec.KMSKeyArn))
 	}
 	if spec.DisableWorkloadMonitoring != nil && *spec.DisableWorkloadMonitoring {
 		command += " --disable-workload-monitoring"
 	}
 	if userSelectedAvailabilityZones {
-		command += fmt.Sprintf(" --availability-zones %s", strings.Join(spec.AvailabilityZones, ","))
+		command += fmt.Sprintf(" --availability-zones %s", shellquote.Join(strings.Join(spec.AvailabilityZones, ",")))
 	}
 	if spec.Hypershift.Enabled {
 		command += " " + hostedCPFlag
 	}
 
 	if spec.AuditLogRoleARN != nil && *spec.AuditLogRoleARN != "" {
-		command += fmt.Sprintf(" --audit-log-arn %s", *spec.AuditLogRoleARN)
+		command += fmt.Sprintf(" --audit-log-arn %s", shellquote.Join(*spec.AuditLogRoleARN))
 	}
 	if spec.MachinePoolRootDisk != nil {
 		machinePoolRootDiskSize := spec.MachinePoolRootDisk.Size
@@ -3848,22 +3904,23 @@ func buildCommand(spec ocm.Spec, operatorRolesPrefix string,
 			for k, v := range spec.DefaultIngress.RouteSelectors {
 				selectors = append(selectors, fmt.Sprintf("%s=%s", k, v))
 			}
-			command += fmt.Sprintf(" --%s %s", ingress.DefaultIngressRouteSelectorFlag, strings.Join(selectors, ","))
+			command += fmt.Sprintf(" --%s %s", ingress.DefaultIngressRouteSelectorFlag,
+				shellquote.Join(strings.Join(selectors, ",")))
 		}
 		if len(spec.DefaultIngress.ExcludedNamespaces) != 0 {
 			command += fmt.Sprintf(" --%s %s", ingress.DefaultIngressExcludedNamespacesFlag,
-				strings.Join(spec.DefaultIngress.ExcludedNamespaces, ","))
+				shellquote.Join(strings.Join(spec.DefaultIngress.ExcludedNamespaces, ",")))
 		}
 		if !helper.Contains([]string{"", consts.SkipSelectionOption}, spec.DefaultIngress.WildcardPolicy) {
 			command += fmt.Sprintf(
 				" --%s %s",
 				ingress.DefaultIngressWildcardPolicyFlag,
-				spec.DefaultIngress.WildcardPolicy,
+				shellquote.Join(spec.DefaultIngress.WildcardPolicy),
 			)
 		}
 		if !helper.Contains([]string{"", consts.SkipSelectionOption}, spec.DefaultIngress.NamespaceOwnershipPolicy) {
 			command += fmt.Sprintf(" --%s %s", ingress.DefaultIngressNamespaceOwnershipPolicyFlag,
-				spec.DefaultIngress.NamespaceOwnershipPolicy)
+				shellquote.Join(spec.DefaultIngress.NamespaceOwnershipPolicy))
 		}
 	}
 
@@ -3872,23 +3929,23 @@ func buildCommand(spec ocm.Spec, operatorRolesPrefix string,
 	if len(spec.AdditionalComputeSecurityGroupIds) > 0 {
 		command += fmt.Sprintf(" --%s %s",
 			securitygroups.ComputeSecurityGroupFlag,
-			strings.Join(spec.AdditionalComputeSecurityGroupIds, ","))
+			shellquote.Join(strings.Join(spec.AdditionalComputeSecurityGroupIds, ",")))
 	}
 
 	if len(spec.AdditionalInfraSecurityGroupIds) > 0 {
 		command += fmt.Sprintf(" --%s %s",
 			securitygroups.InfraSecurityGroupFlag,
-			strings.Join(spec.AdditionalInfraSecurityGroupIds, ","))
+			shellquote.Join(strings.Join(spec.AdditionalInfraSecurityGroupIds, ",")))
 	}
 
 	if len(spec.AdditionalControlPlaneSecurityGroupIds) > 0 {
 		command += fmt.Sprintf(" --%s %s",
 			securitygroups.ControlPlaneSecurityGroupFlag,
-			strings.Join(spec.AdditionalControlPlaneSecurityGroupIds, ","))
+			shellquote.Join(strings.Join(spec.AdditionalControlPlaneSecurityGroupIds, ",")))
 	}
 
 	if spec.BillingAccount != "" {
-		command += fmt.Sprintf(" --billing-account %s", spec.BillingAccount)
+		command += fmt.Sprintf(" --billing-account %s", shellquote.Join(spec.BillingAccount))
 	}
 
 	if spec.NoCni {
@@ -3896,7 +3953,7 @@ func buildCommand(spec ocm.Spec, operatorRolesPrefix string,
 	}
 
 	for _, p := range properties {
-		command += fmt.Sprintf(" --properties \"%s\"", p)
+		command += fmt.Sprintf(" --properties %s", shellquote.Join(p))
 	}
 	return command
 }
@@ -4019,13 +4076,13 @@ func getSecurityGroups(r *rosa.Runtime, cmd *cobra.Command, isVersionCompatibleC
 		if !useExistingVpc {
 			r.Reporter.Errorf("Setting the `%s` flag is only allowed for BYO VPC clusters",
 				securitygroups.SgKindFlagMap[kind])
-			os.Exit(1)
+			os.Exit(reporter.ExitCode())
 		}
 		// HCP is still unsupported
 		if isHostedCp {
 			r.Reporter.Errorf("Parameter '%s' is not supported for Hosted Control Plane clusters",
 				securitygroups.SgKindFlagMap[kind])
-			os.Exit(1)
+			os.Exit(reporter.ExitCode())
 		}
 		if !isVersionCompatibleComputeSgIds {
 			formattedVersion, err := versions.FormatMajorMinorPatch(
@@ -4033,11 +4090,11 @@ func getSecurityGroups(r *rosa.Runtime, cmd *cobra.Command, isVersionCompatibleC
 			)
 			if err != nil {
 				r.Reporter.Errorf(versions.MajorMinorPatchFormattedErrorOutput, err)
-				os.Exit(1)
+				os.Exit(reporter.ExitCode())
 			}
 			r.Reporter.Errorf("Parameter '%s' is not supported prior to version '%s'",
 				securitygroups.SgKindFlagMap[kind], formattedVersion)
-			os.Exit(1)
+			os.Exit(reporter.ExitCode())
 		}
 	} else if interactive.Enabled() && isVersionCompatibleComputeSgIds && useExistingVpc && !isHostedCp {
 		vpcId := ""
@@ -4048,7 +4105,7 @@ func getSecurityGroups(r *rosa.Runtime, cmd *cobra.Command, isVersionCompatibleC
 		}
 		if vpcId == "" {
 			r.Reporter.Warnf("Unexpected situation a VPC ID should have been selected based on chosen subnets")
-			os.Exit(1)
+			os.Exit(reporter.ExitCode())
 		}
 		*additionalSgIds = interactiveSgs.
 			GetSecurityGroupIds(r, cmd, vpcId, kind)
@@ -4070,7 +4127,7 @@ func getMachinePoolRootDisk(r *rosa.Runtime, cmd *cobra.Command, version string,
 		formattedVersion, err := versions.FormatMajorMinorPatch(ocm.MinVersionForMachinePoolRootDisk)
 		if err != nil {
 			r.Reporter.Errorf(versions.MajorMinorPatchFormattedErrorOutput, err)
-			os.Exit(1)
+			os.Exit(reporter.ExitCode())
 		}
 		return nil, fmt.Errorf(
 			"Updating Worker disk size is not supported for versions prior to '%s'",
@@ -96,7 +96,23 @@ var _ = Describe("Validate build command", func() {
 					expectedOperatorRolePath, userSelectedAvailabilityZones,
 					defaultMachinePoolLabels, argsDotProperties)
 				// nolint:lll
-				Expect(command).To(Equal("rosa create cluster --cluster-name cluster-name --operator-roles-prefix prefix --properties \"prop1\" --properties \"prop2\""))
+				Expect(command).To(Equal("rosa create cluster --cluster-name cluster-name --operator-roles-prefix prefix --properties prop1 --properties prop2"))
+			})
+		})
+
+		When("values contain spaces or shell characters", func() {
+			It("quotes the values", func() {
+				clusterConfig.Tags = map[string]string{"owner": "team a"}
+				args.clusterAdminPassword = "pa$$word"
+				clusterConfig.ClusterAdminUser = "cluster-admin"
+				clusterConfig.ClusterAdminPassword = "pa$$word"
+				command := buildCommand(clusterConfig, operatorRolesPrefix,
+					expectedOperatorRolePath, userSelectedAvailabilityZones,
+					defaultMachinePoolLabels, argsDotProperties)
+				args.clusterAdminPassword = ""
+				Expect(command).To(Equal(
+					"rosa create cluster --cluster-name cluster-name --cluster-admin-password pa\\$\\$word" +
+						" --operator-roles-prefix prefix --tags 'owner:team a' --properties prop1 --properties prop2"))
 			})
 		})
 
@@ -108,7 +124,7 @@ var _ = Describe("Validate build command", func() {
 					defaultMachinePoolLabels, argsDotProperties)
 				Expect(command).To(Equal(
 					"rosa create cluster --cluster-name cluster-name --operator-roles-prefix prefix" +
-						" --external-auth-providers-enabled --properties \"prop1\" --properties \"prop2\""))
+						" --external-auth-providers-enabled --properties prop1 --properties prop2"))
 			})
 		})
 	})
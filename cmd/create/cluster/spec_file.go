@@ -0,0 +1,145 @@
+package cluster
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/kballard/go-shellquote"
+	"github.com/spf13/pflag"
+	"gopkg.in/yaml.v3"
+)
+
+const (
+	fromFileFlag   = "from-file"
+	exportSpecFlag = "export-spec"
+)
+
+// loadSpecFile reads a cluster spec file and sets each of its entries on the command line flag
+// with the same name, so that the values go through exactly the same validations as if they had
+// been passed on the command line. Flags explicitly set on the command line take precedence.
+func loadSpecFile(flags *pflag.FlagSet, path string) error {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return fmt.Errorf("Failed to read cluster spec file '%s': %v", path, err)
+	}
+	err = applySpecFile(flags, data)
+	if err != nil {
+		return fmt.Errorf("Failed to load cluster spec file '%s': %v", path, err)
+	}
+	return nil
+}
+
+func applySpecFile(flags *pflag.FlagSet, data []byte) error {
+	doc := yaml.Node{}
+	err := yaml.Unmarshal(data, &doc)
+	if err != nil {
+		return err
+	}
+	if len(doc.Content) == 0 {
+		return nil
+	}
+	spec := doc.Content[0]
+	if spec.Kind != yaml.MappingNode {
+		return fmt.Errorf("expected a mapping of option names to values")
+	}
+
+	for i := 0; i+1 < len(spec.Content); i += 2 {
+		name := spec.Content[i].Value
+		if name == fromFileFlag || name == exportSpecFlag {
+			return fmt.Errorf("option '%s' cannot be set in a spec file", name)
+		}
+		flag := flags.Lookup(name)
+		if flag == nil {
+			return fmt.Errorf("unknown option '%s' on line %d", name, spec.Content[i].Line)
+		}
+		if flag.Changed {
+			continue
+		}
+		values, err := specValues(spec.Content[i+1])
+		if err != nil {
+			return fmt.Errorf("invalid value for option '%s': %v", name, err)
+		}
+		if !strings.HasSuffix(flag.Value.Type(), "Array") && len(values) > 1 {
+			values = []string{strings.Join(values, ",")}
+		}
+		for _, value := range values {
+			err = flags.Set(name, value)
+			if err != nil {
+				return fmt.Errorf("invalid value for option '%s': %v", name, err)
+			}
+		}
+	}
+	return nil
+}
+
+// specValues returns the raw text of a scalar or of every item of a list, so that values like
+// '4.10' are not turned into numbers before the flag parses them.
+func specValues(node *yaml.Node) ([]string, error) {
+	switch node.Kind {
+	case yaml.ScalarNode:
+		return []string{node.Value}, nil
+	case yaml.SequenceNode:
+		values := []string{}
+		for _, item := range node.Content {
+			if item.Kind != yaml.ScalarNode {
+				return nil, fmt.Errorf("list items must be plain values")
+			}
+			values = append(values, item.Value)
+		}
+		return values, nil
+	}
+	return nil, fmt.Errorf("expected a plain value or a list on line %d", node.Line)
+}
+
+// buildSpecFile turns the equivalent 'rosa create cluster' command into a spec file that can be
+// replayed with '--from-file'. Options repeated in the command become lists. The cluster admin
+// password is not written to the file, the admin user is created with a random password instead.
+func buildSpecFile(command string) ([]byte, error) {
+	words, err := shellquote.Split(command)
+	if err != nil {
+		return nil, err
+	}
+	words = words[len(strings.Fields("rosa create cluster")):]
+
+	spec := &yaml.Node{Kind: yaml.MappingNode}
+	entries := map[string]*yaml.Node{}
+	for i := 0; i < len(words); i++ {
+		name := strings.TrimPrefix(words[i], "--")
+		value := &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!bool", Value: "true"}
+		if i+1 < len(words) && !strings.HasPrefix(words[i+1], "--") {
+			value = &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!str", Value: words[i+1]}
+			i++
+		}
+		if name == "cluster-admin-password" {
+			name = "create-admin-user"
+			value = &yaml.Node{Kind: yaml.ScalarNode, Tag: "!!bool", Value: "true"}
+		}
+
+		entry, ok := entries[name]
+		if !ok {
+			entries[name] = value
+			spec.Content = append(spec.Content, &yaml.Node{Kind: yaml.ScalarNode, Value: name}, value)
+			continue
+		}
+		if entry.Kind == yaml.ScalarNode {
+			first := *entry
+			*entry = yaml.Node{Kind: yaml.SequenceNode, Content: []*yaml.Node{&first}}
+		}
+		entry.Content = append(entry.Content, value)
+	}
+
+	return yaml.Marshal(spec)
+}
+
+func exportSpecFile(path string, command string) error {
+	data, err := buildSpecFile(command)
+	if err != nil {
+		return fmt.Errorf("Failed to build cluster spec: %v", err)
+	}
+	err = os.WriteFile(path, data, 0600)
+	if err != nil {
+		return fmt.Errorf("Failed to write cluster spec file '%s': %v", path, err)
+	}
+	return nil
+}
@@ -0,0 +1,108 @@
+package cluster
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/spf13/pflag"
+)
+
+var _ = Describe("Cluster spec file", func() {
+	var flags *pflag.FlagSet
+	var name, version string
+	var multiAZ bool
+	var replicas int
+	var subnetIDs, properties []string
+
+	BeforeEach(func() {
+		flags = pflag.NewFlagSet("test", pflag.ContinueOnError)
+		flags.StringVar(&name, "cluster-name", "", "")
+		flags.StringVar(&version, "version", "", "")
+		flags.BoolVar(&multiAZ, "multi-az", false, "")
+		flags.IntVar(&replicas, "replicas", 0, "")
+		flags.StringSliceVar(&subnetIDs, "subnet-ids", nil, "")
+		flags.StringArrayVar(&properties, "properties", nil, "")
+	})
+
+	Context("applySpecFile", func() {
+		It("OK: sets flags from the file", func() {
+			err := applySpecFile(flags, []byte(`cluster-name: mycluster
+version: 4.10
+multi-az: true
+replicas: 3
+subnet-ids:
+  - subnet-1
+  - subnet-2
+properties:
+  - a:b
+  - c
+`))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(name).To(Equal("mycluster"))
+			Expect(version).To(Equal("4.10"))
+			Expect(multiAZ).To(BeTrue())
+			Expect(replicas).To(Equal(3))
+			Expect(subnetIDs).To(Equal([]string{"subnet-1", "subnet-2"}))
+			Expect(properties).To(Equal([]string{"a:b", "c"}))
+			Expect(flags.Changed("cluster-name")).To(BeTrue())
+		})
+
+		It("OK: command line flags take precedence", func() {
+			Expect(flags.Set("cluster-name", "fromcli")).To(Succeed())
+			err := applySpecFile(flags, []byte("cluster-name: fromfile\n"))
+			Expect(err).ToNot(HaveOccurred())
+			Expect(name).To(Equal("fromcli"))
+		})
+
+		It("KO: fails on unknown options", func() {
+			err := applySpecFile(flags, []byte("cluster-name: mycluster\nfoo: bar\n"))
+			Expect(err).To(MatchError("unknown option 'foo' on line 2"))
+		})
+
+		It("KO: fails on invalid values", func() {
+			err := applySpecFile(flags, []byte("replicas: three\n"))
+			Expect(err).To(HaveOccurred())
+			Expect(err.Error()).To(ContainSubstring("invalid value for option 'replicas'"))
+		})
+
+		It("KO: fails on nested values", func() {
+			err := applySpecFile(flags, []byte("cluster-name:\n  foo: bar\n"))
+			Expect(err).To(HaveOccurred())
+		})
+	})
+
+	Context("buildSpecFile", func() {
+		It("OK: converts a command into a spec file that can be loaded again", func() {
+			data, err := buildSpecFile("rosa create cluster --cluster-name mycluster --version 4.10 --multi-az" +
+				" --replicas 3 --subnet-ids subnet-1,subnet-2 --properties \"a:b\" --properties \"c\"")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(string(data)).To(Equal(`cluster-name: mycluster
+version: "4.10"
+multi-az: true
+replicas: "3"
+subnet-ids: subnet-1,subnet-2
+properties:
+    - a:b
+    - c
+`))
+
+			err = applySpecFile(flags, data)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(name).To(Equal("mycluster"))
+			Expect(version).To(Equal("4.10"))
+			Expect(multiAZ).To(BeTrue())
+			Expect(replicas).To(Equal(3))
+			Expect(subnetIDs).To(Equal([]string{"subnet-1", "subnet-2"}))
+			Expect(properties).To(Equal([]string{"a:b", "c"}))
+		})
+
+		It("OK: creates the admin user with a random password instead of saving the password", func() {
+			data, err := buildSpecFile("rosa create cluster --cluster-name mycluster" +
+				" --cluster-admin-password 'pa$$ word' --cluster-admin-user myadmin")
+			Expect(err).ToNot(HaveOccurred())
+			Expect(string(data)).To(Equal(`cluster-name: mycluster
+create-admin-user: true
+cluster-admin-user: myadmin
+`))
+		})
+	})
+})
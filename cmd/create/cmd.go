@@ -33,6 +33,7 @@ import (
 	"github.com/openshift/rosa/cmd/create/oidcconfig"
 	"github.com/openshift/rosa/cmd/create/oidcprovider"
 	"github.com/openshift/rosa/cmd/create/operatorroles"
+	"github.com/openshift/rosa/cmd/create/scalingschedule"
 	"github.com/openshift/rosa/cmd/create/service"
 	"github.com/openshift/rosa/cmd/create/tuningconfigs"
 	"github.com/openshift/rosa/cmd/create/userrole"
@@ -66,6 +67,7 @@ func init() {
 	Cmd.AddCommand(kubeletconfig.Cmd)
 	Cmd.AddCommand(externalauthprovider.Cmd)
 	Cmd.AddCommand(breakglasscredential.Cmd)
+	Cmd.AddCommand(scalingschedule.NewCreateScalingScheduleCommand())
 
 	flags := Cmd.PersistentFlags()
 	arguments.AddProfileFlag(flags)
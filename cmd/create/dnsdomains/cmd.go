@@ -22,6 +22,7 @@ import (
 
 	"github.com/spf13/cobra"
 
+	"github.com/openshift/rosa/pkg/reporter"
 	"github.com/openshift/rosa/pkg/rosa"
 )
 
@@ -43,7 +44,7 @@ func run(_ *cobra.Command, _ []string) {
 	dnsdomain, err := r.OCMClient.CreateDNSDomain()
 	if err != nil {
 		r.Reporter.Errorf("Failed to create dns domain: %s", err)
-		os.Exit(1)
+		os.Exit(reporter.ExitCode())
 	}
 
 	r.Reporter.Infof("DNS domain ‘%s’ has been created.", dnsdomain.ID())
@@ -28,6 +28,7 @@ import (
 	"github.com/spf13/cobra"
 
 	"github.com/openshift/rosa/pkg/interactive"
+	"github.com/openshift/rosa/pkg/reporter"
 	"github.com/openshift/rosa/pkg/rosa"
 )
 
@@ -79,7 +80,7 @@ func createHTPasswdIDP(cmd *cobra.Command,
 			if err != nil {
 				r.Reporter.Errorf(
 					"Failed to add a user to the HTPasswd IDP of cluster '%s': %v", clusterKey, err)
-				os.Exit(1)
+				os.Exit(reporter.ExitCode())
 			}
 			r.Reporter.Infof("User '%s' added", username)
 		}
@@ -111,7 +112,7 @@ func validateUserArgs(r *rosa.Runtime) {
 		r.Reporter.Errorf("Only one of  'users', 'from-file' or 'username/password' may be specified. \n" +
 			"Choose the option 'users' to add one or more users to the IDP.\n" +
 			"Choose the option 'from-file' to load users from a htpassword file")
-		os.Exit(1)
+		os.Exit(reporter.ExitCode())
 	}
 }
 
@@ -132,7 +133,7 @@ func getUserList(cmd *cobra.Command, r *rosa.Runtime) (userList map[string]strin
 		})
 		if err != nil {
 			r.Reporter.Errorf("Expected a valid --from-file value: %s", err)
-			os.Exit(1)
+			os.Exit(reporter.ExitCode())
 		}
 	}
 
@@ -142,7 +143,7 @@ func getUserList(cmd *cobra.Command, r *rosa.Runtime) (userList map[string]strin
 		if err != nil {
 			r.Reporter.Errorf(
 				"Failed to load Htpasswd file '%s': %v", htpasswdFile, err)
-			os.Exit(1)
+			os.Exit(reporter.ExitCode())
 		}
 		//password in htpasswd are already and do not need to be hashed again in CS
 		hashed = true
@@ -159,7 +160,7 @@ func getUserList(cmd *cobra.Command, r *rosa.Runtime) (userList map[string]strin
 			if !found {
 				r.Reporter.Errorf(
 					"Users should be provided in the format of a comma separate list of user:password")
-				os.Exit(1)
+				os.Exit(reporter.ExitCode())
 
 			}
 			userList[u] = p
@@ -243,7 +244,7 @@ func exitHTPasswdCreate(format, clusterKey string, err error, r *rosa.Runtime) {
 	r.Reporter.Errorf("Failed to create IDP for cluster '%s': %v",
 		clusterKey,
 		fmt.Errorf(format, err))
-	os.Exit(1)
+	os.Exit(reporter.ExitCode())
 }
 
 func UsernameValidator(val interface{}) error {
@@ -27,6 +27,7 @@ import (
 	"github.com/openshift/rosa/pkg/interactive/confirm"
 	. "github.com/openshift/rosa/pkg/kubeletconfig"
 	"github.com/openshift/rosa/pkg/ocm"
+	"github.com/openshift/rosa/pkg/reporter"
 	"github.com/openshift/rosa/pkg/rosa"
 )
 
@@ -68,30 +69,30 @@ func run(_ *cobra.Command, _ []string) {
 
 	if cluster.Hypershift().Enabled() {
 		r.Reporter.Errorf("Hosted Control Plane clusters do not support custom KubeletConfig configuration.")
-		os.Exit(1)
+		os.Exit(reporter.ExitCode())
 	}
 
 	if cluster.State() != cmv1.ClusterStateReady {
 		r.Reporter.Errorf("Cluster '%s' is not yet ready. Current state is '%s'", clusterKey, cluster.State())
-		os.Exit(1)
+		os.Exit(reporter.ExitCode())
 	}
 
 	kubeletConfig, err := r.OCMClient.GetClusterKubeletConfig(cluster.ID())
 	if err != nil {
 		r.Reporter.Errorf("Failed getting KubeletConfig for cluster '%s': %s",
 			cluster.ID(), err)
-		os.Exit(1)
+		os.Exit(reporter.ExitCode())
 	}
 
 	if kubeletConfig != nil {
 		r.Reporter.Errorf("A custom KubeletConfig for cluster '%s' already exists. "+
 			"You should edit it via 'rosa edit kubeletconfig'", clusterKey)
-		os.Exit(1)
+		os.Exit(reporter.ExitCode())
 	}
 
 	requestedPids, err := ValidateOrPromptForRequestedPidsLimit(args.podPidsLimit, clusterKey, nil, r)
 	if err != nil {
-		os.Exit(1)
+		os.Exit(reporter.ExitCode())
 	}
 
 	prompt := fmt.Sprintf("Creating the custom KubeletConfig for cluster '%s' will cause all non-Control Plane "+
@@ -106,7 +107,7 @@ func run(_ *cobra.Command, _ []string) {
 		if err != nil {
 			r.Reporter.Errorf("Failed creating custom KubeletConfig for cluster '%s': '%s'",
 				clusterKey, err)
-			os.Exit(1)
+			os.Exit(reporter.ExitCode())
 		}
 
 		r.Reporter.Infof("Successfully created custom KubeletConfig for cluster '%s'", clusterKey)
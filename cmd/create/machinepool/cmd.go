@@ -30,6 +30,7 @@ import (
 	"github.com/openshift/rosa/pkg/ocm"
 	"github.com/openshift/rosa/pkg/output"
 	"github.com/openshift/rosa/pkg/properties"
+	"github.com/openshift/rosa/pkg/reporter"
 	"github.com/openshift/rosa/pkg/rosa"
 )
 
@@ -46,6 +47,8 @@ var args struct {
 	maxReplicas           int
 	labels                string
 	taints                string
+	labelsFile            string
+	taintsFile            string
 	useSpotInstances      bool
 	spotMaxPrice          string
 	multiAvailabilityZone bool
@@ -57,6 +60,11 @@ var args struct {
 	rootDiskSize          string
 	securityGroupIds      []string
 	nodeDrainGracePeriod  string
+	estimate              bool
+	fromFile              string
+	subnetMap             map[string]string
+	availabilityZoneMap   map[string]string
+	spreadAcrossSubnets   bool
 }
 
 var Cmd = &cobra.Command{
@@ -77,12 +85,29 @@ var Cmd = &cobra.Command{
   # Add a machine pool with labels to a cluster
   rosa create machinepool -c mycluster --name=mp-1 --replicas=2 --instance-type=r5.2xlarge --labels=foo=bar,bar=baz,
 
+  # Add a machine pool with the labels listed one per line in a file to a cluster
+  rosa create machinepool -c mycluster --name=mp-1 --replicas=2 --labels-file=labels.txt
+
   # Add a machine pool with spot instances to a cluster
   rosa create machinepool -c mycluster --name=mp-1 --replicas=2 --instance-type=r5.2xlarge --use-spot-instances \
     --spot-max-price=0.5
 
   # Add a machine pool to a cluster and set the node drain grace period
-  rosa create machinepool -c mycluster --name=mp-1 --node-drain-grace-period="90 minutes"`,
+  rosa create machinepool -c mycluster --name=mp-1 --node-drain-grace-period="90 minutes"
+
+  # Show the vCPU, memory and subscription quota that a machine pool would consume without creating it
+  rosa create machinepool -c mycluster --name=mp-1 --enable-autoscaling --min-replicas=2 --max-replicas=6 \
+    --instance-type=m5.2xlarge --estimate
+
+  # Create the machine pools exported from cluster "mycluster" on cluster "newcluster", in other subnets
+  rosa export machinepools --cluster=mycluster > machinepools.yaml
+  rosa create machinepools --cluster=newcluster --from-file=machinepools.yaml \
+    --subnet-map=subnet-0123=subnet-4567,subnet-89ab=subnet-cdef
+
+  # Add machine pools 'workers-a', 'workers-b' and 'workers-c' to the private subnets of hosted cluster
+  # "mycluster", with 2 replicas each
+  rosa create machinepool -c mycluster --name=workers --replicas=6 --instance-type=m5.xlarge \
+    --spread-across-subnets`,
 	Run:  run,
 	Args: cobra.NoArgs,
 }
@@ -150,6 +175,22 @@ func init() {
 			"This list will overwrite any modifications made to Node taints on an ongoing basis.",
 	)
 
+	flags.StringVar(
+		&args.labelsFile,
+		"labels-file",
+		"",
+		"Path to a file with the labels for machine pool, one 'key=value' per line. Empty lines and lines "+
+			"starting with '#' are ignored. Can't be used with '--labels'.",
+	)
+
+	flags.StringVar(
+		&args.taintsFile,
+		"taints-file",
+		"",
+		"Path to a file with the taints for machine pool, one 'key=value:ScheduleType' per line. Empty "+
+			"lines and lines starting with '#' are ignored. Can't be used with '--taints'.",
+	)
+
 	flags.BoolVar(
 		&args.useSpotInstances,
 		"use-spot-instances",
@@ -230,6 +271,42 @@ func init() {
 			"This flag is only supported for Hosted Control Planes.",
 	)
 
+	flags.BoolVar(&args.estimate,
+		"estimate",
+		false,
+		"Show the total vCPU, memory and subscription quota that the machine pool would consume between its "+
+			"minimum and maximum replicas, and the limits of its spot instances, without creating it.",
+	)
+
+	flags.StringVar(&args.fromFile,
+		fromFileFlag,
+		"",
+		"Create the machine pools defined in the given file, as written by 'rosa export machinepools', "+
+			"instead of a single machine pool. Machine pools that already exist are skipped.",
+	)
+
+	flags.StringToStringVar(&args.subnetMap,
+		subnetMapFlag,
+		nil,
+		"Replace the subnets of the machine pools defined with '--from-file', as a comma-separated list of "+
+			"'old=new' subnet IDs.",
+	)
+
+	flags.StringToStringVar(&args.availabilityZoneMap,
+		availabilityZoneMapFlag,
+		nil,
+		"Replace the availability zones of the machine pools defined with '--from-file', as a "+
+			"comma-separated list of 'old=new' availability zones.",
+	)
+
+	flags.BoolVar(&args.spreadAcrossSubnets,
+		spreadAcrossSubnetsFlag,
+		false,
+		"Create one machine pool in each private subnet of the cluster, named after the given name and "+
+			"the availability zone of the subnet, splitting the replicas between them. Public subnets and "+
+			"subnets in local zones are skipped. This flag is only supported for Hosted Control Planes.",
+	)
+
 	interactive.AddFlag(flags)
 	output.AddFlag(Cmd)
 }
@@ -243,17 +320,28 @@ func run(cmd *cobra.Command, _ []string) {
 	cluster := r.FetchCluster()
 	if cluster.State() != cmv1.ClusterStateReady {
 		r.Reporter.Errorf("Cluster '%s' is not yet ready", clusterKey)
-		os.Exit(1)
+		os.Exit(reporter.ExitCode())
 	}
 
 	val, ok := cluster.Properties()[properties.UseLocalCredentials]
 	useLocalCredentials := ok && val == "true"
 
+	for _, flag := range []string{"labels", "taints"} {
+		err := mpHelpers.SetFlagFromFile(cmd, flag, flag+"-file")
+		if err != nil {
+			r.Reporter.Errorf("%s", err)
+			os.Exit(reporter.ExitCode())
+		}
+	}
+
 	if cmd.Flags().Changed("labels") {
-		_, err := mpHelpers.ParseLabels(args.labels)
+		labels, err := mpHelpers.ParseLabels(args.labels)
+		if err == nil {
+			err = mpHelpers.ValidatePoolLabels(labels, nil)
+		}
 		if err != nil {
 			r.Reporter.Errorf("%s", err)
-			os.Exit(1)
+			os.Exit(reporter.ExitCode())
 		}
 	}
 
@@ -266,7 +354,21 @@ func run(cmd *cobra.Command, _ []string) {
 		Build()
 	if err != nil {
 		r.Reporter.Errorf("Failed to create awsClient: %s", err)
-		os.Exit(1)
+		os.Exit(reporter.ExitCode())
+	}
+
+	if cmd.Flags().Changed(fromFileFlag) {
+		err = createMachinePoolsFromFile(cmd, r, clusterKey, cluster)
+		if err != nil {
+			r.Reporter.Errorf("%s", err)
+			os.Exit(reporter.ExitCode())
+		}
+		return
+	}
+
+	if cmd.Flags().Changed(spreadAcrossSubnetsFlag) && !cluster.Hypershift().Enabled() {
+		r.Reporter.Errorf("The '--%s' flag is only supported for Hosted Control Planes", spreadAcrossSubnetsFlag)
+		os.Exit(reporter.ExitCode())
 	}
 
 	if cluster.Hypershift().Enabled() {
@@ -0,0 +1,250 @@
+/*
+Copyright (c) 2024 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package machinepool
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+
+	"github.com/openshift/rosa/pkg/helper"
+	mpHelpers "github.com/openshift/rosa/pkg/helper/machinepools"
+	"github.com/openshift/rosa/pkg/ocm"
+	"github.com/openshift/rosa/pkg/rosa"
+)
+
+const (
+	fromFileFlag            = "from-file"
+	subnetMapFlag           = "subnet-map"
+	availabilityZoneMapFlag = "availability-zone-map"
+)
+
+// Flags that can be used together with '--from-file', the rest of the flags describe a single
+// machine pool.
+var fromFileFlags = map[string]bool{
+	"cluster":               true,
+	fromFileFlag:            true,
+	subnetMapFlag:           true,
+	availabilityZoneMapFlag: true,
+}
+
+// poolFromFile is a machine pool of a machine pools file, ready to be created on the cluster.
+type poolFromFile struct {
+	spec        *mpHelpers.PoolSpec
+	machinePool *cmv1.MachinePool
+	nodePool    *cmv1.NodePool
+	// exists is true if the cluster already has a machine pool with the same name
+	exists bool
+}
+
+// createMachinePoolsFromFile creates the machine pools, and the kubelet config, written by
+// 'rosa export machinepools'. All the machine pools are validated before creating any of them.
+func createMachinePoolsFromFile(cmd *cobra.Command, r *rosa.Runtime, clusterKey string,
+	cluster *cmv1.Cluster) error {
+	var conflicts []string
+	cmd.LocalNonPersistentFlags().VisitAll(func(flag *pflag.Flag) {
+		if flag.Changed && !fromFileFlags[flag.Name] {
+			conflicts = append(conflicts, "--"+flag.Name)
+		}
+	})
+	if len(conflicts) > 0 {
+		return fmt.Errorf("Flags %s can't be used with '--%s'", strings.Join(conflicts, ", "), fromFileFlag)
+	}
+
+	data, err := os.ReadFile(args.fromFile)
+	if err != nil {
+		return fmt.Errorf("Failed to read machine pools file '%s': %v", args.fromFile, err)
+	}
+	file, err := mpHelpers.ParsePoolsFile(data)
+	if err != nil {
+		return fmt.Errorf("Failed to parse machine pools file '%s': %v", args.fromFile, err)
+	}
+
+	pools, err := preparePoolsFromFile(r, cluster, file)
+	if err != nil {
+		return err
+	}
+	if file.KubeletConfig != nil {
+		err = applyKubeletConfigFromFile(r, clusterKey, cluster, file.KubeletConfig)
+		if err != nil {
+			return err
+		}
+	}
+
+	failed := 0
+	created := 0
+	for _, pool := range pools {
+		if pool.exists {
+			r.Reporter.Warnf("Machine pool '%s' already exists on cluster '%s', skipping it",
+				pool.spec.Name, clusterKey)
+			continue
+		}
+		if pool.nodePool != nil {
+			_, err = r.OCMClient.CreateNodePool(cluster.ID(), pool.nodePool)
+		} else {
+			_, err = r.OCMClient.CreateMachinePool(cluster.ID(), pool.machinePool)
+		}
+		if err != nil {
+			failed++
+			r.Reporter.Errorf("Failed to create machine pool '%s' on cluster '%s': %v",
+				pool.spec.Name, clusterKey, err)
+			continue
+		}
+		created++
+		r.Reporter.Infof("Machine pool '%s' (%s) created successfully on cluster '%s'",
+			pool.spec.Name, pool.spec, clusterKey)
+	}
+	if failed > 0 {
+		return fmt.Errorf("Failed to create %d of %d machine pools on cluster '%s'",
+			failed, failed+created, clusterKey)
+	}
+	return nil
+}
+
+// preparePoolsFromFile remaps the subnets and availability zones of the machine pools of the file, checks
+// that they are valid for the cluster and builds the objects that will be created.
+func preparePoolsFromFile(r *rosa.Runtime, cluster *cmv1.Cluster,
+	file *mpHelpers.PoolsFile) ([]*poolFromFile, error) {
+	hosted := cluster.Hypershift().Enabled()
+	existing := map[string]bool{}
+	if hosted {
+		nodePools, err := r.OCMClient.GetNodePools(cluster.ID())
+		if err != nil {
+			return nil, err
+		}
+		for _, nodePool := range nodePools {
+			existing[nodePool.ID()] = true
+		}
+	} else {
+		machinePools, err := r.OCMClient.GetMachinePools(cluster.ID())
+		if err != nil {
+			return nil, err
+		}
+		for _, machinePool := range machinePools {
+			existing[machinePool.ID()] = true
+		}
+	}
+
+	var instanceTypes *ocm.MachineTypeList
+	pools := []*poolFromFile{}
+	for _, spec := range file.MachinePools {
+		pool := &poolFromFile{spec: spec, exists: existing[spec.Name]}
+		pools = append(pools, pool)
+		if pool.exists {
+			continue
+		}
+		if !machinePoolKeyRE.MatchString(spec.Name) {
+			return nil, fmt.Errorf("Expected a valid name for machine pool '%s'", spec.Name)
+		}
+		spec.Remap(args.subnetMap, args.availabilityZoneMap)
+		err := validatePoolPlacement(cluster, spec)
+		if err != nil {
+			return nil, err
+		}
+
+		if instanceTypes == nil {
+			list, err := r.OCMClient.GetAvailableMachineTypesInRegion(cluster.Region().ID(),
+				cluster.Nodes().AvailabilityZones(), cluster.AWS().STS().RoleARN(), r.AWSClient)
+			if err != nil {
+				return nil, err
+			}
+			instanceTypes = &list
+		}
+		err = instanceTypes.ValidateMachineType(spec.InstanceType, cluster.MultiAZ())
+		if err != nil {
+			return nil, fmt.Errorf("Expected a valid instance type for machine pool '%s': %v", spec.Name, err)
+		}
+
+		// Single AZ clusters only have single AZ machine pools
+		if !hosted && !cluster.MultiAZ() {
+			spec.AvailabilityZones = nil
+		}
+		if hosted {
+			pool.nodePool, err = spec.NodePool()
+		} else {
+			pool.machinePool, err = spec.MachinePool()
+		}
+		if err != nil {
+			return nil, fmt.Errorf("Failed to build machine pool '%s': %v", spec.Name, err)
+		}
+	}
+	return pools, nil
+}
+
+// validatePoolPlacement checks that the machine pool can be created on the cluster, after remapping its
+// subnets and availability zones.
+func validatePoolPlacement(cluster *cmv1.Cluster, spec *mpHelpers.PoolSpec) error {
+	if cluster.Hypershift().Enabled() {
+		if fields := spec.ClassicOnlyFields(); len(fields) > 0 {
+			return fmt.Errorf("Machine pool '%s' sets %s, which isn't supported on hosted clusters",
+				spec.Name, strings.Join(fields, ", "))
+		}
+		if len(spec.Subnets) == 0 {
+			return fmt.Errorf("Machine pool '%s' must set a subnet to be created on a hosted cluster", spec.Name)
+		}
+	} else {
+		if fields := spec.HostedOnlyFields(); len(fields) > 0 {
+			return fmt.Errorf("Machine pool '%s' sets %s, which is only supported on hosted clusters",
+				spec.Name, strings.Join(fields, ", "))
+		}
+		for _, zone := range spec.AvailabilityZones {
+			if !helper.Contains(cluster.Nodes().AvailabilityZones(), zone) {
+				return fmt.Errorf("Availability zone '%s' of machine pool '%s' isn't one of the availability "+
+					"zones of the cluster: %s. Map it with '--%s'", zone, spec.Name,
+					strings.Join(cluster.Nodes().AvailabilityZones(), ", "), availabilityZoneMapFlag)
+			}
+		}
+	}
+	for _, subnet := range spec.Subnets {
+		if !helper.Contains(cluster.AWS().SubnetIDs(), subnet) {
+			return fmt.Errorf("Subnet '%s' of machine pool '%s' isn't one of the subnets of the cluster. "+
+				"Map it with '--%s'", subnet, spec.Name, subnetMapFlag)
+		}
+	}
+	return nil
+}
+
+// applyKubeletConfigFromFile creates the kubelet config of the file, as the machine pools of classic
+// clusters use the one of the cluster. An existing kubelet config is left alone.
+func applyKubeletConfigFromFile(r *rosa.Runtime, clusterKey string, cluster *cmv1.Cluster,
+	spec *mpHelpers.KubeletConfigSpec) error {
+	if cluster.Hypershift().Enabled() {
+		r.Reporter.Warnf("Kubelet config isn't supported on hosted clusters, skipping it")
+		return nil
+	}
+	current, err := r.OCMClient.GetClusterKubeletConfig(cluster.ID())
+	if err != nil {
+		return fmt.Errorf("Failed to get kubelet config for cluster '%s': %v", clusterKey, err)
+	}
+	if current != nil {
+		if current.PodPidsLimit() != spec.PodPidsLimit {
+			r.Reporter.Warnf("Cluster '%s' already has a kubelet config with a pod pids limit of %d, "+
+				"leaving it instead of %d", clusterKey, current.PodPidsLimit(), spec.PodPidsLimit)
+		}
+		return nil
+	}
+	_, err = r.OCMClient.CreateKubeletConfig(cluster.ID(), ocm.KubeletConfigArgs{PodPidsLimit: spec.PodPidsLimit})
+	if err != nil {
+		return fmt.Errorf("Failed to create kubelet config for cluster '%s': %v", clusterKey, err)
+	}
+	r.Reporter.Infof("Kubelet config created successfully on cluster '%s'", clusterKey)
+	return nil
+}
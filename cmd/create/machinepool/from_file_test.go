@@ -0,0 +1,209 @@
+/*
+Copyright (c) 2024 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package machinepool
+
+import (
+	"net/http"
+	"os"
+	"path/filepath"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/ghttp"
+	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+	. "github.com/openshift-online/ocm-sdk-go/testing"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+
+	mpHelpers "github.com/openshift/rosa/pkg/helper/machinepools"
+	"github.com/openshift/rosa/pkg/rosa"
+	"github.com/openshift/rosa/pkg/test"
+)
+
+const (
+	machineTypesResponse = `{
+		"kind": "MachineTypeList", "page": 1, "size": 1, "total": 1,
+		"items": [{"kind": "MachineType", "id": "m5.xlarge", "category": "general_purpose"}]
+	}`
+	currentAccountResponse = `{"kind": "Account", "id": "123", "organization": {"kind": "Organization", "id": "456"}}`
+	quotaCostResponse      = `{"kind": "QuotaCostList", "page": 1, "size": 0, "total": 0, "items": []}`
+)
+
+var _ = Describe("Create machine pools from file", func() {
+	var testRuntime test.TestingRuntime
+
+	hostedCluster := test.MockCluster(func(c *cmv1.ClusterBuilder) {
+		c.Hypershift(cmv1.NewHypershift().Enabled(true))
+		c.Region(cmv1.NewCloudRegion().ID("us-west-2"))
+		c.AWS(cmv1.NewAWS().SubnetIDs("subnet-a", "subnet-b").STS(cmv1.NewSTS().RoleARN("arn:aws:iam::123:role/r")))
+	})
+	classicCluster := test.MockCluster(func(c *cmv1.ClusterBuilder) {
+		c.MultiAZ(true)
+		c.Region(cmv1.NewCloudRegion().ID("us-west-2"))
+		c.Nodes(cmv1.NewClusterNodes().AvailabilityZones("us-west-2a", "us-west-2b", "us-west-2c"))
+		c.AWS(cmv1.NewAWS().STS(cmv1.NewSTS().RoleARN("arn:aws:iam::123:role/r")))
+	})
+	replicas := 2
+
+	BeforeEach(func() {
+		testRuntime.InitRuntime()
+		args.subnetMap = nil
+		args.availabilityZoneMap = nil
+	})
+
+	It("Remaps the subnets and skips existing machine pools", func() {
+		args.subnetMap = map[string]string{"subnet-1": "subnet-b"}
+		existing, err := cmv1.NewNodePool().ID("workers").Build()
+		Expect(err).ToNot(HaveOccurred())
+		testRuntime.ApiServer.AppendHandlers(
+			RespondWithJSON(http.StatusOK, test.FormatNodePoolList([]*cmv1.NodePool{existing})),
+			RespondWithJSON(http.StatusOK, machineTypesResponse),
+			RespondWithJSON(http.StatusOK, currentAccountResponse),
+			RespondWithJSON(http.StatusOK, quotaCostResponse),
+		)
+
+		pools, err := preparePoolsFromFile(testRuntime.RosaRuntime, hostedCluster, &mpHelpers.PoolsFile{
+			MachinePools: []*mpHelpers.PoolSpec{
+				{Name: "workers", InstanceType: "m5.xlarge", Replicas: &replicas, Subnets: []string{"subnet-1"}},
+				{Name: "web", InstanceType: "m5.xlarge", Replicas: &replicas, Subnets: []string{"subnet-1"}},
+			},
+		})
+		Expect(err).ToNot(HaveOccurred())
+		Expect(pools).To(HaveLen(2))
+		Expect(pools[0].exists).To(BeTrue())
+		Expect(pools[1].exists).To(BeFalse())
+		Expect(pools[1].nodePool.Subnet()).To(Equal("subnet-b"))
+		Expect(pools[1].nodePool.AWSNodePool().InstanceType()).To(Equal("m5.xlarge"))
+	})
+
+	It("Fails when a subnet isn't mapped to one of the cluster", func() {
+		testRuntime.ApiServer.AppendHandlers(
+			RespondWithJSON(http.StatusOK, test.FormatNodePoolList([]*cmv1.NodePool{})),
+		)
+
+		_, err := preparePoolsFromFile(testRuntime.RosaRuntime, hostedCluster, &mpHelpers.PoolsFile{
+			MachinePools: []*mpHelpers.PoolSpec{
+				{Name: "web", InstanceType: "m5.xlarge", Replicas: &replicas, Subnets: []string{"subnet-1"}},
+			},
+		})
+		Expect(err).To(MatchError("Subnet 'subnet-1' of machine pool 'web' isn't one of the subnets of the " +
+			"cluster. Map it with '--subnet-map'"))
+	})
+
+	It("Fails when the instance type isn't available", func() {
+		testRuntime.ApiServer.AppendHandlers(
+			RespondWithJSON(http.StatusOK, test.FormatMachinePoolList([]*cmv1.MachinePool{})),
+			RespondWithJSON(http.StatusOK, machineTypesResponse),
+			RespondWithJSON(http.StatusOK, currentAccountResponse),
+			RespondWithJSON(http.StatusOK, quotaCostResponse),
+		)
+
+		_, err := preparePoolsFromFile(testRuntime.RosaRuntime, classicCluster, &mpHelpers.PoolsFile{
+			MachinePools: []*mpHelpers.PoolSpec{
+				{Name: "gpu", InstanceType: "p3.2xlarge", Replicas: &replicas},
+			},
+		})
+		Expect(err).To(MatchError(ContainSubstring(
+			"Expected a valid instance type for machine pool 'gpu': Machine type 'p3.2xlarge' not found")))
+	})
+
+	It("Fails when hosted only settings are created on classic clusters", func() {
+		testRuntime.ApiServer.AppendHandlers(
+			RespondWithJSON(http.StatusOK, test.FormatMachinePoolList([]*cmv1.MachinePool{})),
+		)
+
+		_, err := preparePoolsFromFile(testRuntime.RosaRuntime, classicCluster, &mpHelpers.PoolsFile{
+			MachinePools: []*mpHelpers.PoolSpec{
+				{Name: "web", InstanceType: "m5.xlarge", Replicas: &replicas, TuningConfigs: []string{"tuned"}},
+			},
+		})
+		Expect(err).To(MatchError(
+			"Machine pool 'web' sets tuning_configs, which is only supported on hosted clusters"))
+	})
+
+	Context("createMachinePoolsFromFile", func() {
+		BeforeEach(func() {
+			Cmd.Flags().VisitAll(func(flag *pflag.Flag) {
+				flag.Changed = false
+			})
+			DeferCleanup(func() {
+				args.fromFile = ""
+			})
+		})
+
+		It("Creates the kubelet config and the machine pools of the file", func() {
+			path := filepath.Join(GinkgoT().TempDir(), "machinepools.yaml")
+			Expect(os.WriteFile(path, []byte(`
+kubelet_config:
+  pod_pids_limit: 8192
+machine_pools:
+- name: infra
+  instance_type: m5.xlarge
+  replicas: 3
+  availability_zones:
+  - us-east-1a
+  taints:
+  - key: infra
+    effect: NoSchedule
+`), 0600)).To(Succeed())
+			Expect(Cmd.Flags().Set(fromFileFlag, path)).To(Succeed())
+			Expect(Cmd.Flags().Set(availabilityZoneMapFlag, "us-east-1a=us-west-2b")).To(Succeed())
+
+			machinePoolsPath := test.MockClusterHREF + "/machine_pools"
+			testRuntime.ApiServer.AppendHandlers(
+				RespondWithJSON(http.StatusOK, test.FormatMachinePoolList([]*cmv1.MachinePool{})),
+				RespondWithJSON(http.StatusOK, machineTypesResponse),
+				RespondWithJSON(http.StatusOK, currentAccountResponse),
+				RespondWithJSON(http.StatusOK, quotaCostResponse),
+				RespondWithJSON(http.StatusNotFound, `{"kind": "Error"}`),
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest(http.MethodPost, test.MockClusterHREF+"/kubelet_config"),
+					ghttp.VerifyJSON(`{"kind": "KubeletConfig", "pod_pids_limit": 8192}`),
+					RespondWithJSON(http.StatusCreated, `{"kind": "KubeletConfig", "pod_pids_limit": 8192}`),
+				),
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest(http.MethodPost, machinePoolsPath),
+					ghttp.VerifyJSON(`{
+						"kind": "MachinePool",
+						"id": "infra",
+						"instance_type": "m5.xlarge",
+						"replicas": 3,
+						"availability_zones": ["us-west-2b"],
+						"taints": [{"key": "infra", "effect": "NoSchedule"}]
+					}`),
+					RespondWithJSON(http.StatusCreated, `{"kind": "MachinePool", "id": "infra"}`),
+				),
+			)
+
+			stdout, _, err := test.RunWithOutputCapture(func(r *rosa.Runtime, cmd *cobra.Command) error {
+				return createMachinePoolsFromFile(cmd, r, "cluster1", classicCluster)
+			}, testRuntime.RosaRuntime, Cmd)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(stdout).To(ContainSubstring("Kubelet config created successfully on cluster 'cluster1'"))
+			Expect(stdout).To(ContainSubstring(
+				"Machine pool 'infra' (3 x m5.xlarge) created successfully on cluster 'cluster1'"))
+		})
+
+		It("Rejects the flags of a single machine pool", func() {
+			Expect(Cmd.Flags().Set(fromFileFlag, "machinepools.yaml")).To(Succeed())
+			Expect(Cmd.Flags().Set("replicas", "3")).To(Succeed())
+
+			err := createMachinePoolsFromFile(Cmd, testRuntime.RosaRuntime, "cluster1", classicCluster)
+			Expect(err).To(MatchError("Flags --replicas can't be used with '--from-file'"))
+		})
+	})
+})
@@ -12,6 +12,10 @@ import (
 	"github.com/openshift/rosa/pkg/aws"
 	"github.com/openshift/rosa/pkg/interactive"
 	interactiveSgs "github.com/openshift/rosa/pkg/interactive/securitygroups"
+	"github.com/openshift/rosa/pkg/ocm"
+	ocmOutput "github.com/openshift/rosa/pkg/ocm/output"
+	"github.com/openshift/rosa/pkg/output"
+	"github.com/openshift/rosa/pkg/reporter"
 	"github.com/openshift/rosa/pkg/rosa"
 )
 
@@ -37,7 +41,7 @@ func getSubnetFromUser(cmd *cobra.Command, r *rosa.Runtime, isSubnetSet bool, cl
 		})
 		if err != nil {
 			r.Reporter.Errorf(questionError)
-			os.Exit(1)
+			os.Exit(reporter.ExitCode())
 		}
 	} else {
 		subnet = args.subnet
@@ -47,7 +51,7 @@ func getSubnetFromUser(cmd *cobra.Command, r *rosa.Runtime, isSubnetSet bool, cl
 		subnetOptions, err := getSubnetOptions(r, cluster)
 		if err != nil {
 			r.Reporter.Errorf("%s", err)
-			os.Exit(1)
+			os.Exit(reporter.ExitCode())
 		}
 
 		subnetOption, err := interactive.GetOption(interactive.Input{
@@ -59,7 +63,7 @@ func getSubnetFromUser(cmd *cobra.Command, r *rosa.Runtime, isSubnetSet bool, cl
 		})
 		if err != nil {
 			r.Reporter.Errorf("Expected a valid AWS subnet: %s", err)
-			os.Exit(1)
+			os.Exit(reporter.ExitCode())
 		}
 		subnet = aws.ParseOption(subnetOption)
 	}
@@ -110,3 +114,20 @@ func getVpcIdFromSubnet(subnet ec2types.Subnet) (string, error) {
 
 	return vpcId, nil
 }
+
+// printEstimate prints what the machine pool would consume instead of creating it, warning when the
+// organization doesn't have enough quota for its maximum replicas.
+func printEstimate(r *rosa.Runtime, name string, estimate *ocm.MachinePoolEstimate) error {
+	for _, quota := range estimate.Quota {
+		if quota.MaxCost > quota.Available() {
+			r.Reporter.Warnf("Machine pool '%s' needs %d of quota '%s' with %d replicas, but only %d are available",
+				name, quota.MaxCost, quota.QuotaID, estimate.MaxReplicas, quota.Available())
+		}
+	}
+	if output.HasFlag() {
+		return output.Print(estimate)
+	}
+	r.Reporter.Infof("Machine pool '%s' hasn't been created, it would consume:", name)
+	fmt.Print(ocmOutput.PrintMachinePoolEstimate(estimate, 28))
+	return nil
+}
@@ -13,6 +13,7 @@ import (
 	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
 	"github.com/spf13/cobra"
 
+	"github.com/openshift/rosa/pkg/aws"
 	"github.com/openshift/rosa/pkg/helper"
 	mpHelpers "github.com/openshift/rosa/pkg/helper/machinepools"
 	"github.com/openshift/rosa/pkg/helper/versions"
@@ -21,6 +22,7 @@ import (
 	"github.com/openshift/rosa/pkg/interactive/securitygroups"
 	"github.com/openshift/rosa/pkg/ocm"
 	"github.com/openshift/rosa/pkg/output"
+	"github.com/openshift/rosa/pkg/reporter"
 	"github.com/openshift/rosa/pkg/rosa"
 )
 
@@ -31,12 +33,12 @@ func addMachinePool(cmd *cobra.Command, clusterKey string, cluster *cmv1.Cluster
 	isMultiAvailabilityZoneSet := cmd.Flags().Changed("multi-availability-zone")
 	if isMultiAvailabilityZoneSet && !cluster.MultiAZ() {
 		r.Reporter.Errorf("Setting the `multi-availability-zone` flag is only allowed for multi-AZ clusters")
-		os.Exit(1)
+		os.Exit(reporter.ExitCode())
 	}
 	isAvailabilityZoneSet := cmd.Flags().Changed("availability-zone")
 	if isAvailabilityZoneSet && !cluster.MultiAZ() {
 		r.Reporter.Errorf("Setting the `availability-zone` flag is only allowed for multi-AZ clusters")
-		os.Exit(1)
+		os.Exit(reporter.ExitCode())
 	}
 
 	// Validate flags that are only allowed for BYOVPC cluster
@@ -44,7 +46,7 @@ func addMachinePool(cmd *cobra.Command, clusterKey string, cluster *cmv1.Cluster
 	isByoVpc := helper.IsBYOVPC(cluster)
 	if !isByoVpc && isSubnetSet {
 		r.Reporter.Errorf("Setting the `subnet` flag is only allowed for BYO VPC clusters")
-		os.Exit(1)
+		os.Exit(reporter.ExitCode())
 	}
 
 	isSecurityGroupIdsSet := cmd.Flags().Changed(securitygroups.MachinePoolSecurityGroupFlag)
@@ -52,13 +54,13 @@ func addMachinePool(cmd *cobra.Command, clusterKey string, cluster *cmv1.Cluster
 		cluster.Version().RawID(), ocm.MinVersionForAdditionalComputeSecurityGroupIdsDay2)
 	if err != nil {
 		r.Reporter.Errorf("There was a problem checking version compatibility: %v", err)
-		os.Exit(1)
+		os.Exit(reporter.ExitCode())
 	}
 	if isSecurityGroupIdsSet {
 		if !isByoVpc {
 			r.Reporter.Errorf("Setting the `%s` flag is only allowed for BYOVPC clusters",
 				securitygroups.MachinePoolSecurityGroupFlag)
-			os.Exit(1)
+			os.Exit(reporter.ExitCode())
 		}
 		if !isVersionCompatibleComputeSgIds {
 			formattedVersion, err := versions.FormatMajorMinorPatch(
@@ -66,29 +68,29 @@ func addMachinePool(cmd *cobra.Command, clusterKey string, cluster *cmv1.Cluster
 			)
 			if err != nil {
 				r.Reporter.Errorf(versions.MajorMinorPatchFormattedErrorOutput, err)
-				os.Exit(1)
+				os.Exit(reporter.ExitCode())
 			}
 			r.Reporter.Errorf("Parameter '%s' is not supported prior to version '%s'",
 				securitygroups.MachinePoolSecurityGroupFlag, formattedVersion)
-			os.Exit(1)
+			os.Exit(reporter.ExitCode())
 		}
 	}
 
 	if isSubnetSet && isAvailabilityZoneSet {
 		r.Reporter.Errorf("Setting both `subnet` and `availability-zone` flag is not supported." +
 			" Please select `subnet` or `availability-zone` to create a single availability zone machine pool")
-		os.Exit(1)
+		os.Exit(reporter.ExitCode())
 	}
 
 	// Validate `subnet` or `availability-zone` flags are set for a single AZ machine pool
 	if isAvailabilityZoneSet && isMultiAvailabilityZoneSet && args.multiAvailabilityZone {
 		r.Reporter.Errorf("Setting the `availability-zone` flag is only supported for creating a single AZ " +
 			"machine pool in a multi-AZ cluster")
-		os.Exit(1)
+		os.Exit(reporter.ExitCode())
 	}
 	if isSubnetSet && isMultiAvailabilityZoneSet && args.multiAvailabilityZone {
 		r.Reporter.Errorf("Setting the `subnet` flag is only supported for creating a single AZ machine pool")
-		os.Exit(1)
+		os.Exit(reporter.ExitCode())
 	}
 
 	mpHelpers.HostedClusterOnlyFlag(r, cmd, "version")
@@ -112,13 +114,13 @@ func addMachinePool(cmd *cobra.Command, clusterKey string, cluster *cmv1.Cluster
 		})
 		if err != nil {
 			r.Reporter.Errorf("Expected a valid name for the machine pool: %s", err)
-			os.Exit(1)
+			os.Exit(reporter.ExitCode())
 		}
 	}
 	name = strings.Trim(name, " \t")
 	if !machinePoolKeyRE.MatchString(name) {
 		r.Reporter.Errorf("Expected a valid name for the machine pool")
-		os.Exit(1)
+		os.Exit(reporter.ExitCode())
 	}
 
 	// Allow the user to select subnet for a single AZ BYOVPC cluster
@@ -146,7 +148,7 @@ func addMachinePool(cmd *cobra.Command, clusterKey string, cluster *cmv1.Cluster
 			})
 			if err != nil {
 				r.Reporter.Errorf("Expected a valid value for create multi-AZ machine pool")
-				os.Exit(1)
+				os.Exit(reporter.ExitCode())
 			}
 		} else {
 			multiAZMachinePool = args.multiAvailabilityZone
@@ -171,7 +173,7 @@ func addMachinePool(cmd *cobra.Command, clusterKey string, cluster *cmv1.Cluster
 					})
 					if err != nil {
 						r.Reporter.Errorf("Expected a valid AWS availability zone: %s", err)
-						os.Exit(1)
+						os.Exit(reporter.ExitCode())
 					}
 				} else if isAvailabilityZoneSet {
 					availabilityZone = args.availabilityZone
@@ -180,7 +182,7 @@ func addMachinePool(cmd *cobra.Command, clusterKey string, cluster *cmv1.Cluster
 				if !helper.Contains(cluster.Nodes().AvailabilityZones(), availabilityZone) {
 					r.Reporter.Errorf("Availability zone '%s' doesn't belong to the cluster's availability zones",
 						availabilityZone)
-					os.Exit(1)
+					os.Exit(reporter.ExitCode())
 				}
 			}
 		}
@@ -206,7 +208,7 @@ func addMachinePool(cmd *cobra.Command, clusterKey string, cluster *cmv1.Cluster
 		})
 		if err != nil {
 			r.Reporter.Errorf("Expected a valid value for enable-autoscaling: %s", err)
-			os.Exit(1)
+			os.Exit(reporter.ExitCode())
 		}
 	}
 
@@ -214,7 +216,7 @@ func addMachinePool(cmd *cobra.Command, clusterKey string, cluster *cmv1.Cluster
 		// if the user set replicas and enabled autoscaling
 		if isReplicasSet {
 			r.Reporter.Errorf("Replicas can't be set when autoscaling is enabled")
-			os.Exit(1)
+			os.Exit(reporter.ExitCode())
 		}
 		if interactive.Enabled() || !isMinReplicasSet {
 			minReplicas, err = interactive.GetInt(interactive.Input{
@@ -228,13 +230,13 @@ func addMachinePool(cmd *cobra.Command, clusterKey string, cluster *cmv1.Cluster
 			})
 			if err != nil {
 				r.Reporter.Errorf("Expected a valid number of min replicas: %s", err)
-				os.Exit(1)
+				os.Exit(reporter.ExitCode())
 			}
 		}
 		err = minReplicaValidator(multiAZMachinePool)(minReplicas)
 		if err != nil {
 			r.Reporter.Errorf("%s", err)
-			os.Exit(1)
+			os.Exit(reporter.ExitCode())
 		}
 
 		if interactive.Enabled() || !isMaxReplicasSet {
@@ -249,19 +251,19 @@ func addMachinePool(cmd *cobra.Command, clusterKey string, cluster *cmv1.Cluster
 			})
 			if err != nil {
 				r.Reporter.Errorf("Expected a valid number of max replicas: %s", err)
-				os.Exit(1)
+				os.Exit(reporter.ExitCode())
 			}
 		}
 		err = maxReplicaValidator(minReplicas, multiAZMachinePool)(maxReplicas)
 		if err != nil {
 			r.Reporter.Errorf("%s", err)
-			os.Exit(1)
+			os.Exit(reporter.ExitCode())
 		}
 	} else {
 		// if the user set min/max replicas and hasn't enabled autoscaling
 		if isMinReplicasSet || isMaxReplicasSet {
 			r.Reporter.Errorf("Autoscaling must be enabled in order to set min and max replicas")
-			os.Exit(1)
+			os.Exit(reporter.ExitCode())
 		}
 		if interactive.Enabled() || !isReplicasSet {
 			replicas, err = interactive.GetInt(interactive.Input{
@@ -275,13 +277,13 @@ func addMachinePool(cmd *cobra.Command, clusterKey string, cluster *cmv1.Cluster
 			})
 			if err != nil {
 				r.Reporter.Errorf("Expected a valid number of replicas: %s", err)
-				os.Exit(1)
+				os.Exit(reporter.ExitCode())
 			}
 		}
 		err = minReplicaValidator(multiAZMachinePool)(replicas)
 		if err != nil {
 			r.Reporter.Errorf("%s", err)
-			os.Exit(1)
+			os.Exit(reporter.ExitCode())
 		}
 	}
 
@@ -291,7 +293,7 @@ func addMachinePool(cmd *cobra.Command, clusterKey string, cluster *cmv1.Cluster
 		securityGroupIds, err = getSecurityGroupsOption(r, cmd, cluster)
 		if err != nil {
 			r.Reporter.Errorf("%s", err)
-			os.Exit(1)
+			os.Exit(reporter.ExitCode())
 		}
 	}
 	for i, sg := range securityGroupIds {
@@ -302,7 +304,7 @@ func addMachinePool(cmd *cobra.Command, clusterKey string, cluster *cmv1.Cluster
 	instanceType := args.instanceType
 	if instanceType == "" && !interactive.Enabled() {
 		r.Reporter.Errorf("You must supply a valid instance type")
-		os.Exit(1)
+		os.Exit(reporter.ExitCode())
 	}
 
 	var spin *spinner.Spinner
@@ -319,7 +321,7 @@ func addMachinePool(cmd *cobra.Command, clusterKey string, cluster *cmv1.Cluster
 		subnet)
 	if err != nil {
 		r.Reporter.Errorf("%s", err)
-		os.Exit(1)
+		os.Exit(reporter.ExitCode())
 	}
 
 	instanceTypeList, err := r.OCMClient.GetAvailableMachineTypesInRegion(
@@ -330,7 +332,7 @@ func addMachinePool(cmd *cobra.Command, clusterKey string, cluster *cmv1.Cluster
 	)
 	if err != nil {
 		r.Reporter.Errorf(fmt.Sprintf("%s", err))
-		os.Exit(1)
+		os.Exit(reporter.ExitCode())
 	}
 
 	if spin != nil {
@@ -350,14 +352,14 @@ func addMachinePool(cmd *cobra.Command, clusterKey string, cluster *cmv1.Cluster
 		})
 		if err != nil {
 			r.Reporter.Errorf("Expected a valid instance type: %s", err)
-			os.Exit(1)
+			os.Exit(reporter.ExitCode())
 		}
 	}
 
 	err = instanceTypeList.ValidateMachineType(instanceType, cluster.MultiAZ())
 	if err != nil {
 		r.Reporter.Errorf("Expected a valid instance type: %s", err)
-		os.Exit(1)
+		os.Exit(reporter.ExitCode())
 	}
 
 	existingLabels := make(map[string]string, 0)
@@ -374,7 +376,7 @@ func addMachinePool(cmd *cobra.Command, clusterKey string, cluster *cmv1.Cluster
 	spotMaxPrice := args.spotMaxPrice
 	if isSpotMaxPriceSet && isSpotSet && !useSpotInstances {
 		r.Reporter.Errorf("Can't set max price when not using spot instances")
-		os.Exit(1)
+		os.Exit(reporter.ExitCode())
 	}
 
 	// Validate spot instance are supported
@@ -383,12 +385,12 @@ func addMachinePool(cmd *cobra.Command, clusterKey string, cluster *cmv1.Cluster
 		isLocalZone, err = r.AWSClient.IsLocalAvailabilityZone(availabilityZonesFilter[0])
 		if err != nil {
 			r.Reporter.Errorf("%s", err)
-			os.Exit(1)
+			os.Exit(reporter.ExitCode())
 		}
 	}
 	if isLocalZone && useSpotInstances {
 		r.Reporter.Errorf("Spot instances are not supported for local zones")
-		os.Exit(1)
+		os.Exit(reporter.ExitCode())
 	}
 
 	if !isSpotSet && !isSpotMaxPriceSet && !isLocalZone && interactive.Enabled() {
@@ -400,7 +402,7 @@ func addMachinePool(cmd *cobra.Command, clusterKey string, cluster *cmv1.Cluster
 		})
 		if err != nil {
 			r.Reporter.Errorf("Expected a valid value for use spot instances: %s", err)
-			os.Exit(1)
+			os.Exit(reporter.ExitCode())
 		}
 	}
 
@@ -416,7 +418,7 @@ func addMachinePool(cmd *cobra.Command, clusterKey string, cluster *cmv1.Cluster
 		})
 		if err != nil {
 			r.Reporter.Errorf("Expected a valid value for spot max price: %s", err)
-			os.Exit(1)
+			os.Exit(reporter.ExitCode())
 		}
 	}
 
@@ -425,12 +427,15 @@ func addMachinePool(cmd *cobra.Command, clusterKey string, cluster *cmv1.Cluster
 	err = spotMaxPriceValidator(spotMaxPrice)
 	if err != nil {
 		r.Reporter.Errorf("%s", err)
-		os.Exit(1)
+		os.Exit(reporter.ExitCode())
 	}
 	if spotMaxPrice != "on-demand" {
 		price, _ := strconv.ParseFloat(spotMaxPrice, commonUtils.MaxByteSize)
 		maxPrice = &price
 	}
+	if useSpotInstances && maxPrice != nil {
+		warnSpotMaxPrice(r, instanceType, availabilityZonesFilter, *maxPrice)
+	}
 
 	mpBuilder := cmv1.NewMachinePool().
 		ID(name).
@@ -498,7 +503,7 @@ func addMachinePool(cmd *cobra.Command, clusterKey string, cluster *cmv1.Cluster
 			})
 			if err != nil {
 				r.Reporter.Errorf("Expected a valid machine pool root disk size value: %v", err)
-				os.Exit(1)
+				os.Exit(reporter.ExitCode())
 			}
 		}
 
@@ -506,13 +511,13 @@ func addMachinePool(cmd *cobra.Command, clusterKey string, cluster *cmv1.Cluster
 		rootDiskSize, err := ocm.ParseDiskSizeToGigibyte(rootDiskSizeStr)
 		if err != nil {
 			r.Reporter.Errorf("Expected a valid machine pool root disk size value '%s': %v", rootDiskSizeStr, err)
-			os.Exit(1)
+			os.Exit(reporter.ExitCode())
 		}
 
 		err = diskValidator.ValidateMachinePoolRootDiskSize(cluster.Version().RawID(), rootDiskSize)
 		if err != nil {
 			r.Reporter.Errorf(err.Error())
-			os.Exit(1)
+			os.Exit(reporter.ExitCode())
 		}
 
 		// If the size given by the user is different than the default, we just let the OCM server
@@ -525,19 +530,33 @@ func addMachinePool(cmd *cobra.Command, clusterKey string, cluster *cmv1.Cluster
 	machinePool, err := mpBuilder.Build()
 	if err != nil {
 		r.Reporter.Errorf("Failed to create machine pool for cluster '%s': %v", clusterKey, err)
-		os.Exit(1)
+		os.Exit(reporter.ExitCode())
+	}
+
+	if args.estimate {
+		estimate, err := r.OCMClient.EstimateMachinePool(machinePool)
+		if err != nil {
+			r.Reporter.Errorf("Failed to estimate machine pool for cluster '%s': %v", clusterKey, err)
+			os.Exit(reporter.ExitCode())
+		}
+		err = printEstimate(r, name, estimate)
+		if err != nil {
+			r.Reporter.Errorf("%s", err)
+			os.Exit(reporter.ExitCode())
+		}
+		return
 	}
 
 	createdMachinePool, err := r.OCMClient.CreateMachinePool(cluster.ID(), machinePool)
 	if err != nil {
 		r.Reporter.Errorf("Failed to add machine pool to cluster '%s': %v", clusterKey, err)
-		os.Exit(1)
+		os.Exit(reporter.ExitCode())
 	}
 
 	if output.HasFlag() {
 		if err = output.Print(createdMachinePool); err != nil {
 			r.Reporter.Errorf("Unable to print machine pool: %v", err)
-			os.Exit(1)
+			os.Exit(reporter.ExitCode())
 		}
 	} else {
 		r.Reporter.Infof("Machine pool '%s' created successfully on cluster '%s'", name, clusterKey)
@@ -573,6 +592,25 @@ func getMachinePoolAvailabilityZones(r *rosa.Runtime, cluster *cmv1.Cluster, mul
 	return cluster.Nodes().AvailabilityZones(), nil
 }
 
+// warnSpotMaxPrice warns when the spot max price is below the current spot price of the instance type,
+// as instances may then fail to launch or be interrupted.
+func warnSpotMaxPrice(r *rosa.Runtime, instanceType string, availabilityZones []string, maxPrice float64) {
+	spotPrices, err := r.AWSClient.GetSpotPrices([]string{instanceType}, availabilityZones,
+		time.Now().Add(-aws.SpotPricePeriod))
+	if err != nil {
+		r.Reporter.Debugf("Failed to get spot prices of instance type '%s': %v", instanceType, err)
+		return
+	}
+	spotPrice, ok := spotPrices[instanceType]
+	if !ok || spotPrice.Current <= maxPrice {
+		return
+	}
+	r.Reporter.Warnf("Spot max price $%.4f is below the current spot price $%.4f (average $%.4f in the last "+
+		"day) of instance type '%s' in availability zones '%s'. Instances may not be launched or may be "+
+		"interrupted", maxPrice, spotPrice.Current, spotPrice.Average, instanceType,
+		strings.Join(availabilityZones, ", "))
+}
+
 func minReplicaValidator(multiAZMachinePool bool) interactive.Validator {
 	return func(val interface{}) error {
 		minReplicas, err := strconv.Atoi(fmt.Sprintf("%v", val))
@@ -0,0 +1,73 @@
+/*
+Copyright (c) 2024 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package machinepool
+
+import (
+	"go.uber.org/mock/gomock"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/spf13/cobra"
+
+	"github.com/openshift/rosa/pkg/aws"
+	"github.com/openshift/rosa/pkg/rosa"
+	"github.com/openshift/rosa/pkg/test"
+)
+
+var _ = Describe("Spot max price", func() {
+	var testRuntime test.TestingRuntime
+	var awsClient *aws.MockClient
+	zones := []string{"us-west-2a", "us-west-2b"}
+
+	BeforeEach(func() {
+		testRuntime.InitRuntime()
+		awsClient = aws.NewMockClient(gomock.NewController(GinkgoT()))
+		testRuntime.RosaRuntime.AWSClient = awsClient
+	})
+
+	warn := func(maxPrice float64) string {
+		_, stderr, err := test.RunWithOutputCapture(func(r *rosa.Runtime, _ *cobra.Command) error {
+			warnSpotMaxPrice(r, "m5.xlarge", zones, maxPrice)
+			return nil
+		}, testRuntime.RosaRuntime, &cobra.Command{})
+		Expect(err).NotTo(HaveOccurred())
+		return stderr
+	}
+
+	It("Warns when the max price is below the current spot price", func() {
+		awsClient.EXPECT().GetSpotPrices([]string{"m5.xlarge"}, zones, gomock.Any()).Return(
+			map[string]*aws.SpotPrice{"m5.xlarge": {InstanceType: "m5.xlarge", Current: 0.08, Average: 0.07}}, nil)
+
+		Expect(warn(0.05)).To(ContainSubstring("Spot max price $0.0500 is below the current spot price " +
+			"$0.0800 (average $0.0700 in the last day) of instance type 'm5.xlarge' in availability zones " +
+			"'us-west-2a, us-west-2b'"))
+	})
+
+	It("Doesn't warn when the max price is above the current spot price", func() {
+		awsClient.EXPECT().GetSpotPrices([]string{"m5.xlarge"}, zones, gomock.Any()).Return(
+			map[string]*aws.SpotPrice{"m5.xlarge": {InstanceType: "m5.xlarge", Current: 0.08, Average: 0.07}}, nil)
+
+		Expect(warn(0.1)).To(BeEmpty())
+	})
+
+	It("Doesn't warn when there is no spot price history", func() {
+		awsClient.EXPECT().GetSpotPrices([]string{"m5.xlarge"}, zones, gomock.Any()).Return(
+			map[string]*aws.SpotPrice{}, nil)
+
+		Expect(warn(0.01)).To(BeEmpty())
+	})
+})
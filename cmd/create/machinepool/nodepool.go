@@ -10,12 +10,14 @@ import (
 	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
 	"github.com/spf13/cobra"
 
+	"github.com/openshift/rosa/pkg/helper"
 	"github.com/openshift/rosa/pkg/helper/features"
 	"github.com/openshift/rosa/pkg/helper/machinepools"
 	"github.com/openshift/rosa/pkg/helper/versions"
 	"github.com/openshift/rosa/pkg/interactive"
 	"github.com/openshift/rosa/pkg/interactive/securitygroups"
 	"github.com/openshift/rosa/pkg/output"
+	"github.com/openshift/rosa/pkg/reporter"
 	"github.com/openshift/rosa/pkg/rosa"
 )
 
@@ -27,7 +29,16 @@ func addNodePool(cmd *cobra.Command, clusterKey string, cluster *cmv1.Cluster, r
 	if isSubnetSet && isAvailabilityZoneSet {
 		r.Reporter.Errorf("Setting both `subnet` and `availability-zone` flag is not supported." +
 			" Please select `subnet` or `availability-zone` to create a single availability zone machine pool")
-		os.Exit(1)
+		os.Exit(reporter.ExitCode())
+	}
+	if args.spreadAcrossSubnets && (isSubnetSet || isAvailabilityZoneSet) {
+		r.Reporter.Errorf("Setting `%s` with `subnet` or `availability-zone` is not supported",
+			spreadAcrossSubnetsFlag)
+		os.Exit(reporter.ExitCode())
+	}
+	if args.spreadAcrossSubnets && args.estimate {
+		r.Reporter.Errorf("Setting `%s` with `estimate` is not supported", spreadAcrossSubnetsFlag)
+		os.Exit(reporter.ExitCode())
 	}
 
 	// Machine pool name:
@@ -47,13 +58,13 @@ func addNodePool(cmd *cobra.Command, clusterKey string, cluster *cmv1.Cluster, r
 		})
 		if err != nil {
 			r.Reporter.Errorf("Expected a valid name for the machine pool: %s", err)
-			os.Exit(1)
+			os.Exit(reporter.ExitCode())
 		}
 	}
 	name = strings.Trim(name, " \t")
 	if !machinePoolKeyRE.MatchString(name) {
 		r.Reporter.Errorf("Expected a valid name for the machine pool")
-		os.Exit(1)
+		os.Exit(reporter.ExitCode())
 	}
 
 	// OpenShift version:
@@ -68,21 +79,21 @@ func addNodePool(cmd *cobra.Command, clusterKey string, cluster *cmv1.Cluster, r
 		_, versionList, err := versions.GetVersionList(r, channelGroup, true, true, false, false)
 		if err != nil {
 			r.Reporter.Errorf("%s", err)
-			os.Exit(1)
+			os.Exit(reporter.ExitCode())
 		}
 
 		// Calculate the minimal version for a new hosted machine pool
 		minVersion, err := versions.GetMinimalHostedMachinePoolVersion(clusterVersion)
 		if err != nil {
 			r.Reporter.Errorf("%s", err)
-			os.Exit(1)
+			os.Exit(reporter.ExitCode())
 		}
 
 		// Filter the available list of versions for a hosted machine pool
 		filteredVersionList := versions.GetFilteredVersionList(versionList, minVersion, clusterVersion)
 		if err != nil {
 			r.Reporter.Errorf("%s", err)
-			os.Exit(1)
+			os.Exit(reporter.ExitCode())
 		}
 
 		if version == "" {
@@ -98,7 +109,7 @@ func addNodePool(cmd *cobra.Command, clusterKey string, cluster *cmv1.Cluster, r
 			})
 			if err != nil {
 				r.Reporter.Errorf("Expected a valid OpenShift version: %s", err)
-				os.Exit(1)
+				os.Exit(reporter.ExitCode())
 			}
 		}
 		// This is called in HyperShift, but we don't want to exclude version which are HCP disabled for node pools
@@ -106,19 +117,29 @@ func addNodePool(cmd *cobra.Command, clusterKey string, cluster *cmv1.Cluster, r
 		version, err = r.OCMClient.ValidateVersion(version, filteredVersionList, channelGroup, true, false)
 		if err != nil {
 			r.Reporter.Errorf("Expected a valid OpenShift version: %s", err)
-			os.Exit(1)
+			os.Exit(reporter.ExitCode())
 		}
 	}
 
-	// Allow the user to select subnet for a single AZ BYOVPC cluster
-	subnet := getSubnetFromUser(cmd, r, isSubnetSet, cluster)
-
-	// Select availability zone if the user didn't select subnet
-	if subnet == "" {
-		subnet, err = getSubnetFromAvailabilityZone(cmd, r, isAvailabilityZoneSet, cluster)
+	var subnet string
+	var placements []*subnetPlacement
+	if args.spreadAcrossSubnets {
+		placements, err = getSubnetPlacements(r, clusterKey, cluster, name)
 		if err != nil {
 			r.Reporter.Errorf("%s", err)
-			os.Exit(1)
+			os.Exit(reporter.ExitCode())
+		}
+	} else {
+		// Allow the user to select subnet for a single AZ BYOVPC cluster
+		subnet = getSubnetFromUser(cmd, r, isSubnetSet, cluster)
+
+		// Select availability zone if the user didn't select subnet
+		if subnet == "" {
+			subnet, err = getSubnetFromAvailabilityZone(cmd, r, isAvailabilityZoneSet, cluster)
+			if err != nil {
+				r.Reporter.Errorf("%s", err)
+				os.Exit(reporter.ExitCode())
+			}
 		}
 	}
 
@@ -142,7 +163,7 @@ func addNodePool(cmd *cobra.Command, clusterKey string, cluster *cmv1.Cluster, r
 		})
 		if err != nil {
 			r.Reporter.Errorf("Expected a valid value for enable-autoscaling: %s", err)
-			os.Exit(1)
+			os.Exit(reporter.ExitCode())
 		}
 	}
 
@@ -151,7 +172,7 @@ func addNodePool(cmd *cobra.Command, clusterKey string, cluster *cmv1.Cluster, r
 		// if the user set replicas and enabled autoscaling
 		if isReplicasSet {
 			r.Reporter.Errorf("Replicas can't be set when autoscaling is enabled")
-			os.Exit(1)
+			os.Exit(reporter.ExitCode())
 		}
 		if interactive.Enabled() || !isMinReplicasSet {
 			minReplicas, err = interactive.GetInt(interactive.Input{
@@ -165,13 +186,13 @@ func addNodePool(cmd *cobra.Command, clusterKey string, cluster *cmv1.Cluster, r
 			})
 			if err != nil {
 				r.Reporter.Errorf("Expected a valid number of min replicas: %s", err)
-				os.Exit(1)
+				os.Exit(reporter.ExitCode())
 			}
 		}
 		err = machinepools.MinNodePoolReplicaValidator(true)(minReplicas)
 		if err != nil {
 			r.Reporter.Errorf("%s", err)
-			os.Exit(1)
+			os.Exit(reporter.ExitCode())
 		}
 
 		if interactive.Enabled() || !isMaxReplicasSet {
@@ -186,19 +207,19 @@ func addNodePool(cmd *cobra.Command, clusterKey string, cluster *cmv1.Cluster, r
 			})
 			if err != nil {
 				r.Reporter.Errorf("Expected a valid number of max replicas: %s", err)
-				os.Exit(1)
+				os.Exit(reporter.ExitCode())
 			}
 		}
 		err = machinepools.MaxNodePoolReplicaValidator(minReplicas)(maxReplicas)
 		if err != nil {
 			r.Reporter.Errorf("%s", err)
-			os.Exit(1)
+			os.Exit(reporter.ExitCode())
 		}
 	} else {
 		// if the user set min/max replicas and hasn't enabled autoscaling
 		if isMinReplicasSet || isMaxReplicasSet {
 			r.Reporter.Errorf("Autoscaling must be enabled in order to set min and max replicas")
-			os.Exit(1)
+			os.Exit(reporter.ExitCode())
 		}
 		if interactive.Enabled() || !isReplicasSet {
 			replicas, err = interactive.GetInt(interactive.Input{
@@ -212,13 +233,21 @@ func addNodePool(cmd *cobra.Command, clusterKey string, cluster *cmv1.Cluster, r
 			})
 			if err != nil {
 				r.Reporter.Errorf("Expected a valid number of replicas: %s", err)
-				os.Exit(1)
+				os.Exit(reporter.ExitCode())
 			}
 		}
 		err = machinepools.MinNodePoolReplicaValidator(false)(replicas)
 		if err != nil {
 			r.Reporter.Errorf("%s", err)
-			os.Exit(1)
+			os.Exit(reporter.ExitCode())
+		}
+	}
+
+	if len(placements) > 0 {
+		err = setPlacementReplicas(placements, autoscaling, replicas, minReplicas, maxReplicas)
+		if err != nil {
+			r.Reporter.Errorf("%s", err)
+			os.Exit(reporter.ExitCode())
 		}
 	}
 
@@ -234,13 +263,13 @@ func addNodePool(cmd *cobra.Command, clusterKey string, cluster *cmv1.Cluster, r
 		features.AdditionalDay2SecurityGroupsHcpFeature, version)
 	if err != nil {
 		r.Reporter.Errorf("%s", err)
-		os.Exit(1)
+		os.Exit(reporter.ExitCode())
 	}
 	if interactive.Enabled() && !isSecurityGroupIdsSet && isVersionCompatibleSecurityGroupIds {
 		securityGroupIds, err = getSecurityGroupsOption(r, cmd, cluster)
 		if err != nil {
 			r.Reporter.Errorf("%s", err)
-			os.Exit(1)
+			os.Exit(reporter.ExitCode())
 		}
 	}
 	for i, sg := range securityGroupIds {
@@ -271,7 +300,7 @@ func addNodePool(cmd *cobra.Command, clusterKey string, cluster *cmv1.Cluster, r
 	instanceType := args.instanceType
 	if instanceType == "" && !interactive.Enabled() {
 		r.Reporter.Errorf("You must supply a valid instance type")
-		os.Exit(1)
+		os.Exit(reporter.ExitCode())
 	}
 
 	var spin *spinner.Spinner
@@ -291,16 +320,24 @@ func addNodePool(cmd *cobra.Command, clusterKey string, cluster *cmv1.Cluster, r
 		availabilityZone, err := r.AWSClient.GetSubnetAvailabilityZone(subnet)
 		if err != nil {
 			r.Reporter.Errorf(fmt.Sprintf("%s", err))
-			os.Exit(1)
+			os.Exit(reporter.ExitCode())
 		}
 		availabilityZonesFilter = []string{availabilityZone}
 	}
+	if len(placements) > 0 {
+		availabilityZonesFilter = []string{}
+		for _, placement := range placements {
+			if !helper.Contains(availabilityZonesFilter, placement.availabilityZone) {
+				availabilityZonesFilter = append(availabilityZonesFilter, placement.availabilityZone)
+			}
+		}
+	}
 
 	instanceTypeList, err := r.OCMClient.GetAvailableMachineTypesInRegion(cluster.Region().ID(),
 		availabilityZonesFilter, cluster.AWS().STS().RoleARN(), r.AWSClient)
 	if err != nil {
 		r.Reporter.Errorf(fmt.Sprintf("%s", err))
-		os.Exit(1)
+		os.Exit(reporter.ExitCode())
 	}
 
 	if spin != nil {
@@ -320,14 +357,14 @@ func addNodePool(cmd *cobra.Command, clusterKey string, cluster *cmv1.Cluster, r
 		})
 		if err != nil {
 			r.Reporter.Errorf("Expected a valid instance type: %s", err)
-			os.Exit(1)
+			os.Exit(reporter.ExitCode())
 		}
 	}
 
 	err = instanceTypeList.ValidateMachineType(instanceType, cluster.MultiAZ())
 	if err != nil {
 		r.Reporter.Errorf("Expected a valid instance type: %s", err)
-		os.Exit(1)
+		os.Exit(reporter.ExitCode())
 	}
 
 	autorepair := args.autorepair
@@ -340,7 +377,7 @@ func addNodePool(cmd *cobra.Command, clusterKey string, cluster *cmv1.Cluster, r
 		})
 		if err != nil {
 			r.Reporter.Errorf("Expected a valid value for autorepair: %s", err)
-			os.Exit(1)
+			os.Exit(reporter.ExitCode())
 		}
 	}
 
@@ -352,7 +389,7 @@ func addNodePool(cmd *cobra.Command, clusterKey string, cluster *cmv1.Cluster, r
 	availableTuningConfigs, err := r.OCMClient.GetTuningConfigsName(cluster.ID())
 	if err != nil {
 		r.Reporter.Errorf("%s", err)
-		os.Exit(1)
+		os.Exit(reporter.ExitCode())
 	}
 	if tuningConfigs != "" {
 		if len(availableTuningConfigs) > 0 {
@@ -375,7 +412,7 @@ func addNodePool(cmd *cobra.Command, clusterKey string, cluster *cmv1.Cluster, r
 			})
 			if err != nil {
 				r.Reporter.Errorf("Expected a valid value for tuning configs: %s", err)
-				os.Exit(1)
+				os.Exit(reporter.ExitCode())
 			}
 		}
 	}
@@ -399,14 +436,14 @@ func addNodePool(cmd *cobra.Command, clusterKey string, cluster *cmv1.Cluster, r
 		})
 		if err != nil {
 			r.Reporter.Errorf("Expected a valid value for Node drain grace period: %s", err)
-			os.Exit(1)
+			os.Exit(reporter.ExitCode())
 		}
 	}
 	if nodeDrainGracePeriod != "" {
 		nodeDrainBuilder, err := machinepools.CreateNodeDrainGracePeriodBuilder(nodeDrainGracePeriod)
 		if err != nil {
 			r.Reporter.Errorf(err.Error())
-			os.Exit(1)
+			os.Exit(reporter.ExitCode())
 		}
 		npBuilder.NodeDrainGracePeriod(nodeDrainBuilder)
 	}
@@ -415,22 +452,41 @@ func addNodePool(cmd *cobra.Command, clusterKey string, cluster *cmv1.Cluster, r
 		npBuilder.Version(cmv1.NewVersion().ID(version))
 	}
 
+	if len(placements) > 0 {
+		createNodePoolsAcrossSubnets(r, clusterKey, cluster, npBuilder, autoscaling, placements)
+		return
+	}
+
 	nodePool, err := npBuilder.Build()
 	if err != nil {
 		r.Reporter.Errorf("Failed to create machine pool for hosted cluster '%s': %v", clusterKey, err)
-		os.Exit(1)
+		os.Exit(reporter.ExitCode())
+	}
+
+	if args.estimate {
+		estimate, err := r.OCMClient.EstimateNodePool(nodePool)
+		if err != nil {
+			r.Reporter.Errorf("Failed to estimate machine pool for hosted cluster '%s': %v", clusterKey, err)
+			os.Exit(reporter.ExitCode())
+		}
+		err = printEstimate(r, name, estimate)
+		if err != nil {
+			r.Reporter.Errorf("%s", err)
+			os.Exit(reporter.ExitCode())
+		}
+		return
 	}
 
 	createdNodePool, err := r.OCMClient.CreateNodePool(cluster.ID(), nodePool)
 	if err != nil {
 		r.Reporter.Errorf("Failed to add machine pool to hosted cluster '%s': %v", clusterKey, err)
-		os.Exit(1)
+		os.Exit(reporter.ExitCode())
 	}
 
 	if output.HasFlag() {
 		if err = output.Print(createdNodePool); err != nil {
 			r.Reporter.Errorf("Unable to print machine pool: %v", err)
-			os.Exit(1)
+			os.Exit(reporter.ExitCode())
 		}
 	} else {
 		r.Reporter.Infof("Machine pool '%s' created successfully on hosted cluster '%s'", createdNodePool.ID(), clusterKey)
@@ -475,7 +531,7 @@ func getSubnetFromAvailabilityZone(cmd *cobra.Command, r *rosa.Runtime, isAvaila
 		})
 		if err != nil {
 			r.Reporter.Errorf("Expected a valid AWS availability zone: %s", err)
-			os.Exit(1)
+			os.Exit(reporter.ExitCode())
 		}
 	} else if isAvailabilityZoneSet {
 		availabilityZone = args.availabilityZone
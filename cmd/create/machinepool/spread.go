@@ -0,0 +1,260 @@
+/*
+Copyright (c) 2024 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package machinepool
+
+import (
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+
+	"github.com/openshift/rosa/pkg/helper/machinepools"
+	"github.com/openshift/rosa/pkg/output"
+	"github.com/openshift/rosa/pkg/reporter"
+	"github.com/openshift/rosa/pkg/rosa"
+)
+
+const spreadAcrossSubnetsFlag = "spread-across-subnets"
+
+// subnetPlacement is one of the machine pools created when spreading a machine pool across the private
+// subnets of a hosted cluster.
+type subnetPlacement struct {
+	name             string
+	subnet           string
+	availabilityZone string
+	replicas         int
+	minReplicas      int
+	maxReplicas      int
+}
+
+// getSubnetPlacements returns a machine pool for each private subnet of the cluster, named after the
+// given name and the availability zone of the subnet. Public subnets and subnets in local zones are
+// skipped.
+func getSubnetPlacements(r *rosa.Runtime, clusterKey string, cluster *cmv1.Cluster,
+	name string) ([]*subnetPlacement, error) {
+	subnetIDs := cluster.AWS().SubnetIDs()
+	if len(subnetIDs) == 0 {
+		return nil, fmt.Errorf("Cluster '%s' has no subnets to spread the machine pool across", clusterKey)
+	}
+	subnets, err := r.AWSClient.ListSubnets(subnetIDs...)
+	if err != nil {
+		return nil, fmt.Errorf("Failed to get the subnets of cluster '%s': %v", clusterKey, err)
+	}
+	privateSubnets, err := r.AWSClient.FilterVPCsPrivateSubnets(subnets)
+	if err != nil {
+		return nil, fmt.Errorf("Failed to get the private subnets of cluster '%s': %v", clusterKey, err)
+	}
+	isPrivate := map[string]bool{}
+	for _, subnet := range privateSubnets {
+		isPrivate[aws.ToString(subnet.SubnetId)] = true
+	}
+
+	placements := []*subnetPlacement{}
+	for _, subnet := range subnets {
+		subnetID := aws.ToString(subnet.SubnetId)
+		if !isPrivate[subnetID] {
+			r.Reporter.Infof("Skipping subnet '%s': machine pools can only be placed in private subnets", subnetID)
+			continue
+		}
+		availabilityZone, err := r.AWSClient.GetSubnetAvailabilityZone(subnetID)
+		if err != nil {
+			return nil, fmt.Errorf("Failed to get the availability zone of subnet '%s': %v", subnetID, err)
+		}
+		isLocalZone, err := r.AWSClient.IsLocalAvailabilityZone(availabilityZone)
+		if err != nil {
+			return nil, fmt.Errorf("Failed to get the type of availability zone '%s': %v", availabilityZone, err)
+		}
+		if isLocalZone {
+			r.Reporter.Infof("Skipping subnet '%s': machine pools can't be spread to local zone '%s'",
+				subnetID, availabilityZone)
+			continue
+		}
+		placements = append(placements, &subnetPlacement{
+			subnet:           subnetID,
+			availabilityZone: availabilityZone,
+		})
+	}
+	if len(placements) == 0 {
+		return nil, fmt.Errorf("Cluster '%s' has no private subnets outside local zones to spread the "+
+			"machine pool across", clusterKey)
+	}
+
+	sort.Slice(placements, func(i, j int) bool {
+		if placements[i].availabilityZone != placements[j].availabilityZone {
+			return placements[i].availabilityZone < placements[j].availabilityZone
+		}
+		return placements[i].subnet < placements[j].subnet
+	})
+	nameSubnetPlacements(placements, name, cluster.Region().ID())
+
+	nodePools, err := r.OCMClient.GetNodePools(cluster.ID())
+	if err != nil {
+		return nil, fmt.Errorf("Failed to get the machine pools of cluster '%s': %v", clusterKey, err)
+	}
+	existing := []string{}
+	for _, nodePool := range nodePools {
+		existing = append(existing, nodePool.ID())
+	}
+	err = validateSubnetPlacementNames(placements, name, existing)
+	if err != nil {
+		return nil, err
+	}
+	return placements, nil
+}
+
+// nameSubnetPlacements names the machine pools after the suffix of their availability zone, adding
+// a sequence number when there are several subnets in the same availability zone. For example
+// 'workers-a', or 'workers-a1' and 'workers-a2'.
+func nameSubnetPlacements(placements []*subnetPlacement, name string, region string) {
+	perZone := map[string]int{}
+	for _, placement := range placements {
+		perZone[placement.availabilityZone]++
+	}
+	seen := map[string]int{}
+	for _, placement := range placements {
+		suffix := strings.TrimPrefix(placement.availabilityZone, region)
+		if perZone[placement.availabilityZone] > 1 {
+			seen[placement.availabilityZone]++
+			suffix = fmt.Sprintf("%s%d", suffix, seen[placement.availabilityZone])
+		}
+		placement.name = fmt.Sprintf("%s-%s", name, suffix)
+	}
+}
+
+// validateSubnetPlacementNames checks that the names of all the machine pools are valid before any of
+// them is created, so that a name that is too long or already used doesn't leave a partial spread.
+func validateSubnetPlacementNames(placements []*subnetPlacement, name string, existing []string) error {
+	used := map[string]bool{}
+	for _, id := range existing {
+		used[id] = true
+	}
+	for _, placement := range placements {
+		if len(placement.name) > machinepools.NodePoolNameMaxLength {
+			suffixLength := len(placement.name) - len(name)
+			return fmt.Errorf("Machine pool name '%s' for subnet '%s' is longer than %d characters, "+
+				"use a name of at most %d characters to spread it across subnets", placement.name,
+				placement.subnet, machinepools.NodePoolNameMaxLength,
+				machinepools.NodePoolNameMaxLength-suffixLength)
+		}
+		if used[placement.name] {
+			return fmt.Errorf("Machine pool '%s' for subnet '%s' already exists", placement.name,
+				placement.subnet)
+		}
+		used[placement.name] = true
+	}
+	return nil
+}
+
+// splitReplicas splits the replicas as evenly as possible, giving the remainder to the first ones.
+func splitReplicas(total int, count int) []int {
+	result := make([]int, count)
+	for i := range result {
+		result[i] = total / count
+		if i < total%count {
+			result[i]++
+		}
+	}
+	return result
+}
+
+// setPlacementReplicas splits the replicas, or the min and max replicas, across the placements.
+func setPlacementReplicas(placements []*subnetPlacement, autoscaling bool, replicas int, minReplicas int,
+	maxReplicas int) error {
+	if !autoscaling {
+		for i, split := range splitReplicas(replicas, len(placements)) {
+			placements[i].replicas = split
+		}
+		return nil
+	}
+	if minReplicas < len(placements) {
+		return fmt.Errorf("min-replicas must be at least %d to spread the machine pool across %d subnets",
+			len(placements), len(placements))
+	}
+	maxSplits := splitReplicas(maxReplicas, len(placements))
+	for i, split := range splitReplicas(minReplicas, len(placements)) {
+		placements[i].minReplicas = split
+		placements[i].maxReplicas = maxSplits[i]
+	}
+	return nil
+}
+
+// createNodePoolsAcrossSubnets creates a machine pool for each placement, with the configuration of the
+// given builder. All the machine pools are built before the first one is created, and creation stops at
+// the first failure, reporting the machine pools that were created and the ones that weren't.
+func createNodePoolsAcrossSubnets(r *rosa.Runtime, clusterKey string, cluster *cmv1.Cluster,
+	npBuilder *cmv1.NodePoolBuilder, autoscaling bool, placements []*subnetPlacement) {
+	nodePools := []*cmv1.NodePool{}
+	for _, placement := range placements {
+		npBuilder.ID(placement.name).Subnet(placement.subnet)
+		if autoscaling {
+			npBuilder.Autoscaling(cmv1.NewNodePoolAutoscaling().
+				MinReplica(placement.minReplicas).
+				MaxReplica(placement.maxReplicas))
+		} else {
+			npBuilder.Replicas(placement.replicas)
+		}
+		nodePool, err := npBuilder.Build()
+		if err != nil {
+			r.Reporter.Errorf("Failed to build machine pool '%s' in subnet '%s': %v", placement.name,
+				placement.subnet, err)
+			os.Exit(reporter.ExitCode())
+		}
+		nodePools = append(nodePools, nodePool)
+	}
+
+	created := []*cmv1.NodePool{}
+	for i, placement := range placements {
+		nodePool, err := r.OCMClient.CreateNodePool(cluster.ID(), nodePools[i])
+		if err != nil {
+			if len(created) > 0 {
+				names := []string{}
+				for _, nodePool := range created {
+					names = append(names, nodePool.ID())
+				}
+				r.Reporter.Warnf("Machine pools '%s' were created on hosted cluster '%s'",
+					strings.Join(names, "', '"), clusterKey)
+			}
+			names := []string{}
+			for _, placement := range placements[i+1:] {
+				names = append(names, placement.name)
+			}
+			if len(names) > 0 {
+				r.Reporter.Warnf("Machine pools '%s' were not created", strings.Join(names, "', '"))
+			}
+			r.Reporter.Errorf("Failed to add machine pool '%s' in subnet '%s' to hosted cluster '%s': %v",
+				placement.name, placement.subnet, clusterKey, err)
+			os.Exit(reporter.ExitCode())
+		}
+		created = append(created, nodePool)
+		if !output.HasFlag() {
+			r.Reporter.Infof("Machine pool '%s' created successfully in subnet '%s' (%s) on hosted cluster '%s'",
+				nodePool.ID(), placement.subnet, placement.availabilityZone, clusterKey)
+		}
+	}
+
+	if output.HasFlag() {
+		if err := output.Print(created); err != nil {
+			r.Reporter.Errorf("Unable to print machine pools: %v", err)
+			os.Exit(reporter.ExitCode())
+		}
+		return
+	}
+	r.Reporter.Infof("To view all machine pools, run 'rosa list machinepools --cluster %s'", clusterKey)
+}
@@ -0,0 +1,180 @@
+/*
+Copyright (c) 2024 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package machinepool
+
+import (
+	"net/http"
+
+	awssdk "github.com/aws/aws-sdk-go-v2/aws"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+	. "github.com/openshift-online/ocm-sdk-go/testing"
+	"github.com/spf13/cobra"
+	"go.uber.org/mock/gomock"
+
+	"github.com/openshift/rosa/pkg/aws"
+	"github.com/openshift/rosa/pkg/rosa"
+	"github.com/openshift/rosa/pkg/test"
+)
+
+var _ = Describe("Spread across subnets", func() {
+	Context("splitReplicas", func() {
+		It("Splits replicas evenly", func() {
+			Expect(splitReplicas(6, 3)).To(Equal([]int{2, 2, 2}))
+		})
+
+		It("Gives the remainder to the first placements", func() {
+			Expect(splitReplicas(5, 3)).To(Equal([]int{2, 2, 1}))
+			Expect(splitReplicas(1, 3)).To(Equal([]int{1, 0, 0}))
+		})
+	})
+
+	Context("setPlacementReplicas", func() {
+		var placements []*subnetPlacement
+
+		BeforeEach(func() {
+			placements = []*subnetPlacement{{}, {}}
+		})
+
+		It("Splits replicas", func() {
+			Expect(setPlacementReplicas(placements, false, 3, 0, 0)).To(Succeed())
+			Expect(placements[0].replicas).To(Equal(2))
+			Expect(placements[1].replicas).To(Equal(1))
+		})
+
+		It("Splits min and max replicas", func() {
+			Expect(setPlacementReplicas(placements, true, 0, 2, 5)).To(Succeed())
+			Expect(placements[0].minReplicas).To(Equal(1))
+			Expect(placements[0].maxReplicas).To(Equal(3))
+			Expect(placements[1].minReplicas).To(Equal(1))
+			Expect(placements[1].maxReplicas).To(Equal(2))
+		})
+
+		It("Fails when min replicas is less than the number of subnets", func() {
+			err := setPlacementReplicas(placements, true, 0, 1, 5)
+			Expect(err).To(MatchError("min-replicas must be at least 2 to spread the machine pool " +
+				"across 2 subnets"))
+		})
+	})
+
+	Context("nameSubnetPlacements", func() {
+		It("Names machine pools after the availability zone", func() {
+			placements := []*subnetPlacement{
+				{availabilityZone: "us-east-1a"},
+				{availabilityZone: "us-east-1b"},
+				{availabilityZone: "us-east-1b"},
+			}
+			nameSubnetPlacements(placements, "workers", "us-east-1")
+			Expect(placements[0].name).To(Equal("workers-a"))
+			Expect(placements[1].name).To(Equal("workers-b1"))
+			Expect(placements[2].name).To(Equal("workers-b2"))
+		})
+	})
+
+	Context("validateSubnetPlacementNames", func() {
+		It("Accepts names that fit and aren't used", func() {
+			placements := []*subnetPlacement{{name: "workers-a"}, {name: "workers-b"}}
+			Expect(validateSubnetPlacementNames(placements, "workers", []string{"workers"})).To(Succeed())
+		})
+
+		It("Fails for names longer than the node pool limit", func() {
+			placements := []*subnetPlacement{
+				{name: "gpu-workers-b1", subnet: "subnet-1"},
+				{name: "gpu-workers-b10", subnet: "subnet-2"},
+			}
+			Expect(validateSubnetPlacementNames(placements, "gpu-workers", nil)).To(Succeed())
+			placements = append(placements, &subnetPlacement{name: "gpu-workers-b100", subnet: "subnet-3"})
+			err := validateSubnetPlacementNames(placements, "gpu-workers", nil)
+			Expect(err).To(MatchError("Machine pool name 'gpu-workers-b100' for subnet 'subnet-3' is longer " +
+				"than 15 characters, use a name of at most 10 characters to spread it across subnets"))
+		})
+
+		It("Fails for names of existing machine pools", func() {
+			placements := []*subnetPlacement{{name: "workers-a", subnet: "subnet-1"}}
+			err := validateSubnetPlacementNames(placements, "workers", []string{"workers-a"})
+			Expect(err).To(MatchError("Machine pool 'workers-a' for subnet 'subnet-1' already exists"))
+		})
+	})
+
+	Context("getSubnetPlacements", func() {
+		var testRuntime test.TestingRuntime
+		var awsClient *aws.MockClient
+		var cluster *cmv1.Cluster
+		subnet := func(id string) ec2types.Subnet {
+			return ec2types.Subnet{SubnetId: awssdk.String(id)}
+		}
+		subnets := []ec2types.Subnet{subnet("subnet-3"), subnet("subnet-1"), subnet("subnet-2"),
+			subnet("subnet-4")}
+
+		BeforeEach(func() {
+			testRuntime.InitRuntime()
+			awsClient = aws.NewMockClient(gomock.NewController(GinkgoT()))
+			testRuntime.RosaRuntime.AWSClient = awsClient
+			cluster = test.MockCluster(func(c *cmv1.ClusterBuilder) {
+				c.Region(cmv1.NewCloudRegion().ID("us-east-1"))
+				c.AWS(cmv1.NewAWS().SubnetIDs("subnet-3", "subnet-1", "subnet-2", "subnet-4"))
+			})
+		})
+
+		get := func() ([]*subnetPlacement, string, error) {
+			var placements []*subnetPlacement
+			stdout, _, err := test.RunWithOutputCapture(func(r *rosa.Runtime, _ *cobra.Command) error {
+				var err error
+				placements, err = getSubnetPlacements(r, "cluster1", cluster, "workers")
+				return err
+			}, testRuntime.RosaRuntime, &cobra.Command{})
+			return placements, stdout, err
+		}
+
+		It("Skips public subnets and subnets in local zones", func() {
+			awsClient.EXPECT().ListSubnets("subnet-3", "subnet-1", "subnet-2", "subnet-4").Return(subnets, nil)
+			awsClient.EXPECT().FilterVPCsPrivateSubnets(subnets).Return(
+				[]ec2types.Subnet{subnet("subnet-3"), subnet("subnet-1"), subnet("subnet-2")}, nil)
+			awsClient.EXPECT().GetSubnetAvailabilityZone("subnet-1").Return("us-east-1b", nil)
+			awsClient.EXPECT().GetSubnetAvailabilityZone("subnet-2").Return("us-east-1-bos-1a", nil)
+			awsClient.EXPECT().GetSubnetAvailabilityZone("subnet-3").Return("us-east-1a", nil)
+			awsClient.EXPECT().IsLocalAvailabilityZone("us-east-1a").Return(false, nil)
+			awsClient.EXPECT().IsLocalAvailabilityZone("us-east-1b").Return(false, nil)
+			awsClient.EXPECT().IsLocalAvailabilityZone("us-east-1-bos-1a").Return(true, nil)
+
+			testRuntime.ApiServer.AppendHandlers(RespondWithJSON(http.StatusOK,
+				test.FormatNodePoolList([]*cmv1.NodePool{})))
+
+			placements, stdout, err := get()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(stdout).To(ContainSubstring("Skipping subnet 'subnet-4': machine pools can only be " +
+				"placed in private subnets"))
+			Expect(stdout).To(ContainSubstring("Skipping subnet 'subnet-2': machine pools can't be spread " +
+				"to local zone 'us-east-1-bos-1a'"))
+			Expect(placements).To(Equal([]*subnetPlacement{
+				{name: "workers-a", subnet: "subnet-3", availabilityZone: "us-east-1a"},
+				{name: "workers-b", subnet: "subnet-1", availabilityZone: "us-east-1b"},
+			}))
+		})
+
+		It("Fails when there are no private subnets", func() {
+			awsClient.EXPECT().ListSubnets("subnet-3", "subnet-1", "subnet-2", "subnet-4").Return(subnets, nil)
+			awsClient.EXPECT().FilterVPCsPrivateSubnets(subnets).Return([]ec2types.Subnet{}, nil)
+
+			_, _, err := get()
+			Expect(err).To(MatchError("Cluster 'cluster1' has no private subnets outside local zones to " +
+				"spread the machine pool across"))
+		})
+	})
+})
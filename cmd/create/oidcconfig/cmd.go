@@ -33,6 +33,7 @@ import (
 	"github.com/openshift/rosa/pkg/arguments"
 	"github.com/openshift/rosa/pkg/aws"
 	awscb "github.com/openshift/rosa/pkg/aws/commandbuilder"
+	"github.com/openshift/rosa/pkg/aws/iac"
 	"github.com/openshift/rosa/pkg/aws/tags"
 	. "github.com/openshift/rosa/pkg/constants"
 	"github.com/openshift/rosa/pkg/helper"
@@ -40,6 +41,7 @@ import (
 	"github.com/openshift/rosa/pkg/interactive/confirm"
 	interactiveRoles "github.com/openshift/rosa/pkg/interactive/roles"
 	"github.com/openshift/rosa/pkg/output"
+	"github.com/openshift/rosa/pkg/reporter"
 	"github.com/openshift/rosa/pkg/rosa"
 )
 
@@ -59,7 +61,10 @@ var Cmd = &cobra.Command{
 		"client AWS account and populates it to be compliant with OIDC protocol. " +
 		"It also creates a Secret in Secrets Manager containing the private key.",
 	Example: `  # Create OIDC config
-	rosa create oidc-config`,
+	rosa create oidc-config
+
+	# Generate a Terraform configuration for an unmanaged OIDC config and its provider
+	rosa create oidc-config --managed=false --mode manual --output-format terraform`,
 	Run:  run,
 	Args: cobra.NoArgs,
 }
@@ -107,6 +112,7 @@ func init() {
 	)
 
 	interactive.AddModeFlag(Cmd)
+	iac.AddFlag(Cmd)
 
 	confirm.AddFlag(flags)
 	interactive.AddFlag(flags)
@@ -141,14 +147,14 @@ func run(cmd *cobra.Command, _ []string) {
 	mode, err := interactive.GetMode()
 	if err != nil {
 		r.Reporter.Errorf("%s", err)
-		os.Exit(1)
+		os.Exit(reporter.ExitCode())
 	}
 
 	// Get AWS region
 	region, err := aws.GetRegion(arguments.GetRegion())
 	if err != nil {
 		r.Reporter.Errorf("Error getting region: %v", err)
-		os.Exit(1)
+		os.Exit(reporter.ExitCode())
 	}
 	args.region = region
 
@@ -160,17 +166,17 @@ func run(cmd *cobra.Command, _ []string) {
 
 	if args.rawFiles && mode != "" {
 		r.Reporter.Warnf("--%s param is not supported alongside --mode param.", rawFilesFlag)
-		os.Exit(1)
+		os.Exit(reporter.ExitCode())
 	}
 
 	if args.rawFiles && args.installerRoleArn != "" {
 		r.Reporter.Warnf("--%s param is not supported alongside --%s param", rawFilesFlag, InstallerRoleArnFlag)
-		os.Exit(1)
+		os.Exit(reporter.ExitCode())
 	}
 
 	if args.rawFiles && args.managed {
 		r.Reporter.Warnf("--%s param is not supported alongside --%s param", rawFilesFlag, managedFlag)
-		os.Exit(1)
+		os.Exit(reporter.ExitCode())
 	}
 
 	if !args.rawFiles && interactive.Enabled() && !cmd.Flags().Changed("mode") {
@@ -183,23 +189,29 @@ func run(cmd *cobra.Command, _ []string) {
 		mode, err = interactive.GetOptionMode(cmd, mode, question)
 		if err != nil {
 			r.Reporter.Errorf("Expected a valid %s: %s", question, err)
-			os.Exit(1)
+			os.Exit(reporter.ExitCode())
 		}
 	}
 
 	if output.HasFlag() && mode != "" && mode != interactive.ModeAuto {
 		r.Reporter.Warnf("--output param is not supported outside auto mode.")
-		os.Exit(1)
+		os.Exit(reporter.ExitCode())
+	}
+
+	err = iac.Validate(mode)
+	if err != nil {
+		r.Reporter.Errorf("%s", err)
+		os.Exit(reporter.ExitCode())
 	}
 
 	if args.managed && args.userPrefix != "" {
 		r.Reporter.Warnf("--%s param is not supported for managed OIDC config", userPrefixFlag)
-		os.Exit(1)
+		os.Exit(reporter.ExitCode())
 	}
 
 	if args.managed && args.installerRoleArn != "" {
 		r.Reporter.Warnf("--%s param is not supported for managed OIDC config", InstallerRoleArnFlag)
-		os.Exit(1)
+		os.Exit(reporter.ExitCode())
 	}
 
 	if !args.managed {
@@ -227,7 +239,7 @@ func run(cmd *cobra.Command, _ []string) {
 				})
 				if err != nil {
 					r.Reporter.Errorf("Expected a valid prefix for the configuration: %s", err)
-					os.Exit(1)
+					os.Exit(reporter.ExitCode())
 				}
 				args.userPrefix = prefix
 			}
@@ -239,7 +251,7 @@ func run(cmd *cobra.Command, _ []string) {
 				err := aws.ARNValidator(args.installerRoleArn)
 				if err != nil {
 					r.Reporter.Errorf("Expected a valid ARN: %s", err)
-					os.Exit(1)
+					os.Exit(reporter.ExitCode())
 				}
 				roleExists, _, err := r.AWSClient.CheckRoleExists(roleName)
 				if err != nil {
@@ -248,17 +260,17 @@ func run(cmd *cobra.Command, _ []string) {
 						args.installerRoleArn,
 						err,
 					)
-					os.Exit(1)
+					os.Exit(reporter.ExitCode())
 				}
 				if !roleExists {
 					r.Reporter.Errorf("Role '%s' does not exist", args.installerRoleArn)
-					os.Exit(1)
+					os.Exit(reporter.ExitCode())
 				}
 				isValid, err := r.AWSClient.ValidateAccountRoleVersionCompatibility(
 					roleName, aws.InstallerAccountRole, MinorVersionForGetSecret)
 				if err != nil {
 					r.Reporter.Errorf("There was a problem listing role tags: %v", err)
-					os.Exit(1)
+					os.Exit(reporter.ExitCode())
 				}
 				if !isValid {
 					r.Reporter.Errorf(
@@ -266,7 +278,7 @@ func run(cmd *cobra.Command, _ []string) {
 						args.installerRoleArn,
 						MinorVersionForGetSecret,
 					)
-					os.Exit(1)
+					os.Exit(reporter.ExitCode())
 				}
 			}
 		}
@@ -276,7 +288,7 @@ func run(cmd *cobra.Command, _ []string) {
 		if len([]rune(args.userPrefix)) > maxLengthUserPrefix {
 			r.Reporter.Errorf("Expected a valid prefix for the configuration: "+
 				"length of prefix is limited to %d characters", maxLengthUserPrefix)
-			os.Exit(1)
+			os.Exit(reporter.ExitCode())
 		}
 	}
 
@@ -285,19 +297,26 @@ func run(cmd *cobra.Command, _ []string) {
 		oidcConfigInput, err = oidcconfigs.BuildOidcConfigInput(args.userPrefix, args.region)
 		if err != nil {
 			r.Reporter.Errorf("%s", err)
-			os.Exit(1)
+			os.Exit(reporter.ExitCode())
 		}
 	}
 
 	oidcConfigStrategy, err := getOidcConfigStrategy(mode, &oidcConfigInput)
 	if err != nil {
 		r.Reporter.Errorf("%s", err)
-		os.Exit(1)
+		os.Exit(reporter.ExitCode())
 	}
 	oidcConfigStrategy.execute(r)
 	if !args.rawFiles {
 		oidcprovider.Cmd.Run(oidcprovider.Cmd, []string{"", mode, oidcConfigInput.IssuerUrl})
 	}
+	if iac.Enabled() {
+		err = iac.Print()
+		if err != nil {
+			r.Reporter.Errorf("%s", err)
+			os.Exit(reporter.ExitCode())
+		}
+	}
 }
 
 type CreateOidcConfigStrategy interface {
@@ -317,19 +336,19 @@ func (s *CreateUnmanagedOidcConfigRawStrategy) execute(r *rosa.Runtime) {
 	err := helper.SaveDocument(string(privateKey), privateKeyFilename)
 	if err != nil {
 		r.Reporter.Errorf("There was a problem saving private key to a file: %s", err)
-		os.Exit(1)
+		os.Exit(reporter.ExitCode())
 	}
 	discoveryDocumentFilename := fmt.Sprintf("discovery-document-%s.json", bucketName)
 	err = helper.SaveDocument(discoveryDocument, discoveryDocumentFilename)
 	if err != nil {
 		r.Reporter.Errorf("There was a problem saving discovery document to a file: %s", err)
-		os.Exit(1)
+		os.Exit(reporter.ExitCode())
 	}
 	jwksFilename := fmt.Sprintf("jwks-%s.json", bucketName)
 	err = helper.SaveDocument(string(jwks[:]), jwksFilename)
 	if err != nil {
 		r.Reporter.Errorf("There was a problem saving JSON Web Key Set to a file: %s", err)
-		os.Exit(1)
+		os.Exit(reporter.ExitCode())
 	}
 	if !output.HasFlag() && r.Reporter.IsTerminal() {
 		r.Reporter.Infof(
@@ -366,14 +385,14 @@ func (s *CreateUnmanagedOidcConfigAutoStrategy) execute(r *rosa.Runtime) {
 	err := r.AWSClient.CreateS3Bucket(bucketName, args.region)
 	if err != nil {
 		r.Reporter.Errorf("There was a problem creating S3 bucket '%s': %s", bucketName, err)
-		os.Exit(1)
+		os.Exit(reporter.ExitCode())
 	}
 	err = r.AWSClient.PutPublicReadObjectInS3Bucket(
 		bucketName, strings.NewReader(discoveryDocument), discoveryDocumentKey)
 	if err != nil {
 		r.Reporter.Errorf("There was a problem populating discovery "+
 			"document to S3 bucket '%s': %s", bucketName, err)
-		os.Exit(1)
+		os.Exit(reporter.ExitCode())
 	}
 	err = r.AWSClient.PutPublicReadObjectInS3Bucket(bucketName, bytes.NewReader(jwks), jwksKey)
 	if err != nil {
@@ -382,12 +401,12 @@ func (s *CreateUnmanagedOidcConfigAutoStrategy) execute(r *rosa.Runtime) {
 		}
 		r.Reporter.Errorf("There was a problem populating JWKS "+
 			"to S3 bucket '%s': %s", bucketName, err)
-		os.Exit(1)
+		os.Exit(reporter.ExitCode())
 	}
 	secretARN, err := r.AWSClient.CreateSecretInSecretsManager(privateKeySecretName, string(privateKey[:]))
 	if err != nil {
 		r.Reporter.Errorf("There was a problem saving private key to secrets manager: %s", err)
-		os.Exit(1)
+		os.Exit(reporter.ExitCode())
 	}
 	oidcConfig, err := v1.NewOidcConfig().
 		Managed(false).
@@ -406,13 +425,13 @@ func (s *CreateUnmanagedOidcConfigAutoStrategy) execute(r *rosa.Runtime) {
 			"Please refer to documentation and try again through:\n"+
 			"\trosa register oidc-config --issuer-url %s --secret-arn %s --role-arn %s",
 			err, bucketUrl, secretARN, installerRoleArn)
-		os.Exit(1)
+		os.Exit(reporter.ExitCode())
 	}
 	if output.HasFlag() {
 		err = output.Print(oidcConfig)
 		if err != nil {
 			r.Reporter.Errorf("%s", err)
-			os.Exit(1)
+			os.Exit(reporter.ExitCode())
 		}
 		os.Exit(0)
 	}
@@ -440,7 +459,18 @@ func (s *CreateUnmanagedOidcConfigManualStrategy) execute(r *rosa.Runtime) {
 	err := helper.SaveDocument(string(privateKey), privateKeyFilename)
 	if err != nil {
 		r.Reporter.Errorf("There was a problem saving private key to a file: %s", err)
-		os.Exit(1)
+		os.Exit(reporter.ExitCode())
+	}
+	if iac.Enabled() {
+		err = s.addToTemplate()
+		if err != nil {
+			r.Reporter.Errorf("%s", err)
+			os.Exit(reporter.ExitCode())
+		}
+		// Warnings go to the standard error, so they don't mix with the template
+		r.Reporter.Warnf("The template reads the private key of the OIDC configuration from '%s'. "+
+			"Keep the file until the template is applied and delete it afterwards", privateKeyFilename)
+		return
 	}
 	createBucketConfig := ""
 	if args.region != aws.DefaultRegion {
@@ -473,7 +503,7 @@ func (s *CreateUnmanagedOidcConfigManualStrategy) execute(r *rosa.Runtime) {
 	err = helper.SaveDocument(fmt.Sprintf(aws.ReadOnlyAnonUserPolicyTemplate, bucketName), readOnlyPolicyFilename)
 	if err != nil {
 		r.Reporter.Errorf("There was a problem saving bucket policy document to a file: %s", err)
-		os.Exit(1)
+		os.Exit(reporter.ExitCode())
 	}
 	putBucketBucketPolicyCommand := awscb.NewS3ApiCommandBuilder().
 		SetCommand(awscb.PutBucketPolicy).
@@ -487,7 +517,7 @@ func (s *CreateUnmanagedOidcConfigManualStrategy) execute(r *rosa.Runtime) {
 	err = helper.SaveDocument(discoveryDocument, discoveryDocumentFilename)
 	if err != nil {
 		r.Reporter.Errorf("There was a problem saving discovery document to a file: %s", err)
-		os.Exit(1)
+		os.Exit(reporter.ExitCode())
 	}
 	putDiscoveryDocumentCommand := awscb.NewS3ApiCommandBuilder().
 		SetCommand(awscb.PutObject).
@@ -502,7 +532,7 @@ func (s *CreateUnmanagedOidcConfigManualStrategy) execute(r *rosa.Runtime) {
 	err = helper.SaveDocument(string(jwks[:]), jwksFilename)
 	if err != nil {
 		r.Reporter.Errorf("There was a problem saving JSON Web Key Set to a file: %s", err)
-		os.Exit(1)
+		os.Exit(reporter.ExitCode())
 	}
 	putJwksCommand := awscb.NewS3ApiCommandBuilder().
 		SetCommand(awscb.PutObject).
@@ -534,6 +564,28 @@ func (s *CreateUnmanagedOidcConfigManualStrategy) execute(r *rosa.Runtime) {
 	}
 }
 
+// addToTemplate adds the bucket, its documents and the secret to the template. The secret reads the private
+// key from its file, so the key isn't part of the template.
+func (s *CreateUnmanagedOidcConfigManualStrategy) addToTemplate() error {
+	bucketName := s.oidcConfig.BucketName
+	redHatManaged := map[string]string{tags.RedHatManaged: tags.True}
+	template := iac.Current()
+	err := template.AddBucket(bucketName, args.region, map[string]bool{
+		"BlockPublicAcls":       true,
+		"IgnorePublicAcls":      true,
+		"BlockPublicPolicy":     false,
+		"RestrictPublicBuckets": false,
+	}, fmt.Sprintf(aws.ReadOnlyAnonUserPolicyTemplate, bucketName), redHatManaged)
+	if err != nil {
+		return err
+	}
+	template.AddObject(bucketName, discoveryDocumentKey, s.oidcConfig.DiscoveryDocument, redHatManaged)
+	template.AddObject(bucketName, jwksKey, string(s.oidcConfig.Jwks), redHatManaged)
+	template.AddSecret(s.oidcConfig.PrivateKeySecretName, fmt.Sprintf("Secret for %s", bucketName), args.region,
+		s.oidcConfig.PrivateKeyFilename, redHatManaged)
+	return nil
+}
+
 type CreateManagedOidcConfigAutoStrategy struct {
 	oidcConfigInput *oidcconfigs.OidcConfigInput
 }
@@ -550,7 +602,7 @@ func (s *CreateManagedOidcConfigAutoStrategy) execute(r *rosa.Runtime) {
 	oidcConfig, err := v1.NewOidcConfig().Managed(true).Build()
 	if err != nil {
 		r.Reporter.Errorf("There was a problem building the managed OIDC Configuration: %v", err)
-		os.Exit(1)
+		os.Exit(reporter.ExitCode())
 	}
 	oidcConfig, err = r.OCMClient.CreateOidcConfig(oidcConfig)
 	if err != nil {
@@ -558,14 +610,14 @@ func (s *CreateManagedOidcConfigAutoStrategy) execute(r *rosa.Runtime) {
 			spin.Stop()
 		}
 		r.Reporter.Errorf("There was a problem registering your managed OIDC Configuration: %v", err)
-		os.Exit(1)
+		os.Exit(reporter.ExitCode())
 	}
 	s.oidcConfigInput.IssuerUrl = oidcConfig.IssuerUrl()
 	if output.HasFlag() {
 		err = output.Print(oidcConfig)
 		if err != nil {
 			r.Reporter.Errorf("%s", err)
-			os.Exit(1)
+			os.Exit(reporter.ExitCode())
 		}
 		os.Exit(0)
 	}
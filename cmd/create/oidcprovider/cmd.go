@@ -27,12 +27,14 @@ import (
 
 	"github.com/openshift/rosa/pkg/aws"
 	awscb "github.com/openshift/rosa/pkg/aws/commandbuilder"
+	"github.com/openshift/rosa/pkg/aws/iac"
 	"github.com/openshift/rosa/pkg/aws/tags"
 	"github.com/openshift/rosa/pkg/interactive"
 	"github.com/openshift/rosa/pkg/interactive/confirm"
 	interactiveOidc "github.com/openshift/rosa/pkg/interactive/oidc"
 	"github.com/openshift/rosa/pkg/ocm"
 	"github.com/openshift/rosa/pkg/output"
+	"github.com/openshift/rosa/pkg/reporter"
 	"github.com/openshift/rosa/pkg/rosa"
 )
 
@@ -42,7 +44,10 @@ var Cmd = &cobra.Command{
 	Short:   "Create OIDC provider for an STS cluster.",
 	Long:    "Create OIDC provider for operators to authenticate against in an STS cluster.",
 	Example: `  # Create OIDC provider for cluster named "mycluster"
-  rosa create oidc-provider --cluster=mycluster`,
+  rosa create oidc-provider --cluster=mycluster
+
+  # Generate a Terraform configuration for the OIDC provider instead of AWS CLI commands
+  rosa create oidc-provider --cluster=mycluster --mode manual --output-format terraform`,
 	Run:  run,
 	Args: cobra.MaximumNArgs(3),
 }
@@ -69,6 +74,7 @@ func init() {
 
 	ocm.AddOptionalClusterFlag(Cmd)
 	interactive.AddModeFlag(Cmd)
+	iac.AddFlag(Cmd)
 
 	confirm.AddFlag(flags)
 	interactive.AddFlag(flags)
@@ -98,13 +104,13 @@ func run(cmd *cobra.Command, argv []string) {
 	if cmd.Flag("cluster").Changed && cmd.Flag(OidcConfigIdFlag).Changed {
 		r.Reporter.Errorf("A cluster key for STS cluster and an OIDC Config ID " +
 			"cannot be specified alongside each other.")
-		os.Exit(1)
+		os.Exit(reporter.ExitCode())
 	}
 
 	mode, err := interactive.GetMode()
 	if err != nil {
 		r.Reporter.Errorf("%s", err)
-		os.Exit(1)
+		os.Exit(reporter.ExitCode())
 	}
 
 	// Determine if interactive mode is needed
@@ -120,7 +126,7 @@ func run(cmd *cobra.Command, argv []string) {
 		cluster = r.FetchCluster()
 		if !ocm.IsSts(cluster) {
 			r.Reporter.Errorf("Cluster '%s' is not an STS cluster.", clusterKey)
-			os.Exit(1)
+			os.Exit(reporter.ExitCode())
 		}
 	}
 
@@ -128,10 +134,16 @@ func run(cmd *cobra.Command, argv []string) {
 		mode, err = interactive.GetOptionMode(cmd, mode, "OIDC provider creation mode")
 		if err != nil {
 			r.Reporter.Errorf("Expected a valid OIDC provider creation mode: %s", err)
-			os.Exit(1)
+			os.Exit(reporter.ExitCode())
 		}
 	}
 
+	err = iac.Validate(mode)
+	if err != nil {
+		r.Reporter.Errorf("%s", err)
+		os.Exit(reporter.ExitCode())
+	}
+
 	oidcEndpointURL := ""
 	if cluster != nil {
 		oidcEndpointURL = cluster.AWS().STS().OIDCEndpointURL()
@@ -145,7 +157,7 @@ func run(cmd *cobra.Command, argv []string) {
 			oidcConfig, err := r.OCMClient.GetOidcConfig(args.oidcConfigId)
 			if err != nil {
 				r.Reporter.Errorf("There was a problem retrieving OIDC Config '%s': %v", args.oidcConfigId, err)
-				os.Exit(1)
+				os.Exit(reporter.ExitCode())
 			}
 			oidcEndpointURL = oidcConfig.IssuerUrl()
 		}
@@ -163,7 +175,7 @@ func run(cmd *cobra.Command, argv []string) {
 			r.Reporter.Debugf("Failed to verify if OIDC provider exists: %s", err)
 		} else {
 			r.Reporter.Errorf("Failed to verify if OIDC provider exists: %s", err)
-			os.Exit(1)
+			os.Exit(reporter.ExitCode())
 		}
 	}
 	if oidcProviderExists {
@@ -171,7 +183,7 @@ func run(cmd *cobra.Command, argv []string) {
 			cluster.AWS().STS().OidcConfig() != nil && !cluster.AWS().STS().OidcConfig().Reusable() {
 			r.Reporter.Warnf("Cluster '%s' already has OIDC provider but has not yet started installation. "+
 				"Verify that the cluster operator roles exist and are configured correctly.", clusterKey)
-			os.Exit(1)
+			os.Exit(reporter.ExitCode())
 		}
 		// Returns so that when called from create cluster does not interrupt flow
 		r.Reporter.Infof("OIDC provider already exists")
@@ -197,7 +209,7 @@ func run(cmd *cobra.Command, argv []string) {
 				ocm.ClusterID: clusterKey,
 				ocm.Response:  ocm.Failure,
 			})
-			os.Exit(1)
+			os.Exit(reporter.ExitCode())
 		}
 		r.OCMClient.LogEvent("ROSACreateOIDCProviderModeAuto", map[string]string{
 			ocm.ClusterID: clusterKey,
@@ -207,22 +219,34 @@ func run(cmd *cobra.Command, argv []string) {
 		commands, err := buildCommands(r, oidcEndpointURL, clusterId)
 		if err != nil {
 			r.Reporter.Errorf("There was an error building the list of resources: %s", err)
-			os.Exit(1)
+			os.Exit(reporter.ExitCode())
 			r.OCMClient.LogEvent("ROSACreateOIDCProviderModeManual", map[string]string{
 				ocm.ClusterID: clusterKey,
 				ocm.Response:  ocm.Failure,
 			})
 		}
-		if r.Reporter.IsTerminal() {
-			r.Reporter.Infof("Run the following commands to create the OIDC provider:\n")
-		}
 		r.OCMClient.LogEvent("ROSACreateOIDCProviderModeManual", map[string]string{
 			ocm.ClusterID: clusterKey,
 		})
+		if iac.Enabled() {
+			// When called from another command, like 'create oidc-config', that command prints
+			// the template with its own resources as well
+			if !isProgmaticallyCalled {
+				err = iac.Print()
+				if err != nil {
+					r.Reporter.Errorf("%s", err)
+					os.Exit(reporter.ExitCode())
+				}
+			}
+			return
+		}
+		if r.Reporter.IsTerminal() {
+			r.Reporter.Infof("Run the following commands to create the OIDC provider:\n")
+		}
 		fmt.Println(commands)
 	default:
 		r.Reporter.Errorf("Invalid mode. Allowed values are %s", interactive.Modes)
-		os.Exit(1)
+		os.Exit(reporter.ExitCode())
 	}
 }
 
@@ -260,7 +284,8 @@ func buildCommands(r *rosa.Runtime, oidcEndpointUrl string, clusterId string) (s
 		iamTags[tags.ClusterID] = clusterId
 	}
 
-	clientIdList := strings.Join([]string{aws.OIDCClientIDOpenShift, aws.OIDCClientIDSTSAWS}, " ")
+	clientIds := []string{aws.OIDCClientIDOpenShift, aws.OIDCClientIDSTSAWS}
+	clientIdList := strings.Join(clientIds, " ")
 
 	createOpenIDConnectProvider := awscb.NewIAMCommandBuilder().
 		SetCommand(awscb.CreateOpenIdConnectProvider).
@@ -270,6 +295,9 @@ func buildCommands(r *rosa.Runtime, oidcEndpointUrl string, clusterId string) (s
 		AddTags(iamTags).
 		Build()
 	commands = append(commands, createOpenIDConnectProvider)
+	if iac.Enabled() {
+		iac.Current().AddOIDCProvider(oidcEndpointUrl, clientIds, []string{thumbprint}, iamTags)
+	}
 
 	return awscb.JoinCommands(commands), nil
 }
@@ -10,11 +10,13 @@ import (
 
 	"github.com/openshift/rosa/pkg/aws"
 	awscb "github.com/openshift/rosa/pkg/aws/commandbuilder"
+	"github.com/openshift/rosa/pkg/aws/iac"
 	"github.com/openshift/rosa/pkg/aws/tags"
 	"github.com/openshift/rosa/pkg/helper"
 	"github.com/openshift/rosa/pkg/interactive"
 	"github.com/openshift/rosa/pkg/ocm"
 	"github.com/openshift/rosa/pkg/output"
+	"github.com/openshift/rosa/pkg/reporter"
 	"github.com/openshift/rosa/pkg/rosa"
 )
 
@@ -26,7 +28,7 @@ func handleOperatorRoleCreationByClusterKey(r *rosa.Runtime, env string,
 	cluster := r.FetchCluster()
 	if cluster.AWS().STS().RoleARN() == "" {
 		r.Reporter.Errorf("Cluster '%s' is not an STS cluster.", clusterKey)
-		os.Exit(1)
+		os.Exit(reporter.ExitCode())
 	}
 
 	// Check to see if IAM operator roles have already created
@@ -36,7 +38,7 @@ func handleOperatorRoleCreationByClusterKey(r *rosa.Runtime, env string,
 			r.Reporter.Debugf("Failed to verify if operator roles exist: '%v'", err)
 		} else {
 			r.Reporter.Errorf("Failed to verify if operator roles exist: '%v'", err)
-			os.Exit(1)
+			os.Exit(reporter.ExitCode())
 		}
 	}
 
@@ -50,13 +52,13 @@ func handleOperatorRoleCreationByClusterKey(r *rosa.Runtime, env string,
 	credRequests, err := r.OCMClient.GetCredRequests(cluster.Hypershift().Enabled())
 	if err != nil {
 		r.Reporter.Errorf("Error getting operator credential request from OCM '%v'", err)
-		os.Exit(1)
+		os.Exit(reporter.ExitCode())
 	}
 
 	managedPolicies := cluster.AWS().STS().ManagedPolicies()
 	if args.forcePolicyCreation && managedPolicies {
 		r.Reporter.Warnf("Forcing creation of policies only works for unmanaged policies")
-		os.Exit(1)
+		os.Exit(reporter.ExitCode())
 	}
 
 	switch mode {
@@ -78,13 +80,13 @@ func handleOperatorRoleCreationByClusterKey(r *rosa.Runtime, env string,
 		roleName, err := aws.GetInstallerAccountRoleName(cluster)
 		if err != nil {
 			r.Reporter.Errorf("Expected parsing role account role '%s': '%v'", cluster.AWS().STS().RoleARN(), err)
-			os.Exit(1)
+			os.Exit(reporter.ExitCode())
 		}
 
 		path, err := aws.GetPathFromAccountRole(cluster, aws.AccountRoles[aws.InstallerAccountRole].Name)
 		if err != nil {
 			r.Reporter.Errorf("Expected a valid path for '%s': '%v'", cluster.AWS().STS().RoleARN(), err)
-			os.Exit(1)
+			os.Exit(reporter.ExitCode())
 		}
 		if path != "" && !output.HasFlag() && r.Reporter.IsTerminal() {
 			r.Reporter.Infof("ARN path '%s' detected in installer role '%s'. "+
@@ -99,7 +101,7 @@ func handleOperatorRoleCreationByClusterKey(r *rosa.Runtime, env string,
 		accountRoleVersion, err = r.AWSClient.GetAccountRoleVersion(roleName)
 		if err != nil {
 			r.Reporter.Errorf("Error getting account role version '%v'", err)
-			os.Exit(1)
+			os.Exit(reporter.ExitCode())
 		}
 		err = createRoles(r, operatorRolePolicyPrefix, permissionsBoundary, cluster,
 			accountRoleVersion, policies, defaultPolicyVersion, credRequests, managedPolicies, hostedCPPolicies)
@@ -114,7 +116,7 @@ func handleOperatorRoleCreationByClusterKey(r *rosa.Runtime, env string,
 				ocm.Response:   ocm.Failure,
 				ocm.IsThrottle: isThrottle,
 			})
-			os.Exit(1)
+			os.Exit(reporter.ExitCode())
 		}
 		r.OCMClient.LogEvent("ROSACreateOperatorRolesModeAuto", map[string]string{
 			ocm.ClusterID: clusterKey,
@@ -125,24 +127,20 @@ func handleOperatorRoleCreationByClusterKey(r *rosa.Runtime, env string,
 			cluster, policies, credRequests, managedPolicies, hostedCPPolicies)
 		if err != nil {
 			r.Reporter.Errorf("There was an error building the list of resources: '%v'", err)
-			os.Exit(1)
+			os.Exit(reporter.ExitCode())
 			r.OCMClient.LogEvent("ROSACreateOperatorRolesModeManual", map[string]string{
 				ocm.ClusterID: clusterKey,
 				ocm.Response:  ocm.Failure,
 			})
 		}
-		if r.Reporter.IsTerminal() {
-			r.Reporter.Infof("All policy files saved to the current directory")
-			r.Reporter.Infof("Run the following commands to create the operator roles:\n")
-		}
 		r.OCMClient.LogEvent("ROSACreateOperatorRolesModeManual", map[string]string{
 			ocm.ClusterID: clusterKey,
 		})
-		fmt.Println(commands)
+		printCommands(r, commands)
 
 	default:
 		r.Reporter.Errorf("Invalid mode. Allowed values are '%s'", interactive.Modes)
-		os.Exit(1)
+		os.Exit(reporter.ExitCode())
 	}
 	return nil
 }
@@ -168,7 +166,7 @@ func createRoles(
 			isSupported, err := ocm.CheckSupportedVersion(ocm.GetVersionMinor(ver.ID()), operator.MinVersion())
 			if err != nil {
 				r.Reporter.Errorf("Error validating operator role '%s' version %s", operator.Name(), err)
-				os.Exit(1)
+				os.Exit(reporter.ExitCode())
 			}
 			if !isSupported {
 				continue
@@ -241,6 +239,7 @@ func createRoles(
 		tagsList := map[string]string{
 			tags.OperatorNamespace: operator.Namespace(),
 			tags.OperatorName:      operator.Name(),
+			tags.RolePrefix:        prefix,
 			tags.RedHatManaged:     helper.True,
 		}
 		if !ocm.IsOidcConfigReusable(cluster) {
@@ -279,11 +278,12 @@ func buildCommands(r *rosa.Runtime, env string,
 	sharedVpcRoleArn := cluster.AWS().PrivateHostedZoneRoleARN()
 	isSharedVpc := sharedVpcRoleArn != ""
 
-	if !managedPolicies {
+	// Templates include the policy documents, so they don't need the files
+	if !managedPolicies && !iac.Enabled() {
 		err := aws.GenerateOperatorRolePolicyFiles(r.Reporter, policies, credRequests, sharedVpcRoleArn, r.Creator.Partition)
 		if err != nil {
 			r.Reporter.Errorf("There was an error generating the policy files: %s", err)
-			os.Exit(1)
+			os.Exit(reporter.ExitCode())
 		}
 	}
 
@@ -295,7 +295,7 @@ func buildCommands(r *rosa.Runtime, env string,
 			isSupported, err := ocm.CheckSupportedVersion(ocm.GetVersionMinor(ver.ID()), operator.MinVersion())
 			if err != nil {
 				r.Reporter.Errorf("Error validating operator role '%s' version %s", operator.Name(), err)
-				os.Exit(1)
+				os.Exit(reporter.ExitCode())
 			}
 			if !isSupported {
 				continue
@@ -336,11 +336,22 @@ func buildCommands(r *rosa.Runtime, env string,
 					AddParam(awscb.Path, path).
 					Build()
 				commands = append(commands, createPolicy)
+				if iac.Enabled() {
+					err = iac.Current().AddPolicy(name, path, aws.GetOperatorPolicyDocument(policies,
+						operatorPolicyKey, sharedVpcRoleArn, r.Creator.Partition), iamTags)
+					if err != nil {
+						return "", err
+					}
+				}
 			} else if isSharedVpc && credrequest == aws.IngressOperatorCloudCredentialsRoleType {
 				err := validateIngressOperatorPolicyOverride(r, policyARN, sharedVpcRoleArn, prefix)
 				if err != nil {
 					return "", err
 				}
+				if iac.Enabled() {
+					return "", fmt.Errorf("Updating the existing policy '%s' can't be expressed as a template, "+
+						"run the command without --%s instead", policyARN, iac.FlagName)
+				}
 
 				createPolicyVersion := awscb.NewIAMCommandBuilder().
 					SetCommand(awscb.CreatePolicyVersion).
@@ -361,14 +372,17 @@ func buildCommands(r *rosa.Runtime, env string,
 
 		filename := fmt.Sprintf("operator_%s_policy", credrequest)
 		filename = aws.GetFormattedFileName(filename)
-		r.Reporter.Debugf("Saving '%s' to the current directory", filename)
-		err = helper.SaveDocument(policy, filename)
-		if err != nil {
-			return "", err
+		if !iac.Enabled() {
+			r.Reporter.Debugf("Saving '%s' to the current directory", filename)
+			err = helper.SaveDocument(policy, filename)
+			if err != nil {
+				return "", err
+			}
 		}
 		iamTags := map[string]string{
 			tags.OperatorNamespace: operator.Namespace(),
 			tags.OperatorName:      operator.Name(),
+			tags.RolePrefix:        prefix,
 			tags.RedHatManaged:     helper.True,
 		}
 		if !ocm.IsOidcConfigReusable(cluster) {
@@ -395,6 +409,13 @@ func buildCommands(r *rosa.Runtime, env string,
 			AddParam(awscb.PolicyArn, policyARN).
 			Build()
 		commands = append(commands, createRole, attachRolePolicy)
+		if iac.Enabled() {
+			err = iac.Current().AddRole(roleName, path, permissionsBoundary, policy, iamTags)
+			if err != nil {
+				return "", err
+			}
+			iac.Current().AddAttachment(roleName, policyARN)
+		}
 	}
 	return awscb.JoinCommands(commands), nil
 }
@@ -13,6 +13,7 @@ import (
 
 	"github.com/openshift/rosa/pkg/aws"
 	awscb "github.com/openshift/rosa/pkg/aws/commandbuilder"
+	"github.com/openshift/rosa/pkg/aws/iac"
 	"github.com/openshift/rosa/pkg/aws/tags"
 	"github.com/openshift/rosa/pkg/helper"
 	"github.com/openshift/rosa/pkg/interactive"
@@ -20,6 +21,7 @@ import (
 	interactiveRoles "github.com/openshift/rosa/pkg/interactive/roles"
 	"github.com/openshift/rosa/pkg/ocm"
 	"github.com/openshift/rosa/pkg/output"
+	"github.com/openshift/rosa/pkg/reporter"
 	"github.com/openshift/rosa/pkg/rosa"
 )
 
@@ -37,7 +39,7 @@ func handleOperatorRolesPrefixOptions(r *rosa.Runtime, cmd *cobra.Command) {
 	})
 	if err != nil {
 		r.Reporter.Errorf("Expected a prefix for the operator IAM roles: %s", err)
-		os.Exit(1)
+		os.Exit(reporter.ExitCode())
 	}
 	args.prefix = operatorRolesPrefix
 
@@ -47,7 +49,7 @@ func handleOperatorRolesPrefixOptions(r *rosa.Runtime, cmd *cobra.Command) {
 
 	if cmd.Flags().Changed("hosted-cp") && r.Creator.IsGovcloud {
 		r.Reporter.Errorf("Setting `hosted-cp` is not supported for Govcloud AWS accounts")
-		os.Exit(1)
+		os.Exit(reporter.ExitCode())
 	}
 
 	isHostedCP := args.hostedCp
@@ -62,7 +64,7 @@ func handleOperatorRolesPrefixOptions(r *rosa.Runtime, cmd *cobra.Command) {
 		})
 		if err != nil {
 			r.Reporter.Errorf("Expected a valid --hosted-cp value: %s", err)
-			os.Exit(1)
+			os.Exit(reporter.ExitCode())
 		}
 	}
 	args.hostedCp = isHostedCP
@@ -82,7 +84,7 @@ func handleOperatorRoleCreationByPrefix(r *rosa.Runtime, env string,
 	oidcConfig, err := r.OCMClient.GetOidcConfig(args.oidcConfigId)
 	if err != nil {
 		r.Reporter.Errorf("There was a problem retrieving OIDC Config '%s': %v", args.oidcConfigId, err)
-		os.Exit(1)
+		os.Exit(reporter.ExitCode())
 	}
 	includeHostedCpSet := args.hostedCp
 	operatorRolesPrefix := args.prefix
@@ -95,12 +97,12 @@ func handleOperatorRoleCreationByPrefix(r *rosa.Runtime, env string,
 	installerRoleName, err := aws.GetResourceIdFromARN(installerRoleArn)
 	if err != nil {
 		r.Reporter.Errorf("%s", err)
-		os.Exit(1)
+		os.Exit(reporter.ExitCode())
 	}
 	path, err := aws.GetPathFromARN(installerRoleArn)
 	if err != nil {
 		r.Reporter.Errorf("Expected a valid path for '%s': %v", installerRoleArn, err)
-		os.Exit(1)
+		os.Exit(reporter.ExitCode())
 	}
 	if path != "" && !output.HasFlag() && r.Reporter.IsTerminal() {
 		r.Reporter.Infof("ARN path '%s' detected in installer role '%s'. "+
@@ -112,35 +114,35 @@ func handleOperatorRoleCreationByPrefix(r *rosa.Runtime, env string,
 		aws.AccountRoles[aws.InstallerAccountRole].Name)
 	if !hasStandardNamedInstallerRole {
 		r.Reporter.Infof("Can only use installer roles created through ROSA CLI for this flow.")
-		os.Exit(1)
+		os.Exit(reporter.ExitCode())
 	}
 	operatorRolePolicyPrefix := installerRolePrefix
 	credRequests, err := r.OCMClient.GetCredRequests(includeHostedCpSet)
 	if err != nil {
 		r.Reporter.Errorf("Error getting operator credential request from OCM %s", err)
-		os.Exit(1)
+		os.Exit(reporter.ExitCode())
 	}
 	managedPolicies, err := r.AWSClient.HasManagedPolicies(installerRoleArn)
 	if err != nil {
 		r.Reporter.Errorf("Failed to determine if cluster has managed policies: %v", err)
-		os.Exit(1)
+		os.Exit(reporter.ExitCode())
 	}
 	if managedPolicies && sharedVpcRoleArn != "" {
 		r.Reporter.Errorf("Installer role '%s' has managed policies, the 'shared-vpc-role-arn' flag is not "+
 			"supported for managed policies", installerRoleArn)
-		os.Exit(1)
+		os.Exit(reporter.ExitCode())
 	}
 	awsCreator, err := r.AWSClient.GetCreator()
 	if err != nil {
 		r.Reporter.Errorf("Unable to get IAM credentials: %v", err)
-		os.Exit(1)
+		os.Exit(reporter.ExitCode())
 	}
 
 	operatorIAMRoleList, err := convertCredRequestsOperatorRolesIntoV1OperatorIAMRole(credRequests,
 		args.prefix, awsCreator, path)
 	if err != nil {
 		r.Reporter.Errorf("%s", err)
-		os.Exit(1)
+		os.Exit(reporter.ExitCode())
 	}
 
 	var hostedCPPolicies bool
@@ -148,20 +150,20 @@ func handleOperatorRoleCreationByPrefix(r *rosa.Runtime, env string,
 		hostedCPPolicies, err = r.AWSClient.HasHostedCPPolicies(args.installerRoleArn)
 		if err != nil {
 			r.Reporter.Errorf("Failed to determine if the Installer role ARN has hosted CP policies: %v", err)
-			os.Exit(1)
+			os.Exit(reporter.ExitCode())
 		}
 	}
 
 	operatorRolesList, err := convertV1OperatorIAMRoleIntoOcmOperatorIamRole(operatorIAMRoleList)
 	if err != nil {
 		r.Reporter.Errorf("%v", err)
-		os.Exit(1)
+		os.Exit(reporter.ExitCode())
 	}
 	err = ocm.ValidateOperatorRolesMatchOidcProvider(r.Reporter, r.AWSClient,
 		operatorRolesList, oidcConfig.IssuerUrl(), "4.0", path, managedPolicies)
 	if err != nil && !awserr.IsNoSuchEntityException(err) {
 		r.Reporter.Errorf("%v", err)
-		os.Exit(1)
+		os.Exit(reporter.ExitCode())
 	}
 
 	switch mode {
@@ -185,7 +187,7 @@ func handleOperatorRoleCreationByPrefix(r *rosa.Runtime, env string,
 				ocm.Response:            ocm.Failure,
 				ocm.IsThrottle:          isThrottle,
 			})
-			os.Exit(1)
+			os.Exit(reporter.ExitCode())
 		}
 		if r.Reporter.IsTerminal() {
 			hostedCpOutputParam := ""
@@ -213,23 +215,19 @@ func handleOperatorRoleCreationByPrefix(r *rosa.Runtime, env string,
 			oidcEndpointUrl, hostedCPPolicies, sharedVpcRoleArn)
 		if err != nil {
 			r.Reporter.Errorf("There was an error building the list of resources: %s", err)
-			os.Exit(1)
+			os.Exit(reporter.ExitCode())
 			r.OCMClient.LogEvent("ROSACreateOperatorRolesModeManual", map[string]string{
 				ocm.OperatorRolesPrefix: operatorRolesPrefix,
 				ocm.Response:            ocm.Failure,
 			})
 		}
-		if r.Reporter.IsTerminal() {
-			r.Reporter.Infof("All policy files saved to the current directory")
-			r.Reporter.Infof("Run the following commands to create the operator roles:\n")
-		}
 		r.OCMClient.LogEvent("ROSACreateOperatorRolesModeManual", map[string]string{
 			ocm.OperatorRolesPrefix: operatorRolesPrefix,
 		})
-		fmt.Println(commands)
+		printCommands(r, commands)
 	default:
 		r.Reporter.Errorf("Invalid mode. Allowed values are %s", interactive.Modes)
-		os.Exit(1)
+		os.Exit(reporter.ExitCode())
 	}
 	return nil
 }
@@ -256,29 +254,29 @@ func validateArgumentsOperatorRolesCreationByPrefix(r *rosa.Runtime, operatorRol
 	oidcEndpointUrl string, installerRoleArn string) {
 	if len(operatorRolesPrefix) == 0 {
 		r.Reporter.Errorf("Expected a prefix for the operator IAM roles")
-		os.Exit(1)
+		os.Exit(reporter.ExitCode())
 	}
 	if len(operatorRolesPrefix) > 32 {
 		r.Reporter.Errorf("Expected a prefix with no more than 32 characters")
-		os.Exit(1)
+		os.Exit(reporter.ExitCode())
 	}
 	if !aws.RoleNameRE.MatchString(operatorRolesPrefix) {
 		r.Reporter.Errorf("Expected valid operator roles prefix matching %s", aws.RoleNameRE.String())
-		os.Exit(1)
+		os.Exit(reporter.ExitCode())
 	}
 	parsedURI, err := url.ParseRequestURI(oidcEndpointUrl)
 	if err != nil {
 		r.Reporter.Errorf("%s", err)
-		os.Exit(1)
+		os.Exit(reporter.ExitCode())
 	}
 	if parsedURI.Scheme != helper.ProtocolHttps {
 		r.Reporter.Errorf("Expected OIDC endpoint URL '%s' to use an https:// scheme", oidcEndpointUrl)
-		os.Exit(1)
+		os.Exit(reporter.ExitCode())
 	}
 	err = aws.ARNValidator(installerRoleArn)
 	if err != nil {
 		r.Reporter.Errorf("%s", err)
-		os.Exit(1)
+		os.Exit(reporter.ExitCode())
 	}
 }
 
@@ -356,6 +354,7 @@ func createRolesByPrefix(r *rosa.Runtime, prefix string, permissionsBoundary str
 		tagsList := map[string]string{
 			tags.OperatorNamespace: operator.Namespace(),
 			tags.OperatorName:      operator.Name(),
+			tags.RolePrefix:        prefix,
 			tags.RedHatManaged:     helper.True,
 		}
 		if managedPolicies {
@@ -390,11 +389,12 @@ func buildCommandsFromPrefix(r *rosa.Runtime, env string,
 	managedPolicies bool, path string,
 	operatorIAMRoleList []*cmv1.OperatorIAMRole,
 	oidcEndpointUrl string, hostedCPPolicies bool, sharedVpcRoleArn string) (string, error) {
-	if !managedPolicies {
+	// Templates include the policy documents, so they don't need the files
+	if !managedPolicies && !iac.Enabled() {
 		err := aws.GenerateOperatorRolePolicyFiles(r.Reporter, policies, credRequests, sharedVpcRoleArn, r.Creator.Partition)
 		if err != nil {
 			r.Reporter.Errorf("There was an error generating the policy files: %s", err)
-			os.Exit(1)
+			os.Exit(reporter.ExitCode())
 		}
 	}
 
@@ -437,11 +437,22 @@ func buildCommandsFromPrefix(r *rosa.Runtime, env string,
 					AddParam(awscb.Path, path).
 					Build()
 				commands = append(commands, createPolicy)
+				if iac.Enabled() {
+					err = iac.Current().AddPolicy(name, path, aws.GetOperatorPolicyDocument(policies,
+						operatorPolicyKey, sharedVpcRoleArn, r.Creator.Partition), iamTags)
+					if err != nil {
+						return "", err
+					}
+				}
 			} else if isSharedVpc && credrequest == aws.IngressOperatorCloudCredentialsRoleType {
 				err := validateIngressOperatorPolicyOverride(r, policyARN, sharedVpcRoleArn, prefix)
 				if err != nil {
 					return "", err
 				}
+				if iac.Enabled() {
+					return "", fmt.Errorf("Updating the existing policy '%s' can't be expressed as a template, "+
+						"run the command without --%s instead", policyARN, iac.FlagName)
+				}
 
 				createPolicyVersion := awscb.NewIAMCommandBuilder().
 					SetCommand(awscb.CreatePolicyVersion).
@@ -462,14 +473,17 @@ func buildCommandsFromPrefix(r *rosa.Runtime, env string,
 
 		filename := fmt.Sprintf("operator_%s_policy", credrequest)
 		filename = aws.GetFormattedFileName(filename)
-		r.Reporter.Debugf("Saving '%s' to the current directory", filename)
-		err = helper.SaveDocument(policy, filename)
-		if err != nil {
-			return "", err
+		if !iac.Enabled() {
+			r.Reporter.Debugf("Saving '%s' to the current directory", filename)
+			err = helper.SaveDocument(policy, filename)
+			if err != nil {
+				return "", err
+			}
 		}
 		iamTags := map[string]string{
 			tags.OperatorNamespace: operator.Namespace(),
 			tags.OperatorName:      operator.Name(),
+			tags.RolePrefix:        prefix,
 			tags.RedHatManaged:     helper.True,
 		}
 		if managedPolicies {
@@ -493,6 +507,13 @@ func buildCommandsFromPrefix(r *rosa.Runtime, env string,
 			AddParam(awscb.PolicyArn, policyARN).
 			Build()
 		commands = append(commands, createRole, attachRolePolicy)
+		if iac.Enabled() {
+			err = iac.Current().AddRole(roleName, path, permissionsBoundary, policy, iamTags)
+			if err != nil {
+				return "", err
+			}
+			iac.Current().AddAttachment(roleName, policyARN)
+		}
 	}
 	return awscb.JoinCommands(commands), nil
 }
@@ -17,6 +17,7 @@ limitations under the License.
 package operatorroles
 
 import (
+	"fmt"
 	"os"
 
 	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
@@ -24,9 +25,11 @@ import (
 
 	"github.com/openshift/rosa/pkg/arguments"
 	"github.com/openshift/rosa/pkg/aws"
+	"github.com/openshift/rosa/pkg/aws/iac"
 	"github.com/openshift/rosa/pkg/interactive"
 	"github.com/openshift/rosa/pkg/interactive/confirm"
 	"github.com/openshift/rosa/pkg/ocm"
+	"github.com/openshift/rosa/pkg/reporter"
 	"github.com/openshift/rosa/pkg/rosa"
 )
 
@@ -57,7 +60,10 @@ var Cmd = &cobra.Command{
   rosa create operator-roles --cluster=mycluster
 
   # Create operator roles with a specific permissions boundary
-  rosa create operator-roles -c mycluster --permissions-boundary arn:aws:iam::123456789012:policy/perm-boundary`,
+  rosa create operator-roles -c mycluster --permissions-boundary arn:aws:iam::123456789012:policy/perm-boundary
+
+  # Generate a CloudFormation template for the operator roles instead of AWS CLI commands
+  rosa create operator-roles -c mycluster --mode manual --output-format cloudformation > operator-roles.json`,
 	Run:  run,
 	Args: cobra.MaximumNArgs(3),
 }
@@ -130,6 +136,7 @@ func init() {
 	flags.MarkHidden("channel-group")
 
 	interactive.AddModeFlag(Cmd)
+	iac.AddFlag(Cmd)
 	confirm.AddFlag(flags)
 	interactive.AddFlag(flags)
 }
@@ -154,13 +161,13 @@ func run(cmd *cobra.Command, argv []string) {
 	env, err := ocm.GetEnv()
 	if err != nil {
 		r.Reporter.Errorf("Failed to determine OCM environment: %v", err)
-		os.Exit(1)
+		os.Exit(reporter.ExitCode())
 	}
 
 	mode, err := interactive.GetMode()
 	if err != nil {
 		r.Reporter.Errorf("%s", err)
-		os.Exit(1)
+		os.Exit(reporter.ExitCode())
 	}
 
 	// Determine if interactive mode is needed
@@ -170,13 +177,13 @@ func run(cmd *cobra.Command, argv []string) {
 
 	if !cmd.Flag("cluster").Changed && !cmd.Flag(PrefixFlag).Changed && !isProgmaticallyCalled {
 		r.Reporter.Errorf("Either a cluster key for STS cluster or an operator roles prefix must be specified.")
-		os.Exit(1)
+		os.Exit(reporter.ExitCode())
 	}
 
 	if cmd.Flag("cluster").Changed && cmd.Flag(PrefixFlag).Changed {
 		r.Reporter.Errorf("A cluster key for STS cluster and an operator roles prefix " +
 			"cannot be specified alongside each other.")
-		os.Exit(1)
+		os.Exit(reporter.ExitCode())
 	}
 
 	var cluster *cmv1.Cluster
@@ -186,17 +193,23 @@ func run(cmd *cobra.Command, argv []string) {
 
 	if args.forcePolicyCreation && mode != interactive.ModeAuto {
 		r.Reporter.Warnf("Forcing creation of policies only works in auto mode")
-		os.Exit(1)
+		os.Exit(reporter.ExitCode())
 	}
 
 	if interactive.Enabled() && !isProgmaticallyCalled {
 		mode, err = interactive.GetOptionMode(cmd, mode, "Role creation mode")
 		if err != nil {
 			r.Reporter.Errorf("Expected a valid role creation mode: %s", err)
-			os.Exit(1)
+			os.Exit(reporter.ExitCode())
 		}
 	}
 
+	err = iac.Validate(mode)
+	if err != nil {
+		r.Reporter.Errorf("%s", err)
+		os.Exit(reporter.ExitCode())
+	}
+
 	if cluster == nil && interactive.Enabled() && !isProgmaticallyCalled {
 		handleOperatorRolesPrefixOptions(r, cmd)
 	}
@@ -213,7 +226,7 @@ func run(cmd *cobra.Command, argv []string) {
 		})
 		if err != nil {
 			r.Reporter.Errorf("Expected a valid policy ARN for permissions boundary: %s", err)
-			os.Exit(1)
+			os.Exit(reporter.ExitCode())
 		}
 	}
 
@@ -221,50 +234,50 @@ func run(cmd *cobra.Command, argv []string) {
 		err = aws.ARNValidator(permissionsBoundary)
 		if err != nil {
 			r.Reporter.Errorf("Expected a valid policy ARN for permissions boundary: %s", err)
-			os.Exit(1)
+			os.Exit(reporter.ExitCode())
 		}
 	}
 
 	policies, err := r.OCMClient.GetPolicies("OperatorRole")
 	if err != nil {
 		r.Reporter.Errorf("Expected a valid role creation mode: %s", err)
-		os.Exit(1)
+		os.Exit(reporter.ExitCode())
 	}
 
 	if args.prefix != "" {
 		if args.oidcConfigId == "" {
 			r.Reporter.Errorf("%s is mandatory for %s param flow.", OidcConfigIdFlag, PrefixFlag)
-			os.Exit(1)
+			os.Exit(reporter.ExitCode())
 		}
 
 		if args.installerRoleArn == "" {
 			r.Reporter.Errorf("%s is mandatory for %s param flow.", InstallerRoleArnFlag, PrefixFlag)
-			os.Exit(1)
+			os.Exit(reporter.ExitCode())
 		}
 		channelGroup := args.channelGroup
 		latestPolicyVersion, err := r.OCMClient.GetLatestVersion(channelGroup)
 		if err != nil {
 			r.Reporter.Errorf("Error getting latest version: %s", err)
-			os.Exit(1)
+			os.Exit(reporter.ExitCode())
 		}
 		err = handleOperatorRoleCreationByPrefix(r, env, permissionsBoundary,
 			mode, policies, latestPolicyVersion)
 		if err != nil {
 			r.Reporter.Errorf("Error creating operator roles: %s", err)
-			os.Exit(1)
+			os.Exit(reporter.ExitCode())
 		}
 		return
 	}
 	latestPolicyVersion, err := r.OCMClient.GetLatestVersion(cluster.Version().ChannelGroup())
 	if err != nil {
 		r.Reporter.Errorf("Error getting latest version: %s", err)
-		os.Exit(1)
+		os.Exit(reporter.ExitCode())
 	}
 	err = handleOperatorRoleCreationByClusterKey(r, env, permissionsBoundary,
 		mode, policies, latestPolicyVersion)
 	if err != nil {
 		r.Reporter.Errorf("Error creating operator roles: %s", err)
-		os.Exit(1)
+		os.Exit(reporter.ExitCode())
 	}
 }
 
@@ -280,3 +293,21 @@ func convertV1OperatorIAMRoleIntoOcmOperatorIamRole(
 	}
 	return operatorRolesList, nil
 }
+
+// printCommands prints the commands that create the operator roles, or the template with the same
+// resources when an output format is selected.
+func printCommands(r *rosa.Runtime, commands string) {
+	if iac.Enabled() {
+		err := iac.Print()
+		if err != nil {
+			r.Reporter.Errorf("%s", err)
+			os.Exit(reporter.ExitCode())
+		}
+		return
+	}
+	if r.Reporter.IsTerminal() {
+		r.Reporter.Infof("All policy files saved to the current directory")
+		r.Reporter.Infof("Run the following commands to create the operator roles:\n")
+	}
+	fmt.Println(commands)
+}
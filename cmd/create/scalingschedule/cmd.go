@@ -0,0 +1,168 @@
+/*
+Copyright (c) 2024 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scalingschedule
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+	"github.com/spf13/cobra"
+	errors "github.com/zgalor/weberr"
+
+	"github.com/openshift/rosa/pkg/ocm"
+	"github.com/openshift/rosa/pkg/rosa"
+)
+
+const (
+	use   = "scaling-schedule"
+	short = "Create a scaling schedule for a machine pool"
+	long  = "Create a named schedule that sets the replicas of a machine pool every time a cron expression " +
+		"is due. Cron expressions are evaluated in UTC unless they start with 'CRON_TZ=<zone>'. Schedules " +
+		"are stored with the cluster and applied by 'rosa scaling-schedule run', which can be invoked by any " +
+		"scheduler as often as needed."
+	example = `  # Scale machine pool 'workers' of cluster 'mycluster' down to 0 replicas every weekday at 20:00 UTC
+  # and back up to 3 replicas at 06:00 UTC
+  rosa create scaling-schedule --cluster=mycluster --name=nightly-down --machinepool=workers \
+    --cron="0 20 * * 1-5" --replicas=0
+  rosa create scaling-schedule --cluster=mycluster --name=morning-up --machinepool=workers \
+    --cron="0 6 * * 1-5" --replicas=3`
+)
+
+// now returns the current time, it is replaced in tests.
+var now = time.Now
+
+var args struct {
+	name        string
+	machinePool string
+	cron        string
+	replicas    int
+}
+
+func NewCreateScalingScheduleCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     use,
+		Aliases: []string{"scalingschedule", "scaling-schedules", "scalingschedules"},
+		Short:   short,
+		Long:    long,
+		Example: example,
+		Args:    cobra.NoArgs,
+		Run:     rosa.DefaultRunner(rosa.RuntimeWithOCM(), CreateScalingScheduleRunner()),
+	}
+
+	flags := cmd.Flags()
+	flags.StringVar(&args.name, "name", "", "Name of the scaling schedule.")
+	flags.StringVar(&args.machinePool, "machinepool", "", "Machine pool whose replicas are set by the schedule.")
+	flags.StringVar(&args.cron, "cron", "", "Cron expression, in UTC unless it starts with 'CRON_TZ=<zone>', "+
+		"of the times when the replicas are set.")
+	flags.IntVar(&args.replicas, "replicas", 0, "Number of replicas to set on the machine pool.")
+	ocm.AddClusterFlag(cmd)
+	return cmd
+}
+
+func CreateScalingScheduleRunner() rosa.CommandRunner {
+	return func(_ context.Context, r *rosa.Runtime, cmd *cobra.Command, _ []string) error {
+		for _, flag := range []string{"name", "machinepool", "cron", "replicas"} {
+			if !cmd.Flags().Changed(flag) {
+				return errors.BadRequest.Errorf("Flag '--%s' is required", flag)
+			}
+		}
+		err := ocm.ValidateScalingScheduleName(args.name)
+		if err != nil {
+			return err
+		}
+		_, err = ocm.ParseScalingScheduleCron(args.cron)
+		if err != nil {
+			return err
+		}
+		if args.replicas < 0 {
+			return errors.BadRequest.Errorf("The number of replicas must be a non-negative integer")
+		}
+
+		clusterKey := r.GetClusterKey()
+		cluster, err := r.OCMClient.GetCluster(clusterKey, r.Creator)
+		if err != nil {
+			return err
+		}
+		err = validateMachinePool(r, cluster)
+		if err != nil {
+			return err
+		}
+
+		schedules, err := r.OCMClient.GetScalingSchedules(cluster)
+		if err != nil {
+			return fmt.Errorf("Failed to get scaling schedules for cluster '%s': %v", clusterKey, err)
+		}
+		for _, schedule := range schedules {
+			if schedule.Name == args.name {
+				return errors.BadRequest.Errorf("Scaling schedule '%s' already exists on cluster '%s'",
+					args.name, clusterKey)
+			}
+		}
+
+		err = r.OCMClient.CreateScalingSchedule(cluster, &ocm.ScalingSchedule{
+			Name:        args.name,
+			MachinePool: args.machinePool,
+			Cron:        args.cron,
+			Replicas:    args.replicas,
+			CreatedAt:   now().UTC().Truncate(time.Second),
+		})
+		if err != nil {
+			return fmt.Errorf("Failed to create scaling schedule '%s' on cluster '%s': %v",
+				args.name, clusterKey, err)
+		}
+		r.Reporter.Infof("Scaling schedule '%s' created on cluster '%s'", args.name, clusterKey)
+		return nil
+	}
+}
+
+func validateMachinePool(r *rosa.Runtime, cluster *cmv1.Cluster) error {
+	if cluster.Hypershift().Enabled() {
+		nodePool, exists, err := r.OCMClient.GetNodePool(cluster.ID(), args.machinePool)
+		if err != nil {
+			return err
+		}
+		if !exists {
+			return errors.NotFound.Errorf("Machine pool '%s' does not exist for hosted cluster '%s'",
+				args.machinePool, r.ClusterKey)
+		}
+		if nodePool.Autoscaling() != nil {
+			return errors.BadRequest.Errorf("Machine pool '%s' has autoscaling enabled, its replicas "+
+				"can't be scheduled", args.machinePool)
+		}
+		return nil
+	}
+
+	machinePool, exists, err := r.OCMClient.GetMachinePool(cluster.ID(), args.machinePool)
+	if err != nil {
+		return err
+	}
+	if !exists {
+		return errors.NotFound.Errorf("Machine pool '%s' does not exist for cluster '%s'",
+			args.machinePool, r.ClusterKey)
+	}
+	if machinePool.Autoscaling() != nil {
+		return errors.BadRequest.Errorf("Machine pool '%s' has autoscaling enabled, its replicas "+
+			"can't be scheduled", args.machinePool)
+	}
+	if cluster.MultiAZ() && len(machinePool.AvailabilityZones()) != 1 && args.replicas%3 != 0 {
+		return errors.BadRequest.Errorf("Multi AZ machine pools require that the number of replicas be " +
+			"a multiple of 3")
+	}
+	return nil
+}
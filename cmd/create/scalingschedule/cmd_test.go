@@ -0,0 +1,110 @@
+/*
+Copyright (c) 2024 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scalingschedule
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/ghttp"
+	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+	. "github.com/openshift-online/ocm-sdk-go/testing"
+	"github.com/spf13/cobra"
+
+	"github.com/openshift/rosa/pkg/rosa"
+	"github.com/openshift/rosa/pkg/test"
+)
+
+func TestCreateScalingSchedule(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "rosa create scaling-schedule")
+}
+
+var _ = Describe("rosa create scaling-schedule", func() {
+	var testRuntime test.TestingRuntime
+	var cmd *cobra.Command
+
+	BeforeEach(func() {
+		cmd = NewCreateScalingScheduleCommand()
+		testRuntime.InitRuntime()
+		now = func() time.Time { return time.Date(2024, 5, 6, 12, 0, 0, 0, time.UTC) }
+		DeferCleanup(func() { now = time.Now })
+	})
+
+	run := func(args ...string) (string, error) {
+		Expect(cmd.ParseFlags(args)).To(Succeed())
+		stdout, _, err := test.RunWithOutputCapture(func(r *rosa.Runtime, cmd *cobra.Command) error {
+			return CreateScalingScheduleRunner()(context.Background(), r, cmd, nil)
+		}, testRuntime.RosaRuntime, cmd)
+		return stdout, err
+	}
+
+	mockCluster := test.FormatClusterList([]*cmv1.Cluster{test.MockCluster(func(c *cmv1.ClusterBuilder) {
+		c.Subscription(cmv1.NewSubscription().ID("sub-1"))
+	})})
+
+	It("Requires all the flags", func() {
+		_, err := run("--name=nightly", "--machinepool=workers", "--cron=0 20 * * *")
+		Expect(err).To(MatchError("Flag '--replicas' is required"))
+	})
+
+	It("Rejects invalid cron expressions", func() {
+		_, err := run("--name=nightly", "--machinepool=workers", "--cron=0 20 * *", "--replicas=0")
+		Expect(err).To(MatchError(ContainSubstring("Invalid cron expression")))
+	})
+
+	It("Rejects machine pools with autoscaling", func() {
+		machinePool, err := cmv1.NewMachinePool().ID("workers").
+			Autoscaling(cmv1.NewMachinePoolAutoscaling().MinReplicas(1).MaxReplicas(3)).Build()
+		Expect(err).NotTo(HaveOccurred())
+		testRuntime.ApiServer.AppendHandlers(
+			RespondWithJSON(http.StatusOK, mockCluster),
+			RespondWithJSON(http.StatusOK, test.FormatResource(machinePool)),
+		)
+
+		_, err = run("--name=nightly", "--machinepool=workers", "--cron=0 20 * * *", "--replicas=0")
+		Expect(err).To(MatchError("Machine pool 'workers' has autoscaling enabled, its replicas can't be scheduled"))
+	})
+
+	It("Stores the schedule as a label of the subscription of the cluster", func() {
+		machinePool, err := cmv1.NewMachinePool().ID("workers").Replicas(2).Build()
+		Expect(err).NotTo(HaveOccurred())
+		testRuntime.ApiServer.AppendHandlers(
+			RespondWithJSON(http.StatusOK, mockCluster),
+			RespondWithJSON(http.StatusOK, test.FormatResource(machinePool)),
+			RespondWithJSON(http.StatusOK, test.FormatLabelList(nil)),
+			ghttp.CombineHandlers(
+				ghttp.VerifyRequest(http.MethodPost, "/api/accounts_mgmt/v1/subscriptions/sub-1/labels"),
+				ghttp.VerifyJSON(`{
+					"kind": "Label",
+					"key": "rosa_scaling_schedule_nightly",
+					"value": "{\"name\":\"nightly\",\"machine_pool\":\"workers\",\"cron\":\"0 20 * * *\",`+
+					`\"replicas\":0,\"created_at\":\"2024-05-06T12:00:00Z\"}"
+				}`),
+				RespondWithJSON(http.StatusCreated, "{}"),
+			),
+		)
+
+		stdout, err := run("--name=nightly", "--machinepool=workers", "--cron=0 20 * * *", "--replicas=0")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(stdout).To(ContainSubstring("Scaling schedule 'nightly' created on cluster 'cluster1'"))
+	})
+})
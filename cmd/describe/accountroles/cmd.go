@@ -0,0 +1,335 @@
+/*
+Copyright (c) 2024 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package accountroles
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"os"
+	"sort"
+	"strings"
+	"text/tabwriter"
+
+	awssdk "github.com/aws/aws-sdk-go-v2/aws"
+	iamtypes "github.com/aws/aws-sdk-go-v2/service/iam/types"
+	common "github.com/openshift-online/ocm-common/pkg/aws/validations"
+	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+	"github.com/spf13/cobra"
+	errors "github.com/zgalor/weberr"
+
+	"github.com/openshift/rosa/pkg/aws"
+	"github.com/openshift/rosa/pkg/output"
+	"github.com/openshift/rosa/pkg/rosa"
+)
+
+const (
+	use   = "account-roles"
+	short = "Show details of the account roles with a prefix"
+	long  = "Show details of the account roles with a prefix, and the policies attached to them.\n\n" +
+		"With '--permissions' the actions allowed by the policies are listed, with the wildcards " +
+		"expanded using a catalog of IAM actions bundled with rosa, grouped by service and classified " +
+		"as read, write, delete or pass-role actions. For roles that don't use policies managed by AWS " +
+		"the actions allowed by the permission policy that ROSA attached to the role are also compared " +
+		"with the policy of the current version, to show the changes that 'rosa upgrade account-roles' " +
+		"would apply. Policies attached by the user aren't changed by an upgrade, so they aren't compared."
+	example = `  # Describe the account roles with prefix 'myprefix'
+  rosa describe account-roles --prefix myprefix
+
+  # Review the permissions of the Hosted Control Plane account roles with prefix 'myprefix'
+  rosa describe account-roles --prefix myprefix --hosted-cp --permissions`
+
+	prefixFlag      = "prefix"
+	hostedCPFlag    = "hosted-cp"
+	permissionsFlag = "permissions"
+)
+
+var args struct {
+	prefix      string
+	hostedCP    bool
+	permissions bool
+}
+
+func NewDescribeAccountRolesCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     use,
+		Aliases: []string{"accountroles", "account-role", "accountrole"},
+		Short:   short,
+		Long:    long,
+		Example: example,
+		Args:    cobra.NoArgs,
+		Run:     rosa.DefaultRunner(rosa.RuntimeWithOCMAndAWS(), DescribeAccountRolesRunner()),
+	}
+
+	flags := cmd.Flags()
+	flags.SortFlags = false
+	flags.StringVar(
+		&args.prefix,
+		prefixFlag,
+		"",
+		"Prefix of the account roles to describe.",
+	)
+	flags.BoolVar(
+		&args.hostedCP,
+		hostedCPFlag,
+		false,
+		"Describe the Hosted Control Plane account roles with the prefix.",
+	)
+	flags.BoolVar(
+		&args.permissions,
+		permissionsFlag,
+		false,
+		"List the actions allowed by the policies of the roles, and the changes that an upgrade would apply.",
+	)
+	output.AddFlag(cmd)
+	return cmd
+}
+
+// AccountRole is the description of an account role. The permissions are only set with '--permissions'.
+type AccountRole struct {
+	Name            string           `json:"name"`
+	ARN             string           `json:"arn"`
+	Version         string           `json:"version,omitempty"`
+	ManagedPolicies bool             `json:"managed_policies"`
+	Policies        []string         `json:"policies"`
+	Permissions     []aws.Permission `json:"permissions,omitempty"`
+	Upgrade         *PermissionsDiff `json:"upgrade,omitempty"`
+
+	// policyPermissions are the actions allowed by the permission policy that ROSA attached to the role,
+	// without the ones allowed by other policies.
+	policyPermissions []aws.Permission
+}
+
+// PermissionsDiff contains the permissions that the permission policy of the current version adds to the
+// policy that ROSA attached to a role, and the ones that it removes.
+type PermissionsDiff struct {
+	Added   []aws.Permission `json:"added"`
+	Removed []aws.Permission `json:"removed"`
+}
+
+func DescribeAccountRolesRunner() rosa.CommandRunner {
+	return func(_ context.Context, r *rosa.Runtime, _ *cobra.Command, _ []string) error {
+		if args.prefix == "" {
+			return errors.BadRequest.Errorf("The '--%s' flag is required", prefixFlag)
+		}
+
+		accountRoles := aws.AccountRoles
+		if args.hostedCP {
+			accountRoles = aws.HCPAccountRoles
+		}
+		var policies map[string]*cmv1.AWSSTSPolicy
+		if args.permissions && !args.hostedCP {
+			var err error
+			policies, err = r.OCMClient.GetPolicies("")
+			if err != nil {
+				return fmt.Errorf("Failed to get the policies of the current version: %v", err)
+			}
+		}
+
+		roles := []*AccountRole{}
+		for _, file := range aws.GetSortedRoleFiles(accountRoles) {
+			roleARN, err := r.AWSClient.GetAccountRoleARN(args.prefix, accountRoles[file].Name)
+			if err != nil {
+				if errors.GetType(err) == errors.NotFound {
+					continue
+				}
+				return err
+			}
+			role, err := describeRole(r, roleARN, args.permissions)
+			if err != nil {
+				return fmt.Errorf("Failed to describe role '%s': %v", roleARN, err)
+			}
+			if policies != nil && !role.ManagedPolicies {
+				role.Upgrade, err = diffUpgrade(role.policyPermissions,
+					aws.GetPolicyDetails(policies, fmt.Sprintf("sts_%s_permission_policy", file)))
+				if err != nil {
+					return fmt.Errorf("Failed to compare the policies of role '%s': %v", roleARN, err)
+				}
+			}
+			roles = append(roles, role)
+		}
+		if len(roles) == 0 {
+			return errors.NotFound.Errorf("There are no account roles with prefix '%s'", args.prefix)
+		}
+
+		if output.HasFlag() {
+			return output.Print(roles)
+		}
+		for _, role := range roles {
+			printRole(role)
+		}
+		return nil
+	}
+}
+
+// describeRole returns the description of the role and, when requested, the actions allowed by its
+// attached and inline policies.
+func describeRole(r *rosa.Runtime, roleARN string, permissions bool) (*AccountRole, error) {
+	iamRole, err := r.AWSClient.GetRoleByARN(roleARN)
+	if err != nil {
+		return nil, err
+	}
+	role := &AccountRole{
+		Name:            awssdk.ToString(iamRole.RoleName),
+		ARN:             roleARN,
+		Version:         getTag(iamRole.Tags, common.OpenShiftVersion),
+		ManagedPolicies: common.IsManagedRole(iamRole.Tags),
+		Policies:        []string{},
+	}
+
+	attachedPolicies, err := r.AWSClient.GetAttachedPolicy(iamRole.RoleName)
+	if err != nil {
+		return nil, err
+	}
+	document := &aws.PolicyDocument{}
+	for _, policy := range attachedPolicies {
+		var policyDocument string
+		if policy.PolicyType == aws.Inline {
+			role.Policies = append(role.Policies, fmt.Sprintf("%s (inline)", policy.PolicyName))
+			if !permissions {
+				continue
+			}
+			inlinePolicy, err := r.AWSClient.IsRolePolicyExists(role.Name, policy.PolicyName)
+			if err != nil {
+				return nil, err
+			}
+			policyDocument, err = url.QueryUnescape(awssdk.ToString(inlinePolicy.PolicyDocument))
+			if err != nil {
+				return nil, err
+			}
+		} else {
+			role.Policies = append(role.Policies, policy.PolicyArn)
+			if !permissions {
+				continue
+			}
+			policyDocument, err = r.AWSClient.GetDefaultPolicyDocument(policy.PolicyArn)
+			if err != nil {
+				return nil, err
+			}
+		}
+		parsed, err := aws.ParsePolicyDocument(policyDocument)
+		if err != nil {
+			return nil, fmt.Errorf("Failed to parse the document of policy '%s': %v", policy.PolicyName, err)
+		}
+		document.Statement = append(document.Statement, parsed.Statement...)
+		if policy.PolicyType == aws.Attached && policy.PolicyName == aws.GetPolicyName(role.Name) {
+			role.policyPermissions = parsed.GetPermissions()
+		}
+	}
+	if permissions {
+		role.Permissions = document.GetPermissions()
+	}
+	return role, nil
+}
+
+// diffUpgrade compares the permissions allowed by the policy that ROSA attached to a role with the ones
+// allowed by the policy of the current version. Permissions granted by other policies aren't changed by an
+// upgrade, so they aren't compared.
+func diffUpgrade(permissions []aws.Permission, expectedPolicy string) (*PermissionsDiff, error) {
+	if expectedPolicy == "" {
+		return nil, nil
+	}
+	expected, err := aws.ParsePolicyDocument(expectedPolicy)
+	if err != nil {
+		return nil, err
+	}
+	added, removed := aws.DiffPermissions(permissions, expected.GetPermissions())
+	return &PermissionsDiff{Added: added, Removed: removed}, nil
+}
+
+func printRole(role *AccountRole) {
+	managedPolicies := "No"
+	if role.ManagedPolicies {
+		managedPolicies = "Yes"
+	}
+	fmt.Printf("\n"+
+		"Name:                       %s\n"+
+		"ARN:                        %s\n"+
+		"Version:                    %s\n"+
+		"Managed policies:           %s\n"+
+		"Policies:\n",
+		role.Name,
+		role.ARN,
+		role.Version,
+		managedPolicies,
+	)
+	for _, policy := range role.Policies {
+		fmt.Printf(" - %s\n", policy)
+	}
+	if len(role.Permissions) > 0 {
+		fmt.Print("Permissions:\n")
+		printServices(role.Permissions)
+		fmt.Print("Write, delete and pass-role actions:\n")
+		for _, permission := range role.Permissions {
+			if permission.Class != aws.PermissionRead {
+				fmt.Printf(" - %s (%s)\n", permission.Action, permission.Class)
+			}
+		}
+	}
+	if role.Upgrade != nil {
+		fmt.Print("Changes applied by 'rosa upgrade account-roles':\n")
+		if len(role.Upgrade.Added) == 0 && len(role.Upgrade.Removed) == 0 {
+			fmt.Print(" None\n")
+		}
+		for _, permission := range role.Upgrade.Added {
+			fmt.Printf(" + %s (%s)\n", permission.Action, permission.Class)
+		}
+		for _, permission := range role.Upgrade.Removed {
+			fmt.Printf(" - %s (%s)\n", permission.Action, permission.Class)
+		}
+	}
+}
+
+// printServices prints the number of actions allowed in each service, and how many of them aren't read
+// actions.
+func printServices(permissions []aws.Permission) {
+	services := []string{}
+	total := map[string]int{}
+	classes := map[string]map[string]int{}
+	for _, permission := range permissions {
+		service := permission.Service()
+		if classes[service] == nil {
+			services = append(services, service)
+			classes[service] = map[string]int{}
+		}
+		total[service]++
+		classes[service][permission.Class]++
+	}
+	sort.Strings(services)
+
+	writer := tabwriter.NewWriter(os.Stdout, 0, 4, 2, ' ', 0)
+	fmt.Fprintf(writer, " SERVICE\tACTIONS\tWRITE\tDELETE\tPASS-ROLE\n")
+	for _, service := range services {
+		fmt.Fprintf(writer, " %s\t%d\t%d\t%d\t%d\n",
+			service,
+			total[service],
+			classes[service][aws.PermissionWrite],
+			classes[service][aws.PermissionDelete],
+			classes[service][aws.PermissionPassRole],
+		)
+	}
+	writer.Flush()
+}
+
+func getTag(tags []iamtypes.Tag, key string) string {
+	for _, tag := range tags {
+		if strings.EqualFold(awssdk.ToString(tag.Key), key) {
+			return awssdk.ToString(tag.Value)
+		}
+	}
+	return ""
+}
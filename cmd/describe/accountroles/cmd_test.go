@@ -0,0 +1,166 @@
+/*
+Copyright (c) 2024 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package accountroles
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	awssdk "github.com/aws/aws-sdk-go-v2/aws"
+	iamtypes "github.com/aws/aws-sdk-go-v2/service/iam/types"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	common "github.com/openshift-online/ocm-common/pkg/aws/validations"
+	. "github.com/openshift-online/ocm-sdk-go/testing"
+	"github.com/spf13/cobra"
+	errors "github.com/zgalor/weberr"
+	"go.uber.org/mock/gomock"
+
+	"github.com/openshift/rosa/pkg/aws"
+	"github.com/openshift/rosa/pkg/rosa"
+	. "github.com/openshift/rosa/pkg/test"
+)
+
+func TestDescribeAccountRoles(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "rosa describe account-roles")
+}
+
+const (
+	installerRoleARN = "arn:aws:iam::123:role/team-Installer-Role"
+	installerPolicy  = "arn:aws:iam::123:policy/team-Installer-Role-Policy"
+	customPolicy     = "arn:aws:iam::123:policy/custom"
+
+	permissionPolicy = `{"Version": "2012-10-17", "Statement": [{"Effect": "Allow", ` +
+		`"Action": ["ec2:describeinstances", "ec2:TerminateInstances", "iam:Pass*"], "Resource": "*"}]}`
+	expectedPolicy = `{"Version": "2012-10-17", "Statement": [{"Effect": "Allow", ` +
+		`"Action": ["ec2:DescribeInstances", "ec2:RunInstances", "iam:PassRole"], "Resource": "*"}]}`
+	customPolicyDocument = `{"Version": "2012-10-17", "Statement": [{"Effect": "Allow", ` +
+		`"Action": "s3:GetObject", "Resource": "*"}]}`
+)
+
+var _ = Describe("rosa describe account-roles", func() {
+	var t *TestingRuntime
+	var awsClient *aws.MockClient
+	var cmd *cobra.Command
+
+	BeforeEach(func() {
+		cmd = NewDescribeAccountRolesCommand()
+		t = NewTestRuntime()
+		awsClient = aws.NewMockClient(gomock.NewController(GinkgoT()))
+		t.RosaRuntime.AWSClient = awsClient
+	})
+
+	run := func() (string, error) {
+		stdout, _, err := RunWithOutputCapture(func(r *rosa.Runtime, cmd *cobra.Command) error {
+			return DescribeAccountRolesRunner()(context.Background(), r, cmd, nil)
+		}, t.RosaRuntime, cmd)
+		return stdout, err
+	}
+
+	Context("Validation", func() {
+		It("Fails without a prefix", func() {
+			_, err := run()
+			Expect(err).To(MatchError("The '--prefix' flag is required"))
+			Expect(errors.GetType(err)).To(Equal(errors.BadRequest))
+		})
+
+		It("Fails when the expected policies can't be loaded", func() {
+			Expect(cmd.Flags().Set(prefixFlag, "team")).To(Succeed())
+			Expect(cmd.Flags().Set(permissionsFlag, "true")).To(Succeed())
+			t.ApiServer.AppendHandlers(RespondWithJSON(http.StatusBadRequest,
+				`{"kind": "Error", "reason": "Invalid search"}`))
+			_, err := run()
+			Expect(err).To(MatchError(ContainSubstring("Failed to get the policies of the current version: ")))
+		})
+
+		It("Reports a prefix without account roles as not found", func() {
+			Expect(cmd.Flags().Set(prefixFlag, "team")).To(Succeed())
+			awsClient.EXPECT().GetAccountRoleARN("team", gomock.Any()).Return("",
+				errors.NotFound.Errorf("Role with the prefix 'team' not found")).Times(4)
+			_, err := run()
+			Expect(err).To(MatchError("There are no account roles with prefix 'team'"))
+			Expect(errors.GetType(err)).To(Equal(errors.NotFound))
+		})
+	})
+
+	Context("Runner", func() {
+		BeforeEach(func() {
+			Expect(cmd.Flags().Set(prefixFlag, "team")).To(Succeed())
+			for _, name := range []string{"ControlPlane", "Support", "Worker"} {
+				awsClient.EXPECT().GetAccountRoleARN("team", name).Return("",
+					errors.NotFound.Errorf("Role with the prefix 'team' not found amongst Classic cluster roles"))
+			}
+			awsClient.EXPECT().GetAccountRoleARN("team", "Installer").Return(installerRoleARN, nil)
+			awsClient.EXPECT().GetRoleByARN(installerRoleARN).Return(iamtypes.Role{
+				RoleName: awssdk.String("team-Installer-Role"),
+				Tags: []iamtypes.Tag{
+					{Key: awssdk.String(common.OpenShiftVersion), Value: awssdk.String("4.14")},
+				},
+			}, nil)
+			awsClient.EXPECT().GetAttachedPolicy(awssdk.String("team-Installer-Role")).Return([]aws.PolicyDetail{
+				{PolicyName: "team-Installer-Role-Policy", PolicyArn: installerPolicy, PolicyType: aws.Attached},
+				{PolicyName: "custom", PolicyArn: customPolicy, PolicyType: aws.Attached},
+			}, nil)
+		})
+
+		It("Describes the roles with the prefix", func() {
+			stdout, err := run()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(stdout).To(Equal("\n" +
+				"Name:                       team-Installer-Role\n" +
+				"ARN:                        arn:aws:iam::123:role/team-Installer-Role\n" +
+				"Version:                    4.14\n" +
+				"Managed policies:           No\n" +
+				"Policies:\n" +
+				" - arn:aws:iam::123:policy/team-Installer-Role-Policy\n" +
+				" - arn:aws:iam::123:policy/custom\n"))
+		})
+
+		It("Lists the permissions and the changes of an upgrade", func() {
+			Expect(cmd.Flags().Set(permissionsFlag, "true")).To(Succeed())
+			t.ApiServer.AppendHandlers(RespondWithJSON(http.StatusOK, fmt.Sprintf(`{
+				"kind": "STSPolicyList",
+				"page": 1,
+				"size": 1,
+				"total": 1,
+				"items": [{"id": "sts_installer_permission_policy", "details": %q}]
+			}`, expectedPolicy)))
+			awsClient.EXPECT().GetDefaultPolicyDocument(installerPolicy).Return(permissionPolicy, nil)
+			awsClient.EXPECT().GetDefaultPolicyDocument(customPolicy).Return(customPolicyDocument, nil)
+
+			stdout, err := run()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(stdout).To(HaveSuffix("Policies:\n" +
+				" - arn:aws:iam::123:policy/team-Installer-Role-Policy\n" +
+				" - arn:aws:iam::123:policy/custom\n" +
+				"Permissions:\n" +
+				" SERVICE  ACTIONS  WRITE  DELETE  PASS-ROLE\n" +
+				" ec2      2        0      1       0\n" +
+				" iam      1        0      0       1\n" +
+				" s3       1        0      0       0\n" +
+				"Write, delete and pass-role actions:\n" +
+				" - ec2:TerminateInstances (delete)\n" +
+				" - iam:PassRole (pass-role)\n" +
+				"Changes applied by 'rosa upgrade account-roles':\n" +
+				" + ec2:RunInstances (write)\n" +
+				" - ec2:TerminateInstances (delete)\n"))
+		})
+	})
+})
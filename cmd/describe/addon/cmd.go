@@ -25,6 +25,7 @@ import (
 	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
 	"github.com/spf13/cobra"
 
+	"github.com/openshift/rosa/pkg/reporter"
 	"github.com/openshift/rosa/pkg/rosa"
 )
 
@@ -57,7 +58,7 @@ func run(_ *cobra.Command, argv []string) {
 		r.Reporter.Errorf("Failed to get add-on '%s': %s\n"+
 			"Try running 'rosa list addons' to see all available add-ons.",
 			addOnID, err)
-		os.Exit(1)
+		os.Exit(reporter.ExitCode())
 	}
 
 	printDescription(addOn)
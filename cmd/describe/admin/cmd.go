@@ -24,6 +24,7 @@ import (
 
 	cadmin "github.com/openshift/rosa/cmd/create/admin"
 	"github.com/openshift/rosa/pkg/ocm"
+	"github.com/openshift/rosa/pkg/reporter"
 	"github.com/openshift/rosa/pkg/rosa"
 )
 
@@ -50,14 +51,14 @@ func run(_ *cobra.Command, _ []string) {
 	cluster := r.FetchCluster()
 	if cluster.State() != cmv1.ClusterStateReady {
 		r.Reporter.Errorf("Cluster '%s' is not yet ready", clusterKey)
-		os.Exit(1)
+		os.Exit(reporter.ExitCode())
 	}
 
 	if cluster.ExternalAuthConfig().Enabled() {
 		r.Reporter.Errorf(
 			"Describing the 'cluster-admin' user is not supported for clusters with external authentication configured.",
 		)
-		os.Exit(1)
+		os.Exit(reporter.ExitCode())
 	}
 
 	// Try to find an existing htpasswd identity provider and
@@ -65,7 +66,7 @@ func run(_ *cobra.Command, _ []string) {
 	existingClusterAdminIdp, _, err := cadmin.FindIDPWithAdmin(cluster, r)
 	if err != nil {
 		r.Reporter.Errorf(err.Error())
-		os.Exit(1)
+		os.Exit(reporter.ExitCode())
 	}
 	if existingClusterAdminIdp != nil {
 		r.Reporter.Infof("There is '%s' user on cluster '%s'. To login, run the following command:\n"+
@@ -30,6 +30,7 @@ import (
 
 	"github.com/openshift/rosa/pkg/ocm"
 	"github.com/openshift/rosa/pkg/output"
+	"github.com/openshift/rosa/pkg/reporter"
 	"github.com/openshift/rosa/pkg/rosa"
 )
 
@@ -90,19 +91,19 @@ func run(cmd *cobra.Command, argv []string) {
 		scheduledUpgrade, upgradeState, err = r.OCMClient.GetScheduledUpgrade(cluster.ID())
 		if err != nil {
 			r.Reporter.Errorf("Failed to get scheduled upgrades for cluster '%s': %v", clusterKey, err)
-			os.Exit(1)
+			os.Exit(reporter.ExitCode())
 		}
 
 		if output.HasFlag() {
 			f, err := formatCluster(cluster, scheduledUpgrade, upgradeState, displayName)
 			if err != nil {
 				r.Reporter.Errorf("%s", err)
-				os.Exit(1)
+				os.Exit(reporter.ExitCode())
 			}
 			err = output.Print(f)
 			if err != nil {
 				r.Reporter.Errorf("%s", err)
-				os.Exit(1)
+				os.Exit(reporter.ExitCode())
 			}
 			return
 		}
@@ -110,19 +111,19 @@ func run(cmd *cobra.Command, argv []string) {
 		controlPlaneScheduledUpgrade, err = r.OCMClient.GetControlPlaneScheduledUpgrade(cluster.ID())
 		if err != nil {
 			r.Reporter.Errorf("Failed to get scheduled upgrades for cluster '%s': %v", clusterKey, err)
-			os.Exit(1)
+			os.Exit(reporter.ExitCode())
 		}
 
 		if output.HasFlag() {
 			f, err := formatClusterHypershift(cluster, controlPlaneScheduledUpgrade, displayName)
 			if err != nil {
 				r.Reporter.Errorf("%s", err)
-				os.Exit(1)
+				os.Exit(reporter.ExitCode())
 			}
 			err = output.Print(f)
 			if err != nil {
 				r.Reporter.Errorf("%s", err)
-				os.Exit(1)
+				os.Exit(reporter.ExitCode())
 			}
 			return
 		}
@@ -132,7 +133,7 @@ func run(cmd *cobra.Command, argv []string) {
 	creatorARN, err := arn.Parse(cluster.Properties()[ocmConsts.CreatorArn])
 	if err != nil {
 		r.Reporter.Errorf("Failed to parse creator ARN for cluster '%s'", clusterKey)
-		os.Exit(1)
+		os.Exit(reporter.ExitCode())
 	}
 	phase := ""
 
@@ -197,7 +198,7 @@ func run(cmd *cobra.Command, argv []string) {
 	}
 	if err != nil {
 		r.Reporter.Errorf("Failed to get machine pools for cluster '%s': %v", clusterKey, err)
-		os.Exit(1)
+		os.Exit(reporter.ExitCode())
 	}
 
 	// Print short cluster description:
@@ -428,7 +429,7 @@ func run(cmd *cobra.Command, argv []string) {
 	limitedSupportReasons, err := r.OCMClient.GetLimitedSupportReasons(cluster.ID())
 	if err != nil {
 		r.Reporter.Errorf("Failed to get limited support reasons for cluster '%s': %v", cluster.ID(), err)
-		os.Exit(1)
+		os.Exit(reporter.ExitCode())
 	}
 	if len(limitedSupportReasons) > 0 {
 		str = fmt.Sprintf("%s"+"Limited Support:\n", str)
@@ -443,7 +444,7 @@ func run(cmd *cobra.Command, argv []string) {
 	inflightChecks, err := r.OCMClient.GetInflightChecks(cluster.ID())
 	if err != nil {
 		r.Reporter.Errorf("Failed to get inflight checks for cluster '%s': %v", cluster.ID(), err)
-		os.Exit(1)
+		os.Exit(reporter.ExitCode())
 	}
 	if len(inflightChecks) > 0 {
 		summaries := []string{}
@@ -19,6 +19,7 @@ package describe
 import (
 	"github.com/spf13/cobra"
 
+	"github.com/openshift/rosa/cmd/describe/accountroles"
 	"github.com/openshift/rosa/cmd/describe/addon"
 	"github.com/openshift/rosa/cmd/describe/admin"
 	"github.com/openshift/rosa/cmd/describe/autoscaler"
@@ -54,6 +55,8 @@ func init() {
 	Cmd.AddCommand(autoscaler.NewDescribeAutoscalerCommand())
 	Cmd.AddCommand(externalauthprovider.Cmd)
 	Cmd.AddCommand(breakglasscredential.Cmd)
+	accountRolesCommand := accountroles.NewDescribeAccountRolesCommand()
+	Cmd.AddCommand(accountRolesCommand)
 
 	flags := Cmd.PersistentFlags()
 	arguments.AddProfileFlag(flags)
@@ -65,6 +68,7 @@ func init() {
 		admin.Cmd, breakglasscredential.Cmd,
 		externalauthprovider.Cmd, installation.Cmd,
 		kubeletconfig.Cmd, machinepool.Cmd, upgrade.Cmd,
+		accountRolesCommand,
 	}
 	arguments.MarkRegionDeprecated(Cmd, globallyAvailableCommands)
 }
@@ -24,6 +24,7 @@ import (
 	"github.com/spf13/cobra"
 
 	"github.com/openshift/rosa/pkg/ocm"
+	"github.com/openshift/rosa/pkg/reporter"
 	"github.com/openshift/rosa/pkg/rosa"
 )
 
@@ -69,19 +70,19 @@ func run(_ *cobra.Command, _ []string) {
 	if args.clusterKey == "" {
 		r.Reporter.Errorf(
 			"Expected the cluster to be specified with the --cluster flag")
-		os.Exit(1)
+		os.Exit(reporter.ExitCode())
 	}
 	ocm.SetClusterKey(args.clusterKey)
 
 	if args.installationKey == "" {
 		r.Reporter.Errorf(
 			"Expected the add-on installation to be specified with the --addon flag")
-		os.Exit(1)
+		os.Exit(reporter.ExitCode())
 	}
 
 	if err := describeAddonInstallation(r, args.installationKey); err != nil {
 		r.Reporter.Errorf("Failed to describe add-on installation: %v", err)
-		os.Exit(1)
+		os.Exit(reporter.ExitCode())
 	}
 }
 
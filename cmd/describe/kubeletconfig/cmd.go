@@ -24,6 +24,7 @@ import (
 
 	"github.com/openshift/rosa/pkg/ocm"
 	"github.com/openshift/rosa/pkg/output"
+	"github.com/openshift/rosa/pkg/reporter"
 	"github.com/openshift/rosa/pkg/rosa"
 )
 
@@ -54,7 +55,7 @@ func run(_ *cobra.Command, _ []string) {
 	kubeletConfig, err := r.OCMClient.GetClusterKubeletConfig(cluster.ID())
 	if err != nil {
 		r.Reporter.Errorf("%v", err)
-		os.Exit(1)
+		os.Exit(reporter.ExitCode())
 	}
 
 	if kubeletConfig == nil {
@@ -66,7 +67,7 @@ func run(_ *cobra.Command, _ []string) {
 		err = output.Print(kubeletConfig)
 		if err != nil {
 			r.Reporter.Errorf("%v", err)
-			os.Exit(1)
+			os.Exit(reporter.ExitCode())
 		}
 		os.Exit(0)
 	}
@@ -19,12 +19,14 @@ package machinepool
 import (
 	"fmt"
 	"os"
+	"time"
 
 	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
 	"github.com/spf13/cobra"
 
 	"github.com/openshift/rosa/pkg/ocm"
 	"github.com/openshift/rosa/pkg/output"
+	"github.com/openshift/rosa/pkg/reporter"
 	"github.com/openshift/rosa/pkg/rosa"
 )
 
@@ -34,15 +36,26 @@ var Cmd = &cobra.Command{
 	Short:   "Show details of a machine pool on a cluster",
 	Long:    "Show details of a machine pool on a cluster.",
 	Example: `  # Show details of a machine pool named "mymachinepool"" on a cluster named "mycluster"
-  rosa describe machinepool --cluster=mycluster --machinepool=mymachinepool`,
+  rosa describe machinepool --cluster=mycluster --machinepool=mymachinepool
+
+  # Show the vCPU, memory and subscription quota that machine pool "mymachinepool" consumes
+  rosa describe machinepool --cluster=mycluster --machinepool=mymachinepool --estimate
+
+  # Watch machine pool "mymachinepool" while it scales or upgrades on a hosted cluster named "mycluster"
+  rosa describe machinepool --cluster=mycluster --machinepool=mymachinepool --watch`,
 	Run:  run,
 	Args: cobra.MaximumNArgs(1),
 }
 
 var args struct {
 	machinePool string
+	estimate    bool
+	watch       bool
 }
 
+// watchInterval is the time between refreshes of a watched machine pool.
+var watchInterval = 10 * time.Second
+
 func init() {
 	flags := Cmd.Flags()
 	flags.SortFlags = false
@@ -54,6 +67,20 @@ func init() {
 		"",
 		"Machine pool of the cluster to target",
 	)
+	flags.BoolVar(
+		&args.estimate,
+		"estimate",
+		false,
+		"Show the total vCPU, memory and subscription quota of the machine pool between its minimum and "+
+			"maximum replicas, and the limits of its spot instances.",
+	)
+	flags.BoolVar(
+		&args.watch,
+		"watch",
+		false,
+		"Refresh the status of the machine pool until no scale or upgrade operation is in progress. "+
+			"Only supported for Hosted Control Plane clusters.",
+	)
 }
 
 func run(cmd *cobra.Command, argv []string) {
@@ -62,7 +89,7 @@ func run(cmd *cobra.Command, argv []string) {
 	err := runWithRuntime(r, cmd, argv)
 	if err != nil {
 		r.Reporter.Errorf(err.Error())
-		os.Exit(1)
+		os.Exit(reporter.ExitCode())
 	}
 }
 
@@ -75,13 +102,19 @@ func runWithRuntime(r *rosa.Runtime, cmd *cobra.Command, argv []string) error {
 	if machinePool == "" {
 		return fmt.Errorf("You need to specify a machine pool name")
 	}
+	if args.watch && (args.estimate || output.HasFlag()) {
+		return fmt.Errorf("The '--watch' flag can't be used with '--estimate' or '--output'")
+	}
 	clusterKey := r.GetClusterKey()
 	cluster := r.FetchCluster()
 	if cluster.State() != cmv1.ClusterStateReady {
 		r.Reporter.Errorf("Cluster '%s' is not yet ready", clusterKey)
-		os.Exit(1)
+		os.Exit(reporter.ExitCode())
 	}
 	isHypershift := cluster.Hypershift().Enabled()
+	if args.watch && !isHypershift {
+		return fmt.Errorf("The '--watch' flag is only supported for Hosted Control Plane clusters")
+	}
 
 	if isHypershift {
 		return describeNodePool(r, cluster, clusterKey, machinePool)
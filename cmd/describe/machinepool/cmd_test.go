@@ -3,6 +3,7 @@ package machinepool
 import (
 	"fmt"
 	"net/http"
+	"strings"
 	"time"
 
 	. "github.com/onsi/ginkgo/v2"
@@ -87,6 +88,57 @@ Spot instances:             Yes (max $5)
 Disk size:                  default
 Security Group IDs:         
 `
+	describeClassicEstimateOutput = `Spot instances:             Yes (max $0.5)
+Disk size:                  default
+Security Group IDs:         
+Estimated replicas:         2-4
+Estimated vCPU:             8-16 (4 per node)
+Estimated memory:           32 GiB-64 GiB (16 GiB per node)
+Subscription quota:         4-8 of 12 available from 'compute.node|gpu|byoc|moa'
+Approximate spot cost:      Up to $0.5 per hour per instance, at most $1.00-$2.00 per hour for the machine pool, ` +
+		`depending on the spot price. Instances are interrupted when the spot price exceeds the limit
+`
+	describeHealthOutput = `Message:                               
+Autoscaler state:                      Within range (2 of 1-3)
+Warnings:
+ - Limited support: Machine pool nodepool85 can't scale
+ - Failed inflight check 'egress' (ID check1), run 'rosa verify network' after adjusting the network configuration
+`
+	machineTypes = `{
+		"kind": "MachineTypeList",
+		"page": 1,
+		"size": 1,
+		"total": 1,
+		"items": [{
+			"kind": "MachineType",
+			"id": "g4dn.xlarge",
+			"generic_name": "t4-gpu-4",
+			"category": "accelerated_computing",
+			"cpu": {"value": 4, "unit": "vCPU"},
+			"memory": {"value": 17179869184, "unit": "B"}
+		}]
+	}`
+	currentAccount = `{"kind": "Account", "organization": {"kind": "Organization", "id": "123abc"}}`
+	quotaCosts     = `{
+		"kind": "QuotaCostList",
+		"page": 1,
+		"size": 1,
+		"total": 1,
+		"items": [{
+			"kind": "QuotaCost",
+			"quota_id": "compute.node|gpu|byoc|moa",
+			"allowed": 20,
+			"consumed": 8,
+			"related_resources": [{
+				"resource_type": "compute.node",
+				"resource_name": "t4-gpu-4",
+				"product": "MOA",
+				"cloud_provider": "aws",
+				"byoc": "byoc",
+				"cost": 2
+			}]
+		}]
+	}`
 	describeClassicYamlOutput = `availability_zones:
 - us-east-1a
 - us-east-1b
@@ -133,11 +185,16 @@ var _ = Describe("Upgrade machine pool", func() {
 		nodePoolUpgradePolicy := test.FormatNodePoolUpgradePolicyList(upgradePolicies)
 
 		noNodePoolUpgradePolicy := test.FormatNodePoolUpgradePolicyList([]*cmv1.NodePoolUpgradePolicy{})
+		noLimitedSupportReasons := test.FormatLimitedSupportReasonList([]*cmv1.LimitedSupportReason{})
+		noInflightChecks := test.FormatInflightCheckList([]*cmv1.InflightCheck{})
 
 		BeforeEach(func() {
 			testRuntime.InitRuntime()
 			// Reset flag to avoid any side effect on other tests
 			Cmd.Flags().Set("output", "")
+			args.estimate = false
+			args.watch = false
+			watchInterval = 0
 		})
 		It("Fails if we are not specifying a machine pool name", func() {
 			args.machinePool = ""
@@ -176,6 +233,8 @@ var _ = Describe("Upgrade machine pool", func() {
 				// Second get for upgrades
 				testRuntime.ApiServer.AppendHandlers(RespondWithJSON(http.StatusOK, nodePoolResponse))
 				testRuntime.ApiServer.AppendHandlers(RespondWithJSON(http.StatusOK, noNodePoolUpgradePolicy))
+				testRuntime.ApiServer.AppendHandlers(RespondWithJSON(http.StatusOK, noLimitedSupportReasons))
+				testRuntime.ApiServer.AppendHandlers(RespondWithJSON(http.StatusOK, noInflightChecks))
 				stdout, stderr, err := test.RunWithOutputCaptureAndArgv(runWithRuntime, testRuntime.RosaRuntime,
 					Cmd, &[]string{})
 				Expect(err).To(BeNil())
@@ -190,6 +249,8 @@ var _ = Describe("Upgrade machine pool", func() {
 				// Second get for upgrades
 				testRuntime.ApiServer.AppendHandlers(RespondWithJSON(http.StatusOK, nodePoolResponse))
 				testRuntime.ApiServer.AppendHandlers(RespondWithJSON(http.StatusOK, nodePoolUpgradePolicy))
+				testRuntime.ApiServer.AppendHandlers(RespondWithJSON(http.StatusOK, noLimitedSupportReasons))
+				testRuntime.ApiServer.AppendHandlers(RespondWithJSON(http.StatusOK, noInflightChecks))
 				stdout, stderr, err := test.RunWithOutputCaptureAndArgv(runWithRuntime, testRuntime.RosaRuntime,
 					Cmd, &[]string{})
 				Expect(err).To(BeNil())
@@ -205,6 +266,8 @@ var _ = Describe("Upgrade machine pool", func() {
 				// Second get for upgrades
 				testRuntime.ApiServer.AppendHandlers(RespondWithJSON(http.StatusOK, nodePoolResponse))
 				testRuntime.ApiServer.AppendHandlers(RespondWithJSON(http.StatusOK, nodePoolUpgradePolicy))
+				testRuntime.ApiServer.AppendHandlers(RespondWithJSON(http.StatusOK, noLimitedSupportReasons))
+				testRuntime.ApiServer.AppendHandlers(RespondWithJSON(http.StatusOK, noInflightChecks))
 				stdout, stderr, err := test.RunWithOutputCaptureAndArgv(runWithRuntime, testRuntime.RosaRuntime,
 					Cmd, &[]string{})
 				Expect(err).To(BeNil())
@@ -212,7 +275,90 @@ var _ = Describe("Upgrade machine pool", func() {
 				Expect(stderr).To(BeEmpty())
 			})
 		})
+		Context("Hypershift health", func() {
+			It("Shows the autoscaler state and the warnings tied to the machine pool", func() {
+				args.machinePool = nodePoolName
+				autoscalingNodePool := formatAutoscalingNodePool(2)
+				reason, err := cmv1.NewLimitedSupportReason().Summary("Machine pool nodepool85 can't scale").
+					Details("Instance type is not available").Build()
+				Expect(err).To(BeNil())
+				otherReason, err := cmv1.NewLimitedSupportReason().Summary("Machine pool nodepool85-b can't scale").
+					Build()
+				Expect(err).To(BeNil())
+				inflight, err := cmv1.NewInflightCheck().ID("check1").Name("egress").
+					State(cmv1.InflightCheckStateFailed).
+					Details(map[string]interface{}{"subnet-123": map[string]interface{}{}}).Build()
+				Expect(err).To(BeNil())
+				testRuntime.ApiServer.AppendHandlers(RespondWithJSON(http.StatusOK, hypershiftClusterReady))
+				testRuntime.ApiServer.AppendHandlers(RespondWithJSON(http.StatusOK, autoscalingNodePool))
+				testRuntime.ApiServer.AppendHandlers(RespondWithJSON(http.StatusOK, autoscalingNodePool))
+				testRuntime.ApiServer.AppendHandlers(RespondWithJSON(http.StatusOK, noNodePoolUpgradePolicy))
+				testRuntime.ApiServer.AppendHandlers(RespondWithJSON(http.StatusOK,
+					test.FormatLimitedSupportReasonList([]*cmv1.LimitedSupportReason{reason, otherReason})))
+				testRuntime.ApiServer.AppendHandlers(RespondWithJSON(http.StatusOK,
+					test.FormatInflightCheckList([]*cmv1.InflightCheck{inflight})))
+				stdout, stderr, err := test.RunWithOutputCaptureAndArgv(runWithRuntime, testRuntime.RosaRuntime,
+					Cmd, &[]string{})
+				Expect(err).To(BeNil())
+				Expect(stdout).To(HaveSuffix(describeHealthOutput))
+				Expect(stderr).To(BeEmpty())
+			})
+			It("Still shows the machine pool when the health can't be fetched", func() {
+				args.machinePool = nodePoolName
+				testRuntime.ApiServer.AppendHandlers(RespondWithJSON(http.StatusOK, hypershiftClusterReady))
+				testRuntime.ApiServer.AppendHandlers(RespondWithJSON(http.StatusOK, nodePoolResponse))
+				testRuntime.ApiServer.AppendHandlers(RespondWithJSON(http.StatusOK, nodePoolResponse))
+				testRuntime.ApiServer.AppendHandlers(RespondWithJSON(http.StatusOK, noNodePoolUpgradePolicy))
+				testRuntime.ApiServer.AppendHandlers(RespondWithJSON(http.StatusForbidden, "{}"))
+				testRuntime.ApiServer.AppendHandlers(RespondWithJSON(http.StatusOK, noInflightChecks))
+				stdout, stderr, err := test.RunWithOutputCaptureAndArgv(runWithRuntime, testRuntime.RosaRuntime,
+					Cmd, &[]string{})
+				Expect(err).To(BeNil())
+				Expect(stdout).To(Equal(describeStringOutput))
+				Expect(stderr).To(ContainSubstring("Failed to get limited support reasons for cluster"))
+			})
+			It("Watches the machine pool until it is scaled", func() {
+				args.machinePool = nodePoolName
+				args.watch = true
+				testRuntime.ApiServer.AppendHandlers(RespondWithJSON(http.StatusOK, hypershiftClusterReady))
+				for _, currentReplicas := range []int{0, 0, 1} {
+					autoscalingNodePool := formatAutoscalingNodePool(currentReplicas)
+					testRuntime.ApiServer.AppendHandlers(RespondWithJSON(http.StatusOK, autoscalingNodePool))
+					testRuntime.ApiServer.AppendHandlers(RespondWithJSON(http.StatusOK, autoscalingNodePool))
+					testRuntime.ApiServer.AppendHandlers(RespondWithJSON(http.StatusOK, noNodePoolUpgradePolicy))
+					testRuntime.ApiServer.AppendHandlers(RespondWithJSON(http.StatusOK, noLimitedSupportReasons))
+					testRuntime.ApiServer.AppendHandlers(RespondWithJSON(http.StatusOK, noInflightChecks))
+				}
+				stdout, stderr, err := test.RunWithOutputCaptureAndArgv(runWithRuntime, testRuntime.RosaRuntime,
+					Cmd, &[]string{})
+				Expect(err).To(BeNil())
+				Expect(strings.Count(stdout, "\nID:")).To(Equal(2))
+				Expect(stdout).To(ContainSubstring("Autoscaler state:                      " +
+					"Scaling up to minimum of 1"))
+				Expect(stdout).To(ContainSubstring("Autoscaler state:                      " +
+					"At minimum of 1"))
+				Expect(stdout).To(ContainSubstring("Machine pool 'nodepool85' has no scale or upgrade operation " +
+					"in progress"))
+				Expect(stderr).To(BeEmpty())
+			})
+			It("Fails to watch with an output format", func() {
+				args.machinePool = nodePoolName
+				args.watch = true
+				Cmd.Flags().Set("output", "json")
+				_, _, err := test.RunWithOutputCaptureAndArgv(runWithRuntime, testRuntime.RosaRuntime,
+					Cmd, &[]string{})
+				Expect(err).To(MatchError("The '--watch' flag can't be used with '--estimate' or '--output'"))
+			})
+		})
 		Context("ROSA Classic", func() {
+			It("Fails to watch a machine pool", func() {
+				args.machinePool = nodePoolName
+				args.watch = true
+				testRuntime.ApiServer.AppendHandlers(RespondWithJSON(http.StatusOK, classicClusterReady))
+				_, _, err := test.RunWithOutputCaptureAndArgv(runWithRuntime, testRuntime.RosaRuntime,
+					Cmd, &[]string{})
+				Expect(err).To(MatchError("The '--watch' flag is only supported for Hosted Control Plane clusters"))
+			})
 			It("Pass a machine pool name through argv but it is not found", func() {
 				args.machinePool = ""
 				testRuntime.ApiServer.AppendHandlers(RespondWithJSON(http.StatusOK, classicClusterReady))
@@ -245,6 +391,20 @@ var _ = Describe("Upgrade machine pool", func() {
 				Expect(stdout).To(Equal(describeClassicStringOutput))
 				Expect(stderr).To(BeEmpty())
 			})
+			It("Pass a machine pool name through parameter and it is found. estimate", func() {
+				args.machinePool = nodePoolName
+				args.estimate = true
+				testRuntime.ApiServer.AppendHandlers(RespondWithJSON(http.StatusOK, classicClusterReady))
+				testRuntime.ApiServer.AppendHandlers(RespondWithJSON(http.StatusOK, formatAutoscalingMachinePool()))
+				testRuntime.ApiServer.AppendHandlers(RespondWithJSON(http.StatusOK, machineTypes))
+				testRuntime.ApiServer.AppendHandlers(RespondWithJSON(http.StatusOK, currentAccount))
+				testRuntime.ApiServer.AppendHandlers(RespondWithJSON(http.StatusOK, quotaCosts))
+				stdout, stderr, err := test.RunWithOutputCaptureAndArgv(runWithRuntime, testRuntime.RosaRuntime,
+					Cmd, &[]string{})
+				Expect(err).To(BeNil())
+				Expect(stdout).To(HaveSuffix(describeClassicEstimateOutput))
+				Expect(stderr).To(BeEmpty())
+			})
 			It("Format AWS additional security groups if exist", func() {
 				securityGroupsIds := []string{"123", "321"}
 				awsNodePool, err := cmv1.NewAWSNodePool().AdditionalSecurityGroupIds(securityGroupsIds...).Build()
@@ -286,6 +446,18 @@ func formatNodePool() string {
 	return test.FormatResource(np)
 }
 
+// formatAutoscalingNodePool simulates the output of APIs for a fake node pool with autoscaling
+func formatAutoscalingNodePool(currentReplicas int) string {
+	version := cmv1.NewVersion().ID("4.12.24").RawID("openshift-4.12.24")
+	awsNodePool := cmv1.NewAWSNodePool().InstanceType("m5.xlarge")
+	np, err := cmv1.NewNodePool().ID(nodePoolName).Version(version).
+		AWSNodePool(awsNodePool).AvailabilityZone("us-east-1a").Subnet("subnet-123").
+		Autoscaling(cmv1.NewNodePoolAutoscaling().MinReplica(1).MaxReplica(3)).
+		Status(cmv1.NewNodePoolStatus().CurrentReplicas(currentReplicas)).Build()
+	Expect(err).To(BeNil())
+	return test.FormatResource(np)
+}
+
 // formatMachinePool simulates the output of APIs for a fake machine pool
 func formatMachinePool() string {
 	awsMachinePoolPool := cmv1.NewAWSMachinePool().SpotMarketOptions(cmv1.NewAWSSpotMarketOptions().MaxPrice(5))
@@ -295,6 +467,15 @@ func formatMachinePool() string {
 	return test.FormatResource(mp)
 }
 
+// formatAutoscalingMachinePool simulates the output of APIs for a fake machine pool with autoscaling
+func formatAutoscalingMachinePool() string {
+	awsMachinePoolPool := cmv1.NewAWSMachinePool().SpotMarketOptions(cmv1.NewAWSSpotMarketOptions().MaxPrice(0.5))
+	mp, err := cmv1.NewMachinePool().ID(nodePoolName).AWS(awsMachinePoolPool).InstanceType("g4dn.xlarge").
+		Autoscaling(cmv1.NewMachinePoolAutoscaling().MinReplicas(2).MaxReplicas(4)).Build()
+	Expect(err).To(BeNil())
+	return test.FormatResource(mp)
+}
+
 func buildNodePoolUpgradePolicy() *cmv1.NodePoolUpgradePolicy {
 	t, err := time.Parse(time.RFC3339, "2023-08-07T15:22:00Z")
 	Expect(err).To(BeNil())
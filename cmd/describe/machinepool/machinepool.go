@@ -5,6 +5,7 @@ import (
 
 	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
 
+	"github.com/openshift/rosa/pkg/ocm"
 	ocmOutput "github.com/openshift/rosa/pkg/ocm/output"
 	"github.com/openshift/rosa/pkg/output"
 	"github.com/openshift/rosa/pkg/rosa"
@@ -20,7 +21,18 @@ func describeMachinePool(r *rosa.Runtime, cluster *cmv1.Cluster, clusterKey stri
 		return fmt.Errorf("Machine pool '%s' not found", machinePoolID)
 	}
 
+	var estimate *ocm.MachinePoolEstimate
+	if args.estimate {
+		estimate, err = r.OCMClient.EstimateMachinePool(machinePool)
+		if err != nil {
+			return err
+		}
+	}
+
 	if output.HasFlag() {
+		if estimate != nil {
+			return output.Print(estimate)
+		}
 		return output.Print(machinePool)
 	}
 
@@ -51,6 +63,9 @@ func describeMachinePool(r *rosa.Runtime, cluster *cmv1.Cluster, clusterKey stri
 		ocmOutput.PrintMachinePoolDiskSize(machinePool),
 		output.PrintStringSlice(machinePool.AWS().AdditionalSecurityGroupIds()),
 	)
+	if estimate != nil {
+		machinePoolOutput += ocmOutput.PrintMachinePoolEstimate(estimate, 28)
+	}
 	fmt.Print(machinePoolOutput)
 
 	return nil
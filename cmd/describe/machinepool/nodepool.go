@@ -4,38 +4,204 @@ import (
 	"bytes"
 	"encoding/json"
 	"fmt"
+	"regexp"
 
 	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
 
+	"github.com/openshift/rosa/pkg/ocm"
 	ocmOutput "github.com/openshift/rosa/pkg/ocm/output"
 	"github.com/openshift/rosa/pkg/output"
 	"github.com/openshift/rosa/pkg/rosa"
+	"github.com/openshift/rosa/pkg/timeout"
 )
 
 func describeNodePool(r *rosa.Runtime, cluster *cmv1.Cluster, clusterKey string, nodePoolID string) error {
-	r.Reporter.Debugf("Fetching node pool '%s' for cluster '%s'", nodePoolID, clusterKey)
-	nodePool, exists, err := r.OCMClient.GetNodePool(cluster.ID(), nodePoolID)
-	if err != nil {
-		return err
-	}
-	if !exists {
-		return fmt.Errorf("Machine pool '%s' not found", nodePoolID)
+	if args.watch {
+		return watchNodePool(r, cluster, clusterKey, nodePoolID)
 	}
 
-	_, scheduledUpgrade, err := r.OCMClient.GetHypershiftNodePoolUpgrade(cluster.ID(), clusterKey, nodePoolID)
+	nodePool, scheduledUpgrade, warnings, err := getNodePoolHealth(r, cluster, clusterKey, nodePoolID)
 	if err != nil {
 		return err
 	}
 
+	var estimate *ocm.MachinePoolEstimate
+	if args.estimate {
+		estimate, err = r.OCMClient.EstimateNodePool(nodePool)
+		if err != nil {
+			return err
+		}
+	}
+
 	if output.HasFlag() {
+		if estimate != nil {
+			return output.Print(estimate)
+		}
 		var formattedOutput map[string]interface{}
 		formattedOutput, err = formatNodePoolOutput(nodePool, scheduledUpgrade)
 		if err != nil {
 			return err
 		}
+		if len(warnings) > 0 {
+			formattedOutput["warnings"] = warnings
+		}
 		return output.Print(formattedOutput)
 	}
 
+	nodePoolOutput := printNodePool(cluster, nodePool, scheduledUpgrade, warnings)
+	if estimate != nil {
+		nodePoolOutput += ocmOutput.PrintMachinePoolEstimate(estimate, 39)
+	}
+	fmt.Print(nodePoolOutput)
+
+	return nil
+}
+
+// watchNodePool prints the node pool every time it changes, until no scale or upgrade operation is in
+// progress.
+func watchNodePool(r *rosa.Runtime, cluster *cmv1.Cluster, clusterKey string, nodePoolID string) error {
+	lastOutput := ""
+	for {
+		// Stop on timeout or interruption without waiting for another request to fail
+		if err := timeout.Err(); err != nil {
+			return err
+		}
+		nodePool, scheduledUpgrade, warnings, err := getNodePoolHealth(r, cluster, clusterKey, nodePoolID)
+		if err != nil {
+			return err
+		}
+		nodePoolOutput := printNodePool(cluster, nodePool, scheduledUpgrade, warnings)
+		if nodePoolOutput != lastOutput {
+			fmt.Print(nodePoolOutput)
+			lastOutput = nodePoolOutput
+		}
+		if !isNodePoolScaling(nodePool) && !isNodePoolUpgrading(scheduledUpgrade) {
+			r.Reporter.Infof("Machine pool '%s' has no scale or upgrade operation in progress", nodePoolID)
+			return nil
+		}
+		r.Reporter.Debugf("Waiting %s for machine pool '%s' to change", watchInterval, nodePoolID)
+		if err := timeout.Sleep(watchInterval); err != nil {
+			return err
+		}
+	}
+}
+
+// getNodePoolHealth returns the node pool, its scheduled upgrade, and the limited support reasons and
+// failed inflight checks of the cluster that mention the node pool or its subnet. Failing to get the
+// limited support reasons or the inflight checks is only a warning, so that the node pool is still shown.
+func getNodePoolHealth(r *rosa.Runtime, cluster *cmv1.Cluster, clusterKey string,
+	nodePoolID string) (*cmv1.NodePool, *cmv1.NodePoolUpgradePolicy, []string, error) {
+	r.Reporter.Debugf("Fetching node pool '%s' for cluster '%s'", nodePoolID, clusterKey)
+	nodePool, exists, err := r.OCMClient.GetNodePool(cluster.ID(), nodePoolID)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	if !exists {
+		return nil, nil, nil, fmt.Errorf("Machine pool '%s' not found", nodePoolID)
+	}
+
+	_, scheduledUpgrade, err := r.OCMClient.GetHypershiftNodePoolUpgrade(cluster.ID(), clusterKey, nodePoolID)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	limitedSupportReasons, err := r.OCMClient.GetLimitedSupportReasons(cluster.ID())
+	if err != nil {
+		r.Reporter.Warnf("Failed to get limited support reasons for cluster '%s', they aren't included in the "+
+			"warnings: %v", clusterKey, err)
+	}
+	inflightChecks, err := r.OCMClient.GetInflightChecks(cluster.ID())
+	if err != nil {
+		r.Reporter.Warnf("Failed to get inflight checks for cluster '%s', they aren't included in the "+
+			"warnings: %v", clusterKey, err)
+	}
+
+	return nodePool, scheduledUpgrade, getNodePoolWarnings(nodePool, limitedSupportReasons, inflightChecks), nil
+}
+
+// getNodePoolWarnings returns the limited support reasons and failed inflight checks that mention the
+// node pool or its subnet.
+func getNodePoolWarnings(nodePool *cmv1.NodePool, limitedSupportReasons []*cmv1.LimitedSupportReason,
+	inflightChecks []*cmv1.InflightCheck) []string {
+	keys := []string{nodePool.ID()}
+	if nodePool.Subnet() != "" {
+		keys = append(keys, nodePool.Subnet())
+	}
+	mentionsNodePool := func(text string) bool {
+		for _, key := range keys {
+			if regexp.MustCompile(`(^|[^\w-])` + regexp.QuoteMeta(key) + `($|[^\w-])`).MatchString(text) {
+				return true
+			}
+		}
+		return false
+	}
+
+	warnings := []string{}
+	for _, reason := range limitedSupportReasons {
+		if mentionsNodePool(reason.Summary()) || mentionsNodePool(reason.Details()) {
+			warnings = append(warnings, fmt.Sprintf("Limited support: %s", reason.Summary()))
+		}
+	}
+	for _, inflight := range inflightChecks {
+		if inflight.State() != cmv1.InflightCheckStateFailed {
+			continue
+		}
+		details, err := json.Marshal(inflight.Details())
+		if err != nil || !mentionsNodePool(string(details)) {
+			continue
+		}
+		warnings = append(warnings, fmt.Sprintf("Failed inflight check '%s' (ID %s), run "+
+			"'rosa verify network' after adjusting the network configuration", inflight.Name(), inflight.ID()))
+	}
+	return warnings
+}
+
+// isNodePoolScaling returns true if the current replicas of the node pool are not yet the desired
+// replicas, or within the autoscaling range.
+func isNodePoolScaling(nodePool *cmv1.NodePool) bool {
+	status, ok := nodePool.GetStatus()
+	if !ok {
+		return false
+	}
+	if autoscaling, ok := nodePool.GetAutoscaling(); ok {
+		return status.CurrentReplicas() < autoscaling.MinReplica() ||
+			status.CurrentReplicas() > autoscaling.MaxReplica()
+	}
+	return status.CurrentReplicas() != nodePool.Replicas()
+}
+
+func isNodePoolUpgrading(scheduledUpgrade *cmv1.NodePoolUpgradePolicy) bool {
+	if scheduledUpgrade == nil || scheduledUpgrade.State() == nil {
+		return false
+	}
+	return scheduledUpgrade.State().Value() == cmv1.UpgradePolicyStateValueStarted ||
+		scheduledUpgrade.State().Value() == cmv1.UpgradePolicyStateValueDelayed
+}
+
+// printAutoscalerState describes where the current replicas are within the autoscaling range.
+func printAutoscalerState(nodePool *cmv1.NodePool) string {
+	status, ok := nodePool.GetStatus()
+	if !ok {
+		return "Unknown"
+	}
+	current := status.CurrentReplicas()
+	min := nodePool.Autoscaling().MinReplica()
+	max := nodePool.Autoscaling().MaxReplica()
+	switch {
+	case current < min:
+		return fmt.Sprintf("Scaling up to minimum of %d", min)
+	case current > max:
+		return fmt.Sprintf("Scaling down to maximum of %d", max)
+	case current == min:
+		return fmt.Sprintf("At minimum of %d", min)
+	case current == max:
+		return fmt.Sprintf("At maximum of %d", max)
+	}
+	return fmt.Sprintf("Within range (%d of %d-%d)", current, min, max)
+}
+
+func printNodePool(cluster *cmv1.Cluster, nodePool *cmv1.NodePool,
+	scheduledUpgrade *cmv1.NodePoolUpgradePolicy, warnings []string) string {
 	// Prepare string
 	nodePoolOutput := fmt.Sprintf("\n"+
 		"ID:                                    %s\n"+
@@ -72,6 +238,21 @@ func describeNodePool(r *rosa.Runtime, cluster *cmv1.Cluster, clusterKey string,
 		ocmOutput.PrintNodePoolMessage(nodePool.Status()),
 	)
 
+	if nodePool.Autoscaling() != nil {
+		nodePoolOutput = fmt.Sprintf("%s"+
+			"Autoscaler state:                      %s\n",
+			nodePoolOutput,
+			printAutoscalerState(nodePool),
+		)
+	} else if isNodePoolScaling(nodePool) {
+		nodePoolOutput = fmt.Sprintf("%s"+
+			"Scaling:                               %d of %d replicas\n",
+			nodePoolOutput,
+			nodePool.Status().CurrentReplicas(),
+			nodePool.Replicas(),
+		)
+	}
+
 	// Print scheduled upgrades if existing
 	if scheduledUpgrade != nil {
 		nodePoolOutput = fmt.Sprintf("%s"+
@@ -82,9 +263,14 @@ func describeNodePool(r *rosa.Runtime, cluster *cmv1.Cluster, clusterKey string,
 			scheduledUpgrade.NextRun().Format("2006-01-02 15:04 MST"),
 		)
 	}
-	fmt.Print(nodePoolOutput)
+	if len(warnings) > 0 {
+		nodePoolOutput += "Warnings:\n"
+		for _, warning := range warnings {
+			nodePoolOutput += fmt.Sprintf(" - %s\n", warning)
+		}
+	}
 
-	return nil
+	return nodePoolOutput
 }
 
 func formatNodePoolOutput(nodePool *cmv1.NodePool,
@@ -27,6 +27,7 @@ import (
 	"github.com/openshift/rosa/cmd/create/idp"
 	"github.com/openshift/rosa/pkg/interactive/confirm"
 	"github.com/openshift/rosa/pkg/ocm"
+	"github.com/openshift/rosa/pkg/reporter"
 	"github.com/openshift/rosa/pkg/rosa"
 )
 
@@ -41,7 +42,7 @@ func (d *DeleteAdminIDP) deleteAdmin(r *rosa.Runtime, identityProvider *cmv1.Ide
 	if err != nil {
 		r.Reporter.Errorf("Failed to delete htpasswd idp '%s' of cluster '%s': %s",
 			identityProvider.ID(), r.ClusterKey, err)
-		os.Exit(1)
+		os.Exit(reporter.ExitCode())
 	}
 }
 
@@ -56,21 +57,21 @@ func (d *DeleteUserAdminFromIDP) deleteAdmin(r *rosa.Runtime, identityProvider *
 	if err != nil {
 		r.Reporter.Errorf("Failed to delete '%s' user from htpasswd idp users list of cluster '%s': %s",
 			cadmin.ClusterAdminUsername, r.ClusterKey, err)
-		os.Exit(1)
+		os.Exit(reporter.ExitCode())
 	}
 
 	users, err := r.OCMClient.GetHTPasswdUserList(clusterID, identityProvider.ID())
 	if err != nil {
 		r.Reporter.Errorf("Failed to list htpasswd idp users of cluster '%s': %s",
 			r.ClusterKey, err)
-		os.Exit(1)
+		os.Exit(reporter.ExitCode())
 	}
 
 	htpasswdIdentityProvider, ok := identityProvider.GetHtpasswd()
 	if !ok {
 		r.Reporter.Errorf("Failed to get htpasswd idp of cluster '%s': %s",
 			r.ClusterKey, err)
-		os.Exit(1)
+		os.Exit(reporter.ExitCode())
 	}
 
 	if users.Len() == 0 && htpasswdIdentityProvider.Username() == "" {
@@ -79,7 +80,7 @@ func (d *DeleteUserAdminFromIDP) deleteAdmin(r *rosa.Runtime, identityProvider *
 		if err != nil {
 			r.Reporter.Errorf("Failed to delete htpasswd idp '%s' of cluster '%s': %s",
 				identityProvider.ID(), r.ClusterKey, err)
-			os.Exit(1)
+			os.Exit(reporter.ExitCode())
 		}
 	}
 }
@@ -105,13 +106,13 @@ func run(_ *cobra.Command, _ []string) {
 	cluster := r.FetchCluster()
 	if cluster.State() != cmv1.ClusterStateReady {
 		r.Reporter.Errorf("Cluster '%s' is not yet ready", r.ClusterKey)
-		os.Exit(1)
+		os.Exit(reporter.ExitCode())
 	}
 
 	if cluster.ExternalAuthConfig().Enabled() {
 		r.Reporter.Errorf(
 			"Deleting the 'cluster-admin' user is not supported for clusters with external authentication configured.")
-		os.Exit(1)
+		os.Exit(reporter.ExitCode())
 	}
 
 	// Try to find the htpasswd identity provider:
@@ -119,12 +120,12 @@ func run(_ *cobra.Command, _ []string) {
 	clusterAdminIDP, _, err := cadmin.FindIDPWithAdmin(cluster, r)
 	if err != nil {
 		r.Reporter.Errorf(err.Error())
-		os.Exit(1)
+		os.Exit(reporter.ExitCode())
 	}
 
 	if clusterAdminIDP == nil {
 		r.Reporter.Errorf("Cluster '%s' does not have ‘%s’ user", r.ClusterKey, cadmin.ClusterAdminUsername)
-		os.Exit(1)
+		os.Exit(reporter.ExitCode())
 	}
 
 	if confirm.Confirm("delete %s user on cluster %s", cadmin.ClusterAdminUsername, r.ClusterKey) {
@@ -134,7 +135,7 @@ func run(_ *cobra.Command, _ []string) {
 		err := r.OCMClient.DeleteUser(clusterID, admin.ClusterAdminGroupname, cadmin.ClusterAdminUsername)
 		if err != nil {
 			r.Reporter.Errorf("%s", err)
-			os.Exit(1)
+			os.Exit(reporter.ExitCode())
 		}
 
 		deletionStrategy := getAdminUserDeletionStrategy(r, clusterAdminIDP)
@@ -155,7 +156,7 @@ func wasAdminCreatedUsingOldROSA(r *rosa.Runtime, identityProvider *cmv1.Identit
 	htpasswdIdp, ok := identityProvider.GetHtpasswd()
 	if !ok {
 		r.Reporter.Errorf("Failed to get htpasswd idp for cluster '%s'", r.Cluster.ID())
-		os.Exit(1)
+		os.Exit(reporter.ExitCode())
 	}
 	return htpasswdIdp.Username() == cadmin.ClusterAdminUsername
 }
@@ -23,6 +23,7 @@ import (
 
 	"github.com/openshift/rosa/pkg/interactive/confirm"
 	"github.com/openshift/rosa/pkg/ocm"
+	"github.com/openshift/rosa/pkg/reporter"
 	"github.com/openshift/rosa/pkg/rosa"
 )
 
@@ -50,7 +51,7 @@ func run(_ *cobra.Command, _ []string) {
 
 	if cluster.Hypershift().Enabled() {
 		r.Reporter.Errorf("Hosted Control Plane clusters do not support cluster-autoscaler configuration")
-		os.Exit(1)
+		os.Exit(reporter.ExitCode())
 	}
 
 	if !confirm.Confirm("delete cluster autoscaler?") {
@@ -63,7 +64,7 @@ func run(_ *cobra.Command, _ []string) {
 	if err != nil {
 		r.Reporter.Errorf("Failed to delete autoscaler configuration for cluster '%s': %s",
 			cluster.ID(), err)
-		os.Exit(1)
+		os.Exit(reporter.ExitCode())
 	}
 	r.Reporter.Infof("Successfully deleted autoscaler configuration for cluster '%s'", cluster.ID())
 }
@@ -33,6 +33,7 @@ import (
 	"github.com/openshift/rosa/cmd/dlt/oidcconfig"
 	"github.com/openshift/rosa/cmd/dlt/oidcprovider"
 	"github.com/openshift/rosa/cmd/dlt/operatorrole"
+	"github.com/openshift/rosa/cmd/dlt/scalingschedule"
 	"github.com/openshift/rosa/cmd/dlt/service"
 	"github.com/openshift/rosa/cmd/dlt/tuningconfigs"
 	"github.com/openshift/rosa/cmd/dlt/upgrade"
@@ -68,6 +69,7 @@ func init() {
 	Cmd.AddCommand(autoscaler.Cmd)
 	Cmd.AddCommand(kubeletconfig.Cmd)
 	Cmd.AddCommand(externalauthprovider.Cmd)
+	Cmd.AddCommand(scalingschedule.NewDeleteScalingScheduleCommand())
 
 	flags := Cmd.PersistentFlags()
 	arguments.AddProfileFlag(flags)
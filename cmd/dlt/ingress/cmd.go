@@ -26,6 +26,7 @@ import (
 
 	"github.com/openshift/rosa/pkg/interactive/confirm"
 	"github.com/openshift/rosa/pkg/ocm"
+	"github.com/openshift/rosa/pkg/reporter"
 	"github.com/openshift/rosa/pkg/rosa"
 )
 
@@ -68,7 +69,7 @@ func run(_ *cobra.Command, argv []string) {
 			"Ingress  identifier '%s' isn't valid: it must contain only four letters or digits",
 			ingressID,
 		)
-		os.Exit(1)
+		os.Exit(reporter.ExitCode())
 	}
 
 	clusterKey := r.GetClusterKey()
@@ -79,7 +80,7 @@ func run(_ *cobra.Command, argv []string) {
 	ingresses, err := r.OCMClient.GetIngresses(cluster.ID())
 	if err != nil {
 		r.Reporter.Errorf("Failed to get ingresses for cluster '%s': %v", clusterKey, err)
-		os.Exit(1)
+		os.Exit(reporter.ExitCode())
 	}
 
 	var ingress *cmv1.Ingress
@@ -96,7 +97,7 @@ func run(_ *cobra.Command, argv []string) {
 	}
 	if ingress == nil {
 		r.Reporter.Errorf("Ingress '%s' does not exist on cluster '%s'", ingressID, clusterKey)
-		os.Exit(1)
+		os.Exit(reporter.ExitCode())
 	}
 
 	if confirm.Confirm("delete ingress %s on cluster %s", ingressID, clusterKey) {
@@ -105,7 +106,7 @@ func run(_ *cobra.Command, argv []string) {
 		if err != nil {
 			r.Reporter.Errorf("Failed to delete ingress '%s' on cluster '%s': %s",
 				ingress.ID(), clusterKey, err)
-			os.Exit(1)
+			os.Exit(reporter.ExitCode())
 		}
 		r.Reporter.Infof("Successfully deleted ingress '%s' from cluster '%s'", ingressID, clusterKey)
 	}
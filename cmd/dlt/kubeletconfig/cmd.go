@@ -24,6 +24,7 @@ import (
 
 	"github.com/openshift/rosa/pkg/interactive/confirm"
 	"github.com/openshift/rosa/pkg/ocm"
+	"github.com/openshift/rosa/pkg/reporter"
 	"github.com/openshift/rosa/pkg/rosa"
 )
 
@@ -61,7 +62,7 @@ func run(_ *cobra.Command, _ []string) {
 		if err != nil {
 			r.Reporter.Errorf("Failed to delete custom KubeletConfig for cluster '%s': '%s'",
 				clusterKey, err)
-			os.Exit(1)
+			os.Exit(reporter.ExitCode())
 		}
 		r.Reporter.Infof("Successfully deleted custom KubeletConfig for cluster '%s'", clusterKey)
 		os.Exit(0)
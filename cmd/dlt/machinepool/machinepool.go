@@ -7,6 +7,7 @@ import (
 	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
 
 	"github.com/openshift/rosa/pkg/interactive/confirm"
+	"github.com/openshift/rosa/pkg/reporter"
 	"github.com/openshift/rosa/pkg/rosa"
 )
 
@@ -17,7 +18,7 @@ var machinePoolKeyRE = regexp.MustCompile(`^[a-z]([-a-z0-9]*[a-z0-9])?$`)
 func deleteMachinePool(r *rosa.Runtime, machinePoolID string, clusterKey string, cluster *cmv1.Cluster) {
 	if !machinePoolKeyRE.MatchString(machinePoolID) {
 		r.Reporter.Errorf("Expected a valid identifier for the machine pool")
-		os.Exit(1)
+		os.Exit(reporter.ExitCode())
 	}
 
 	// Try to find the machine pool:
@@ -25,7 +26,7 @@ func deleteMachinePool(r *rosa.Runtime, machinePoolID string, clusterKey string,
 	machinePools, err := r.OCMClient.GetMachinePools(cluster.ID())
 	if err != nil {
 		r.Reporter.Errorf("Failed to get machine pools for cluster '%s': %v", clusterKey, err)
-		os.Exit(1)
+		os.Exit(reporter.ExitCode())
 	}
 
 	var machinePool *cmv1.MachinePool
@@ -36,7 +37,7 @@ func deleteMachinePool(r *rosa.Runtime, machinePoolID string, clusterKey string,
 	}
 	if machinePool == nil {
 		r.Reporter.Errorf("Failed to get machine pool '%s' for cluster '%s'", machinePoolID, clusterKey)
-		os.Exit(1)
+		os.Exit(reporter.ExitCode())
 	}
 
 	if confirm.Confirm("delete machine pool '%s' on cluster '%s'", machinePoolID, clusterKey) {
@@ -45,7 +46,7 @@ func deleteMachinePool(r *rosa.Runtime, machinePoolID string, clusterKey string,
 		if err != nil {
 			r.Reporter.Errorf("Failed to delete machine pool '%s' on cluster '%s': %s",
 				machinePool.ID(), clusterKey, err)
-			os.Exit(1)
+			os.Exit(reporter.ExitCode())
 		}
 		r.Reporter.Infof("Successfully deleted machine pool '%s' from cluster '%s'", machinePoolID, clusterKey)
 	}
@@ -30,6 +30,7 @@ import (
 	"github.com/openshift/rosa/pkg/interactive/confirm"
 	interactiveOidc "github.com/openshift/rosa/pkg/interactive/oidc"
 	"github.com/openshift/rosa/pkg/ocm"
+	"github.com/openshift/rosa/pkg/reporter"
 	"github.com/openshift/rosa/pkg/rosa"
 )
 
@@ -89,7 +90,7 @@ func run(cmd *cobra.Command, argv []string) {
 	mode, err := interactive.GetMode()
 	if err != nil {
 		r.Reporter.Errorf("%s", err)
-		os.Exit(1)
+		os.Exit(reporter.ExitCode())
 	}
 
 	// Determine if interactive mode is needed
@@ -102,7 +103,7 @@ func run(cmd *cobra.Command, argv []string) {
 		mode, err = interactive.GetOptionMode(cmd, mode, "OIDC provider deletion mode")
 		if err != nil {
 			r.Reporter.Errorf("Expected a valid OIDC provider deletion mode: %v", err)
-			os.Exit(1)
+			os.Exit(reporter.ExitCode())
 		}
 	}
 
@@ -116,10 +117,10 @@ func run(cmd *cobra.Command, argv []string) {
 			if errors.GetType(err) == errors.Conflict {
 				r.Reporter.Errorf("More than one cluster found with the same name '%s'. Please "+
 					"use cluster ID instead", clusterKey)
-				os.Exit(1)
+				os.Exit(reporter.ExitCode())
 			}
 			r.Reporter.Errorf("Error validating cluster '%s': %v", clusterKey, err)
-			os.Exit(1)
+			os.Exit(reporter.ExitCode())
 		}
 
 		if sub != nil {
@@ -129,23 +130,23 @@ func run(cmd *cobra.Command, argv []string) {
 		if err != nil {
 			if errors.GetType(err) != errors.NotFound {
 				r.Reporter.Errorf("Error validating cluster '%s': %v", clusterKey, err)
-				os.Exit(1)
+				os.Exit(reporter.ExitCode())
 			} else if sub == nil {
 				r.Reporter.Errorf("Failed to get cluster '%s': %v", r.ClusterKey, err)
-				os.Exit(1)
+				os.Exit(reporter.ExitCode())
 			}
 
 		}
 		if cluster != nil && cluster.ID() != "" {
 			r.Reporter.Errorf("Cluster '%s' is in '%s' state. OIDC provider can be deleted only for the "+
 				"uninstalled clusters", cluster.ID(), cluster.State())
-			os.Exit(1)
+			os.Exit(reporter.ExitCode())
 		}
 
 		providerArn, err = r.AWSClient.GetOpenIDConnectProviderByClusterIdTag(sub.ClusterID())
 		if err != nil {
 			r.Reporter.Errorf("Failed to get the OIDC provider for cluster '%s'.", clusterKey)
-			os.Exit(1)
+			os.Exit(reporter.ExitCode())
 		}
 		if providerArn == "" {
 			r.Reporter.Infof("Cluster '%s' doesn't have OIDC provider associated with it. "+
@@ -164,19 +165,19 @@ func run(cmd *cobra.Command, argv []string) {
 			oidcConfig, err := r.OCMClient.GetOidcConfig(args.oidcConfigId)
 			if err != nil {
 				r.Reporter.Errorf("There was a problem retrieving OIDC Config '%s': %v", args.oidcConfigId, err)
-				os.Exit(1)
+				os.Exit(reporter.ExitCode())
 			}
 			oidcEndpointUrl = oidcConfig.IssuerUrl()
 		}
 		parsedURI, _ := url.ParseRequestURI(oidcEndpointUrl)
 		if parsedURI.Scheme != helper.ProtocolHttps {
 			r.Reporter.Errorf("Expected OIDC endpoint URL '%s' to use an https:// scheme", oidcEndpointUrl)
-			os.Exit(1)
+			os.Exit(reporter.ExitCode())
 		}
 		providerArn, err = r.AWSClient.GetOpenIDConnectProviderByOidcEndpointUrl(oidcEndpointUrl)
 		if err != nil {
 			r.Reporter.Errorf("Failed to get the OIDC provider for endpoint URL '%s': %v", oidcEndpointUrl, err)
-			os.Exit(1)
+			os.Exit(reporter.ExitCode())
 		}
 		if providerArn == "" {
 			r.Reporter.Infof("Provider '%s' not found.", oidcEndpointUrl)
@@ -188,39 +189,39 @@ func run(cmd *cobra.Command, argv []string) {
 		if err != nil {
 			r.Reporter.Errorf("There was a problem checking if any clusters are using OIDC provider '%s' : %v",
 				oidcEndpointUrl, err)
-			os.Exit(1)
+			os.Exit(reporter.ExitCode())
 		}
 		if hasClusterUsingOidcProvider {
 			r.Reporter.Errorf("There are clusters using OIDC config '%s', can't delete the provider", oidcEndpointUrl)
-			os.Exit(1)
+			os.Exit(reporter.ExitCode())
 		}
 	}
 	switch mode {
 	case interactive.ModeAuto:
 		r.OCMClient.LogEvent("ROSADeleteOIDCProviderModeAuto", nil)
 		if !confirm.Prompt(true, "Delete the OIDC provider '%s'?", providerArn) {
-			os.Exit(1)
+			os.Exit(reporter.ExitCode())
 		}
 		err := r.AWSClient.DeleteOpenIDConnectProvider(providerArn)
 		if err != nil {
 			r.Reporter.Errorf("There was an error deleting the OIDC provider: %s", err)
-			os.Exit(1)
+			os.Exit(reporter.ExitCode())
 		}
 		r.Reporter.Infof("Successfully deleted the OIDC provider %s", providerArn)
 	case interactive.ModeManual:
 		r.OCMClient.LogEvent("ROSADeleteOIDCProviderModeManual", nil)
-		commands := buildCommand(providerArn)
+		commands := BuildCommand(providerArn)
 		if r.Reporter.IsTerminal() {
 			r.Reporter.Infof("Run the following commands to delete the OIDC provider:\n")
 		}
 		fmt.Println(commands)
 	default:
 		r.Reporter.Errorf("Invalid mode. Allowed values are %s", interactive.Modes)
-		os.Exit(1)
+		os.Exit(reporter.ExitCode())
 	}
 }
 
-func buildCommand(providerARN string) string {
+func BuildCommand(providerARN string) string {
 	return awscb.NewIAMCommandBuilder().
 		SetCommand(awscb.DeleteOpenIdConnectProvider).
 		AddParam(awscb.OpenIdConnectProviderArn, providerARN).
@@ -0,0 +1,88 @@
+/*
+Copyright (c) 2024 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scalingschedule
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/spf13/cobra"
+	errors "github.com/zgalor/weberr"
+
+	"github.com/openshift/rosa/pkg/interactive/confirm"
+	"github.com/openshift/rosa/pkg/ocm"
+	"github.com/openshift/rosa/pkg/rosa"
+)
+
+const (
+	use     = "scaling-schedule NAME"
+	short   = "Delete a scaling schedule"
+	long    = "Delete a scaling schedule of a machine pool. The replicas of the machine pool are left as they are."
+	example = `  # Delete scaling schedule 'nightly-down' of cluster 'mycluster'
+  rosa delete scaling-schedule --cluster=mycluster nightly-down`
+)
+
+func NewDeleteScalingScheduleCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     use,
+		Aliases: []string{"scalingschedule", "scaling-schedules", "scalingschedules"},
+		Short:   short,
+		Long:    long,
+		Example: example,
+		Args:    cobra.ExactArgs(1),
+		Run:     rosa.DefaultRunner(rosa.RuntimeWithOCM(), DeleteScalingScheduleRunner()),
+	}
+
+	ocm.AddClusterFlag(cmd)
+	return cmd
+}
+
+func DeleteScalingScheduleRunner() rosa.CommandRunner {
+	return func(_ context.Context, r *rosa.Runtime, _ *cobra.Command, argv []string) error {
+		name := argv[0]
+		clusterKey := r.GetClusterKey()
+		cluster, err := r.OCMClient.GetCluster(clusterKey, r.Creator)
+		if err != nil {
+			return err
+		}
+
+		schedules, err := r.OCMClient.GetScalingSchedules(cluster)
+		if err != nil {
+			return fmt.Errorf("Failed to get scaling schedules for cluster '%s': %v", clusterKey, err)
+		}
+		found := false
+		for _, schedule := range schedules {
+			if schedule.Name == name {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return errors.NotFound.Errorf("Scaling schedule '%s' does not exist on cluster '%s'", name, clusterKey)
+		}
+
+		if !confirm.Confirm("delete scaling schedule '%s' on cluster '%s'", name, clusterKey) {
+			return nil
+		}
+		err = r.OCMClient.DeleteScalingSchedule(cluster, name)
+		if err != nil {
+			return fmt.Errorf("Failed to delete scaling schedule '%s' on cluster '%s': %v", name, clusterKey, err)
+		}
+		r.Reporter.Infof("Scaling schedule '%s' deleted from cluster '%s'", name, clusterKey)
+		return nil
+	}
+}
@@ -26,6 +26,7 @@ import (
 
 	"github.com/openshift/rosa/pkg/interactive/confirm"
 	"github.com/openshift/rosa/pkg/ocm"
+	"github.com/openshift/rosa/pkg/reporter"
 	"github.com/openshift/rosa/pkg/rosa"
 )
 
@@ -62,7 +63,7 @@ func run(cmd *cobra.Command, _ []string) {
 	if args.ID == "" {
 		r.Reporter.Errorf("id not specified.")
 		cmd.Help()
-		os.Exit(1)
+		os.Exit(reporter.ExitCode())
 	}
 
 	if !confirm.Confirm("delete service with id '%s'", args.ID) {
@@ -74,14 +75,14 @@ func run(cmd *cobra.Command, _ []string) {
 	service, err := r.OCMClient.GetManagedService(ocm.DescribeManagedServiceArgs{ID: args.ID})
 	if err != nil {
 		r.Reporter.Errorf("Failed to get Managed Service: %s", err)
-		os.Exit(1)
+		os.Exit(reporter.ExitCode())
 	}
 
 	r.Reporter.Debugf("Deleting service with id %q", args.ID)
 	_, err = r.OCMClient.DeleteManagedService(args)
 	if err != nil {
 		r.Reporter.Errorf("%s", err)
-		os.Exit(1)
+		os.Exit(reporter.ExitCode())
 	}
 	r.Reporter.Infof("Service %q will start uninstalling now", args.ID)
 
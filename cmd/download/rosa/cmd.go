@@ -54,7 +54,7 @@ func run(_ *cobra.Command, _ []string) {
 	err := helper.Download(downloadURL, filename)
 	if err != nil {
 		reporter.Errorf("%s", err)
-		os.Exit(1)
+		os.Exit(rprtr.ExitCode())
 	}
 
 	reporter.Infof("Successfully downloaded %s", filename)
@@ -27,6 +27,7 @@ import (
 	"github.com/openshift/rosa/pkg/clusterautoscaler"
 	"github.com/openshift/rosa/pkg/interactive"
 	"github.com/openshift/rosa/pkg/ocm"
+	"github.com/openshift/rosa/pkg/reporter"
 	"github.com/openshift/rosa/pkg/rosa"
 )
 
@@ -74,25 +75,25 @@ func run(cmd *cobra.Command, _ []string) {
 
 	if cluster.Hypershift().Enabled() {
 		r.Reporter.Errorf("Hosted Control Plane clusters do not support cluster-autoscaler configuration")
-		os.Exit(1)
+		os.Exit(reporter.ExitCode())
 	}
 
 	if cluster.State() != cmv1.ClusterStateReady {
 		r.Reporter.Errorf("Cluster '%s' is not yet ready. Current state is '%s'", clusterKey, cluster.State())
-		os.Exit(1)
+		os.Exit(reporter.ExitCode())
 	}
 
 	autoscaler, err := r.OCMClient.GetClusterAutoscaler(cluster.ID())
 	if err != nil {
 		r.Reporter.Errorf("Failed updating autoscaler configuration for cluster '%s': %s",
 			cluster.ID(), err)
-		os.Exit(1)
+		os.Exit(reporter.ExitCode())
 	}
 
 	if autoscaler == nil {
 		r.Reporter.Errorf("No autoscaler for cluster '%s' has been found. "+
 			"You should first create it via 'rosa create autoscaler'", clusterKey)
-		os.Exit(1)
+		os.Exit(reporter.ExitCode())
 	}
 
 	if !clusterautoscaler.IsAutoscalerSetViaCLI(cmd.Flags(), argsPrefix) && !interactive.Enabled() {
@@ -135,7 +136,7 @@ func run(cmd *cobra.Command, _ []string) {
 		if err != nil {
 			r.Reporter.Errorf("Failed updating autoscaler configuration for cluster '%s': %s",
 				cluster.ID(), err)
-			os.Exit(1)
+			os.Exit(reporter.ExitCode())
 		}
 		autoscalerArgs.ScaleDown.UtilizationThreshold = utilizationThreshold
 	}
@@ -144,21 +145,21 @@ func run(cmd *cobra.Command, _ []string) {
 	if err != nil {
 		r.Reporter.Errorf("Failed updating autoscaler configuration for cluster '%s': %s",
 			cluster.ID(), err)
-		os.Exit(1)
+		os.Exit(reporter.ExitCode())
 	}
 
 	autoscalerConfig, err := clusterautoscaler.CreateAutoscalerConfig(autoscalerArgs)
 	if err != nil {
 		r.Reporter.Errorf("Failed updating autoscaler configuration for cluster '%s': %s",
 			cluster.ID(), err)
-		os.Exit(1)
+		os.Exit(reporter.ExitCode())
 	}
 
 	_, err = r.OCMClient.UpdateClusterAutoscaler(cluster.ID(), autoscalerConfig)
 	if err != nil {
 		r.Reporter.Errorf("Failed updating autoscaler configuration for cluster '%s': %s",
 			cluster.ID(), err)
-		os.Exit(1)
+		os.Exit(reporter.ExitCode())
 	}
 
 	r.Reporter.Infof("Successfully updated autoscaler configuration for cluster '%s'", cluster.ID())
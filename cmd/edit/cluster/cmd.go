@@ -19,6 +19,7 @@ package cluster
 import (
 	"errors"
 	"fmt"
+	"io"
 	"os"
 	"strings"
 	"time"
@@ -27,11 +28,13 @@ import (
 	"github.com/spf13/cobra"
 
 	"github.com/openshift/rosa/pkg/aws"
+	"github.com/openshift/rosa/pkg/diff"
 	"github.com/openshift/rosa/pkg/helper"
 	"github.com/openshift/rosa/pkg/input"
 	"github.com/openshift/rosa/pkg/interactive"
 	"github.com/openshift/rosa/pkg/interactive/confirm"
 	"github.com/openshift/rosa/pkg/ocm"
+	"github.com/openshift/rosa/pkg/reporter"
 	"github.com/openshift/rosa/pkg/rosa"
 )
 
@@ -63,7 +66,10 @@ var Cmd = &cobra.Command{
   rosa edit cluster -c mycluster --private
 
   # Edit all options interactively
-  rosa edit cluster -c mycluster --interactive`,
+  rosa edit cluster -c mycluster --interactive
+
+  # Show the changes that making the cluster private would make without applying them
+  rosa edit cluster -c mycluster --private --dry-run`,
 	Run:  run,
 	Args: cobra.NoArgs,
 }
@@ -74,6 +80,7 @@ func init() {
 
 	ocm.AddClusterFlag(Cmd)
 	confirm.AddFlag(Cmd.Flags())
+	diff.AddFlag(flags)
 
 	// Basic options
 	flags.StringVar(
@@ -176,7 +183,7 @@ func run(cmd *cobra.Command, _ []string) {
 	expiration, err := validateExpiration()
 	if err != nil {
 		r.Reporter.Errorf(fmt.Sprintf("%s", err))
-		os.Exit(1)
+		os.Exit(reporter.ExitCode())
 	}
 
 	if interactive.Enabled() {
@@ -227,7 +234,7 @@ func run(cmd *cobra.Command, _ []string) {
 			len(noProxySlice) > 0 ||
 			(additionalTrustBundleFile != nil && *additionalTrustBundleFile != "")) {
 		r.Reporter.Errorf("Cluster-wide proxy is not supported on clusters using the default VPC")
-		os.Exit(1)
+		os.Exit(reporter.ExitCode())
 	}
 
 	var private *bool
@@ -245,7 +252,7 @@ func run(cmd *cobra.Command, _ []string) {
 	privateWarning, err = warnUserForOAuthHCPVisibility(r, clusterKey, cluster, privateWarning)
 	if err != nil {
 		r.Reporter.Errorf("%v", err)
-		os.Exit(1)
+		os.Exit(reporter.ExitCode())
 	}
 	if interactive.Enabled() {
 		privateValue, err = interactive.GetBool(interactive.Input{
@@ -255,12 +262,13 @@ func run(cmd *cobra.Command, _ []string) {
 		})
 		if err != nil {
 			r.Reporter.Errorf("Expected a valid private value: %s", err)
-			os.Exit(1)
+			os.Exit(reporter.ExitCode())
 		}
 		private = &privateValue
 	} else if privateValue {
 		r.Reporter.Warnf("You are choosing to make your cluster API private. %s", privateWarning)
-		if !confirm.Confirm("set cluster '%s' as private", clusterKey) {
+		// A dry run doesn't change the cluster, so there is nothing to confirm
+		if !diff.Enabled() && !confirm.Confirm("set cluster '%s' as private", clusterKey) {
 			os.Exit(0)
 		}
 	}
@@ -283,10 +291,10 @@ func run(cmd *cobra.Command, _ []string) {
 		})
 		if err != nil {
 			r.Reporter.Errorf("Expected a valid disable-workload-monitoring value: %v", err)
-			os.Exit(1)
+			os.Exit(reporter.ExitCode())
 		}
 		disableWorkloadMonitoring = &disableWorkloadMonitoringValue
-	} else if disableWorkloadMonitoringValue {
+	} else if disableWorkloadMonitoringValue && !diff.Enabled() {
 		if !confirm.Confirm("disable workload monitoring for your cluster %s", clusterKey) {
 			os.Exit(0)
 		}
@@ -304,7 +312,7 @@ func run(cmd *cobra.Command, _ []string) {
 		})
 		if err != nil {
 			r.Reporter.Errorf("Expected a valid proxy-enabled value: %s", err)
-			os.Exit(1)
+			os.Exit(reporter.ExitCode())
 		}
 		enableProxy = enableProxyValue
 	}
@@ -338,7 +346,7 @@ func run(cmd *cobra.Command, _ []string) {
 		})
 		if err != nil {
 			r.Reporter.Errorf("Expected a valid http proxy: %s", err)
-			os.Exit(1)
+			os.Exit(reporter.ExitCode())
 		}
 
 		if len(httpProxyValue) == 0 {
@@ -356,7 +364,7 @@ func run(cmd *cobra.Command, _ []string) {
 		err = ocm.ValidateHTTPProxy(*httpProxy)
 		if err != nil {
 			r.Reporter.Errorf("%s", err)
-			os.Exit(1)
+			os.Exit(reporter.ExitCode())
 		}
 	}
 
@@ -380,7 +388,7 @@ func run(cmd *cobra.Command, _ []string) {
 		})
 		if err != nil {
 			r.Reporter.Errorf("Expected a valid https proxy: %s", err)
-			os.Exit(1)
+			os.Exit(reporter.ExitCode())
 		}
 		if len(httpsProxyValue) == 0 {
 			//user skipped the prompt by pressing 'enter'
@@ -397,7 +405,7 @@ func run(cmd *cobra.Command, _ []string) {
 		err = interactive.IsURL(*httpsProxy)
 		if err != nil {
 			r.Reporter.Errorf("%s", err)
-			os.Exit(1)
+			os.Exit(reporter.ExitCode())
 		}
 	}
 
@@ -414,13 +422,13 @@ func run(cmd *cobra.Command, _ []string) {
 		})
 		if err != nil {
 			r.Reporter.Errorf("Expected a valid set of no proxy domains/CIDR's: %s", err)
-			os.Exit(1)
+			os.Exit(reporter.ExitCode())
 		}
 		noProxySlice = helper.HandleEmptyStringOnSlice(strings.Split(noProxyInput, ","))
 	}
 	if isExpectedHTTPProxyOrHTTPSProxy(httpProxy, httpsProxy, noProxySlice, cluster) {
 		r.Reporter.Errorf("Expected at least one of the following: http-proxy, https-proxy")
-		os.Exit(1)
+		os.Exit(reporter.ExitCode())
 	}
 
 	if len(noProxySlice) > 0 {
@@ -431,13 +439,13 @@ func run(cmd *cobra.Command, _ []string) {
 		duplicate, found := aws.HasDuplicates(noProxySlice)
 		if found {
 			r.Reporter.Errorf("Invalid no-proxy list, duplicate key '%s' found", duplicate)
-			os.Exit(1)
+			os.Exit(reporter.ExitCode())
 		}
 		for _, domain := range noProxySlice {
 			err := aws.UserNoProxyValidator(domain)
 			if err != nil {
 				r.Reporter.Errorf("%s", err)
-				os.Exit(1)
+				os.Exit(reporter.ExitCode())
 			}
 		}
 	}
@@ -455,7 +463,7 @@ func run(cmd *cobra.Command, _ []string) {
 		})
 		if err != nil {
 			r.Reporter.Errorf("Expected a valid -update-additional-trust-bundle value: %s", err)
-			os.Exit(1)
+			os.Exit(reporter.ExitCode())
 		}
 		updateAdditionalTrustBundle = updateAdditionalTrustBundleValue
 	}
@@ -478,7 +486,7 @@ func run(cmd *cobra.Command, _ []string) {
 		})
 		if err != nil {
 			r.Reporter.Errorf("Expected a valid additional trust bundle file name: %s", err)
-			os.Exit(1)
+			os.Exit(reporter.ExitCode())
 		}
 
 		if len(additionalTrustBundleFileValue) == 0 {
@@ -496,7 +504,7 @@ func run(cmd *cobra.Command, _ []string) {
 		err = ocm.ValidateAdditionalTrustBundle(*additionalTrustBundleFile)
 		if err != nil {
 			r.Reporter.Errorf("%s", err)
-			os.Exit(1)
+			os.Exit(reporter.ExitCode())
 		}
 	}
 
@@ -504,13 +512,13 @@ func run(cmd *cobra.Command, _ []string) {
 	auditLogRole, err := setAuditLogForwarding(r, cmd, cluster, args.auditLogRoleARN)
 	if err != nil {
 		r.Reporter.Errorf("%s", err)
-		os.Exit(1)
+		os.Exit(reporter.ExitCode())
 	}
 	if interactive.Enabled() && aws.IsHostedCP(cluster) {
 		auditLogRole, err = auditLogInteractivePrompt(r, cmd, cluster)
 		if err != nil {
 			r.Reporter.Errorf("%s", err)
-			os.Exit(1)
+			os.Exit(reporter.ExitCode())
 		}
 	}
 
@@ -542,7 +550,7 @@ func run(cmd *cobra.Command, _ []string) {
 				cert, err := os.ReadFile(*additionalTrustBundleFile)
 				if err != nil {
 					r.Reporter.Errorf("Failed to read additional trust bundle file: %s", err)
-					os.Exit(1)
+					os.Exit(reporter.ExitCode())
 				}
 				*clusterConfig.AdditionalTrustBundle = string(cert)
 			}
@@ -570,21 +578,25 @@ func run(cmd *cobra.Command, _ []string) {
 		})
 		if err != nil {
 			r.Reporter.Errorf("Expected a valid value: %v", err)
-			os.Exit(1)
+			os.Exit(reporter.ExitCode())
 		}
 	}
 
+	if diff.Enabled() {
+		os.Exit(printClusterChanges(r, clusterKey, cluster, clusterConfig, deleteProtection))
+	}
+
 	if cluster.DeleteProtection().Enabled() != deleteProtection {
 		r.Reporter.Debugf("Updating cluster deletion protection to : %t", deleteProtection)
 		newDeleteProtection, err := cmv1.NewDeleteProtection().Enabled(deleteProtection).Build()
 		if err != nil {
 			r.Reporter.Errorf("Failed to build delete protection: %v", err)
-			os.Exit(1)
+			os.Exit(reporter.ExitCode())
 		}
 
 		if err := r.OCMClient.UpdateClusterDeletionProtection(cluster.ID(), newDeleteProtection); err != nil {
 			r.Reporter.Errorf("Failed to update cluster delete protection: %v", err)
-			os.Exit(1)
+			os.Exit(reporter.ExitCode())
 		}
 	}
 
@@ -592,11 +604,37 @@ func run(cmd *cobra.Command, _ []string) {
 	err = r.OCMClient.UpdateCluster(cluster.ID(), r.Creator, clusterConfig)
 	if err != nil {
 		r.Reporter.Errorf("Failed to update cluster: %v", err)
-		os.Exit(1)
+		os.Exit(reporter.ExitCode())
 	}
 	r.Reporter.Infof("Updated cluster '%s'", clusterKey)
 }
 
+// printClusterChanges prints the differences between the cluster and the patch that would be sent
+// to update it, including the deletion protection that is updated separately, and returns the exit
+// code of the dry run.
+func printClusterChanges(r *rosa.Runtime, clusterKey string, cluster *cmv1.Cluster, clusterConfig ocm.Spec,
+	deleteProtection bool) int {
+	patch, err := r.OCMClient.BuildClusterUpdate(clusterConfig)
+	if err != nil {
+		r.Reporter.Errorf("Failed to build cluster update: %v", err)
+		os.Exit(reporter.ExitCode())
+	}
+	desired, err := diff.Of(func(w io.Writer) error { return cmv1.MarshalCluster(patch, w) })
+	if err != nil {
+		r.Reporter.Errorf("Failed to compare cluster '%s': %v", clusterKey, err)
+		os.Exit(reporter.ExitCode())
+	}
+	if cluster.DeleteProtection().Enabled() != deleteProtection {
+		desired["delete_protection"] = map[string]interface{}{"enabled": deleteProtection}
+	}
+	current, err := diff.Of(func(w io.Writer) error { return cmv1.MarshalCluster(cluster, w) })
+	if err != nil {
+		r.Reporter.Errorf("Failed to compare cluster '%s': %v", clusterKey, err)
+		os.Exit(reporter.ExitCode())
+	}
+	return diff.Print(fmt.Sprintf("cluster '%s'", clusterKey), diff.Fields(desired, current))
+}
+
 // warnUserForOAuthHCPVisibility is a method for HCP only that checks if the user has public ingress and warns them
 // about how changing cluster visibility may impact them
 func warnUserForOAuthHCPVisibility(r *rosa.Runtime, clusterKey string, cluster *cmv1.Cluster,
@@ -698,7 +736,9 @@ func setAuditLogForwarding(r *rosa.Runtime, cmd *cobra.Command, cluster *cmv1.Cl
 }
 
 func confirmAuditLogForwarding(r *rosa.Runtime, auditLogArn *string) {
-
+	if diff.Enabled() {
+		return
+	}
 	if *auditLogArn != "" {
 		r.Reporter.Warnf("You are choosing to enable audit log forwarding")
 		if !confirm.Confirm("enable audit log forwarding for cluster with the provided role arn '%s'", *auditLogArn) {
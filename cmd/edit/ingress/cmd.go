@@ -18,6 +18,7 @@ package ingress
 
 import (
 	"fmt"
+	"io"
 	"os"
 	"reflect"
 	"regexp"
@@ -27,12 +28,14 @@ import (
 	"github.com/spf13/cobra"
 	"github.com/spf13/pflag"
 
+	"github.com/openshift/rosa/pkg/diff"
 	utils "github.com/openshift/rosa/pkg/helper"
 	helper "github.com/openshift/rosa/pkg/ingress"
 	"github.com/openshift/rosa/pkg/interactive"
 	"github.com/openshift/rosa/pkg/interactive/confirm"
 	"github.com/openshift/rosa/pkg/interactive/consts"
 	"github.com/openshift/rosa/pkg/ocm"
+	"github.com/openshift/rosa/pkg/reporter"
 	"github.com/openshift/rosa/pkg/rosa"
 )
 
@@ -57,7 +60,10 @@ var Cmd = &cobra.Command{
   rosa edit ingress --private=false --cluster=mycluster apps
 
   # Update the load balancer type of the apps2 ingress 
-  rosa edit ingress --lb-type=nlb --cluster=mycluster apps2`,
+  rosa edit ingress --lb-type=nlb --cluster=mycluster apps2
+
+  # Show the changes that making the default ingress private would make without applying them
+  rosa edit ingress --private --cluster=mycluster apps --dry-run`,
 	Run: run,
 	Args: func(_ *cobra.Command, argv []string) error {
 		if len(argv) != 1 {
@@ -131,6 +137,7 @@ func init() {
 	)
 
 	addIngressV2Flags(flags)
+	diff.AddFlag(flags)
 
 	Cmd.RegisterFlagCompletionFunc(lbTypeFlag, lbTypeCompletion)
 	Cmd.RegisterFlagCompletionFunc(wildcardPolicyFlag, wildcardPoliciesTypeCompletion)
@@ -162,7 +169,7 @@ func run(cmd *cobra.Command, argv []string) {
 			"Ingress  identifier '%s' isn't valid: it must contain only letters or digits",
 			ingressID,
 		)
-		os.Exit(1)
+		os.Exit(reporter.ExitCode())
 	}
 
 	clusterKey := r.GetClusterKey()
@@ -181,7 +188,7 @@ func run(cmd *cobra.Command, argv []string) {
 		hasLegacyIngressSupport, err = r.OCMClient.HasLegacyIngressSupport(cluster)
 		if err != nil {
 			r.Reporter.Errorf("There was a problem checking version compatibility: %v", err)
-			os.Exit(1)
+			os.Exit(reporter.ExitCode())
 		}
 	}
 
@@ -191,12 +198,12 @@ func run(cmd *cobra.Command, argv []string) {
 				"New ingress attributes %s can't be supplied for Hosted Control Plane clusters",
 				utils.SliceToSortedString(exclusivelyIngressV2Flags),
 			)
-			os.Exit(1)
+			os.Exit(reporter.ExitCode())
 		} else if hasLegacyIngressSupport {
 			r.Reporter.Errorf("New ingress attributes %s can't be supplied for legacy supported clusters."+
 				" For more information on how to be supported please check: %s",
 				utils.SliceToSortedString(exclusivelyIngressV2Flags), ingressV2DocLink)
-			os.Exit(1)
+			os.Exit(reporter.ExitCode())
 		}
 	}
 
@@ -204,7 +211,7 @@ func run(cmd *cobra.Command, argv []string) {
 		r.Reporter.Errorf(
 			"Classic cluster '%s' is PrivateLink on legacy ingress support and does not allow updating ingresses",
 			clusterKey)
-		os.Exit(1)
+		os.Exit(reporter.ExitCode())
 	}
 
 	var private *bool
@@ -218,7 +225,7 @@ func run(cmd *cobra.Command, argv []string) {
 		})
 		if err != nil {
 			r.Reporter.Errorf("Expected a valid private value: %s", err)
-			os.Exit(1)
+			os.Exit(reporter.ExitCode())
 		}
 		private = &privArg
 	}
@@ -231,7 +238,7 @@ func run(cmd *cobra.Command, argv []string) {
 		err := r.OCMClient.UpdateCluster(clusterKey, r.Creator, clusterConfig)
 		if err != nil {
 			r.Reporter.Errorf("Failed to update cluster API on cluster '%s': %v", clusterKey, err)
-			os.Exit(1)
+			os.Exit(reporter.ExitCode())
 		}
 		r.Reporter.Infof("Updated ingress '%s' on cluster '%s'", ingressID, clusterKey)
 		os.Exit(0)
@@ -242,7 +249,7 @@ func run(cmd *cobra.Command, argv []string) {
 	ingresses, err := r.OCMClient.GetIngresses(cluster.ID())
 	if err != nil {
 		r.Reporter.Errorf("Failed to get ingresses for cluster '%s': %v", clusterKey, err)
-		os.Exit(1)
+		os.Exit(reporter.ExitCode())
 	}
 
 	var ingress *cmv1.Ingress
@@ -259,18 +266,18 @@ func run(cmd *cobra.Command, argv []string) {
 	}
 	if ingress == nil {
 		r.Reporter.Errorf("Failed to get ingress '%s' for cluster '%s'", ingressID, clusterKey)
-		os.Exit(1)
+		os.Exit(reporter.ExitCode())
 	}
 
 	var routeSelector *string
 	if cmd.Flags().Changed(routeSelectorFlag) || cmd.Flags().Changed(labelMatchFlag) {
 		if ocm.IsHyperShiftCluster(cluster) {
 			r.Reporter.Errorf("Updating route selectors is not supported for Hosted Control Plane clusters")
-			os.Exit(1)
+			os.Exit(reporter.ExitCode())
 		}
 		if ingress.Default() && hasLegacyIngressSupport {
 			r.Reporter.Errorf("Updating route selectors for default ingress is not allowed for legacy ingress support")
-			os.Exit(1)
+			os.Exit(reporter.ExitCode())
 		}
 		routeSelector = &args.routeSelector
 	} else if interactive.Enabled() && !ocm.IsHyperShiftCluster(cluster) &&
@@ -288,7 +295,7 @@ func run(cmd *cobra.Command, argv []string) {
 		})
 		if err != nil {
 			r.Reporter.Errorf("Expected a valid comma-separated list of attributes: %s", err)
-			os.Exit(1)
+			os.Exit(reporter.ExitCode())
 		}
 		routeSelector = &routeSelectorArg
 	}
@@ -297,11 +304,11 @@ func run(cmd *cobra.Command, argv []string) {
 	if cmd.Flags().Changed(lbTypeFlag) {
 		if ocm.IsHyperShiftCluster(cluster) {
 			r.Reporter.Errorf("Updating Load Balancer Type is not supported for Hosted Control Plane clusters")
-			os.Exit(1)
+			os.Exit(reporter.ExitCode())
 		}
 		if ocm.IsSts(cluster) && hasLegacyIngressSupport {
 			r.Reporter.Errorf("Updating Load Balancer Type is not supported for STS clusters on legacy ingress support")
-			os.Exit(1)
+			os.Exit(reporter.ExitCode())
 		}
 		lbType = &args.lbType
 	} else if interactive.Enabled() && (!ocm.IsHyperShiftCluster(cluster) &&
@@ -318,7 +325,7 @@ func run(cmd *cobra.Command, argv []string) {
 		})
 		if err != nil {
 			r.Reporter.Errorf("Expected a valid Load Balancer type: %s", err)
-			os.Exit(1)
+			os.Exit(reporter.ExitCode())
 		}
 		lbType = &lbTypeArg
 	}
@@ -334,7 +341,7 @@ func run(cmd *cobra.Command, argv []string) {
 		if cmd.Flags().Changed(excludedNamespacesFlag) {
 			if ocm.IsHyperShiftCluster(cluster) {
 				r.Reporter.Errorf("Updating excluded namespace is not supported for Hosted Control Plane clusters")
-				os.Exit(1)
+				os.Exit(reporter.ExitCode())
 			}
 			excludedNamespaces = &args.excludedNamespaces
 		} else if isInteractiveEnabledAndNotHcp {
@@ -345,14 +352,14 @@ func run(cmd *cobra.Command, argv []string) {
 			})
 			if err != nil {
 				r.Reporter.Errorf("Expected a valid comma-separated list of attributes: %s", err)
-				os.Exit(1)
+				os.Exit(reporter.ExitCode())
 			}
 			excludedNamespaces = &excludedNamespacesArg
 		}
 		if cmd.Flags().Changed(wildcardPolicyFlag) {
 			if ocm.IsHyperShiftCluster(cluster) {
 				r.Reporter.Errorf("Updating Wildcard Policy is not supported for Hosted Control Plane clusters")
-				os.Exit(1)
+				os.Exit(reporter.ExitCode())
 			}
 			wildcardPolicy = &args.wildcardPolicy
 		} else if isInteractiveEnabledAndNotHcp {
@@ -364,7 +371,7 @@ func run(cmd *cobra.Command, argv []string) {
 			})
 			if err != nil {
 				r.Reporter.Errorf("Expected a valid Wildcard Policy: %s", err)
-				os.Exit(1)
+				os.Exit(reporter.ExitCode())
 			}
 			wildcardPolicy = &wildcardPolicyArg
 		}
@@ -373,7 +380,7 @@ func run(cmd *cobra.Command, argv []string) {
 				r.Reporter.Errorf(
 					"Updating Namespace Ownership Policy is not supported for Hosted Control Plane clusters",
 				)
-				os.Exit(1)
+				os.Exit(reporter.ExitCode())
 			}
 			namespaceOwnershipPolicy = &args.namespaceOwnershipPolicy
 		} else if isInteractiveEnabledAndNotHcp {
@@ -385,7 +392,7 @@ func run(cmd *cobra.Command, argv []string) {
 			})
 			if err != nil {
 				r.Reporter.Errorf("Expected a valid Namespace Ownership Policy: %s", err)
-				os.Exit(1)
+				os.Exit(reporter.ExitCode())
 			}
 			namespaceOwnershipPolicy = &namespaceOwnershipPolicyArg
 		}
@@ -396,7 +403,7 @@ func run(cmd *cobra.Command, argv []string) {
 		if cmd.Flags().Changed(clusterRoutesHostnameFlag) {
 			if ocm.IsHyperShiftCluster(cluster) {
 				r.Reporter.Errorf("Updating Cluster Routes Hostname is not supported for Hosted Control Plane clusters")
-				os.Exit(1)
+				os.Exit(reporter.ExitCode())
 			}
 			clusterRoutesHostname = &args.clusterRoutesHostname
 		}
@@ -405,7 +412,7 @@ func run(cmd *cobra.Command, argv []string) {
 				r.Reporter.Errorf(
 					"Updating Cluster Routes TLS Secret Reference is not supported for Hosted Control Plane clusters",
 				)
-				os.Exit(1)
+				os.Exit(reporter.ExitCode())
 			}
 			clusterRoutesTlsSecretRef = &args.clusterRoutesTlsSecretRef
 		}
@@ -415,12 +422,12 @@ func run(cmd *cobra.Command, argv []string) {
 				r.Reporter.Errorf(
 					"Updating Cluster Component Routes is not supported for Hosted Control Plane clusters",
 				)
-				os.Exit(1)
+				os.Exit(reporter.ExitCode())
 			}
 			componentRoutes, err = parseComponentRoutes(args.componentRoutes)
 			if err != nil {
 				r.Reporter.Errorf("An error occurred whilst parsing the supplied component routes: %s", err)
-				os.Exit(1)
+				os.Exit(reporter.ExitCode())
 			}
 		} else if isInteractiveEnabledAndNotHcp {
 			componentRoutes = map[string]*cmv1.ComponentRouteBuilder{}
@@ -441,7 +448,7 @@ func run(cmd *cobra.Command, argv []string) {
 						})
 						if err != nil {
 							r.Reporter.Errorf("Expected a valid component route '%s': %s", parameterName, err)
-							os.Exit(1)
+							os.Exit(reporter.ExitCode())
 						}
 						// TODO: use reflection, couldn't get it to work
 						if parameterName == hostnameParameter {
@@ -456,6 +463,7 @@ func run(cmd *cobra.Command, argv []string) {
 		}
 	}
 
+	current := ingress
 	curListening := ingress.Listening()
 	curRouteSelectors := ingress.RouteSelectors()
 	curLbType := ingress.LoadBalancerType()
@@ -482,7 +490,7 @@ func run(cmd *cobra.Command, argv []string) {
 			routeSelectors, err = helper.GetRouteSelector(*routeSelector)
 			if err != nil {
 				r.Reporter.Errorf("%s", err)
-				os.Exit(1)
+				os.Exit(reporter.ExitCode())
 			}
 		}
 		ingressBuilder = ingressBuilder.RouteSelectors(routeSelectors)
@@ -523,7 +531,7 @@ func run(cmd *cobra.Command, argv []string) {
 	ingress, err = ingressBuilder.Build()
 	if err != nil {
 		r.Reporter.Errorf("Failed to create ingress for cluster '%s': %v", clusterKey, err)
-		os.Exit(1)
+		os.Exit(reporter.ExitCode())
 	}
 
 	sameRouteSelectors := routeSelector == nil || reflect.DeepEqual(curRouteSelectors, ingress.RouteSelectors())
@@ -556,12 +564,24 @@ func run(cmd *cobra.Command, argv []string) {
 		os.Exit(0)
 	}
 
+	if diff.Enabled() {
+		changes, err := diff.Objects(
+			func(w io.Writer) error { return cmv1.MarshalIngress(ingress, w) },
+			func(w io.Writer) error { return cmv1.MarshalIngress(current, w) },
+		)
+		if err != nil {
+			r.Reporter.Errorf("Failed to compare ingress '%s' on cluster '%s': %v", ingress.ID(), clusterKey, err)
+			os.Exit(reporter.ExitCode())
+		}
+		os.Exit(diff.Print(fmt.Sprintf("ingress '%s' on cluster '%s'", ingress.ID(), clusterKey), changes))
+	}
+
 	r.Reporter.Debugf("Updating ingress '%s' on cluster '%s'", ingress.ID(), clusterKey)
 	_, err = r.OCMClient.UpdateIngress(cluster.ID(), ingress)
 	if err != nil {
 		r.Reporter.Errorf("Failed to update ingress '%s' on cluster '%s': %s",
 			ingress.ID(), clusterKey, err)
-		os.Exit(1)
+		os.Exit(reporter.ExitCode())
 	}
 	r.Reporter.Infof("Updated ingress '%s' on cluster '%s'", ingress.ID(), clusterKey)
 }
@@ -27,6 +27,7 @@ import (
 	"github.com/openshift/rosa/pkg/interactive/confirm"
 	. "github.com/openshift/rosa/pkg/kubeletconfig"
 	"github.com/openshift/rosa/pkg/ocm"
+	"github.com/openshift/rosa/pkg/reporter"
 	"github.com/openshift/rosa/pkg/rosa"
 )
 
@@ -70,32 +71,32 @@ func run(_ *cobra.Command, _ []string) {
 
 	if cluster.Hypershift().Enabled() {
 		r.Reporter.Errorf("Hosted Control Plane clusters do not support KubeletConfig configuration")
-		os.Exit(1)
+		os.Exit(reporter.ExitCode())
 	}
 
 	if cluster.State() != cmv1.ClusterStateReady {
 		r.Reporter.Errorf("Cluster '%s' is not yet ready. Current state is '%s'", clusterKey, cluster.State())
-		os.Exit(1)
+		os.Exit(reporter.ExitCode())
 	}
 
 	kubeletconfig, err := r.OCMClient.GetClusterKubeletConfig(cluster.ID())
 	if err != nil {
 		r.Reporter.Errorf("Failed to fetch existing KubeletConfig configuration for cluster '%s': %s",
 			clusterKey, err)
-		os.Exit(1)
+		os.Exit(reporter.ExitCode())
 	}
 
 	if kubeletconfig == nil {
 		r.Reporter.Errorf("No KubeletConfig for cluster '%s' has been found. "+
 			"You should first create it via 'rosa create kubeletconfig'", clusterKey)
-		os.Exit(1)
+		os.Exit(reporter.ExitCode())
 	}
 
 	r.Reporter.Debugf("Updating KubeletConfig for cluster '%s'", clusterKey)
 
 	requestedPids, err := ValidateOrPromptForRequestedPidsLimit(args.podPidsLimit, clusterKey, kubeletconfig, r)
 	if err != nil {
-		os.Exit(1)
+		os.Exit(reporter.ExitCode())
 	}
 
 	prompt := fmt.Sprintf("Updating the custom KubeletConfig for cluster '%s' will cause all non-Control Plane "+
@@ -107,7 +108,7 @@ func run(_ *cobra.Command, _ []string) {
 		if err != nil {
 			r.Reporter.Errorf("Failed creating custom KubeletConfig for cluster '%s': %s",
 				cluster.ID(), err)
-			os.Exit(1)
+			os.Exit(reporter.ExitCode())
 		}
 
 		r.Reporter.Infof("Successfully updated custom KubeletConfig for cluster '%s'", clusterKey)
@@ -0,0 +1,355 @@
+/*
+Copyright (c) 2024 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package machinepool
+
+import (
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strings"
+	"sync"
+	"text/tabwriter"
+
+	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+	"github.com/spf13/cobra"
+
+	"github.com/openshift/rosa/pkg/diff"
+	mpHelpers "github.com/openshift/rosa/pkg/helper/machinepools"
+	"github.com/openshift/rosa/pkg/interactive/confirm"
+	"github.com/openshift/rosa/pkg/rosa"
+)
+
+// Flags that can be applied to all the machine pools that match the selector.
+var selectorFlags = []string{"replicas", "labels", "add-labels", "taints", "autorepair"}
+
+// poolEdit is the change that will be made to one of the machine pools that match the selector.
+type poolEdit struct {
+	id      string
+	changes []diff.Field
+	// skipped is the reason why the machine pool can't be edited, if any
+	skipped string
+	update  func() error
+	err     error
+}
+
+// editMachinePools applies the changes given in the command line to all the machine pools of the
+// cluster whose labels match the selector. It prints the plan first, and then updates the machine
+// pools in parallel.
+func editMachinePools(cmd *cobra.Command, r *rosa.Runtime, clusterKey string, cluster *cmv1.Cluster) error {
+	edits, err := planMachinePoolEdits(cmd, r, clusterKey, cluster)
+	if err != nil {
+		return err
+	}
+	printMachinePoolEdits(edits, clusterKey)
+
+	pending := 0
+	for _, edit := range edits {
+		if edit.update != nil {
+			pending++
+		}
+	}
+	if diff.Enabled() {
+		if pending > 0 {
+			os.Exit(diff.ChangesExitCode)
+		}
+		return nil
+	}
+	if pending == 0 {
+		r.Reporter.Infof("No changes to machine pools on cluster '%s'", clusterKey)
+		return nil
+	}
+	if !confirm.Confirm("edit %d machine pools on cluster '%s'", pending, clusterKey) {
+		return nil
+	}
+
+	applyMachinePoolEdits(edits)
+	return printMachinePoolEditResults(edits, clusterKey)
+}
+
+// planMachinePoolEdits returns the changes that will be made to every machine pool that matches
+// the selector, sorted by identifier.
+func planMachinePoolEdits(cmd *cobra.Command, r *rosa.Runtime, clusterKey string,
+	cluster *cmv1.Cluster) ([]*poolEdit, error) {
+	selector, err := mpHelpers.ParseLabels(args.selector)
+	if err != nil {
+		return nil, fmt.Errorf("Invalid selector: %v", err)
+	}
+	if len(selector) == 0 {
+		return nil, fmt.Errorf("Expected at least one 'key=value' pair in the selector")
+	}
+	for _, flag := range []string{"enable-autoscaling", "min-replicas", "max-replicas", "version",
+		"tuning-configs", "node-drain-grace-period"} {
+		if cmd.Flags().Changed(flag) {
+			return nil, fmt.Errorf("Flag '--%s' can't be used with '--selector', supported flags are: --%s",
+				flag, strings.Join(selectorFlags, ", --"))
+		}
+	}
+	anySet := false
+	for _, flag := range selectorFlags {
+		anySet = anySet || cmd.Flags().Changed(flag)
+	}
+	if !anySet {
+		return nil, fmt.Errorf("Expected at least one of --%s to apply to the selected machine pools",
+			strings.Join(selectorFlags, ", --"))
+	}
+	if cmd.Flags().Changed("replicas") && args.replicas < 0 {
+		return nil, fmt.Errorf("The number of machine pool replicas needs to be a non-negative integer")
+	}
+	var labels map[string]string
+	if cmd.Flags().Changed("labels") {
+		labels, err = mpHelpers.ParseLabels(args.labels)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if cmd.Flags().Changed("add-labels") {
+		_, err = mpHelpers.ParseLabels(args.addLabels)
+		if err != nil {
+			return nil, err
+		}
+	}
+	var taints []*cmv1.TaintBuilder
+	if cmd.Flags().Changed("taints") {
+		taints, err = mpHelpers.ParseTaints(args.taints)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	var edits []*poolEdit
+	if cluster.Hypershift().Enabled() {
+		edits, err = planNodePoolEdits(cmd, r, clusterKey, cluster, selector, labels, taints)
+	} else {
+		if cmd.Flags().Changed("autorepair") {
+			return nil, fmt.Errorf("Setting the 'autorepair' flag is only supported for hosted clusters")
+		}
+		edits, err = planClassicMachinePoolEdits(cmd, r, clusterKey, cluster, selector, labels, taints)
+	}
+	if err != nil {
+		return nil, err
+	}
+	if len(edits) == 0 {
+		return nil, fmt.Errorf("There are no machine pools on cluster '%s' with labels matching '%s'",
+			clusterKey, args.selector)
+	}
+	sort.Slice(edits, func(i, j int) bool {
+		return edits[i].id < edits[j].id
+	})
+	return edits, nil
+}
+
+func planClassicMachinePoolEdits(cmd *cobra.Command, r *rosa.Runtime, clusterKey string, cluster *cmv1.Cluster,
+	selector map[string]string, labels map[string]string, taints []*cmv1.TaintBuilder) ([]*poolEdit, error) {
+	machinePools, err := r.OCMClient.GetMachinePools(cluster.ID())
+	if err != nil {
+		return nil, fmt.Errorf("Failed to get machine pools for cluster '%s': %v", clusterKey, err)
+	}
+	edits := []*poolEdit{}
+	for _, current := range machinePools {
+		if !matchesSelector(current.Labels(), selector) {
+			continue
+		}
+		edit := &poolEdit{id: current.ID()}
+		edits = append(edits, edit)
+
+		builder := cmv1.NewMachinePool().ID(current.ID())
+		if cmd.Flags().Changed("replicas") {
+			if current.Autoscaling() != nil {
+				edit.skipped = "Autoscaling is enabled, can't set replicas"
+				continue
+			}
+			if cluster.MultiAZ() && isMultiAZMachinePool(current) && args.replicas%3 != 0 {
+				edit.skipped = "Multi AZ machine pools require that the number of replicas be a multiple of 3"
+				continue
+			}
+			builder.Replicas(args.replicas)
+		}
+		labelMap, err := poolLabels(cmd, current.Labels(), labels)
+		if err != nil {
+			return nil, fmt.Errorf("Failed to edit machine pool '%s': %v", current.ID(), err)
+		}
+		if labelMap != nil {
+			builder.Labels(labelMap)
+		}
+		if taints != nil {
+			builder.Taints(taints...)
+		}
+		desired, err := builder.Build()
+		if err != nil {
+			return nil, fmt.Errorf("Failed to build machine pool '%s': %v", current.ID(), err)
+		}
+		edit.changes, err = diff.Objects(
+			func(w io.Writer) error { return cmv1.MarshalMachinePool(desired, w) },
+			func(w io.Writer) error { return cmv1.MarshalMachinePool(current, w) },
+		)
+		if err != nil {
+			return nil, fmt.Errorf("Failed to compare machine pool '%s': %v", current.ID(), err)
+		}
+		if len(edit.changes) > 0 {
+			edit.update = func() error {
+				_, err := r.OCMClient.UpdateMachinePool(cluster.ID(), desired)
+				return err
+			}
+		}
+	}
+	return edits, nil
+}
+
+func planNodePoolEdits(cmd *cobra.Command, r *rosa.Runtime, clusterKey string, cluster *cmv1.Cluster,
+	selector map[string]string, labels map[string]string, taints []*cmv1.TaintBuilder) ([]*poolEdit, error) {
+	nodePools, err := r.OCMClient.GetNodePools(cluster.ID())
+	if err != nil {
+		return nil, fmt.Errorf("Failed to get machine pools for hosted cluster '%s': %v", clusterKey, err)
+	}
+	edits := []*poolEdit{}
+	for _, current := range nodePools {
+		if !matchesSelector(current.Labels(), selector) {
+			continue
+		}
+		edit := &poolEdit{id: current.ID()}
+		edits = append(edits, edit)
+
+		builder := cmv1.NewNodePool().ID(current.ID())
+		if cmd.Flags().Changed("replicas") {
+			if current.Autoscaling() != nil {
+				edit.skipped = "Autoscaling is enabled, can't set replicas"
+				continue
+			}
+			builder.Replicas(args.replicas)
+		}
+		labelMap, err := poolLabels(cmd, current.Labels(), labels)
+		if err != nil {
+			return nil, fmt.Errorf("Failed to edit machine pool '%s': %v", current.ID(), err)
+		}
+		if labelMap != nil {
+			builder.Labels(labelMap)
+		}
+		if taints != nil {
+			builder.Taints(taints...)
+		}
+		if cmd.Flags().Changed("autorepair") {
+			builder.AutoRepair(args.autorepair)
+		}
+		desired, err := builder.Build()
+		if err != nil {
+			return nil, fmt.Errorf("Failed to build machine pool '%s': %v", current.ID(), err)
+		}
+		edit.changes, err = diff.Objects(
+			func(w io.Writer) error { return cmv1.MarshalNodePool(desired, w) },
+			func(w io.Writer) error { return cmv1.MarshalNodePool(current, w) },
+		)
+		if err != nil {
+			return nil, fmt.Errorf("Failed to compare machine pool '%s': %v", current.ID(), err)
+		}
+		if len(edit.changes) > 0 {
+			edit.update = func() error {
+				_, err := r.OCMClient.UpdateNodePool(cluster.ID(), desired)
+				return err
+			}
+		}
+	}
+	return edits, nil
+}
+
+// poolLabels returns the labels to set on a selected machine pool: the ones given in '--labels', which
+// replace the current ones, or the current ones with the ones given in '--add-labels' added. It returns
+// nil when none of these flags is used.
+func poolLabels(cmd *cobra.Command, current map[string]string, labels map[string]string) (map[string]string,
+	error) {
+	if cmd.Flags().Changed("add-labels") {
+		return mpHelpers.AddLabels(current, args.addLabels)
+	}
+	if labels == nil {
+		return nil, nil
+	}
+	err := mpHelpers.ValidatePoolLabels(labels, current)
+	if err != nil {
+		return nil, err
+	}
+	return labels, nil
+}
+
+// matchesSelector returns true if the labels contain all the key and value pairs of the selector.
+func matchesSelector(labels map[string]string, selector map[string]string) bool {
+	for key, value := range selector {
+		if actual, ok := labels[key]; !ok || actual != value {
+			return false
+		}
+	}
+	return true
+}
+
+func printMachinePoolEdits(edits []*poolEdit, clusterKey string) {
+	fmt.Printf("Machine pools on cluster '%s' matching '%s':\n\n", clusterKey, args.selector)
+	for _, edit := range edits {
+		switch {
+		case edit.skipped != "":
+			fmt.Printf("  %s: skipped, %s\n", edit.id, edit.skipped)
+		case len(edit.changes) == 0:
+			fmt.Printf("  %s: no changes\n", edit.id)
+		default:
+			fmt.Printf("  %s:\n%s", edit.id, diff.Format(edit.changes, "    "))
+		}
+	}
+	fmt.Println()
+}
+
+// applyMachinePoolEdits updates the machine pools in parallel and records the result of every
+// update in the edit.
+func applyMachinePoolEdits(edits []*poolEdit) {
+	var wg sync.WaitGroup
+	for _, edit := range edits {
+		if edit.update == nil {
+			continue
+		}
+		wg.Add(1)
+		go func(edit *poolEdit) {
+			defer wg.Done()
+			edit.err = edit.update()
+		}(edit)
+	}
+	wg.Wait()
+}
+
+func printMachinePoolEditResults(edits []*poolEdit, clusterKey string) error {
+	writer := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintf(writer, "MACHINE POOL\tRESULT\n")
+	failed := 0
+	updated := 0
+	for _, edit := range edits {
+		result := "Updated"
+		switch {
+		case edit.skipped != "":
+			result = "Skipped: " + edit.skipped
+		case edit.update == nil:
+			result = "No changes"
+		case edit.err != nil:
+			failed++
+			result = fmt.Sprintf("Failed: %v", edit.err)
+		default:
+			updated++
+		}
+		fmt.Fprintf(writer, "%s\t%s\n", edit.id, result)
+	}
+	writer.Flush()
+	if failed > 0 {
+		return fmt.Errorf("Failed to update %d of %d machine pools on cluster '%s'",
+			failed, failed+updated, clusterKey)
+	}
+	return nil
+}
@@ -0,0 +1,241 @@
+/*
+Copyright (c) 2024 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package machinepool
+
+import (
+	"net/http"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/ghttp"
+	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+	. "github.com/openshift-online/ocm-sdk-go/testing"
+	"github.com/spf13/cobra"
+	"github.com/spf13/pflag"
+
+	"github.com/openshift/rosa/pkg/rosa"
+	"github.com/openshift/rosa/pkg/test"
+)
+
+var _ = Describe("Edit machine pools by selector", func() {
+	var testRuntime test.TestingRuntime
+
+	setFlags := func(values map[string]string) {
+		Cmd.Flags().VisitAll(func(flag *pflag.Flag) {
+			flag.Changed = false
+		})
+		args.selector = ""
+		args.replicas = 0
+		args.labels = ""
+		args.addLabels = ""
+		args.taints = ""
+		for name, value := range values {
+			Expect(Cmd.Flags().Set(name, value)).To(Succeed())
+		}
+	}
+
+	BeforeEach(func() {
+		testRuntime.InitRuntime()
+	})
+
+	Context("Hosted clusters", func() {
+		cluster := test.MockCluster(func(c *cmv1.ClusterBuilder) {
+			c.Hypershift(cmv1.NewHypershift().Enabled(true))
+		})
+		nodePoolsPath := test.MockClusterHREF + "/node_pools"
+		nodePools := []*cmv1.NodePool{
+			mockNodePool(cmv1.NewNodePool().ID("web").Replicas(2).Labels(map[string]string{"env": "prod"})),
+			mockNodePool(cmv1.NewNodePool().ID("db").Labels(map[string]string{"env": "prod", "tier": "db"}).
+				Autoscaling(cmv1.NewNodePoolAutoscaling().MinReplica(1).MaxReplica(3))),
+			mockNodePool(cmv1.NewNodePool().ID("api").Replicas(4).Labels(map[string]string{"env": "prod"})),
+			mockNodePool(cmv1.NewNodePool().ID("dev").Replicas(2).Labels(map[string]string{"env": "dev"})),
+		}
+
+		It("Plans the changes of the matching node pools", func() {
+			setFlags(map[string]string{"selector": "env=prod", "replicas": "4"})
+			testRuntime.ApiServer.AppendHandlers(RespondWithJSON(http.StatusOK, test.FormatNodePoolList(nodePools)))
+
+			edits, err := planMachinePoolEdits(Cmd, testRuntime.RosaRuntime, "cluster1", cluster)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(edits).To(HaveLen(3))
+
+			Expect(edits[0].id).To(Equal("api"))
+			Expect(edits[0].changes).To(BeEmpty())
+			Expect(edits[0].update).To(BeNil())
+
+			Expect(edits[1].id).To(Equal("db"))
+			Expect(edits[1].skipped).To(Equal("Autoscaling is enabled, can't set replicas"))
+			Expect(edits[1].update).To(BeNil())
+
+			Expect(edits[2].id).To(Equal("web"))
+			Expect(edits[2].changes).To(HaveLen(1))
+			Expect(edits[2].changes[0].Path).To(Equal("replicas"))
+			Expect(edits[2].update).ToNot(BeNil())
+		})
+
+		It("Updates the matching node pools in parallel", func() {
+			setFlags(map[string]string{"selector": "env=prod", "add-labels": "team=a", "autorepair": "false"})
+			testRuntime.ApiServer.AppendHandlers(RespondWithJSON(http.StatusOK, test.FormatNodePoolList(nodePools)))
+
+			edits, err := planMachinePoolEdits(Cmd, testRuntime.RosaRuntime, "cluster1", cluster)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(edits).To(HaveLen(3))
+			for _, edit := range edits {
+				Expect(edit.update).ToNot(BeNil())
+			}
+			Expect(edits[1].changes).To(ContainElement(And(HaveField("Path", "labels"), HaveField("Desired",
+				map[string]interface{}{"env": "prod", "tier": "db", "team": "a"}))))
+
+			testRuntime.ApiServer.RouteToHandler(http.MethodPatch, nodePoolsPath+"/api", ghttp.CombineHandlers(
+				ghttp.VerifyJSON(`{
+					"kind": "NodePool",
+					"id": "api",
+					"auto_repair": false,
+					"labels": {"env": "prod", "team": "a"}
+				}`),
+				RespondWithJSON(http.StatusOK, test.FormatResource(nodePools[2])),
+			))
+			testRuntime.ApiServer.RouteToHandler(http.MethodPatch, nodePoolsPath+"/db",
+				RespondWithJSON(http.StatusBadRequest, `{"kind": "Error", "reason": "Invalid labels"}`))
+			testRuntime.ApiServer.RouteToHandler(http.MethodPatch, nodePoolsPath+"/web",
+				RespondWithJSON(http.StatusOK, test.FormatResource(nodePools[0])))
+
+			applyMachinePoolEdits(edits)
+			Expect(edits[0].err).ToNot(HaveOccurred())
+			Expect(edits[1].err).To(MatchError(ContainSubstring("Invalid labels")))
+			Expect(edits[2].err).ToNot(HaveOccurred())
+
+			stdout, _, err := test.RunWithOutputCapture(func(*rosa.Runtime, *cobra.Command) error {
+				return printMachinePoolEditResults(edits, "cluster1")
+			}, testRuntime.RosaRuntime, Cmd)
+			Expect(err).To(MatchError("Failed to update 1 of 3 machine pools on cluster 'cluster1'"))
+			Expect(stdout).To(ContainSubstring("api           Updated"))
+			Expect(stdout).To(ContainSubstring("db            Failed: "))
+		})
+
+		It("Replaces the labels of the matching node pools", func() {
+			setFlags(map[string]string{"selector": "env=prod", "labels": "team=a"})
+			testRuntime.ApiServer.AppendHandlers(RespondWithJSON(http.StatusOK, test.FormatNodePoolList(nodePools)))
+
+			edits, err := planMachinePoolEdits(Cmd, testRuntime.RosaRuntime, "cluster1", cluster)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(edits).To(HaveLen(3))
+			Expect(edits[1].id).To(Equal("db"))
+			Expect(edits[1].changes).To(ContainElement(And(HaveField("Path", "labels"), HaveField("Desired",
+				map[string]interface{}{"team": "a"}))))
+		})
+
+		It("Selects the node pools by a label with a reserved prefix", func() {
+			setFlags(map[string]string{"selector": "node-role.kubernetes.io/infra=", "replicas": "3"})
+			infra := mockNodePool(cmv1.NewNodePool().ID("infra").Replicas(2).
+				Labels(map[string]string{"node-role.kubernetes.io/infra": ""}))
+			testRuntime.ApiServer.AppendHandlers(RespondWithJSON(http.StatusOK,
+				test.FormatNodePoolList(append([]*cmv1.NodePool{infra}, nodePools...))))
+
+			edits, err := planMachinePoolEdits(Cmd, testRuntime.RosaRuntime, "cluster1", cluster)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(edits).To(HaveLen(1))
+			Expect(edits[0].id).To(Equal("infra"))
+			Expect(edits[0].update).ToNot(BeNil())
+		})
+
+		It("Rejects labels with a reserved prefix", func() {
+			setFlags(map[string]string{"selector": "env=prod", "labels": "kubernetes.io/hostname=foo"})
+			testRuntime.ApiServer.AppendHandlers(RespondWithJSON(http.StatusOK, test.FormatNodePoolList(nodePools)))
+
+			_, err := planMachinePoolEdits(Cmd, testRuntime.RosaRuntime, "cluster1", cluster)
+			Expect(err).To(MatchError(ContainSubstring(
+				"Invalid label key 'kubernetes.io/hostname': the 'kubernetes.io' prefix is reserved for Kubernetes")))
+		})
+
+		It("Fails when no node pool matches", func() {
+			setFlags(map[string]string{"selector": "env=test", "replicas": "1"})
+			testRuntime.ApiServer.AppendHandlers(RespondWithJSON(http.StatusOK, test.FormatNodePoolList(nodePools)))
+
+			_, err := planMachinePoolEdits(Cmd, testRuntime.RosaRuntime, "cluster1", cluster)
+			Expect(err).To(MatchError(
+				"There are no machine pools on cluster 'cluster1' with labels matching 'env=test'"))
+		})
+	})
+
+	Context("Classic clusters", func() {
+		cluster := test.MockCluster(func(c *cmv1.ClusterBuilder) {
+			c.MultiAZ(true)
+		})
+		machinePools := []*cmv1.MachinePool{
+			mockMachinePool(cmv1.NewMachinePool().ID("worker").Replicas(3).
+				AvailabilityZones("a", "b", "c").Labels(map[string]string{"env": "prod"})),
+			mockMachinePool(cmv1.NewMachinePool().ID("single").Replicas(1).
+				AvailabilityZones("a").Labels(map[string]string{"env": "prod"})),
+		}
+
+		It("Skips multi AZ machine pools when the replicas aren't a multiple of 3", func() {
+			setFlags(map[string]string{"selector": "env=prod", "replicas": "4"})
+			testRuntime.ApiServer.AppendHandlers(
+				RespondWithJSON(http.StatusOK, test.FormatMachinePoolList(machinePools)))
+
+			edits, err := planMachinePoolEdits(Cmd, testRuntime.RosaRuntime, "cluster1", cluster)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(edits).To(HaveLen(2))
+			Expect(edits[0].id).To(Equal("single"))
+			Expect(edits[0].update).ToNot(BeNil())
+			Expect(edits[1].id).To(Equal("worker"))
+			Expect(edits[1].skipped).To(ContainSubstring("multiple of 3"))
+
+			stdout, _, err := test.RunWithOutputCapture(func(*rosa.Runtime, *cobra.Command) error {
+				printMachinePoolEdits(edits, "cluster1")
+				return nil
+			}, testRuntime.RosaRuntime, Cmd)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(stdout).To(ContainSubstring("  single:\n    replicas: 1 -> 4\n"))
+			Expect(stdout).To(ContainSubstring("  worker: skipped, Multi AZ machine pools"))
+		})
+
+		It("Rejects hosted only flags", func() {
+			setFlags(map[string]string{"selector": "env=prod", "autorepair": "false"})
+
+			_, err := planMachinePoolEdits(Cmd, testRuntime.RosaRuntime, "cluster1", cluster)
+			Expect(err).To(MatchError("Setting the 'autorepair' flag is only supported for hosted clusters"))
+		})
+	})
+
+	It("Rejects flags that can't be applied to several machine pools", func() {
+		setFlags(map[string]string{"selector": "env=prod", "min-replicas": "2"})
+
+		_, err := planMachinePoolEdits(Cmd, testRuntime.RosaRuntime, "cluster1", test.MockCluster(nil))
+		Expect(err).To(MatchError(ContainSubstring("Flag '--min-replicas' can't be used with '--selector'")))
+	})
+
+	It("Requires a change", func() {
+		setFlags(map[string]string{"selector": "env=prod"})
+
+		_, err := planMachinePoolEdits(Cmd, testRuntime.RosaRuntime, "cluster1", test.MockCluster(nil))
+		Expect(err).To(MatchError(ContainSubstring("Expected at least one of --replicas")))
+	})
+})
+
+func mockNodePool(builder *cmv1.NodePoolBuilder) *cmv1.NodePool {
+	nodePool, err := builder.Build()
+	Expect(err).ToNot(HaveOccurred())
+	return nodePool
+}
+
+func mockMachinePool(builder *cmv1.MachinePoolBuilder) *cmv1.MachinePool {
+	machinePool, err := builder.Build()
+	Expect(err).ToNot(HaveOccurred())
+	return machinePool
+}
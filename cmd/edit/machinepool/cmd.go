@@ -22,8 +22,10 @@ import (
 
 	"github.com/spf13/cobra"
 
+	"github.com/openshift/rosa/pkg/diff"
 	mpHelpers "github.com/openshift/rosa/pkg/helper/machinepools"
 	"github.com/openshift/rosa/pkg/ocm"
+	"github.com/openshift/rosa/pkg/reporter"
 	"github.com/openshift/rosa/pkg/rosa"
 )
 
@@ -33,26 +35,47 @@ var args struct {
 	minReplicas          int
 	maxReplicas          int
 	labels               string
+	addLabels            string
 	taints               string
+	labelsFile           string
+	taintsFile           string
 	version              string
 	autorepair           bool
 	tuningConfigs        string
 	nodeDrainGracePeriod string
+	selector             string
 }
 
 var Cmd = &cobra.Command{
 	Use:     "machinepool ID",
 	Aliases: []string{"machinepools", "machine-pool", "machine-pools"},
 	Short:   "Edit machine pool",
-	Long:    "Edit machine pools on a cluster.",
+	Long: "Edit machine pools on a cluster. With '--selector' the changes are applied to all the machine " +
+		"pools whose labels match, after printing the changes that will be made to each of them.",
 	Example: `  # Set 4 replicas on machine pool 'mp1' on cluster 'mycluster'
   rosa edit machinepool --replicas=4 --cluster=mycluster mp1
   # Enable autoscaling and Set 3-5 replicas on machine pool 'mp1' on cluster 'mycluster'
   rosa edit machinepool --enable-autoscaling --min-replicas=3 --max-replicas=5 --cluster=mycluster mp1
   # Set the node drain grace period to 1 hour on machine pool 'mp1' on cluster 'mycluster'
-  rosa edit machinepool --node-drain-grace-period="1 hour" --cluster=mycluster mp1`,
+  rosa edit machinepool --node-drain-grace-period="1 hour" --cluster=mycluster mp1
+  # Show the changes that setting 6 replicas would make to machine pool 'mp1' without applying them
+  rosa edit machinepool --replicas=6 --cluster=mycluster mp1 --dry-run
+  # Set the taints listed one per line in a file on machine pool 'mp1' on cluster 'mycluster'
+  rosa edit machinepool --taints-file=taints.txt --cluster=mycluster mp1
+  # Add the label 'team=a' to machine pool 'mp1' on cluster 'mycluster', keeping its other labels
+  rosa edit machinepool --add-labels=team=a --cluster=mycluster mp1
+  # Set 4 replicas on all the machine pools labeled 'env=prod' on cluster 'mycluster'
+  rosa edit machinepools --selector=env=prod --replicas=4 --cluster=mycluster`,
 	Run: run,
-	Args: func(_ *cobra.Command, argv []string) error {
+	Args: func(cmd *cobra.Command, argv []string) error {
+		if cmd.Flags().Changed("selector") {
+			if len(argv) != 0 {
+				return fmt.Errorf(
+					"Expected no command line parameters when the machine pools are selected with '--selector'",
+				)
+			}
+			return nil
+		}
 		if len(argv) != 1 {
 			return fmt.Errorf(
 				"Expected exactly one command line parameter containing the id of the machine pool",
@@ -103,6 +126,15 @@ func init() {
 			"This list will overwrite any modifications made to node labels on an ongoing basis.",
 	)
 
+	flags.StringVar(
+		&args.addLabels,
+		"add-labels",
+		"",
+		"Labels to add to the existing labels of the machine pool. Format should be a comma-separated list "+
+			"of 'key=value'. The values of the keys that the machine pool already has are replaced, and the "+
+			"other labels are kept. Can't be used with '--labels'.",
+	)
+
 	flags.StringVar(
 		&args.taints,
 		"taints",
@@ -111,6 +143,22 @@ func init() {
 			"This list will overwrite any modifications made to node taints on an ongoing basis.",
 	)
 
+	flags.StringVar(
+		&args.labelsFile,
+		"labels-file",
+		"",
+		"Path to a file with the labels for machine pool, one 'key=value' per line. Empty lines and lines "+
+			"starting with '#' are ignored. Can't be used with '--labels'.",
+	)
+
+	flags.StringVar(
+		&args.taintsFile,
+		"taints-file",
+		"",
+		"Path to a file with the taints for machine pool, one 'key=value:ScheduleType' per line. Empty "+
+			"lines and lines starting with '#' are ignored. Can't be used with '--taints'.",
+	)
+
 	flags.StringVar(
 		&args.version,
 		"version",
@@ -146,6 +194,18 @@ func init() {
 			"This flag is only supported for Hosted Control Planes.",
 	)
 
+	flags.StringVar(
+		&args.selector,
+		"selector",
+		"",
+		"Edit all the machine pools whose labels match the given comma-separated list of 'key=value' pairs, "+
+			"instead of a single machine pool. Only --replicas, --labels, --add-labels, --taints and "+
+			"--autorepair can be applied this way. As for a single machine pool, --labels replaces the labels "+
+			"of each machine pool, including the ones matched by the selector, and --add-labels keeps them.",
+	)
+
+	diff.AddFlag(flags)
+
 	flags.MarkHidden("version")
 }
 
@@ -153,15 +213,40 @@ func run(cmd *cobra.Command, argv []string) {
 	r := rosa.NewRuntime().WithAWS().WithOCM()
 	defer r.Cleanup()
 
-	machinePoolID := argv[0]
 	clusterKey := r.GetClusterKey()
 	cluster := r.FetchCluster()
 
-	if cmd.Flags().Changed("labels") {
-		_, err := mpHelpers.ParseLabels(args.labels)
+	for _, flag := range []string{"labels", "taints"} {
+		err := mpHelpers.SetFlagFromFile(cmd, flag, flag+"-file")
 		if err != nil {
 			r.Reporter.Errorf("%s", err)
-			os.Exit(1)
+			os.Exit(reporter.ExitCode())
+		}
+	}
+
+	if cmd.Flags().Changed("labels") && cmd.Flags().Changed("add-labels") {
+		r.Reporter.Errorf("Flags '--labels' and '--add-labels' can't be used together")
+		os.Exit(reporter.ExitCode())
+	}
+
+	if cmd.Flags().Changed("selector") {
+		err := editMachinePools(cmd, r, clusterKey, cluster)
+		if err != nil {
+			r.Reporter.Errorf("%s", err)
+			os.Exit(reporter.ExitCode())
+		}
+		return
+	}
+
+	machinePoolID := argv[0]
+
+	for _, flag := range []string{"labels", "add-labels"} {
+		if cmd.Flags().Changed(flag) {
+			_, err := mpHelpers.ParseLabels(cmd.Flags().Lookup(flag).Value.String())
+			if err != nil {
+				r.Reporter.Errorf("%s", err)
+				os.Exit(reporter.ExitCode())
+			}
 		}
 	}
 
@@ -1,12 +1,15 @@
 package machinepool
 
 import (
+	"fmt"
+	"io"
 	"os"
 	"regexp"
 
 	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
 	"github.com/spf13/cobra"
 
+	"github.com/openshift/rosa/pkg/diff"
 	mpHelpers "github.com/openshift/rosa/pkg/helper/machinepools"
 	"github.com/openshift/rosa/pkg/interactive"
 	rprtr "github.com/openshift/rosa/pkg/reporter"
@@ -22,7 +25,7 @@ func editMachinePool(cmd *cobra.Command, machinePoolID string, clusterKey string
 	var err error
 	if !machinePoolKeyRE.MatchString(machinePoolID) {
 		r.Reporter.Errorf("Expected a valid identifier for the machine pool")
-		os.Exit(1)
+		os.Exit(rprtr.ExitCode())
 	}
 
 	mpHelpers.HostedClusterOnlyFlag(r, cmd, "version")
@@ -34,10 +37,12 @@ func editMachinePool(cmd *cobra.Command, machinePoolID string, clusterKey string
 	isReplicasSet := cmd.Flags().Changed("replicas")
 	isAutoscalingSet := cmd.Flags().Changed("enable-autoscaling")
 	isLabelsSet := cmd.Flags().Changed("labels")
+	isAddLabelsSet := cmd.Flags().Changed("add-labels")
 	isTaintsSet := cmd.Flags().Changed("taints")
 
 	// if no value set enter interactive mode
-	if !(isMinReplicasSet || isMaxReplicasSet || isReplicasSet || isAutoscalingSet || isLabelsSet || isTaintsSet) {
+	if !(isMinReplicasSet || isMaxReplicasSet || isReplicasSet || isAutoscalingSet || isLabelsSet ||
+		isAddLabelsSet || isTaintsSet) {
 		interactive.Enable()
 	}
 
@@ -46,7 +51,7 @@ func editMachinePool(cmd *cobra.Command, machinePoolID string, clusterKey string
 	machinePools, err := r.OCMClient.GetMachinePools(cluster.ID())
 	if err != nil {
 		r.Reporter.Errorf("Failed to get machine pools for cluster '%s': %v", clusterKey, err)
-		os.Exit(1)
+		os.Exit(rprtr.ExitCode())
 	}
 
 	var machinePool *cmv1.MachinePool
@@ -57,29 +62,36 @@ func editMachinePool(cmd *cobra.Command, machinePoolID string, clusterKey string
 	}
 	if machinePool == nil {
 		r.Reporter.Errorf("Failed to get machine pool '%s' for cluster '%s'", machinePoolID, clusterKey)
-		os.Exit(1)
+		os.Exit(rprtr.ExitCode())
 	}
 
 	autoscaling, replicas, minReplicas, maxReplicas, scalingUpdated, minReplicaUpdated, maxReplicaUpdated :=
 		getMachinePoolReplicas(cmd, r.Reporter, machinePoolID, machinePool.Replicas(), machinePool.Autoscaling(),
-			!isLabelsSet && !isTaintsSet)
+			!isLabelsSet && !isAddLabelsSet && !isTaintsSet)
 
 	if scalingUpdated {
 		if !autoscaling && replicas < 0 ||
 			(autoscaling && isMinReplicasSet && minReplicas < 0) {
 			r.Reporter.Errorf("The number of machine pool replicas needs to be a non-negative integer")
-			os.Exit(1)
+			os.Exit(rprtr.ExitCode())
 		}
 
 		if cluster.MultiAZ() && isMultiAZMachinePool(machinePool) &&
 			(!autoscaling && replicas%3 != 0 ||
 				(autoscaling && (minReplicas%3 != 0 || maxReplicas%3 != 0))) {
 			r.Reporter.Errorf("Multi AZ clusters require that the number of MachinePool replicas be a multiple of 3")
-			os.Exit(1)
+			os.Exit(rprtr.ExitCode())
 		}
 	}
 
 	labelMap := mpHelpers.GetLabelMap(cmd, r, machinePool.Labels(), args.labels)
+	if isAddLabelsSet {
+		labelMap, err = mpHelpers.AddLabels(machinePool.Labels(), args.addLabels)
+		if err != nil {
+			r.Reporter.Errorf("%s", err)
+			os.Exit(rprtr.ExitCode())
+		}
+	}
 
 	taintBuilders := mpHelpers.GetTaints(cmd, r, machinePool.Taints(), args.taints)
 
@@ -89,7 +101,7 @@ func editMachinePool(cmd *cobra.Command, machinePoolID string, clusterKey string
 	// Check either for an explicit flag or interactive mode. Since
 	// interactive will always show both labels and taints we can safely
 	// assume that the value entered is the same as the value desired.
-	if isLabelsSet || interactive.Enabled() {
+	if isLabelsSet || isAddLabelsSet || interactive.Enabled() {
 		mpBuilder = mpBuilder.Labels(labelMap)
 	}
 	if isTaintsSet || interactive.Enabled() {
@@ -113,10 +125,24 @@ func editMachinePool(cmd *cobra.Command, machinePoolID string, clusterKey string
 		}
 	}
 
+	current := machinePool
 	machinePool, err = mpBuilder.Build()
 	if err != nil {
 		r.Reporter.Errorf("Failed to create machine pool for cluster '%s': %v", clusterKey, err)
-		os.Exit(1)
+		os.Exit(rprtr.ExitCode())
+	}
+
+	if diff.Enabled() {
+		changes, err := diff.Objects(
+			func(w io.Writer) error { return cmv1.MarshalMachinePool(machinePool, w) },
+			func(w io.Writer) error { return cmv1.MarshalMachinePool(current, w) },
+		)
+		if err != nil {
+			r.Reporter.Errorf("Failed to compare machine pool '%s' on cluster '%s': %v",
+				machinePool.ID(), clusterKey, err)
+			os.Exit(rprtr.ExitCode())
+		}
+		os.Exit(diff.Print(fmt.Sprintf("machine pool '%s' on cluster '%s'", machinePool.ID(), clusterKey), changes))
 	}
 
 	r.Reporter.Debugf("Updating machine pool '%s' on cluster '%s'", machinePool.ID(), clusterKey)
@@ -124,7 +150,7 @@ func editMachinePool(cmd *cobra.Command, machinePoolID string, clusterKey string
 	if err != nil {
 		r.Reporter.Errorf("Failed to update machine pool '%s' on cluster '%s': %s",
 			machinePool.ID(), clusterKey, err)
-		os.Exit(1)
+		os.Exit(rprtr.ExitCode())
 	}
 	r.Reporter.Infof("Updated machine pool '%s' on cluster '%s'", machinePool.ID(), clusterKey)
 }
@@ -157,14 +183,14 @@ func getMachinePoolReplicas(cmd *cobra.Command,
 	if (isMinReplicasSet || isMaxReplicasSet) && !autoscaling && existingAutoscaling == nil {
 		reporter.Errorf("Autoscaling is not enabled on machine pool '%s'. can't set min or max replicas",
 			machinePoolID)
-		os.Exit(1)
+		os.Exit(rprtr.ExitCode())
 	}
 
 	// if the user set replicas but enabled autoscaling or hasn't disabled existing autoscaling
 	if isReplicasSet && existingAutoscaling != nil && (!isAutoscalingSet || autoscaling) {
 		reporter.Errorf("Autoscaling enabled on machine pool '%s'. can't set replicas",
 			machinePoolID)
-		os.Exit(1)
+		os.Exit(rprtr.ExitCode())
 	}
 
 	if !isAutoscalingSet {
@@ -178,7 +204,7 @@ func getMachinePoolReplicas(cmd *cobra.Command,
 			})
 			if err != nil {
 				reporter.Errorf("Expected a valid value for enable-autoscaling: %s", err)
-				os.Exit(1)
+				os.Exit(rprtr.ExitCode())
 			}
 		}
 	}
@@ -195,7 +221,7 @@ func getMachinePoolReplicas(cmd *cobra.Command,
 			})
 			if err != nil {
 				reporter.Errorf("Expected a valid number of min replicas: %s", err)
-				os.Exit(1)
+				os.Exit(rprtr.ExitCode())
 			}
 		}
 
@@ -210,7 +236,7 @@ func getMachinePoolReplicas(cmd *cobra.Command,
 			})
 			if err != nil {
 				reporter.Errorf("Expected a valid number of max replicas: %s", err)
-				os.Exit(1)
+				os.Exit(rprtr.ExitCode())
 			}
 		}
 	} else if interactive.Enabled() || !isReplicasSet && askForScalingParams {
@@ -225,7 +251,7 @@ func getMachinePoolReplicas(cmd *cobra.Command,
 		})
 		if err != nil {
 			reporter.Errorf("Expected a valid number of replicas: %s", err)
-			os.Exit(1)
+			os.Exit(rprtr.ExitCode())
 		}
 	}
 	return
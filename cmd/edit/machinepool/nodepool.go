@@ -2,12 +2,14 @@ package machinepool
 
 import (
 	"fmt"
+	"io"
 	"os"
 	"strings"
 
 	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
 	"github.com/spf13/cobra"
 
+	"github.com/openshift/rosa/pkg/diff"
 	"github.com/openshift/rosa/pkg/helper/machinepools"
 	"github.com/openshift/rosa/pkg/interactive"
 	rprtr "github.com/openshift/rosa/pkg/reporter"
@@ -22,6 +24,7 @@ func editNodePool(cmd *cobra.Command, nodePoolID string, clusterKey string, clus
 	isReplicasSet := cmd.Flags().Changed("replicas")
 	isAutoscalingSet := cmd.Flags().Changed("enable-autoscaling")
 	isLabelsSet := cmd.Flags().Changed("labels")
+	isAddLabelsSet := cmd.Flags().Changed("add-labels")
 	isTaintsSet := cmd.Flags().Changed("taints")
 	isVersionSet := cmd.Flags().Changed("version")
 	isAutorepairSet := cmd.Flags().Changed("autorepair")
@@ -32,11 +35,12 @@ func editNodePool(cmd *cobra.Command, nodePoolID string, clusterKey string, clus
 	if isVersionSet {
 		r.Reporter.Errorf("Editing versions is not supported, for upgrades please use " +
 			"'rosa upgrade machinepool'")
-		os.Exit(1)
+		os.Exit(rprtr.ExitCode())
 	}
 
 	// isAnyAdditionalParameterSet is true if at least one parameter not related to replicas and autoscaling is set
-	isAnyAdditionalParameterSet := isLabelsSet || isTaintsSet || isAutorepairSet || isTuningsConfigSet
+	isAnyAdditionalParameterSet := isLabelsSet || isAddLabelsSet || isTaintsSet || isAutorepairSet ||
+		isTuningsConfigSet
 	isAnyParameterSet := isMinReplicasSet || isMaxReplicasSet || isReplicasSet ||
 		isAutoscalingSet || isAnyAdditionalParameterSet
 
@@ -50,11 +54,11 @@ func editNodePool(cmd *cobra.Command, nodePoolID string, clusterKey string, clus
 	nodePool, exists, err := r.OCMClient.GetNodePool(cluster.ID(), nodePoolID)
 	if err != nil {
 		r.Reporter.Errorf("Failed to get machine pools for hosted cluster '%s': %v", clusterKey, err)
-		os.Exit(1)
+		os.Exit(rprtr.ExitCode())
 	}
 	if !exists {
 		r.Reporter.Errorf("Machine pool '%s' does not exist for hosted cluster '%s'", nodePoolID, clusterKey)
-		os.Exit(1)
+		os.Exit(rprtr.ExitCode())
 	}
 
 	autoscaling, replicas, minReplicas, maxReplicas := getNodePoolReplicas(cmd, r.Reporter, nodePoolID,
@@ -62,15 +66,22 @@ func editNodePool(cmd *cobra.Command, nodePoolID string, clusterKey string, clus
 
 	if !autoscaling && replicas < 0 {
 		r.Reporter.Errorf("The number of machine pool replicas needs to be a non-negative integer")
-		os.Exit(1)
+		os.Exit(rprtr.ExitCode())
 	}
 
 	if autoscaling && cmd.Flags().Changed("min-replicas") && minReplicas < 1 {
 		r.Reporter.Errorf("The number of machine pool min-replicas needs to be greater than zero")
-		os.Exit(1)
+		os.Exit(rprtr.ExitCode())
 	}
 
 	labelMap := machinepools.GetLabelMap(cmd, r, nodePool.Labels(), args.labels)
+	if isAddLabelsSet {
+		labelMap, err = machinepools.AddLabels(nodePool.Labels(), args.addLabels)
+		if err != nil {
+			r.Reporter.Errorf("%s", err)
+			os.Exit(rprtr.ExitCode())
+		}
+	}
 
 	taintBuilders := machinepools.GetTaints(cmd, r, nodePool.Taints(), args.taints)
 
@@ -80,7 +91,7 @@ func editNodePool(cmd *cobra.Command, nodePoolID string, clusterKey string, clus
 	// Check either for an explicit flag or interactive mode. Since
 	// interactive will always show both labels and taints we can safely
 	// assume that the value entered is the same as the value desired.
-	if isLabelsSet || interactive.Enabled() {
+	if isLabelsSet || isAddLabelsSet || interactive.Enabled() {
 		npBuilder = npBuilder.Labels(labelMap)
 	}
 	if isTaintsSet || interactive.Enabled() {
@@ -113,7 +124,7 @@ func editNodePool(cmd *cobra.Command, nodePoolID string, clusterKey string, clus
 			})
 			if err != nil {
 				r.Reporter.Errorf("Expected a valid value for autorepair: %s", err)
-				os.Exit(1)
+				os.Exit(rprtr.ExitCode())
 			}
 		}
 
@@ -127,7 +138,7 @@ func editNodePool(cmd *cobra.Command, nodePoolID string, clusterKey string, clus
 		availableTuningConfigs, err := r.OCMClient.GetTuningConfigsName(cluster.ID())
 		if err != nil {
 			r.Reporter.Errorf("%s", err)
-			os.Exit(1)
+			os.Exit(rprtr.ExitCode())
 		}
 		if tuningConfigs != "" {
 			if len(availableTuningConfigs) > 0 {
@@ -156,7 +167,7 @@ func editNodePool(cmd *cobra.Command, nodePoolID string, clusterKey string, clus
 				})
 				if err != nil {
 					r.Reporter.Errorf("Expected a valid value for tuning configs: %s", err)
-					os.Exit(1)
+					os.Exit(rprtr.ExitCode())
 				}
 			}
 		}
@@ -180,7 +191,7 @@ func editNodePool(cmd *cobra.Command, nodePoolID string, clusterKey string, clus
 			})
 			if err != nil {
 				r.Reporter.Errorf("Expected a valid value for Node drain grace period: %s", err)
-				os.Exit(1)
+				os.Exit(rprtr.ExitCode())
 			}
 		}
 
@@ -188,16 +199,30 @@ func editNodePool(cmd *cobra.Command, nodePoolID string, clusterKey string, clus
 			nodeDrainBuilder, err := machinepools.CreateNodeDrainGracePeriodBuilder(nodeDrainGracePeriod)
 			if err != nil {
 				r.Reporter.Errorf(err.Error())
-				os.Exit(1)
+				os.Exit(rprtr.ExitCode())
 			}
 			npBuilder.NodeDrainGracePeriod(nodeDrainBuilder)
 		}
 	}
 
+	current := nodePool
 	nodePool, err = npBuilder.Build()
 	if err != nil {
 		r.Reporter.Errorf("Failed to create machine pool for hosted cluster '%s': %v", clusterKey, err)
-		os.Exit(1)
+		os.Exit(rprtr.ExitCode())
+	}
+
+	if diff.Enabled() {
+		changes, err := diff.Objects(
+			func(w io.Writer) error { return cmv1.MarshalNodePool(nodePool, w) },
+			func(w io.Writer) error { return cmv1.MarshalNodePool(current, w) },
+		)
+		if err != nil {
+			r.Reporter.Errorf("Failed to compare machine pool '%s' on hosted cluster '%s': %v",
+				nodePool.ID(), clusterKey, err)
+			os.Exit(rprtr.ExitCode())
+		}
+		os.Exit(diff.Print(fmt.Sprintf("machine pool '%s' on hosted cluster '%s'", nodePool.ID(), clusterKey), changes))
 	}
 
 	r.Reporter.Debugf("Updating machine pool '%s' on hosted cluster '%s'", nodePool.ID(), clusterKey)
@@ -205,7 +230,7 @@ func editNodePool(cmd *cobra.Command, nodePoolID string, clusterKey string, clus
 	if err != nil {
 		r.Reporter.Errorf("Failed to update machine pool '%s' on hosted cluster '%s': %s",
 			nodePool.ID(), clusterKey, err)
-		os.Exit(1)
+		os.Exit(rprtr.ExitCode())
 	}
 	r.Reporter.Infof("Updated machine pool '%s' on hosted cluster '%s'", nodePool.ID(), clusterKey)
 }
@@ -233,7 +258,7 @@ func getNodePoolReplicas(cmd *cobra.Command,
 	if (isMinReplicasSet || isMaxReplicasSet) && !autoscaling && existingAutoscaling == nil {
 		reporter.Errorf("Autoscaling is not enabled on machine pool '%s'. can't set min or max replicas",
 			nodePoolID)
-		os.Exit(1)
+		os.Exit(rprtr.ExitCode())
 	}
 
 	if !isAutoscalingSet {
@@ -247,7 +272,7 @@ func getNodePoolReplicas(cmd *cobra.Command,
 			})
 			if err != nil {
 				reporter.Errorf("Expected a valid value for enable-autoscaling: %s", err)
-				os.Exit(1)
+				os.Exit(rprtr.ExitCode())
 			}
 		}
 	}
@@ -266,7 +291,7 @@ func getNodePoolReplicas(cmd *cobra.Command,
 			})
 			if err != nil {
 				reporter.Errorf("Expected a valid number of min replicas: %s", err)
-				os.Exit(1)
+				os.Exit(rprtr.ExitCode())
 			}
 		}
 
@@ -283,7 +308,7 @@ func getNodePoolReplicas(cmd *cobra.Command,
 			})
 			if err != nil {
 				reporter.Errorf("Expected a valid number of max replicas: %s", err)
-				os.Exit(1)
+				os.Exit(rprtr.ExitCode())
 			}
 		}
 	} else if interactive.Enabled() || !isReplicasSet {
@@ -305,7 +330,7 @@ func getNodePoolReplicas(cmd *cobra.Command,
 		})
 		if err != nil {
 			reporter.Errorf("Expected a valid number of replicas: %s", err)
-			os.Exit(1)
+			os.Exit(rprtr.ExitCode())
 		}
 	}
 	return
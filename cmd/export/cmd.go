@@ -0,0 +1,41 @@
+/*
+Copyright (c) 2024 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package export
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/openshift/rosa/cmd/export/machinepool"
+	"github.com/openshift/rosa/pkg/arguments"
+)
+
+var Cmd = &cobra.Command{
+	Use:   "export",
+	Short: "Export the definition of resources",
+	Long:  "Export the definition of resources, so that they can be created again on another cluster.",
+	Example: `  # Export the machine pools of cluster 'mycluster'
+  rosa export machinepools --cluster=mycluster > machinepools.yaml`,
+	Args: cobra.NoArgs,
+}
+
+func init() {
+	Cmd.AddCommand(machinepool.NewExportMachinePoolsCommand())
+
+	flags := Cmd.PersistentFlags()
+	arguments.AddProfileFlag(flags)
+	arguments.AddRegionFlag(flags)
+}
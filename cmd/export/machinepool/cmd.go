@@ -0,0 +1,112 @@
+/*
+Copyright (c) 2024 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package machinepool
+
+import (
+	"context"
+
+	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+	"github.com/spf13/cobra"
+
+	mpHelpers "github.com/openshift/rosa/pkg/helper/machinepools"
+	"github.com/openshift/rosa/pkg/ocm"
+	"github.com/openshift/rosa/pkg/output"
+	"github.com/openshift/rosa/pkg/rosa"
+)
+
+const (
+	use   = "machinepools"
+	short = "Export the machine pools of a cluster"
+	long  = "Export the replicas or autoscaling, instance type, labels, taints, spot settings, availability " +
+		"zones, subnets, tuning configs and node drain grace period of the machine pools of a cluster, and " +
+		"its kubelet config, so that they can be created again on another cluster with " +
+		"'rosa create machinepools --from-file'. The definitions are written in YAML unless '--output=json' " +
+		"is used."
+	example = `  # Export the machine pools of cluster 'mycluster' and create them on cluster 'newcluster'
+  rosa export machinepools --cluster=mycluster > machinepools.yaml
+  rosa create machinepools --cluster=newcluster --from-file=machinepools.yaml`
+)
+
+func NewExportMachinePoolsCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     use,
+		Aliases: []string{"machinepool", "machine-pools", "machine-pool"},
+		Short:   short,
+		Long:    long,
+		Example: example,
+		Args:    cobra.NoArgs,
+		Run:     rosa.DefaultRunner(rosa.RuntimeWithOCM(), ExportMachinePoolsRunner()),
+	}
+
+	ocm.AddClusterFlag(cmd)
+	output.AddFlag(cmd)
+	return cmd
+}
+
+func ExportMachinePoolsRunner() rosa.CommandRunner {
+	return func(_ context.Context, r *rosa.Runtime, _ *cobra.Command, _ []string) error {
+		clusterKey := r.GetClusterKey()
+		cluster, err := r.OCMClient.GetCluster(clusterKey, r.Creator)
+		if err != nil {
+			return err
+		}
+
+		file, err := exportMachinePools(r, cluster)
+		if err != nil {
+			return err
+		}
+		if !output.HasFlag() {
+			output.SetOutput(output.YAML)
+		}
+		return output.Print(file)
+	}
+}
+
+func exportMachinePools(r *rosa.Runtime, cluster *cmv1.Cluster) (*mpHelpers.PoolsFile, error) {
+	file := &mpHelpers.PoolsFile{
+		Cluster:      cluster.Name(),
+		MachinePools: []*mpHelpers.PoolSpec{},
+	}
+	if cluster.Hypershift().Enabled() {
+		nodePools, err := r.OCMClient.GetNodePools(cluster.ID())
+		if err != nil {
+			return nil, err
+		}
+		for _, nodePool := range nodePools {
+			file.MachinePools = append(file.MachinePools, mpHelpers.PoolSpecFromNodePool(nodePool))
+		}
+		return file, nil
+	}
+
+	machinePools, err := r.OCMClient.GetMachinePools(cluster.ID())
+	if err != nil {
+		return nil, err
+	}
+	for _, machinePool := range machinePools {
+		file.MachinePools = append(file.MachinePools, mpHelpers.PoolSpecFromMachinePool(machinePool))
+	}
+	kubeletConfig, err := r.OCMClient.GetClusterKubeletConfig(cluster.ID())
+	if err != nil {
+		return nil, err
+	}
+	if kubeletConfig != nil {
+		file.KubeletConfig = &mpHelpers.KubeletConfigSpec{
+			PodPidsLimit: kubeletConfig.PodPidsLimit(),
+		}
+	}
+	return file, nil
+}
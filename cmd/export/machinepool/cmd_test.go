@@ -0,0 +1,118 @@
+/*
+Copyright (c) 2024 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package machinepool
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+	. "github.com/openshift-online/ocm-sdk-go/testing"
+	"github.com/spf13/cobra"
+
+	"github.com/openshift/rosa/pkg/output"
+	"github.com/openshift/rosa/pkg/rosa"
+	"github.com/openshift/rosa/pkg/test"
+)
+
+func TestExportMachinePools(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "rosa export machinepools")
+}
+
+var _ = Describe("rosa export machinepools", func() {
+	var testRuntime test.TestingRuntime
+	var cmd *cobra.Command
+
+	BeforeEach(func() {
+		cmd = NewExportMachinePoolsCommand()
+		testRuntime.InitRuntime()
+		output.SetOutput("")
+		DeferCleanup(output.SetOutput, "")
+	})
+
+	run := func() (string, error) {
+		stdout, _, err := test.RunWithOutputCapture(func(r *rosa.Runtime, cmd *cobra.Command) error {
+			return ExportMachinePoolsRunner()(context.Background(), r, cmd, nil)
+		}, testRuntime.RosaRuntime, cmd)
+		return stdout, err
+	}
+
+	It("Exports the node pools of hosted clusters as YAML", func() {
+		cluster := test.MockCluster(func(c *cmv1.ClusterBuilder) {
+			c.Hypershift(cmv1.NewHypershift().Enabled(true))
+		})
+		nodePool, err := cmv1.NewNodePool().
+			ID("workers").
+			AWSNodePool(cmv1.NewAWSNodePool().InstanceType("m5.xlarge")).
+			Replicas(2).
+			Subnet("subnet-1").
+			Labels(map[string]string{"app": "web"}).
+			Build()
+		Expect(err).ToNot(HaveOccurred())
+		testRuntime.ApiServer.AppendHandlers(
+			RespondWithJSON(http.StatusOK, test.FormatClusterList([]*cmv1.Cluster{cluster})),
+			RespondWithJSON(http.StatusOK, test.FormatNodePoolList([]*cmv1.NodePool{nodePool})),
+		)
+
+		stdout, err := run()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(stdout).To(Equal(`cluster: ` + test.MockClusterName + `
+machine_pools:
+- instance_type: m5.xlarge
+  labels:
+    app: web
+  name: workers
+  replicas: 2
+  subnets:
+  - subnet-1
+`))
+	})
+
+	It("Exports the machine pools and kubelet config of classic clusters", func() {
+		cluster := test.MockCluster(nil)
+		machinePool, err := cmv1.NewMachinePool().
+			ID("worker").
+			InstanceType("m5.xlarge").
+			Autoscaling(cmv1.NewMachinePoolAutoscaling().MinReplicas(2).MaxReplicas(4)).
+			Build()
+		Expect(err).ToNot(HaveOccurred())
+		kubeletConfig, err := cmv1.NewKubeletConfig().PodPidsLimit(8192).Build()
+		Expect(err).ToNot(HaveOccurred())
+		testRuntime.ApiServer.AppendHandlers(
+			RespondWithJSON(http.StatusOK, test.FormatClusterList([]*cmv1.Cluster{cluster})),
+			RespondWithJSON(http.StatusOK, test.FormatMachinePoolList([]*cmv1.MachinePool{machinePool})),
+			RespondWithJSON(http.StatusOK, test.FormatResource(kubeletConfig)),
+		)
+		output.SetOutput(output.JSON)
+
+		stdout, err := run()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(stdout).To(MatchJSON(`{
+			"cluster": "` + test.MockClusterName + `",
+			"kubelet_config": {"pod_pids_limit": 8192},
+			"machine_pools": [{
+				"name": "worker",
+				"instance_type": "m5.xlarge",
+				"autoscaling": {"min_replicas": 2, "max_replicas": 4}
+			}]
+		}`))
+	})
+})
@@ -25,6 +25,7 @@ import (
 
 	"github.com/openshift/rosa/cmd/create/idp"
 	"github.com/openshift/rosa/pkg/ocm"
+	"github.com/openshift/rosa/pkg/reporter"
 	"github.com/openshift/rosa/pkg/rosa"
 )
 
@@ -84,13 +85,13 @@ func run(_ *cobra.Command, argv []string) {
 			"Username '%s' isn't valid: it must contain only letters, digits, dashes and underscores",
 			username,
 		)
-		os.Exit(1)
+		os.Exit(reporter.ExitCode())
 	}
 	if username == idp.ClusterAdminUsername {
 		r.Reporter.Errorf("Username '%s' is reserved for `rosa create/delete admin` command. "+
 			"Run `rosa create admin -c %s` to create user '%s'",
 			idp.ClusterAdminUsername, clusterKey, idp.ClusterAdminUsername)
-		os.Exit(1)
+		os.Exit(reporter.ExitCode())
 	}
 
 	role := argv[0]
@@ -109,19 +110,19 @@ func run(_ *cobra.Command, argv []string) {
 	}
 	if !isRoleValid {
 		r.Reporter.Errorf("Expected at least one of %s", validRoles)
-		os.Exit(1)
+		os.Exit(reporter.ExitCode())
 	}
 
 	cluster := r.FetchCluster()
 	if cluster.State() != cmv1.ClusterStateReady {
 		r.Reporter.Errorf("Cluster '%s' is not yet ready", clusterKey)
-		os.Exit(1)
+		os.Exit(reporter.ExitCode())
 	}
 
 	user, err := cmv1.NewUser().ID(username).Build()
 	if err != nil {
 		r.Reporter.Errorf("Failed to create user '%s' for cluster '%s'", username, clusterKey)
-		os.Exit(1)
+		os.Exit(reporter.ExitCode())
 	}
 
 	r.Reporter.Debugf("Adding user '%s' to group '%s' in cluster '%s'", username, role, clusterKey)
@@ -129,7 +130,7 @@ func run(_ *cobra.Command, argv []string) {
 	if err != nil {
 		r.Reporter.Errorf("Failed to grant '%s' to user '%s' to cluster '%s': %s",
 			role, username, clusterKey, err)
-		os.Exit(1)
+		os.Exit(reporter.ExitCode())
 	}
 
 	r.Reporter.Infof("Granted role '%s' to user '%s' on cluster '%s'", role, username, clusterKey)
@@ -24,6 +24,7 @@ import (
 
 	"github.com/openshift/rosa/pkg/interactive/confirm"
 	"github.com/openshift/rosa/pkg/ocm"
+	"github.com/openshift/rosa/pkg/reporter"
 	"github.com/openshift/rosa/pkg/rosa"
 )
 
@@ -54,20 +55,20 @@ func run(_ *cobra.Command, _ []string) {
 		r.Reporter.Errorf("Hibernating a cluster is only supported for 'Ready' clusters."+
 			" Cluster '%s' is in '%s' state",
 			clusterKey, cluster.State())
-		os.Exit(1)
+		os.Exit(reporter.ExitCode())
 	}
 
 	if !confirm.Yes() {
 		r.Reporter.Infof(limitedSupportWarning)
 		if !confirm.Prompt(false, confirmationPrompt) {
-			os.Exit(1)
+			os.Exit(reporter.ExitCode())
 		}
 	}
 
 	err := r.OCMClient.HibernateCluster(cluster.ID())
 	if err != nil {
 		r.Reporter.Errorf("Failed to update cluster: %v", err)
-		os.Exit(1)
+		os.Exit(reporter.ExitCode())
 	}
 	r.Reporter.Infof(hibernationPeriodWarning)
 	r.Reporter.Infof("Cluster '%s' is hibernating.", clusterKey)
@@ -33,6 +33,7 @@ import (
 	"github.com/openshift/rosa/pkg/helper"
 	"github.com/openshift/rosa/pkg/interactive/confirm"
 	"github.com/openshift/rosa/pkg/ocm"
+	"github.com/openshift/rosa/pkg/reporter"
 	"github.com/openshift/rosa/pkg/rosa"
 )
 
@@ -111,14 +112,14 @@ func run(cmd *cobra.Command, argv []string) {
 	err := login.Call(cmd, argv, r.Reporter)
 	if err != nil {
 		r.Reporter.Errorf("Failed to login to OCM: %v", err)
-		os.Exit(1)
+		os.Exit(reporter.ExitCode())
 	}
 
 	// Get AWS region
 	awsRegion, err := aws.GetRegion(arguments.GetRegion())
 	if err != nil {
 		r.Reporter.Errorf("Error getting region: %v", err)
-		os.Exit(1)
+		os.Exit(reporter.ExitCode())
 	}
 	supportedRegions, err := r.OCMClient.GetDatabaseRegionList()
 	if err != nil {
@@ -127,7 +128,7 @@ func run(cmd *cobra.Command, argv []string) {
 	if !helper.Contains(supportedRegions, awsRegion) {
 		r.Reporter.Errorf("Unsupported region '%s', available regions: %s",
 			awsRegion, helper.SliceToSortedString(supportedRegions))
-		os.Exit(1)
+		os.Exit(reporter.ExitCode())
 	}
 	// Create the AWS client:
 	client, err := aws.NewClient().
@@ -142,7 +143,7 @@ func run(cmd *cobra.Command, argv []string) {
 			r.OCMClient.LogEvent("ROSAInitCredentialsSTS", nil)
 		}
 		r.Reporter.Errorf("Error creating AWS client: %v", err)
-		os.Exit(1)
+		os.Exit(reporter.ExitCode())
 	}
 
 	// Validate AWS credentials for current user
@@ -151,12 +152,12 @@ func run(cmd *cobra.Command, argv []string) {
 	if err != nil {
 		r.OCMClient.LogEvent("ROSAInitCredentialsFailed", nil)
 		r.Reporter.Errorf("Error validating AWS credentials: %v", err)
-		os.Exit(1)
+		os.Exit(reporter.ExitCode())
 	}
 	if !ok {
 		r.OCMClient.LogEvent("ROSAInitCredentialsInvalid", nil)
 		r.Reporter.Errorf("AWS credentials are invalid")
-		os.Exit(1)
+		os.Exit(reporter.ExitCode())
 	}
 	r.Reporter.Infof("AWS credentials are valid!")
 
@@ -171,7 +172,7 @@ func run(cmd *cobra.Command, argv []string) {
 		err = deleteStack(cfClient, r.OCMClient)
 		if err != nil {
 			r.Reporter.Errorf("%v", err)
-			os.Exit(1)
+			os.Exit(reporter.ExitCode())
 		}
 
 		r.Reporter.Infof("Admin user '%s' deleted successfully!", aws.AdminUserName)
@@ -200,7 +201,7 @@ func run(cmd *cobra.Command, argv []string) {
 	if err != nil {
 		r.OCMClient.LogEvent("ROSAInitCreateStackFailed", nil)
 		r.Reporter.Errorf("Failed to create user '%s': %v", aws.AdminUserName, err)
-		os.Exit(1)
+		os.Exit(reporter.ExitCode())
 	}
 	if created {
 		r.Reporter.Infof("Admin user '%s' created successfully!", aws.AdminUserName)
@@ -217,13 +218,13 @@ func run(cmd *cobra.Command, argv []string) {
 		policies, err := r.OCMClient.GetPolicies("OSDSCPPolicy")
 		if err != nil {
 			r.Reporter.Errorf("Failed to get 'osdscppolicy' for '%s': %v", aws.AdminUserName, err)
-			os.Exit(1)
+			os.Exit(reporter.ExitCode())
 		}
 		isValid, err := client.ValidateSCP(&target, policies)
 		if !isValid {
 			r.OCMClient.LogEvent("ROSAInitSCPPoliciesFailed", nil)
 			r.Reporter.Errorf("Failed to verify permissions for user '%s': %v", target, err)
-			os.Exit(1)
+			os.Exit(reporter.ExitCode())
 		}
 		r.Reporter.Infof("AWS SCP policies ok")
 	} else {
@@ -24,6 +24,7 @@ import (
 	"github.com/openshift/rosa/pkg/aws"
 	"github.com/openshift/rosa/pkg/interactive"
 	"github.com/openshift/rosa/pkg/interactive/confirm"
+	"github.com/openshift/rosa/pkg/reporter"
 	"github.com/openshift/rosa/pkg/rosa"
 )
 
@@ -74,13 +75,13 @@ func run(cmd *cobra.Command, argv []string) {
 	orgAccount, _, err := r.OCMClient.GetCurrentOrganization()
 	if err != nil {
 		r.Reporter.Errorf("Error getting organization account: %v", err)
-		os.Exit(1)
+		os.Exit(reporter.ExitCode())
 	}
 
 	if args.organizationID != "" && orgAccount != args.organizationID {
 		r.Reporter.Errorf("Invalid organization ID '%s'. "+
 			"It doesn't match with the user session '%s'.", args.organizationID, orgAccount)
-		os.Exit(1)
+		os.Exit(reporter.ExitCode())
 	}
 
 	if r.Reporter.IsTerminal() {
@@ -106,26 +107,26 @@ func run(cmd *cobra.Command, argv []string) {
 		})
 		if err != nil {
 			r.Reporter.Errorf("Expected a valid ocm role ARN to link to a current organization: %s", err)
-			os.Exit(1)
+			os.Exit(reporter.ExitCode())
 		}
 	}
 	if roleArn != "" {
 		err = aws.ARNValidator(roleArn)
 		if err != nil {
 			r.Reporter.Errorf("Expected a valid ocm role ARN to link to a current organization: %s", err)
-			os.Exit(1)
+			os.Exit(reporter.ExitCode())
 		}
 	}
 
 	role, err := r.AWSClient.GetRoleByARN(roleArn)
 	if err != nil {
 		r.Reporter.Errorf("There was a problem checking if role '%s' exists: %v", roleArn, err)
-		os.Exit(1)
+		os.Exit(reporter.ExitCode())
 	}
 
 	if *role.Arn != roleArn {
 		r.Reporter.Errorf("ARN '%s' did not match the existing role ARN", *role.Arn)
-		os.Exit(1)
+		os.Exit(reporter.ExitCode())
 	}
 
 	if !confirm.Prompt(true, "Link the '%s' role with organization '%s'?", roleArn, orgAccount) {
@@ -148,11 +149,11 @@ func run(cmd *cobra.Command, argv []string) {
 				"Only organization member can run this command. "+
 				"Please ask someone with the organization member role to run the following command \n\n"+
 				"\t rosa link ocm-role --role-arn %s --organization-id %s", errMessage, roleArn, orgAccount)
-			os.Exit(1)
+			os.Exit(reporter.ExitCode())
 		}
 		r.Reporter.Errorf("Unable to link role arn '%s' with the organization id : '%s' : %v",
 			roleArn, orgAccount, err)
-		os.Exit(1)
+		os.Exit(reporter.ExitCode())
 	}
 	if !linked {
 		r.Reporter.Infof("Role-arn '%s' is already linked with the organization account '%s'", roleArn, orgAccount)
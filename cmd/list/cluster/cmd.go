@@ -17,8 +17,10 @@ limitations under the License.
 package cluster
 
 import (
+	"encoding/csv"
 	"fmt"
 	"os"
+	"strings"
 	"text/tabwriter"
 
 	v1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
@@ -26,6 +28,7 @@ import (
 
 	"github.com/openshift/rosa/pkg/aws"
 	"github.com/openshift/rosa/pkg/output"
+	"github.com/openshift/rosa/pkg/reporter"
 	"github.com/openshift/rosa/pkg/rosa"
 )
 
@@ -33,99 +36,172 @@ var Cmd = &cobra.Command{
 	Use:     "clusters",
 	Aliases: []string{"cluster"},
 	Short:   "List clusters",
-	Long:    "List clusters.",
+	Long: "List clusters.\n\n" +
+		"The filters are sent to OCM, so that only the matching clusters are retrieved. Filters of " +
+		"different flags are combined with AND, and values of the same flag with OR.",
 	Example: `  # List all clusters
-  rosa list clusters`,
+  rosa list clusters
+
+  # List the ready Hosted Control Plane clusters of version 4.15 in us-east-1
+  rosa list clusters --state ready --topology hosted-cp --version 4.15 --cluster-region us-east-1
+
+  # List the clusters using an OCM search expression
+  rosa list clusters --search "name LIKE 'prod-%'"
+
+  # Export the region and version of all the clusters of the organization sorted by version
+  rosa list clusters --all --columns name,region,version --sort-by version -o csv`,
 	Args: cobra.NoArgs,
 	Run:  run,
 }
 
-const clusterCount = 1000
-
 var args struct {
 	listAll        bool
 	accountRoleArn string
+	search         string
+	states         []string
+	regions        []string
+	versions       []string
+	topologies     []string
+	columns        string
+	sortBy         string
 }
 
 func init() {
 	flags := Cmd.Flags()
 	flags.SortFlags = false
 
-	output.AddFlag(Cmd)
+	output.AddFlagWithCSV(Cmd)
 	flags.BoolVarP(&args.listAll, "all", "a", false, "List all clusters across different AWS "+
 		"accounts under the same Red Hat organization")
 	flags.StringVar(&args.accountRoleArn, "account-role-arn", "", "List all clusters "+
 		"using the account role identified by the ARN")
+	flags.StringVar(&args.search, "search", "", "OCM search expression that the clusters must match, "+
+		"like \"name LIKE 'prod-%'\"")
+	flags.StringSliceVar(&args.states, "state", nil, "List only the clusters in the given states, "+
+		"like 'ready' or 'installing'")
+	flags.StringSliceVar(&args.regions, "cluster-region", nil, "List only the clusters in the given AWS "+
+		"regions")
+	flags.StringSliceVar(&args.versions, "version", nil, "List only the clusters with the given OpenShift "+
+		"versions. A minor version, like '4.15', matches all its patch versions")
+	flags.StringSliceVar(&args.topologies, "topology", nil, fmt.Sprintf("List only the clusters with the "+
+		"given topologies. Allowed topologies are %s", strings.Join(topologies, ", ")))
+	flags.StringVar(&args.columns, "columns", defaultColumns, fmt.Sprintf("Comma separated list of the "+
+		"columns of the table and the CSV output. Allowed columns are %s", strings.Join(columnNames(), ", ")))
+	flags.StringVar(&args.sortBy, "sort-by", "", "Column used to sort the clusters. Prefix it with '-' "+
+		"to sort in descending order")
+
+	Cmd.RegisterFlagCompletionFunc("topology", func(cmd *cobra.Command, args []string,
+		toComplete string) ([]string, cobra.ShellCompDirective) {
+		return topologies, cobra.ShellCompDirectiveNoFileComp
+	})
+	Cmd.RegisterFlagCompletionFunc("columns", func(cmd *cobra.Command, args []string,
+		toComplete string) ([]string, cobra.ShellCompDirective) {
+		return columnNames(), cobra.ShellCompDirectiveNoFileComp | cobra.ShellCompDirectiveNoSpace
+	})
 }
 
-func listClustersUsingAccountRole(creator *aws.Creator, runtime *rosa.Runtime) ([]*v1.Cluster, error) {
-	role, err := runtime.AWSClient.GetAccountRoleByArn(args.accountRoleArn)
+func run(_ *cobra.Command, _ []string) {
+	r := rosa.NewRuntime().WithAWS().WithOCM()
+	defer r.Cleanup()
+
+	err := runWithRuntime(r)
 	if err != nil {
-		return []*v1.Cluster{}, err
+		r.Reporter.Errorf("%s", err)
+		os.Exit(reporter.ExitCode())
 	}
-
-	return runtime.OCMClient.GetClustersUsingAccountRole(creator, role, clusterCount)
 }
 
-func run(_ *cobra.Command, _ []string) {
-	r := rosa.NewRuntime().WithAWS().WithOCM()
-	defer r.Cleanup()
+func runWithRuntime(r *rosa.Runtime) error {
+	search, err := buildSearch(args.search, args.states, args.regions, args.versions, args.topologies)
+	if err != nil {
+		return err
+	}
+	selected, err := parseColumns(args.columns)
+	if err != nil {
+		return err
+	}
+	var sortBy column
+	var descending bool
+	if args.sortBy != "" {
+		sortBy, descending, err = parseSortBy(args.sortBy)
+		if err != nil {
+			return err
+		}
+	}
 
 	// Retrieve the list of clusters:
 	var creator *aws.Creator
-	if args.listAll {
-		creator = nil
-	} else {
+	if !args.listAll {
 		creator = r.Creator
 	}
 
-	var clusters []*v1.Cluster
-	var err error
-
+	var role *aws.Role
 	if args.accountRoleArn != "" {
-		clusters, err = listClustersUsingAccountRole(creator, r)
-	} else {
-		clusters, err = r.OCMClient.GetClusters(creator, clusterCount)
+		accountRole, err := r.AWSClient.GetAccountRoleByArn(args.accountRoleArn)
+		if err != nil {
+			return fmt.Errorf("Failed to get clusters: %v", err)
+		}
+		role = &accountRole
 	}
 
+	clusters, err := r.OCMClient.ListClusters(creator, role, search)
 	if err != nil {
-		r.Reporter.Errorf("Failed to get clusters: %v", err)
-		os.Exit(1)
+		return fmt.Errorf("Failed to get clusters: %v", err)
+	}
+	if args.sortBy != "" {
+		sortClusters(clusters, sortBy, descending)
 	}
 
-	if output.HasFlag() {
-		err = output.Print(clusters)
-		if err != nil {
-			r.Reporter.Errorf("%s", err)
-			os.Exit(1)
-		}
-		os.Exit(0)
+	switch output.Output() {
+	case output.CSV:
+		return printCSV(clusters, selected)
+	case "":
+	default:
+		return output.Print(clusters)
 	}
 
 	if len(clusters) == 0 {
 		r.Reporter.Infof("No clusters available")
-		os.Exit(0)
+		return nil
 	}
 
 	// Create the writer that will be used to print the tabulated results:
 	writer := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
-	fmt.Fprintf(writer, "ID\tNAME\tSTATE\tTOPOLOGY\n")
+	headers := make([]string, len(selected))
+	for i, column := range selected {
+		headers[i] = column.header
+	}
+	fmt.Fprintf(writer, "%s\n", strings.Join(headers, "\t"))
 	for _, cluster := range clusters {
-		typeOutput := "Classic"
-		if cluster.AWS() != nil && cluster.AWS().STS() != nil && cluster.AWS().STS().Enabled() {
-			typeOutput = "Classic (STS)"
-		}
-		if cluster.Hypershift().Enabled() {
-			typeOutput = "Hosted CP"
+		fmt.Fprintf(writer, "%s\n", strings.Join(values(cluster, selected), "\t"))
+	}
+	return writer.Flush()
+}
+
+func printCSV(clusters []*v1.Cluster, selected []column) error {
+	writer := csv.NewWriter(os.Stdout)
+	headers := make([]string, len(selected))
+	for i, column := range selected {
+		headers[i] = column.name
+	}
+	err := writer.Write(headers)
+	if err != nil {
+		return err
+	}
+	for _, cluster := range clusters {
+		err = writer.Write(values(cluster, selected))
+		if err != nil {
+			return err
 		}
-		fmt.Fprintf(
-			writer,
-			"%s\t%s\t%s\t%s\n",
-			cluster.ID(),
-			cluster.Name(),
-			cluster.State(),
-			typeOutput,
-		)
 	}
 	writer.Flush()
+	return writer.Error()
+}
+
+func values(cluster *v1.Cluster, selected []column) []string {
+	result := make([]string, len(selected))
+	for i, column := range selected {
+		result[i] = column.value(cluster)
+	}
+	return result
 }
@@ -0,0 +1,167 @@
+package cluster
+
+import (
+	"bytes"
+	"fmt"
+	"net/http"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/ghttp"
+	v1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+	. "github.com/openshift-online/ocm-sdk-go/testing"
+	"github.com/spf13/cobra"
+
+	"github.com/openshift/rosa/pkg/output"
+	"github.com/openshift/rosa/pkg/rosa"
+	"github.com/openshift/rosa/pkg/test"
+)
+
+func mockCluster(id string, version string, region string) *v1.Cluster {
+	return test.MockCluster(func(c *v1.ClusterBuilder) {
+		c.ID(id).Name("cluster-" + id).State(v1.ClusterStateReady).
+			OpenshiftVersion(version).Region(v1.NewCloudRegion().ID(region))
+	})
+}
+
+func formatPage(clusters []*v1.Cluster, page int, total int) string {
+	var items bytes.Buffer
+	v1.MarshalClusterList(clusters, &items)
+	return fmt.Sprintf(`{"kind": "ClusterList", "page": %d, "size": %d, "total": %d, "items": %s}`,
+		page, len(clusters), total, items.String())
+}
+
+var _ = Describe("list clusters", func() {
+	Context("search", func() {
+		DescribeTable("builds the OCM search expression",
+			func(search string, states, regions, versions, topologyNames []string, expected string) {
+				result, err := buildSearch(search, states, regions, versions, topologyNames)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(result).To(Equal(expected))
+			},
+			Entry("without filters", "", nil, nil, nil, nil, ""),
+			Entry("with the search expression only", "name LIKE 'a%'", nil, nil, nil, nil, "name LIKE 'a%'"),
+			Entry("with states", "", []string{"ready", "error"}, nil, nil, nil, "state IN ('ready', 'error')"),
+			Entry("with a minor version", "", nil, nil, []string{"4.15"}, nil,
+				"openshift_version = '4.15' OR openshift_version LIKE '4.15.%'"),
+			Entry("with a topology", "", nil, nil, nil, []string{"Hosted-CP"}, "(hypershift.enabled = 'true')"),
+			Entry("with several filters", "name = 'x'", nil, []string{"us-east-1"}, nil,
+				[]string{"classic", "classic-sts"},
+				"(region.id IN ('us-east-1')) AND "+
+					"((hypershift.enabled = 'false' AND aws.sts.enabled = 'false') OR "+
+					"(hypershift.enabled = 'false' AND aws.sts.enabled = 'true')) AND (name = 'x')"),
+			Entry("escaping quotes", "", nil, []string{"it's"}, nil, nil, "region.id IN ('it''s')"),
+		)
+
+		It("fails with an invalid topology", func() {
+			_, err := buildSearch("", nil, nil, nil, []string{"rosa"})
+			Expect(err).To(MatchError("Invalid topology 'rosa'. Allowed topologies are " +
+				"classic, classic-sts, hosted-cp"))
+		})
+	})
+
+	Context("columns", func() {
+		It("parses the selected columns", func() {
+			result, err := parseColumns("id, Region,version")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result).To(HaveLen(3))
+			Expect(result[1].header).To(Equal("REGION"))
+		})
+
+		It("fails with an invalid column", func() {
+			_, err := parseColumns("id,size")
+			Expect(err).To(MatchError(ContainSubstring("Invalid column 'size'")))
+		})
+
+		It("sorts versions as versions", func() {
+			clusters := []*v1.Cluster{
+				mockCluster("a", "4.15.2", "us-east-1"),
+				mockCluster("b", "4.9.10", "us-east-1"),
+				mockCluster("c", "4.14.0", "us-east-1"),
+			}
+			by, descending, err := parseSortBy("version")
+			Expect(err).NotTo(HaveOccurred())
+			sortClusters(clusters, by, descending)
+			Expect([]string{clusters[0].ID(), clusters[1].ID(), clusters[2].ID()}).To(Equal([]string{"b", "c", "a"}))
+
+			by, descending, err = parseSortBy("-id")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(descending).To(BeTrue())
+			sortClusters(clusters, by, descending)
+			Expect([]string{clusters[0].ID(), clusters[1].ID(), clusters[2].ID()}).To(Equal([]string{"c", "b", "a"}))
+		})
+	})
+
+	It("doesn't shadow the AWS region flag of the parent command", func() {
+		Expect(Cmd.LocalFlags().Lookup("region")).To(BeNil())
+		Expect(Cmd.LocalFlags().Lookup("cluster-region")).NotTo(BeNil())
+	})
+
+	Context("run", func() {
+		var t *test.TestingRuntime
+
+		BeforeEach(func() {
+			t = test.NewTestRuntime()
+			args.listAll = true
+			args.accountRoleArn = ""
+			args.search = ""
+			args.states = nil
+			args.regions = nil
+			args.versions = nil
+			args.topologies = nil
+			args.columns = defaultColumns
+			args.sortBy = ""
+			output.SetOutput("")
+			DeferCleanup(output.SetOutput, "")
+		})
+
+		It("retrieves all the pages with the filters", func() {
+			args.states = []string{"ready"}
+			args.columns = "id,region,version"
+			args.sortBy = "-version"
+			t.ApiServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					ghttp.VerifyFormKV("search", "product.id = 'rosa' AND (state IN ('ready'))"),
+					ghttp.VerifyFormKV("page", "1"),
+					RespondWithJSON(http.StatusOK, formatPage([]*v1.Cluster{
+						mockCluster("a", "4.14.1", "us-east-1"),
+						mockCluster("b", "4.15.0", "eu-west-1"),
+					}, 1, 3)),
+				),
+				ghttp.CombineHandlers(
+					ghttp.VerifyFormKV("page", "2"),
+					RespondWithJSON(http.StatusOK, formatPage([]*v1.Cluster{
+						mockCluster("c", "4.9.0", "us-east-2"),
+					}, 2, 3)),
+				),
+			)
+			stdout, _, err := test.RunWithOutputCapture(func(r *rosa.Runtime, _ *cobra.Command) error {
+				return runWithRuntime(r)
+			}, t.RosaRuntime, Cmd)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(stdout).To(Equal("ID  REGION     VERSION\n" +
+				"b   eu-west-1  4.15.0\n" +
+				"a   us-east-1  4.14.1\n" +
+				"c   us-east-2  4.9.0\n"))
+		})
+
+		It("prints the selected columns as CSV", func() {
+			output.SetOutput(output.CSV)
+			args.columns = "name,state,topology"
+			t.ApiServer.AppendHandlers(RespondWithJSON(http.StatusOK, formatPage([]*v1.Cluster{
+				mockCluster("a", "4.14.1", "us-east-1"),
+			}, 1, 1)))
+			stdout, _, err := test.RunWithOutputCapture(func(r *rosa.Runtime, _ *cobra.Command) error {
+				return runWithRuntime(r)
+			}, t.RosaRuntime, Cmd)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(stdout).To(Equal("name,state,topology\ncluster-a,ready,Classic\n"))
+		})
+
+		It("fails before the request with an invalid sort column", func() {
+			args.sortBy = "size"
+			err := runWithRuntime(t.RosaRuntime)
+			Expect(err).To(MatchError(ContainSubstring("Invalid sort column 'size'")))
+		})
+	})
+})
@@ -0,0 +1,226 @@
+/*
+Copyright (c) 2024 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cluster
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	ver "github.com/hashicorp/go-version"
+	v1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+)
+
+const (
+	topologyClassic    = "classic"
+	topologyClassicSTS = "classic-sts"
+	topologyHostedCP   = "hosted-cp"
+)
+
+var topologies = []string{topologyClassic, topologyClassicSTS, topologyHostedCP}
+
+// topologySearches are the OCM search expressions that select the clusters of every topology.
+var topologySearches = map[string]string{
+	topologyClassic:    "hypershift.enabled = 'false' AND aws.sts.enabled = 'false'",
+	topologyClassicSTS: "hypershift.enabled = 'false' AND aws.sts.enabled = 'true'",
+	topologyHostedCP:   "hypershift.enabled = 'true'",
+}
+
+const defaultColumns = "id,name,state,topology"
+
+// column is a column that can be selected with the '--columns' flag.
+type column struct {
+	name   string
+	header string
+	value  func(*v1.Cluster) string
+}
+
+var columns = []column{
+	{"id", "ID", (*v1.Cluster).ID},
+	{"name", "NAME", (*v1.Cluster).Name},
+	{"state", "STATE", func(c *v1.Cluster) string { return string(c.State()) }},
+	{"topology", "TOPOLOGY", topology},
+	{"region", "REGION", func(c *v1.Cluster) string { return c.Region().ID() }},
+	{"version", "VERSION", func(c *v1.Cluster) string { return c.OpenshiftVersion() }},
+	{"multi_az", "MULTI AZ", func(c *v1.Cluster) string { return strconv.FormatBool(c.MultiAZ()) }},
+	{"private", "PRIVATE", func(c *v1.Cluster) string {
+		return strconv.FormatBool(c.API().Listening() == v1.ListeningMethodInternal)
+	}},
+	{"api_url", "API URL", func(c *v1.Cluster) string { return c.API().URL() }},
+	{"console_url", "CONSOLE URL", func(c *v1.Cluster) string { return c.Console().URL() }},
+	{"created", "CREATED", func(c *v1.Cluster) string {
+		if c.CreationTimestamp().IsZero() {
+			return ""
+		}
+		return c.CreationTimestamp().UTC().Format(time.RFC3339)
+	}},
+	{"aws_account", "AWS ACCOUNT", func(c *v1.Cluster) string { return c.AWS().AccountID() }},
+	{"channel_group", "CHANNEL GROUP", func(c *v1.Cluster) string { return c.Version().ChannelGroup() }},
+	{"external_id", "EXTERNAL ID", (*v1.Cluster).ExternalID},
+	{"billing_model", "BILLING MODEL", func(c *v1.Cluster) string { return string(c.BillingModel()) }},
+}
+
+func columnNames() []string {
+	names := make([]string, len(columns))
+	for i, column := range columns {
+		names[i] = column.name
+	}
+	return names
+}
+
+func findColumn(name string) (column, bool) {
+	for _, column := range columns {
+		if column.name == name {
+			return column, true
+		}
+	}
+	return column{}, false
+}
+
+// parseColumns returns the columns selected with a comma separated list of names.
+func parseColumns(value string) ([]column, error) {
+	result := []column{}
+	for _, name := range strings.Split(value, ",") {
+		name = strings.ToLower(strings.TrimSpace(name))
+		if name == "" {
+			continue
+		}
+		column, ok := findColumn(name)
+		if !ok {
+			return nil, fmt.Errorf("Invalid column '%s'. Allowed columns are %s",
+				name, strings.Join(columnNames(), ", "))
+		}
+		result = append(result, column)
+	}
+	if len(result) == 0 {
+		return nil, fmt.Errorf("At least one column is required")
+	}
+	return result, nil
+}
+
+func topology(cluster *v1.Cluster) string {
+	if cluster.Hypershift().Enabled() {
+		return "Hosted CP"
+	}
+	if cluster.AWS().STS().Enabled() {
+		return "Classic (STS)"
+	}
+	return "Classic"
+}
+
+// buildSearch returns the OCM search expression that selects the clusters matching the filters, or an
+// empty string if there are no filters. The expression given with '--search' is added as is.
+func buildSearch(search string, states []string, regions []string, versions []string,
+	topologyNames []string) (string, error) {
+	terms := []string{}
+	if len(states) > 0 {
+		terms = append(terms, fmt.Sprintf("state IN (%s)", quoteList(states)))
+	}
+	if len(regions) > 0 {
+		terms = append(terms, fmt.Sprintf("region.id IN (%s)", quoteList(regions)))
+	}
+	if len(versions) > 0 {
+		// A version like '4.15' selects all the patch versions of that minor version
+		matches := []string{}
+		for _, version := range versions {
+			version = strings.TrimPrefix(version, "openshift-v")
+			matches = append(matches, fmt.Sprintf("openshift_version = %s OR openshift_version LIKE %s",
+				quote(version), quote(version+".%")))
+		}
+		terms = append(terms, strings.Join(matches, " OR "))
+	}
+	if len(topologyNames) > 0 {
+		matches := []string{}
+		for _, name := range topologyNames {
+			match, ok := topologySearches[strings.ToLower(name)]
+			if !ok {
+				return "", fmt.Errorf("Invalid topology '%s'. Allowed topologies are %s",
+					name, strings.Join(topologies, ", "))
+			}
+			matches = append(matches, fmt.Sprintf("(%s)", match))
+		}
+		terms = append(terms, strings.Join(matches, " OR "))
+	}
+	if search != "" {
+		terms = append(terms, search)
+	}
+	if len(terms) == 1 {
+		return terms[0], nil
+	}
+	for i, term := range terms {
+		terms[i] = fmt.Sprintf("(%s)", term)
+	}
+	return strings.Join(terms, " AND "), nil
+}
+
+// quote returns the given value as an OCM search string literal.
+func quote(value string) string {
+	return fmt.Sprintf("'%s'", strings.ReplaceAll(value, "'", "''"))
+}
+
+func quoteList(values []string) string {
+	quoted := make([]string, len(values))
+	for i, value := range values {
+		quoted[i] = quote(value)
+	}
+	return strings.Join(quoted, ", ")
+}
+
+// parseSortBy returns the column used to sort the clusters and whether the order is descending, which is
+// selected prefixing the name of the column with '-'.
+func parseSortBy(value string) (column, bool, error) {
+	descending := strings.HasPrefix(value, "-")
+	name := strings.ToLower(strings.TrimPrefix(value, "-"))
+	column, ok := findColumn(name)
+	if !ok {
+		return column, false, fmt.Errorf("Invalid sort column '%s'. Allowed columns are %s",
+			name, strings.Join(columnNames(), ", "))
+	}
+	return column, descending, nil
+}
+
+// sortClusters sorts the clusters by the value of the given column. Versions are compared as versions,
+// so that '4.9' comes before '4.15'. The sort is stable, so clusters with the same value keep the order
+// returned by OCM.
+func sortClusters(clusters []*v1.Cluster, by column, descending bool) {
+	sort.SliceStable(clusters, func(i, j int) bool {
+		a, b := by.value(clusters[i]), by.value(clusters[j])
+		if descending {
+			a, b = b, a
+		}
+		if by.name == "version" {
+			if less, ok := versionLess(a, b); ok {
+				return less
+			}
+		}
+		return a < b
+	})
+}
+
+func versionLess(a string, b string) (less bool, ok bool) {
+	versionA, err := ver.NewVersion(a)
+	if err != nil {
+		return false, false
+	}
+	versionB, err := ver.NewVersion(b)
+	if err != nil {
+		return false, false
+	}
+	return versionA.LessThan(versionB), true
+}
@@ -17,17 +17,22 @@ limitations under the License.
 package instancetypes
 
 import (
+	"bytes"
+	"encoding/json"
 	"fmt"
 	"os"
 	"text/tabwriter"
+	"time"
 
 	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
 	"github.com/spf13/cobra"
 
 	"github.com/openshift/rosa/pkg/arguments"
+	"github.com/openshift/rosa/pkg/aws"
 	"github.com/openshift/rosa/pkg/helper"
 	"github.com/openshift/rosa/pkg/ocm"
 	"github.com/openshift/rosa/pkg/output"
+	"github.com/openshift/rosa/pkg/reporter"
 	"github.com/openshift/rosa/pkg/rosa"
 )
 
@@ -40,7 +45,10 @@ func makeCmd() *cobra.Command {
 		Short:   "List Instance types",
 		Long:    "List Instance types that are available for use with ROSA.",
 		Example: `  # List all instance types
-	rosa list instance-types`,
+	rosa list instance-types
+
+  # List the instance types with their spot prices in the availability zones of cluster 'mycluster'
+	rosa list instance-types --spot --cluster=mycluster`,
 		Run:  run,
 		Args: cobra.NoArgs,
 	}
@@ -52,10 +60,23 @@ func init() {
 	initFlags(Cmd)
 }
 
+var args struct {
+	spot bool
+}
+
 func initFlags(cmd *cobra.Command) {
 	flags := cmd.Flags()
 	arguments.AddRegionFlag(flags)
 	output.AddFlag(cmd)
+	ocm.AddOptionalClusterFlag(cmd)
+	flags.BoolVar(
+		&args.spot,
+		"spot",
+		false,
+		"Show the hourly on-demand price, and the current and time-weighted average hourly spot prices of the last day, "+
+			"in US dollars. Spot prices are those of the availability zones of the cluster if '--cluster' is "+
+			"used, and of all the availability zones of the region otherwise.",
+	)
 }
 
 func run(cmd *cobra.Command, _ []string) {
@@ -64,7 +85,7 @@ func run(cmd *cobra.Command, _ []string) {
 	err := runWithRuntime(r, cmd)
 	if err != nil {
 		r.Reporter.Errorf(err.Error())
-		os.Exit(1)
+		os.Exit(reporter.ExitCode())
 	}
 }
 
@@ -72,8 +93,31 @@ func runWithRuntime(r *rosa.Runtime, cmd *cobra.Command) error {
 	r.Reporter.Debugf("Fetching instance types")
 
 	var machineTypes ocm.MachineTypeList
-	if cmd.Flags().Changed("region") {
-		var availabilityZones []string
+	var availabilityZones []string
+	region := r.AWSClient.GetRegion()
+	if cmd.Flags().Changed("cluster") {
+		if cmd.Flags().Changed("region") {
+			return fmt.Errorf("The '--region' and '--cluster' options can't be used together")
+		}
+		cluster := r.FetchCluster()
+		region = cluster.Region().ID()
+		availabilityZones = cluster.Nodes().AvailabilityZones()
+		awsClient, err := aws.NewClient().
+			Region(cluster.Region().ID()).
+			Logger(r.Logger).
+			Build()
+		if err != nil {
+			return fmt.Errorf("Failed to create AWS client: %v", err)
+		}
+		r.AWSClient = awsClient
+
+		machineTypes, err = r.OCMClient.GetAvailableMachineTypesInRegion(cluster.Region().ID(),
+			availabilityZones, "", r.AWSClient)
+		if err != nil {
+			return fmt.Errorf("Failed to fetch instance types: %v", err)
+		}
+	} else if cmd.Flags().Changed("region") {
+		region = arguments.GetRegion()
 		roleArn := ""
 		regionList, _, err := r.OCMClient.GetRegionList(false, "", "", "",
 			r.AWSClient, false, false)
@@ -98,7 +142,34 @@ func runWithRuntime(r *rosa.Runtime, cmd *cobra.Command) error {
 		machineTypes = availableMachineTypes
 	}
 
+	var spotPrices map[string]*aws.SpotPrice
+	var onDemandPrices map[string]float64
+	if args.spot {
+		instanceTypes := []string{}
+		for _, machine := range machineTypes.Items {
+			if machine.Available {
+				instanceTypes = append(instanceTypes, machine.MachineType.ID())
+			}
+		}
+		r.Reporter.Debugf("Fetching spot prices of %d instance types", len(instanceTypes))
+		var err error
+		spotPrices, err = r.AWSClient.GetSpotPrices(instanceTypes, availabilityZones,
+			time.Now().Add(-aws.SpotPricePeriod))
+		if err != nil {
+			return fmt.Errorf("Failed to fetch spot prices: %v", err)
+		}
+		r.Reporter.Debugf("Fetching on-demand prices in region '%s'", region)
+		onDemandPrices, err = r.AWSClient.GetOnDemandPrices(region, instanceTypes)
+		if err != nil {
+			// Reading the price list requires a permission that isn't needed otherwise
+			r.Reporter.Warnf("Failed to fetch on-demand prices: %v", err)
+		}
+	}
+
 	if output.HasFlag() {
+		if args.spot {
+			return printPrices(machineTypes, spotPrices, onDemandPrices)
+		}
 		var instanceTypes []*cmv1.MachineType
 		for _, machine := range machineTypes.Items {
 			instanceTypes = append(instanceTypes, machine.MachineType)
@@ -117,7 +188,11 @@ func runWithRuntime(r *rosa.Runtime, cmd *cobra.Command) error {
 
 	// Create the writer that will be used to print the tabulated results:
 	writer := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
-	fmt.Fprintf(writer, "ID\tCATEGORY\tCPU_CORES\tMEMORY\n")
+	if args.spot {
+		fmt.Fprintf(writer, "ID\tCATEGORY\tCPU_CORES\tMEMORY\tON_DEMAND_PRICE\tSPOT_PRICE\tAVG_SPOT_PRICE\n")
+	} else {
+		fmt.Fprintf(writer, "ID\tCATEGORY\tCPU_CORES\tMEMORY\n")
+	}
 
 	for _, machine := range machineTypes.Items {
 		if !machine.Available {
@@ -125,17 +200,62 @@ func runWithRuntime(r *rosa.Runtime, cmd *cobra.Command) error {
 		}
 		availableMachine := machine.MachineType
 		fmt.Fprintf(writer,
-			"%s\t%s\t%d\t%s\n",
+			"%s\t%s\t%d\t%s",
 			availableMachine.ID(), availableMachine.Category(), int(availableMachine.CPU().Value()),
 			ByteCountIEC(int(availableMachine.Memory().Value()),
 				availableMachine.Memory().Unit()),
 		)
+		if args.spot {
+			onDemand, current, average := "N/A", "N/A", "N/A"
+			if price, ok := onDemandPrices[availableMachine.ID()]; ok {
+				onDemand = fmt.Sprintf("$%.4f", price)
+			}
+			if spotPrice, ok := spotPrices[availableMachine.ID()]; ok {
+				current = fmt.Sprintf("$%.4f", spotPrice.Current)
+				average = fmt.Sprintf("$%.4f", spotPrice.Average)
+			}
+			fmt.Fprintf(writer, "\t%s\t%s\t%s", onDemand, current, average)
+		}
+		fmt.Fprintf(writer, "\n")
 	}
 	writer.Flush()
 
 	return nil
 }
 
+// printPrices prints the available instance types, each with its on-demand price and spot price when they
+// are known.
+func printPrices(machineTypes ocm.MachineTypeList, spotPrices map[string]*aws.SpotPrice,
+	onDemandPrices map[string]float64) error {
+	instanceTypes := []map[string]interface{}{}
+	for _, machine := range machineTypes.Items {
+		if !machine.Available {
+			continue
+		}
+		var b bytes.Buffer
+		err := cmv1.MarshalMachineType(machine.MachineType, &b)
+		if err != nil {
+			return err
+		}
+		instanceType := map[string]interface{}{}
+		err = json.Unmarshal(b.Bytes(), &instanceType)
+		if err != nil {
+			return err
+		}
+		if price, ok := onDemandPrices[machine.MachineType.ID()]; ok {
+			instanceType["on_demand_price"] = price
+		}
+		if spotPrice, ok := spotPrices[machine.MachineType.ID()]; ok {
+			instanceType["spot_price"] = map[string]float64{
+				"current": spotPrice.Current,
+				"average": spotPrice.Average,
+			}
+		}
+		instanceTypes = append(instanceTypes, instanceType)
+	}
+	return output.Print(instanceTypes)
+}
+
 func ByteCountIEC(b int, uValue string) string {
 	var unit int
 	if uValue == "B" {
@@ -1,6 +1,8 @@
 package instancetypes
 
 import (
+	"encoding/json"
+	"fmt"
 	"net/http"
 	"time"
 
@@ -25,8 +27,9 @@ var _ = Describe("list instance-types", func() {
 	var (
 		ssoServer, apiServer *ghttp.Server
 
-		cmd *cobra.Command
-		r   *rosa.Runtime
+		cmd       *cobra.Command
+		r         *rosa.Runtime
+		awsClient *aws.MockClient
 
 		// GET /api/accounts_mgmt/v1/current_account
 		currentAccount = `
@@ -226,12 +229,13 @@ g4dn.12xlarge  accelerated_computing  48         192.0 GiB
 		}
 
 		ctrl := gomock.NewController(GinkgoT())
-		awsClient := aws.NewMockClient(ctrl)
+		awsClient = aws.NewMockClient(ctrl)
 		r.AWSClient = awsClient
 		awsClient.EXPECT().GetAWSAccessKeys().Return(&aws.AccessKey{
 			AccessKeyID:     "abc123",
 			SecretAccessKey: "abc123",
 		}, nil).AnyTimes()
+		awsClient.EXPECT().GetRegion().Return("us-east-2").AnyTimes()
 
 		DeferCleanup(r.Cleanup)
 	})
@@ -283,6 +287,72 @@ g4dn.12xlarge  accelerated_computing  48         192.0 GiB
 		Expect(stdout).To(Equal(regionSuccessOutput))
 	})
 
+	It("Succeeds with --spot", func() {
+
+		cmd.Flags().Set("region", "us-east-1")
+		cmd.Flags().Set("spot", "true")
+		DeferCleanup(func() { args.spot = false })
+
+		apiServer.AppendHandlers(
+			RespondWithJSON(http.StatusOK, regionsSuccess),
+			RespondWithJSON(http.StatusOK, machinesSuccess),
+			RespondWithJSON(http.StatusOK, currentAccount),
+			RespondWithJSON(http.StatusOK, orgQuota),
+		)
+		awsClient.EXPECT().GetSpotPrices([]string{"g4dn.12xlarge"}, nil, gomock.Any()).Return(
+			map[string]*aws.SpotPrice{
+				"g4dn.12xlarge": {InstanceType: "g4dn.12xlarge", Current: 1.2345, Average: 1.5},
+			}, nil)
+		awsClient.EXPECT().GetOnDemandPrices("us-east-1", []string{"g4dn.12xlarge"}).Return(
+			map[string]float64{"g4dn.12xlarge": 3.912}, nil)
+
+		stdout, stderr, err := test.RunWithOutputCapture(runWithRuntime, r, cmd)
+		Expect(err).To(BeNil())
+		Expect(stderr).To(Equal(""))
+		Expect(stdout).To(Equal(
+			"ID             CATEGORY               CPU_CORES  MEMORY     ON_DEMAND_PRICE  SPOT_PRICE  " +
+				"AVG_SPOT_PRICE\n" +
+				"g4dn.12xlarge  accelerated_computing  48         192.0 GiB  $3.9120          $1.2345     " +
+				"$1.5000\n"))
+	})
+
+	It("Succeeds with --spot and JSON output", func() {
+
+		cmd.Flags().Set("region", "us-east-1")
+		cmd.Flags().Set("spot", "true")
+		cmd.Flags().Set("output", "json")
+		DeferCleanup(func() {
+			args.spot = false
+			cmd.Flags().Set("output", "")
+		})
+
+		apiServer.AppendHandlers(
+			RespondWithJSON(http.StatusOK, regionsSuccess),
+			RespondWithJSON(http.StatusOK, machinesSuccess),
+			RespondWithJSON(http.StatusOK, currentAccount),
+			RespondWithJSON(http.StatusOK, orgQuota),
+		)
+		awsClient.EXPECT().GetSpotPrices([]string{"g4dn.12xlarge"}, nil, gomock.Any()).Return(
+			map[string]*aws.SpotPrice{
+				"g4dn.12xlarge": {InstanceType: "g4dn.12xlarge", Current: 1.2345, Average: 1.5},
+			}, nil)
+		awsClient.EXPECT().GetOnDemandPrices("us-east-1", []string{"g4dn.12xlarge"}).Return(
+			nil, fmt.Errorf("access denied"))
+
+		stdout, stderr, err := test.RunWithOutputCapture(runWithRuntime, r, cmd)
+		Expect(err).To(BeNil())
+		Expect(stderr).To(ContainSubstring("Failed to fetch on-demand prices: access denied"))
+		var instanceTypes []map[string]interface{}
+		Expect(json.Unmarshal([]byte(stdout), &instanceTypes)).To(Succeed())
+		Expect(instanceTypes).To(HaveLen(1))
+		Expect(instanceTypes[0]).To(HaveKeyWithValue("id", "g4dn.12xlarge"))
+		Expect(instanceTypes[0]).To(HaveKeyWithValue("spot_price", map[string]interface{}{
+			"current": 1.2345,
+			"average": 1.5,
+		}))
+		Expect(instanceTypes[0]).NotTo(HaveKey("on_demand_price"))
+	})
+
 	It("Handles unknown --region", func() {
 
 		cmd.Flags().Set("region", "us-east-xyz")
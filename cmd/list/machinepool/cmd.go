@@ -24,6 +24,7 @@ import (
 
 	"github.com/openshift/rosa/pkg/ocm"
 	"github.com/openshift/rosa/pkg/output"
+	"github.com/openshift/rosa/pkg/reporter"
 	"github.com/openshift/rosa/pkg/rosa"
 )
 
@@ -33,13 +34,27 @@ var Cmd = &cobra.Command{
 	Short:   "List cluster machine pools",
 	Long:    "List machine pools configured on a cluster.",
 	Example: `  # List all machine pools on a cluster named "mycluster"
-  rosa list machinepools --cluster=mycluster`,
+  rosa list machinepools --cluster=mycluster
+
+  # Show how far the machine pools of hosted cluster "mycluster" are behind the control plane
+  rosa list machinepools --cluster=mycluster --show-upgrades`,
 	Run:  run,
 	Args: cobra.NoArgs,
 }
 
+var args struct {
+	showUpgrades bool
+}
+
 func init() {
 	ocm.AddClusterFlag(Cmd)
+	Cmd.Flags().BoolVar(
+		&args.showUpgrades,
+		"show-upgrades",
+		false,
+		"Show the version of each machine pool compared to the control plane, its available upgrades "+
+			"and its scheduled upgrade. Only supported for Hosted Control Planes.",
+	)
 	output.AddFlag(Cmd)
 }
 
@@ -53,7 +68,12 @@ func run(_ *cobra.Command, _ []string) {
 	if cluster.State() != cmv1.ClusterStateReady &&
 		cluster.State() != cmv1.ClusterStateHibernating {
 		r.Reporter.Errorf("Cluster '%s' is not yet ready", clusterKey)
-		os.Exit(1)
+		os.Exit(reporter.ExitCode())
+	}
+
+	if args.showUpgrades && !cluster.Hypershift().Enabled() {
+		r.Reporter.Errorf("The '--show-upgrades' option is only supported for Hosted Control Planes")
+		os.Exit(reporter.ExitCode())
 	}
 
 	if cluster.Hypershift().Enabled() {
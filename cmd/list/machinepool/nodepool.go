@@ -3,12 +3,16 @@ package machinepool
 import (
 	"fmt"
 	"os"
+	"strings"
 	"text/tabwriter"
 
 	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
 
+	"github.com/openshift/rosa/pkg/helper/versions"
+	"github.com/openshift/rosa/pkg/ocm"
 	ocmOutput "github.com/openshift/rosa/pkg/ocm/output"
 	"github.com/openshift/rosa/pkg/output"
+	"github.com/openshift/rosa/pkg/reporter"
 	"github.com/openshift/rosa/pkg/rosa"
 )
 
@@ -18,16 +22,25 @@ func listNodePools(r *rosa.Runtime, clusterKey string, cluster *cmv1.Cluster) {
 	nodePools, err := r.OCMClient.GetNodePools(cluster.ID())
 	if err != nil {
 		r.Reporter.Errorf("Failed to get machine pools for cluster '%s': %v", clusterKey, err)
-		os.Exit(1)
+		os.Exit(reporter.ExitCode())
+	}
+
+	if args.showUpgrades {
+		err = listNodePoolUpgrades(r, clusterKey, cluster, nodePools)
+		if err != nil {
+			r.Reporter.Errorf("%v", err)
+			os.Exit(reporter.ExitCode())
+		}
+		return
 	}
 
 	if output.HasFlag() {
 		err = output.Print(nodePools)
 		if err != nil {
 			r.Reporter.Errorf("%s", err)
-			os.Exit(1)
+			os.Exit(reporter.ExitCode())
 		}
-		os.Exit(0)
+		return
 	}
 
 	// Create the writer that will be used to print the tabulated results:
@@ -54,3 +67,99 @@ func listNodePools(r *rosa.Runtime, clusterKey string, cluster *cmv1.Cluster) {
 	}
 	writer.Flush()
 }
+
+// nodePoolUpgrades is the version of a machine pool of a hosted cluster compared to the control plane.
+type nodePoolUpgrades struct {
+	ID                  string            `json:"id"`
+	Version             string            `json:"version"`
+	ControlPlaneVersion string            `json:"control_plane_version"`
+	Drift               string            `json:"drift"`
+	SupportedSkew       bool              `json:"supported_skew"`
+	AvailableUpgrades   []string          `json:"available_upgrades"`
+	ScheduledUpgrade    *scheduledUpgrade `json:"scheduled_upgrade,omitempty"`
+}
+
+type scheduledUpgrade struct {
+	Version      string `json:"version,omitempty"`
+	State        string `json:"state"`
+	ScheduleType string `json:"schedule_type"`
+	NextRun      string `json:"next_run"`
+}
+
+func listNodePoolUpgrades(r *rosa.Runtime, clusterKey string, cluster *cmv1.Cluster,
+	nodePools []*cmv1.NodePool) error {
+	controlPlaneVersion := ocm.GetRawVersionId(cluster.Version().ID())
+	rows := []*nodePoolUpgrades{}
+	outsideSkew := []string{}
+	for _, nodePool := range nodePools {
+		row := &nodePoolUpgrades{
+			ID:                  nodePool.ID(),
+			Version:             ocm.GetRawVersionId(nodePool.Version().ID()),
+			ControlPlaneVersion: controlPlaneVersion,
+			AvailableUpgrades:   ocm.GetNodePoolAvailableUpgrades(nodePool),
+		}
+		var err error
+		row.Drift, row.SupportedSkew, err = versions.GetNodePoolDrift(controlPlaneVersion, row.Version)
+		if err != nil {
+			return fmt.Errorf("Failed to compare the version of machine pool '%s' with the control plane: %v",
+				nodePool.ID(), err)
+		}
+		if !row.SupportedSkew {
+			outsideSkew = append(outsideSkew, nodePool.ID())
+		}
+
+		r.Reporter.Debugf("Loading scheduled upgrades for machine pool '%s' on cluster '%s'",
+			nodePool.ID(), clusterKey)
+		upgradePolicies, err := r.OCMClient.GetNodePoolUpgradePolicies(cluster.ID(), nodePool.ID())
+		if err != nil {
+			return fmt.Errorf("Failed to get scheduled upgrades for machine pool '%s': %v", nodePool.ID(), err)
+		}
+		for _, upgradePolicy := range upgradePolicies {
+			if upgradePolicy.UpgradeType() == cmv1.UpgradeTypeNodePool {
+				row.ScheduledUpgrade = &scheduledUpgrade{
+					Version:      upgradePolicy.Version(),
+					State:        string(upgradePolicy.State().Value()),
+					ScheduleType: string(upgradePolicy.ScheduleType()),
+					NextRun:      upgradePolicy.NextRun().Format("2006-01-02 15:04 MST"),
+				}
+				break
+			}
+		}
+		rows = append(rows, row)
+	}
+
+	if output.HasFlag() {
+		return output.Print(rows)
+	}
+
+	writer := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+	fmt.Fprintf(writer, "ID\tVERSION\tCONTROL PLANE\tDRIFT\tAVAILABLE UPGRADES\tSCHEDULED UPGRADE\n")
+	for _, row := range rows {
+		fmt.Fprintf(writer, "%s\t%s\t%s\t%s\t%s\t%s\n",
+			row.ID,
+			row.Version,
+			row.ControlPlaneVersion,
+			row.Drift,
+			strings.Join(row.AvailableUpgrades, ", "),
+			printScheduledUpgrade(row.ScheduledUpgrade),
+		)
+	}
+	writer.Flush()
+
+	if len(outsideSkew) > 0 {
+		r.Reporter.Warnf("Machine pools %s are outside the supported skew of %d minor versions from the "+
+			"control plane, upgrade them with 'rosa upgrade machinepool'",
+			strings.Join(outsideSkew, ", "), versions.MinorVersionsSupported)
+	}
+	return nil
+}
+
+func printScheduledUpgrade(upgrade *scheduledUpgrade) string {
+	if upgrade == nil {
+		return ""
+	}
+	if upgrade.ScheduleType == string(cmv1.ScheduleTypeAutomatic) {
+		return fmt.Sprintf("automatic, next %s on %s", upgrade.State, upgrade.NextRun)
+	}
+	return fmt.Sprintf("%s %s on %s", upgrade.State, upgrade.Version, upgrade.NextRun)
+}
@@ -29,6 +29,7 @@ import (
 	"github.com/openshift/rosa/pkg/aws"
 	"github.com/openshift/rosa/pkg/ocm"
 	"github.com/openshift/rosa/pkg/output"
+	"github.com/openshift/rosa/pkg/reporter"
 	"github.com/openshift/rosa/pkg/rosa"
 )
 
@@ -86,7 +87,7 @@ func run(cmd *cobra.Command, _ []string) {
 		config, err = r.OCMClient.GetOidcConfig(args.oidcConfigId)
 		if err != nil {
 			r.Reporter.Errorf("Failed to get OIDC config: %v", err)
-			os.Exit(1)
+			os.Exit(reporter.ExitCode())
 		}
 	}
 	providers, err := r.AWSClient.ListOidcProviders(clusterId, config)
@@ -96,7 +97,7 @@ func run(cmd *cobra.Command, _ []string) {
 	}
 	if err != nil {
 		r.Reporter.Errorf("Failed to get OIDC providers: %v", err)
-		os.Exit(1)
+		os.Exit(reporter.ExitCode())
 	}
 
 	providersInUse := map[string]bool{}
@@ -104,14 +105,14 @@ func run(cmd *cobra.Command, _ []string) {
 		resourceName, err := aws.GetResourceIdFromOidcProviderARN(provider.Arn)
 		if err != nil {
 			r.Reporter.Errorf("%v", err)
-			os.Exit(1)
+			os.Exit(reporter.ExitCode())
 		}
 		has, err := r.OCMClient.
 			HasAClusterUsingOidcProvider(
 				fmt.Sprintf("https://%s", resourceName), r.Creator.AccountID)
 		if err != nil {
 			r.Reporter.Errorf("%v", err)
-			os.Exit(1)
+			os.Exit(reporter.ExitCode())
 		}
 		providersInUse[provider.Arn] = has
 	}
@@ -125,7 +126,7 @@ func run(cmd *cobra.Command, _ []string) {
 		err = output.Print(outList)
 		if err != nil {
 			r.Reporter.Errorf("%s", err)
-			os.Exit(1)
+			os.Exit(reporter.ExitCode())
 		}
 		os.Exit(0)
 	}
@@ -0,0 +1,95 @@
+/*
+Copyright (c) 2024 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scalingschedules
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+
+	"github.com/openshift/rosa/pkg/ocm"
+	"github.com/openshift/rosa/pkg/output"
+	"github.com/openshift/rosa/pkg/rosa"
+)
+
+const (
+	use     = "scaling-schedules"
+	short   = "List scaling schedules"
+	long    = "List the scaling schedules of the machine pools of a cluster."
+	example = `  # List the scaling schedules of cluster 'mycluster'
+  rosa list scaling-schedules --cluster=mycluster`
+)
+
+func NewListScalingSchedulesCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     use,
+		Aliases: []string{"scalingschedules", "scaling-schedule", "scalingschedule"},
+		Short:   short,
+		Long:    long,
+		Example: example,
+		Args:    cobra.NoArgs,
+		Run:     rosa.DefaultRunner(rosa.RuntimeWithOCM(), ListScalingSchedulesRunner()),
+	}
+
+	ocm.AddClusterFlag(cmd)
+	output.AddFlag(cmd)
+	return cmd
+}
+
+func ListScalingSchedulesRunner() rosa.CommandRunner {
+	return func(_ context.Context, r *rosa.Runtime, _ *cobra.Command, _ []string) error {
+		clusterKey := r.GetClusterKey()
+		cluster, err := r.OCMClient.GetCluster(clusterKey, r.Creator)
+		if err != nil {
+			return err
+		}
+
+		schedules, err := r.OCMClient.GetScalingSchedules(cluster)
+		if err != nil {
+			return fmt.Errorf("Failed to get scaling schedules for cluster '%s': %v", clusterKey, err)
+		}
+
+		if output.HasFlag() {
+			return output.Print(schedules)
+		}
+
+		if len(schedules) == 0 {
+			r.Reporter.Infof("There are no scaling schedules for cluster '%s'", clusterKey)
+			return nil
+		}
+
+		writer := tabwriter.NewWriter(os.Stdout, 0, 4, 2, ' ', 0)
+		fmt.Fprintf(writer, "NAME\tMACHINE POOL\tCRON\tREPLICAS\tLAST RUN\n")
+		for _, schedule := range schedules {
+			lastRun := "never"
+			if schedule.LastRun != nil {
+				lastRun = schedule.LastRun.Format("2006-01-02 15:04 MST")
+			}
+			fmt.Fprintf(writer, "%s\t%s\t%s\t%d\t%s\n",
+				schedule.Name,
+				schedule.MachinePool,
+				schedule.Cron,
+				schedule.Replicas,
+				lastRun,
+			)
+		}
+		return writer.Flush()
+	}
+}
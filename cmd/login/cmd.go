@@ -41,6 +41,7 @@ import (
 	"github.com/openshift/rosa/pkg/properties"
 	rprtr "github.com/openshift/rosa/pkg/reporter"
 	"github.com/openshift/rosa/pkg/rosa"
+	"github.com/openshift/rosa/pkg/timeout"
 )
 
 // #nosec G101
@@ -173,7 +174,7 @@ func run(cmd *cobra.Command, argv []string) {
 	err := runWithRuntime(r, cmd, argv)
 	if err != nil {
 		r.Reporter.Errorf(err.Error())
-		os.Exit(1)
+		os.Exit(rprtr.ExitCode())
 	}
 }
 
@@ -198,13 +199,13 @@ func runWithRuntime(r *rosa.Runtime, cmd *cobra.Command, argv []string) error {
 	// Confirm that token is not passed with auth code flags
 	if (args.useAuthCode || args.useDeviceCode) && args.token != "" {
 		r.Reporter.Errorf("Token cannot be passed with '--use-auth-code' or '--use-device-code' commands")
-		os.Exit(1)
+		os.Exit(rprtr.ExitCode())
 	}
 
 	// FedRAMP does not support oauth code flow login yet
 	if fedramp.HasFlag(cmd) && (args.useAuthCode || args.useDeviceCode) {
 		r.Reporter.Errorf("This login method is currently not supported with FedRAMP")
-		os.Exit(1)
+		os.Exit(rprtr.ExitCode())
 	}
 
 	if args.useAuthCode {
@@ -214,10 +215,13 @@ func runWithRuntime(r *rosa.Runtime, cmd *cobra.Command, argv []string) error {
 			spin.Start()
 		}
 		// Short wait for a less jarring experience
-		time.Sleep(2 * time.Second)
+		err := timeout.Sleep(2 * time.Second)
 		if spin != nil {
 			spin.Stop()
 		}
+		if err != nil {
+			return err
+		}
 		token, err := authentication.InitiateAuthCode(oauthClientId)
 		if err != nil {
 			return fmt.Errorf("An error occurred while retrieving the token: %v", err)
@@ -261,7 +265,7 @@ func runWithRuntime(r *rosa.Runtime, cmd *cobra.Command, argv []string) error {
 	err = CheckAndLogIntoFedramp(fedramp.HasFlag(cmd), fedramp.HasAdminFlag(cmd), cfg, token, r)
 	if err != nil {
 		r.Reporter.Errorf("%s", err.Error())
-		os.Exit(1)
+		os.Exit(rprtr.ExitCode())
 	}
 
 	haveReqs := token != "" || (args.clientID != "" && args.clientSecret != "")
@@ -310,7 +314,7 @@ func runWithRuntime(r *rosa.Runtime, cmd *cobra.Command, argv []string) error {
 	gatewayURL, err := ocm.ResolveGatewayUrl(env, cfg)
 	if err != nil {
 		r.Reporter.Errorf("Failed to resolve gateway URL: %v", err)
-		os.Exit(1)
+		os.Exit(rprtr.ExitCode())
 	}
 
 	var ok bool
@@ -368,7 +372,7 @@ func runWithRuntime(r *rosa.Runtime, cmd *cobra.Command, argv []string) error {
 		regValue, err := sdk.GetRhRegion(gatewayURL, args.rhRegion)
 		if err != nil {
 			r.Reporter.Errorf("Can't find region: %v", err)
-			os.Exit(1)
+			os.Exit(rprtr.ExitCode())
 		}
 		gatewayURL = fmt.Sprintf("https://%s", regValue.URL)
 	}
@@ -28,9 +28,10 @@ import (
 var Cmd = &cobra.Command{
 	Use:   "logout",
 	Short: "Log out",
-	Long:  "Log out, removing the configuration file.",
-	Run:   run,
-	Args:  cobra.NoArgs,
+	Long: "Log out, removing the configuration file. When there are contexts, only the current one, " +
+		"or the one selected with '--context', is removed.",
+	Run:  run,
+	Args: cobra.NoArgs,
 }
 
 func run(_ *cobra.Command, _ []string) {
@@ -39,6 +40,6 @@ func run(_ *cobra.Command, _ []string) {
 	err := config.Remove()
 	if err != nil {
 		reporter.Errorf("Failed to remove config file: %v", err)
-		os.Exit(1)
+		os.Exit(rprtr.ExitCode())
 	}
 }
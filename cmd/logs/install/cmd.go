@@ -28,6 +28,7 @@ import (
 	errors "github.com/zgalor/weberr"
 
 	"github.com/openshift/rosa/pkg/ocm"
+	"github.com/openshift/rosa/pkg/reporter"
 	"github.com/openshift/rosa/pkg/rosa"
 )
 
@@ -103,7 +104,7 @@ func run(cmd *cobra.Command, argv []string) {
 				"Cluster '%s' has been in %s state for too long. Please contact support",
 				clusterKey, cluster.State(),
 			)
-			os.Exit(1)
+			os.Exit(reporter.ExitCode())
 		}
 		r.Reporter.Warnf(pendingMessage)
 		os.Exit(0)
@@ -113,7 +114,7 @@ func run(cmd *cobra.Command, argv []string) {
 		r.Reporter.Errorf("Cluster '%s' is in '%s' state and no installation logs are available",
 			clusterKey, cluster.State(),
 		)
-		os.Exit(1)
+		os.Exit(reporter.ExitCode())
 	}
 
 	// Get logs from Hive
@@ -123,7 +124,7 @@ func run(cmd *cobra.Command, argv []string) {
 			r.Reporter.Infof(pendingMessage)
 		} else {
 			r.Reporter.Errorf("Failed to get logs for cluster '%s': %v", clusterKey, err)
-			os.Exit(1)
+			os.Exit(reporter.ExitCode())
 		}
 	}
 	printLog(logs, nil)
@@ -147,7 +148,7 @@ func run(cmd *cobra.Command, argv []string) {
 			state, _ := r.OCMClient.GetClusterState(cluster.ID())
 			if state == cmv1.ClusterStateError {
 				r.Reporter.Errorf("There was an error installing cluster '%s'", clusterKey)
-				os.Exit(1)
+				os.Exit(reporter.ExitCode())
 			}
 			if state == cmv1.ClusterStateReady {
 				r.Reporter.Infof("Cluster '%s' is now ready", clusterKey)
@@ -159,7 +160,7 @@ func run(cmd *cobra.Command, argv []string) {
 		if err != nil {
 			if errors.GetType(err) != errors.NotFound {
 				r.Reporter.Errorf(fmt.Sprintf("Failed to watch logs for cluster '%s': %v", clusterKey, err))
-				os.Exit(1)
+				os.Exit(reporter.ExitCode())
 			}
 		}
 		printLog(response, spin)
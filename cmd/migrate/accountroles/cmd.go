@@ -0,0 +1,320 @@
+/*
+Copyright (c) 2024 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package accountroles
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"os"
+	"sort"
+	"strings"
+	"text/tabwriter"
+
+	awssdk "github.com/aws/aws-sdk-go-v2/aws"
+	iamtypes "github.com/aws/aws-sdk-go-v2/service/iam/types"
+	common "github.com/openshift-online/ocm-common/pkg/aws/validations"
+	"github.com/spf13/cobra"
+	errors "github.com/zgalor/weberr"
+
+	"github.com/openshift/rosa/pkg/aws"
+	"github.com/openshift/rosa/pkg/aws/tags"
+	"github.com/openshift/rosa/pkg/interactive/confirm"
+	"github.com/openshift/rosa/pkg/rosa"
+)
+
+const (
+	use   = "account-roles"
+	short = "Move account roles to a new prefix or path"
+	long  = "Create a copy of the account roles with a prefix with a new prefix and, optionally, a new " +
+		"path. The new roles have the same trust policy, tags and permissions boundary as the existing " +
+		"ones. The customer managed policies named after the prefix are copied to the new prefix, and the " +
+		"other policies are attached to the new roles as they are.\n\n" +
+		"The command reports the clusters that still use the existing roles. With '--delete-old' the " +
+		"existing roles that aren't used by any cluster are deleted, with their policies when no other " +
+		"role uses them."
+	example = `  # Copy the account roles with prefix 'old' to prefix 'new'
+  rosa migrate account-roles --from-prefix old --to-prefix new
+
+  # Copy the account roles to the '/rosa/' path and delete the ones that aren't used
+  rosa migrate account-roles --from-prefix old --to-prefix new --path /rosa/ --delete-old`
+
+	fromPrefixFlag = "from-prefix"
+	toPrefixFlag   = "to-prefix"
+	pathFlag       = "path"
+	deleteOldFlag  = "delete-old"
+)
+
+var args struct {
+	fromPrefix string
+	toPrefix   string
+	path       string
+	deleteOld  bool
+}
+
+func NewMigrateAccountRolesCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     use,
+		Aliases: []string{"accountroles", "account-role", "accountrole"},
+		Short:   short,
+		Long:    long,
+		Example: example,
+		Args:    cobra.NoArgs,
+		Run:     rosa.DefaultRunner(rosa.RuntimeWithOCMAndAWS(), MigrateAccountRolesRunner()),
+	}
+
+	flags := cmd.Flags()
+	flags.SortFlags = false
+	flags.StringVar(
+		&args.fromPrefix,
+		fromPrefixFlag,
+		"",
+		"Prefix of the existing account roles.",
+	)
+	flags.StringVar(
+		&args.toPrefix,
+		toPrefixFlag,
+		"",
+		"Prefix of the new account roles.",
+	)
+	flags.StringVar(
+		&args.path,
+		pathFlag,
+		"",
+		"The ARN path for the new account roles and policies. Defaults to the path of the existing ones.",
+	)
+	flags.BoolVar(
+		&args.deleteOld,
+		deleteOldFlag,
+		false,
+		"Delete the existing account roles that aren't used by any cluster after creating the new ones.",
+	)
+	confirm.AddFlag(flags)
+	return cmd
+}
+
+// migratedRole is an account role and the copy of it with the new prefix.
+type migratedRole struct {
+	name            string
+	arn             string
+	newName         string
+	managedPolicies bool
+	clusters        []string
+}
+
+func MigrateAccountRolesRunner() rosa.CommandRunner {
+	return func(_ context.Context, r *rosa.Runtime, cmd *cobra.Command, _ []string) error {
+		if args.fromPrefix == "" || args.toPrefix == "" {
+			return errors.BadRequest.Errorf("The '--%s' and '--%s' flags are required", fromPrefixFlag,
+				toPrefixFlag)
+		}
+		// Role and policy names are unique in the account regardless of the path, so a role can't be
+		// copied to a new path with the same name
+		if args.fromPrefix == args.toPrefix {
+			return errors.BadRequest.Errorf("The '--%s' flag must be different from '--%s'", toPrefixFlag,
+				fromPrefixFlag)
+		}
+		err := aws.ARNPathValidator(args.path)
+		if err != nil {
+			return errors.BadRequest.Errorf("%v", err)
+		}
+
+		roles, err := findAccountRoles(r, args.fromPrefix)
+		if err != nil {
+			return err
+		}
+
+		for _, role := range roles {
+			err = migrateRole(r, role)
+			if err != nil {
+				return fmt.Errorf("Failed to migrate role '%s': %v", role.name, err)
+			}
+		}
+
+		for _, role := range roles {
+			accountRole, err := r.AWSClient.GetAccountRoleByArn(role.arn)
+			if err != nil {
+				return err
+			}
+			clusters, err := r.OCMClient.ListClusters(r.Creator, &accountRole, "")
+			if err != nil {
+				return fmt.Errorf("Failed to find the clusters that use role '%s': %v", role.name, err)
+			}
+			for _, cluster := range clusters {
+				role.clusters = append(role.clusters, cluster.Name())
+			}
+		}
+		printRoles(roles)
+
+		if !args.deleteOld {
+			r.Reporter.Infof("Run the command with '--%s' to delete the existing roles that aren't used "+
+				"by any cluster", deleteOldFlag)
+			return nil
+		}
+		for _, role := range roles {
+			if len(role.clusters) > 0 {
+				r.Reporter.Warnf("Role '%s' is used by %d clusters, it will not be deleted", role.name,
+					len(role.clusters))
+				continue
+			}
+			if !confirm.Prompt(true, "Delete the account role '%s'?", role.name) {
+				continue
+			}
+			err = r.AWSClient.DeleteAccountRole(role.name, role.managedPolicies)
+			if err != nil {
+				return fmt.Errorf("Failed to delete role '%s': %v", role.name, err)
+			}
+			r.Reporter.Infof("Deleted role '%s'", role.name)
+		}
+		return nil
+	}
+}
+
+// findAccountRoles returns the classic and Hosted Control Plane account roles with the prefix.
+func findAccountRoles(r *rosa.Runtime, prefix string) ([]*migratedRole, error) {
+	roles := []*migratedRole{}
+	for _, accountRoles := range []map[string]aws.AccountRole{aws.AccountRoles, aws.HCPAccountRoles} {
+		files := []string{}
+		for file := range accountRoles {
+			files = append(files, file)
+		}
+		sort.Strings(files)
+		for _, file := range files {
+			roleARN, err := r.AWSClient.GetAccountRoleARN(prefix, accountRoles[file].Name)
+			if err != nil {
+				if errors.GetType(err) == errors.NotFound {
+					continue
+				}
+				return nil, err
+			}
+			roles = append(roles, &migratedRole{
+				name:    common.GetRoleName(prefix, accountRoles[file].Name),
+				arn:     roleARN,
+				newName: common.GetRoleName(args.toPrefix, accountRoles[file].Name),
+			})
+		}
+	}
+	if len(roles) == 0 {
+		return nil, errors.NotFound.Errorf("There are no account roles with prefix '%s'", prefix)
+	}
+	return roles, nil
+}
+
+// migrateRole creates the copy of the role with the new prefix, and attaches the policies of the existing
+// role to it.
+func migrateRole(r *rosa.Runtime, role *migratedRole) error {
+	iamRole, err := r.AWSClient.GetRoleByARN(role.arn)
+	if err != nil {
+		return err
+	}
+	trustPolicy, err := url.QueryUnescape(awssdk.ToString(iamRole.AssumeRolePolicyDocument))
+	if err != nil {
+		return err
+	}
+	path := args.path
+	if path == "" {
+		path = awssdk.ToString(iamRole.Path)
+	}
+	permissionsBoundary := ""
+	if iamRole.PermissionsBoundary != nil {
+		permissionsBoundary = awssdk.ToString(iamRole.PermissionsBoundary.PermissionsBoundaryArn)
+	}
+	role.managedPolicies = common.IsManagedRole(iamRole.Tags)
+
+	r.Reporter.Infof("Creating role '%s'", role.newName)
+	_, err = r.AWSClient.EnsureRole(role.newName, trustPolicy, permissionsBoundary, "",
+		migrateTags(iamRole.Tags), path, role.managedPolicies)
+	if err != nil {
+		return err
+	}
+
+	policies, err := r.AWSClient.GetAttachedPolicy(iamRole.RoleName)
+	if err != nil {
+		return err
+	}
+	for _, policy := range policies {
+		if policy.PolicyType != aws.Attached {
+			r.Reporter.Warnf("Inline policy '%s' of role '%s' wasn't copied", policy.PolicyName, role.name)
+			continue
+		}
+		policyARN, err := migratePolicy(r, policy)
+		if err != nil {
+			return err
+		}
+		err = r.AWSClient.AttachRolePolicy(role.newName, policyARN)
+		if err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// migratePolicy returns the policy to attach to the new role. Customer managed policies named after the
+// prefix are copied to the new prefix, and the other policies are used as they are.
+func migratePolicy(r *rosa.Runtime, policy aws.PolicyDetail) (string, error) {
+	if aws.IsAWSManagedPolicy(policy.PolicyArn) || !strings.HasPrefix(policy.PolicyName, args.fromPrefix+"-") {
+		return policy.PolicyArn, nil
+	}
+	output, err := r.AWSClient.IsPolicyExists(policy.PolicyArn)
+	if err != nil {
+		return "", err
+	}
+	document, err := r.AWSClient.GetDefaultPolicyDocument(policy.PolicyArn)
+	if err != nil {
+		return "", err
+	}
+	path := args.path
+	if path == "" {
+		path = awssdk.ToString(output.Policy.Path)
+	}
+	name := args.toPrefix + strings.TrimPrefix(policy.PolicyName, args.fromPrefix)
+	r.Reporter.Infof("Creating policy '%s'", name)
+	return r.AWSClient.EnsurePolicy(getPolicyARN(r, name, path), document, "", migrateTags(output.Policy.Tags),
+		path)
+}
+
+// migrateTags returns the tags of a role or policy with the new prefix.
+func migrateTags(iamTags []iamtypes.Tag) map[string]string {
+	result := map[string]string{}
+	for _, tag := range iamTags {
+		result[awssdk.ToString(tag.Key)] = awssdk.ToString(tag.Value)
+	}
+	if _, ok := result[tags.RolePrefix]; ok {
+		result[tags.RolePrefix] = args.toPrefix
+	}
+	return result
+}
+
+func getPolicyARN(r *rosa.Runtime, name string, path string) string {
+	if path == "" {
+		path = "/"
+	}
+	return fmt.Sprintf("arn:%s:iam::%s:policy%s%s", r.Creator.Partition, r.Creator.AccountID, path, name)
+}
+
+func printRoles(roles []*migratedRole) {
+	writer := tabwriter.NewWriter(os.Stdout, 0, 4, 2, ' ', 0)
+	fmt.Fprintf(writer, "ROLE\tNEW ROLE\tCLUSTERS\n")
+	for _, role := range roles {
+		clusters := "-"
+		if len(role.clusters) > 0 {
+			clusters = strings.Join(role.clusters, ", ")
+		}
+		fmt.Fprintf(writer, "%s\t%s\t%s\n", role.name, role.newName, clusters)
+	}
+	writer.Flush()
+}
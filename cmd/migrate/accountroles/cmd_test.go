@@ -0,0 +1,192 @@
+/*
+Copyright (c) 2024 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package accountroles
+
+import (
+	"context"
+	"net/http"
+	"net/url"
+	"testing"
+
+	awssdk "github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/iam"
+	iamtypes "github.com/aws/aws-sdk-go-v2/service/iam/types"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+	. "github.com/openshift-online/ocm-sdk-go/testing"
+	"github.com/spf13/cobra"
+	errors "github.com/zgalor/weberr"
+	"go.uber.org/mock/gomock"
+
+	"github.com/openshift/rosa/pkg/aws"
+	"github.com/openshift/rosa/pkg/aws/tags"
+	"github.com/openshift/rosa/pkg/rosa"
+	. "github.com/openshift/rosa/pkg/test"
+)
+
+func TestMigrateAccountRoles(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "rosa migrate account-roles")
+}
+
+const (
+	installerARN       = "arn:aws:iam::123:role/old-Installer-Role"
+	installerPolicyARN = "arn:aws:iam::123:policy/old-Installer-Role-Policy"
+	trustPolicy        = `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"sts:AssumeRole"}]}`
+	permissionPolicy   = `{"Version":"2012-10-17","Statement":[{"Effect":"Allow","Action":"ec2:*"}]}`
+)
+
+var _ = Describe("rosa migrate account-roles", func() {
+	var t *TestingRuntime
+	var awsClient *aws.MockClient
+	var cmd *cobra.Command
+
+	BeforeEach(func() {
+		cmd = NewMigrateAccountRolesCommand()
+		t = NewTestRuntime()
+		awsClient = aws.NewMockClient(gomock.NewController(GinkgoT()))
+		t.RosaRuntime.AWSClient = awsClient
+		t.RosaRuntime.Creator.Partition = "aws"
+	})
+
+	run := func() (string, string, error) {
+		return RunWithOutputCapture(func(r *rosa.Runtime, cmd *cobra.Command) error {
+			return MigrateAccountRolesRunner()(context.Background(), r, cmd, nil)
+		}, t.RosaRuntime, cmd)
+	}
+
+	Context("Validation", func() {
+		It("Fails without the prefixes", func() {
+			Expect(cmd.Flags().Set(fromPrefixFlag, "old")).To(Succeed())
+			_, _, err := run()
+			Expect(err).To(MatchError("The '--from-prefix' and '--to-prefix' flags are required"))
+		})
+
+		It("Fails with the same prefix", func() {
+			Expect(cmd.Flags().Set(fromPrefixFlag, "old")).To(Succeed())
+			Expect(cmd.Flags().Set(toPrefixFlag, "old")).To(Succeed())
+			_, _, err := run()
+			Expect(err).To(MatchError("The '--to-prefix' flag must be different from '--from-prefix'"))
+		})
+
+		It("Fails with an invalid path", func() {
+			Expect(cmd.Flags().Set(fromPrefixFlag, "old")).To(Succeed())
+			Expect(cmd.Flags().Set(toPrefixFlag, "new")).To(Succeed())
+			Expect(cmd.Flags().Set(pathFlag, "rosa")).To(Succeed())
+			_, _, err := run()
+			Expect(err).To(MatchError(ContainSubstring("invalid ARN Path")))
+			Expect(errors.GetType(err)).To(Equal(errors.BadRequest))
+		})
+
+		It("Reports a prefix without account roles as not found", func() {
+			Expect(cmd.Flags().Set(fromPrefixFlag, "old")).To(Succeed())
+			Expect(cmd.Flags().Set(toPrefixFlag, "new")).To(Succeed())
+			awsClient.EXPECT().GetAccountRoleARN("old", gomock.Any()).Return("",
+				errors.NotFound.Errorf("Role with the prefix 'old' not found")).AnyTimes()
+			_, _, err := run()
+			Expect(err).To(MatchError("There are no account roles with prefix 'old'"))
+			Expect(errors.GetType(err)).To(Equal(errors.NotFound))
+		})
+	})
+
+	Context("Migration", func() {
+		BeforeEach(func() {
+			Expect(cmd.Flags().Set(fromPrefixFlag, "old")).To(Succeed())
+			Expect(cmd.Flags().Set(toPrefixFlag, "new")).To(Succeed())
+			Expect(cmd.Flags().Set(pathFlag, "/rosa/")).To(Succeed())
+
+			// Only the classic installer role exists
+			awsClient.EXPECT().GetAccountRoleARN("old", gomock.Any()).DoAndReturn(
+				func(prefix string, roleType string) (string, error) {
+					if roleType == "Installer" {
+						return installerARN, nil
+					}
+					return "", errors.NotFound.Errorf("Role with the prefix '%s' not found", prefix)
+				}).AnyTimes()
+			roleTags := []iamtypes.Tag{
+				{Key: awssdk.String(tags.RolePrefix), Value: awssdk.String("old")},
+				{Key: awssdk.String(tags.RoleType), Value: awssdk.String("installer")},
+			}
+			awsClient.EXPECT().GetRoleByARN(installerARN).Return(iamtypes.Role{
+				RoleName:                 awssdk.String("old-Installer-Role"),
+				Arn:                      awssdk.String(installerARN),
+				Path:                     awssdk.String("/"),
+				AssumeRolePolicyDocument: awssdk.String(url.QueryEscape(trustPolicy)),
+				PermissionsBoundary: &iamtypes.AttachedPermissionsBoundary{
+					PermissionsBoundaryArn: awssdk.String("arn:aws:iam::123:policy/boundary"),
+				},
+				Tags: roleTags,
+			}, nil)
+			awsClient.EXPECT().EnsureRole("new-Installer-Role", trustPolicy, "arn:aws:iam::123:policy/boundary", "",
+				map[string]string{tags.RolePrefix: "new", tags.RoleType: "installer"}, "/rosa/", false).Return(
+				"arn:aws:iam::123:role/rosa/new-Installer-Role", nil)
+			awsClient.EXPECT().GetAttachedPolicy(awssdk.String("old-Installer-Role")).Return([]aws.PolicyDetail{
+				{PolicyName: "old-Installer-Role-Policy", PolicyArn: installerPolicyARN, PolicyType: aws.Attached},
+				{PolicyName: "ReadOnlyAccess", PolicyArn: "arn:aws:iam::aws:policy/ReadOnlyAccess",
+					PolicyType: aws.Attached},
+			}, nil)
+			awsClient.EXPECT().IsPolicyExists(installerPolicyARN).Return(&iam.GetPolicyOutput{
+				Policy: &iamtypes.Policy{
+					Path: awssdk.String("/"),
+					Tags: []iamtypes.Tag{{Key: awssdk.String(tags.RolePrefix), Value: awssdk.String("old")}},
+				},
+			}, nil)
+			awsClient.EXPECT().GetDefaultPolicyDocument(installerPolicyARN).Return(permissionPolicy, nil)
+			awsClient.EXPECT().EnsurePolicy("arn:aws:iam::123:policy/rosa/new-Installer-Role-Policy",
+				permissionPolicy, "", map[string]string{tags.RolePrefix: "new"}, "/rosa/").Return(
+				"arn:aws:iam::123:policy/rosa/new-Installer-Role-Policy", nil)
+			awsClient.EXPECT().AttachRolePolicy("new-Installer-Role",
+				"arn:aws:iam::123:policy/rosa/new-Installer-Role-Policy")
+			awsClient.EXPECT().AttachRolePolicy("new-Installer-Role", "arn:aws:iam::aws:policy/ReadOnlyAccess")
+			awsClient.EXPECT().GetAccountRoleByArn(installerARN).Return(aws.Role{
+				RoleType: aws.InstallerAccountRoleType,
+				RoleARN:  installerARN,
+			}, nil)
+		})
+
+		It("Copies the roles and reports the clusters that use the existing ones", func() {
+			cluster := MockCluster(func(c *cmv1.ClusterBuilder) {
+				c.Name("mycluster")
+			})
+			t.ApiServer.AppendHandlers(
+				RespondWithJSON(http.StatusOK, FormatClusterList([]*cmv1.Cluster{cluster})),
+			)
+			Expect(cmd.Flags().Set(deleteOldFlag, "true")).To(Succeed())
+			stdout, stderr, err := run()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(stdout).To(ContainSubstring("" +
+				"ROLE                NEW ROLE            CLUSTERS\n" +
+				"old-Installer-Role  new-Installer-Role  mycluster\n"))
+			Expect(stderr).To(ContainSubstring("Role 'old-Installer-Role' is used by 1 clusters, " +
+				"it will not be deleted"))
+		})
+
+		It("Deletes the existing roles that aren't used", func() {
+			t.ApiServer.AppendHandlers(
+				RespondWithJSON(http.StatusOK, FormatClusterList([]*cmv1.Cluster{})),
+			)
+			Expect(cmd.Flags().Set(deleteOldFlag, "true")).To(Succeed())
+			Expect(cmd.Flags().Set("yes", "true")).To(Succeed())
+			awsClient.EXPECT().DeleteAccountRole("old-Installer-Role", false)
+			stdout, _, err := run()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(stdout).To(ContainSubstring("old-Installer-Role  new-Installer-Role  -\n"))
+			Expect(stdout).To(ContainSubstring("Deleted role 'old-Installer-Role'"))
+		})
+	})
+})
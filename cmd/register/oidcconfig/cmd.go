@@ -32,6 +32,7 @@ import (
 	"github.com/openshift/rosa/pkg/interactive/confirm"
 	interactiveRoles "github.com/openshift/rosa/pkg/interactive/roles"
 	"github.com/openshift/rosa/pkg/output"
+	"github.com/openshift/rosa/pkg/reporter"
 	"github.com/openshift/rosa/pkg/rosa"
 )
 
@@ -101,13 +102,13 @@ func run(cmd *cobra.Command, _ []string) {
 	mode, err := interactive.GetMode()
 	if err != nil {
 		r.Reporter.Errorf("%s", err)
-		os.Exit(1)
+		os.Exit(reporter.ExitCode())
 	}
 	if !cmd.Flags().Changed("mode") {
 		mode, err = interactive.GetOptionMode(cmd, mode, "OIDC Provider creation mode")
 		if err != nil {
 			r.Reporter.Errorf("Expected a valid OIDC Provider creation mode: %s", err)
-			os.Exit(1)
+			os.Exit(reporter.ExitCode())
 		}
 	}
 
@@ -124,26 +125,26 @@ func run(cmd *cobra.Command, _ []string) {
 	err = aws.ARNValidator(args.installerRoleArn)
 	if err != nil {
 		r.Reporter.Errorf("Expected a valid ARN: %s", err)
-		os.Exit(1)
+		os.Exit(reporter.ExitCode())
 	}
 	roleExists, _, err := r.AWSClient.CheckRoleExists(roleName)
 	if err != nil {
 		r.Reporter.Errorf("There was a problem checking if role '%s' exists: %v", args.installerRoleArn, err)
-		os.Exit(1)
+		os.Exit(reporter.ExitCode())
 	}
 	if !roleExists {
 		r.Reporter.Errorf("Role '%s' does not exist", args.installerRoleArn)
-		os.Exit(1)
+		os.Exit(reporter.ExitCode())
 	}
 	isValid, err := r.AWSClient.ValidateAccountRoleVersionCompatibility(
 		roleName, aws.InstallerAccountRole, MinorVersionForGetSecret)
 	if err != nil {
 		r.Reporter.Errorf("There was a problem listing role tags: %v", err)
-		os.Exit(1)
+		os.Exit(reporter.ExitCode())
 	}
 	if !isValid {
 		r.Reporter.Errorf("Role '%s' is not of minimum version '%s'", args.installerRoleArn, MinorVersionForGetSecret)
-		os.Exit(1)
+		os.Exit(reporter.ExitCode())
 	}
 
 	if interactive.Enabled() && !cmd.Flags().Changed(IssuerUrlFlag) {
@@ -155,13 +156,13 @@ func run(cmd *cobra.Command, _ []string) {
 		})
 		if err != nil {
 			r.Reporter.Errorf("Expected an issuer URL: %s", err)
-			os.Exit(1)
+			os.Exit(reporter.ExitCode())
 		}
 		args.issuerUrl = issuerUrl
 	}
 	if err := interactive.IsURLHttps(args.issuerUrl); err != nil {
 		r.Reporter.Errorf("%v", err)
-		os.Exit(1)
+		os.Exit(reporter.ExitCode())
 	}
 
 	if interactive.Enabled() && !cmd.Flags().Changed(SecretArnFlag) {
@@ -173,13 +174,13 @@ func run(cmd *cobra.Command, _ []string) {
 		})
 		if err != nil {
 			r.Reporter.Errorf("Expected a secret ARN: %s", err)
-			os.Exit(1)
+			os.Exit(reporter.ExitCode())
 		}
 		args.secretArn = secretArn
 	}
 	if err := aws.SecretManagerArnValidator(args.secretArn); err != nil {
 		r.Reporter.Errorf("%v", err)
-		os.Exit(1)
+		os.Exit(reporter.ExitCode())
 	}
 
 	var spin *spinner.Spinner
@@ -201,13 +202,13 @@ func run(cmd *cobra.Command, _ []string) {
 			spin.Stop()
 		}
 		r.Reporter.Errorf("There was a problem building your unmanaged OIDC Configuration: %v", err)
-		os.Exit(1)
+		os.Exit(reporter.ExitCode())
 	}
 	if output.HasFlag() {
 		err = output.Print(oidcConfig)
 		if err != nil {
 			r.Reporter.Errorf("%s", err)
-			os.Exit(1)
+			os.Exit(reporter.ExitCode())
 		}
 		os.Exit(0)
 	}
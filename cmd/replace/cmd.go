@@ -0,0 +1,42 @@
+/*
+Copyright (c) 2024 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package replace
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/openshift/rosa/cmd/replace/machinepool"
+	"github.com/openshift/rosa/pkg/arguments"
+)
+
+var Cmd = &cobra.Command{
+	Use:   "replace",
+	Short: "Replace a resource with a new one",
+	Long: "Replace a resource with a new one that has a different configuration, for settings that can't be " +
+		"changed once the resource exists.",
+	Example: `  # Replace machine pool 'mp-1' of cluster 'mycluster' with one using m5.2xlarge instances
+  rosa replace machinepool --cluster=mycluster mp-1 --instance-type=m5.2xlarge`,
+	Args: cobra.NoArgs,
+}
+
+func init() {
+	Cmd.AddCommand(machinepool.NewReplaceMachinePoolCommand())
+
+	flags := Cmd.PersistentFlags()
+	arguments.AddProfileFlag(flags)
+	arguments.AddRegionFlag(flags)
+}
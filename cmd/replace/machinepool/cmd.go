@@ -0,0 +1,223 @@
+/*
+Copyright (c) 2024 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package machinepool
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"strings"
+	"time"
+
+	diskValidator "github.com/openshift-online/ocm-common/pkg/machinepool/validations"
+	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+	"github.com/spf13/cobra"
+	errors "github.com/zgalor/weberr"
+
+	"github.com/openshift/rosa/pkg/interactive/confirm"
+	"github.com/openshift/rosa/pkg/ocm"
+	"github.com/openshift/rosa/pkg/rosa"
+)
+
+const (
+	use   = "machinepool"
+	short = "Replace a machine pool with a new one"
+	long  = "Replace a machine pool with a new one that has a different instance type or disk size, which " +
+		"can't be changed with 'rosa edit machinepool'.\n\n" +
+		"The new machine pool copies the replicas, autoscaling, labels, taints, subnets and tuning configs " +
+		"of the existing one. Once it is ready, the existing machine pool is scaled down, so that its " +
+		"nodes are drained, and deleted.\n\n" +
+		"With the default 'surge' mode the new machine pool is created first, so that the capacity of the " +
+		"cluster doesn't drop. The 'recreate' mode deletes the existing machine pool first, for when the " +
+		"quota doesn't allow both to exist at the same time.\n\n" +
+		"The command waits for the new machine pool to be ready: until it has its replicas on Hosted " +
+		"Control Plane clusters, and until the cluster has the additional compute nodes on classic " +
+		"clusters, where machine pools don't report their replicas. '--settle-time' adds an extra delay " +
+		"after that."
+	example = `  # Replace machine pool 'mp-1' of cluster 'mycluster' with one using m5.2xlarge instances
+  rosa replace machinepool --cluster=mycluster mp-1 --instance-type=m5.2xlarge
+
+  # Replace machine pool 'mp-1' with one named 'mp-2' using 500 GiB disks, deleting 'mp-1' first
+  rosa replace machinepool --cluster=mycluster mp-1 --name=mp-2 --disk-size=500GiB --mode=recreate`
+
+	machinePoolFlag  = "machinepool"
+	nameFlag         = "name"
+	instanceTypeFlag = "instance-type"
+	diskSizeFlag     = "disk-size"
+	modeFlag         = "mode"
+	intervalFlag     = "interval"
+	settleTimeFlag   = "settle-time"
+
+	modeSurge    = "surge"
+	modeRecreate = "recreate"
+)
+
+var modes = []string{modeSurge, modeRecreate}
+
+var machinePoolKeyRE = regexp.MustCompile(`^[a-z]([-a-z0-9]*[a-z0-9])?$`)
+
+var args struct {
+	machinePool  string
+	name         string
+	instanceType string
+	diskSize     string
+	mode         string
+	interval     time.Duration
+	settleTime   time.Duration
+}
+
+func NewReplaceMachinePoolCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     use,
+		Aliases: []string{"machine-pool"},
+		Short:   short,
+		Long:    long,
+		Example: example,
+		Args:    cobra.MaximumNArgs(1),
+		Run:     rosa.DefaultRunner(rosa.RuntimeWithOCM(), ReplaceMachinePoolRunner()),
+	}
+
+	flags := cmd.Flags()
+	flags.SortFlags = false
+	ocm.AddClusterFlag(cmd)
+	flags.StringVar(
+		&args.machinePool,
+		machinePoolFlag,
+		"",
+		"Machine pool of the cluster to replace.",
+	)
+	flags.StringVar(
+		&args.name,
+		nameFlag,
+		"",
+		"Name of the new machine pool. By default it is the name of the existing machine pool with a "+
+			"numeric suffix, like 'mp-2' for 'mp-1'.",
+	)
+	flags.StringVar(
+		&args.instanceType,
+		instanceTypeFlag,
+		"",
+		"Instance type of the new machine pool. By default it is the one of the existing machine pool.",
+	)
+	flags.StringVar(
+		&args.diskSize,
+		diskSizeFlag,
+		"",
+		"Root disk size of the new machine pool, like '200GiB'. Only supported on classic clusters. By "+
+			"default it is the one of the existing machine pool.",
+	)
+	flags.StringVar(
+		&args.mode,
+		modeFlag,
+		modeSurge,
+		fmt.Sprintf("How to replace the machine pool. Allowed values are %s.", strings.Join(modes, ", ")),
+	)
+	cmd.RegisterFlagCompletionFunc(modeFlag, modeCompletion)
+	flags.DurationVar(
+		&args.interval,
+		intervalFlag,
+		15*time.Second,
+		"Time between checks of the replicas of the machine pools.",
+	)
+	flags.DurationVar(
+		&args.settleTime,
+		settleTimeFlag,
+		0,
+		"Additional time given to the nodes of the new machine pool once they are ready, before the "+
+			"existing one is scaled down.",
+	)
+	confirm.AddFlag(flags)
+	return cmd
+}
+
+func modeCompletion(_ *cobra.Command, _ []string, _ string) ([]string, cobra.ShellCompDirective) {
+	return modes, cobra.ShellCompDirectiveDefault
+}
+
+func ReplaceMachinePoolRunner() rosa.CommandRunner {
+	return func(_ context.Context, r *rosa.Runtime, cmd *cobra.Command, argv []string) error {
+		machinePoolID := args.machinePool
+		// Allow the use also directly the machine pool id as positional parameter
+		if len(argv) == 1 && !cmd.Flag(machinePoolFlag).Changed {
+			machinePoolID = argv[0]
+		}
+		if machinePoolID == "" {
+			return errors.BadRequest.Errorf("You need to specify a machine pool name")
+		}
+		if args.instanceType == "" && args.diskSize == "" {
+			return errors.BadRequest.Errorf("At least one of '--%s' or '--%s' is required",
+				instanceTypeFlag, diskSizeFlag)
+		}
+		if args.mode != modeSurge && args.mode != modeRecreate {
+			return errors.BadRequest.Errorf("Invalid mode '%s'. Allowed values are %s",
+				args.mode, strings.Join(modes, ", "))
+		}
+		if args.name != "" && !machinePoolKeyRE.MatchString(args.name) {
+			return errors.BadRequest.Errorf("Expected a valid name for the machine pool")
+		}
+		if args.name == machinePoolID {
+			return errors.BadRequest.Errorf("The new machine pool needs a name other than '%s'", machinePoolID)
+		}
+		if args.interval <= 0 {
+			return errors.BadRequest.Errorf("Interval must be greater than zero")
+		}
+		if args.settleTime < 0 {
+			return errors.BadRequest.Errorf("Settle time must not be negative")
+		}
+
+		clusterKey := r.GetClusterKey()
+		cluster, err := r.OCMClient.GetCluster(clusterKey, r.Creator)
+		if err != nil {
+			return err
+		}
+		if cluster.State() != cmv1.ClusterStateReady {
+			return errors.BadRequest.Errorf("Cluster '%s' is not yet ready", clusterKey)
+		}
+
+		var replacement *replacement
+		if cluster.Hypershift().Enabled() {
+			replacement, err = newNodePoolReplacement(r, cluster, machinePoolID)
+		} else {
+			replacement, err = newMachinePoolReplacement(r, cluster, machinePoolID)
+		}
+		if err != nil {
+			return err
+		}
+
+		r.Reporter.Infof("Machine pool '%s' will be replaced with machine pool '%s' using %s",
+			machinePoolID, replacement.newID, strings.Join(replacement.changes, " and "))
+		if !confirm.Confirm("replace machine pool '%s' on cluster '%s'", machinePoolID, clusterKey) {
+			return nil
+		}
+		return replacement.run(r, args.mode)
+	}
+}
+
+// parseDiskSize returns the disk size given with the '--disk-size' flag, in GiB, checking that it is
+// supported by the version of the cluster.
+func parseDiskSize(cluster *cmv1.Cluster) (int, error) {
+	size, err := ocm.ParseDiskSizeToGigibyte(args.diskSize)
+	if err != nil {
+		return 0, errors.BadRequest.Errorf("Expected a valid machine pool root disk size value '%s': %v",
+			args.diskSize, err)
+	}
+	err = diskValidator.ValidateMachinePoolRootDiskSize(cluster.Version().RawID(), size)
+	if err != nil {
+		return 0, errors.BadRequest.Errorf("%s", err)
+	}
+	return size, nil
+}
@@ -0,0 +1,238 @@
+package machinepool
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/ghttp"
+	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+	. "github.com/openshift-online/ocm-sdk-go/testing"
+	"github.com/spf13/cobra"
+	errors "github.com/zgalor/weberr"
+
+	"github.com/openshift/rosa/pkg/rosa"
+	. "github.com/openshift/rosa/pkg/test"
+)
+
+func TestReplaceMachinePool(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "rosa replace machinepool")
+}
+
+var (
+	nodePoolsPath    = MockClusterHREF + "/node_pools"
+	machinePoolsPath = MockClusterHREF + "/machine_pools"
+)
+
+var _ = Describe("rosa replace machinepool", func() {
+	Context("Execute command", func() {
+		var t *TestingRuntime
+		var cmd *cobra.Command
+
+		mockNodePool := func(id string, instanceType string, current int) *cmv1.NodePool {
+			nodePool, err := cmv1.NewNodePool().ID(id).Replicas(2).
+				Labels(map[string]string{"app": "db"}).
+				Subnet("subnet-1").
+				TuningConfigs("tuned-1").
+				AWSNodePool(cmv1.NewAWSNodePool().InstanceType(instanceType)).
+				Status(cmv1.NewNodePoolStatus().CurrentReplicas(current)).
+				Build()
+			Expect(err).NotTo(HaveOccurred())
+			return nodePool
+		}
+
+		BeforeEach(func() {
+			t = NewTestRuntime()
+			cmd = NewReplaceMachinePoolCommand()
+			Expect(cmd.Flags().Set("yes", "true")).To(Succeed())
+			args.machinePool = "mp-1"
+			args.interval = 10 * time.Millisecond
+		})
+
+		It("Creates the new node pool before removing the existing one in surge mode", func() {
+			args.instanceType = "m5.2xlarge"
+			cluster := MockCluster(func(c *cmv1.ClusterBuilder) {
+				c.State(cmv1.ClusterStateReady)
+				c.Hypershift(cmv1.NewHypershift().Enabled(true))
+			})
+			t.SetCluster(cluster.Name(), cluster)
+			oldNodePool := mockNodePool("mp-1", "m5.xlarge", 2)
+			t.ApiServer.AppendHandlers(
+				RespondWithJSON(http.StatusOK, FormatClusterList([]*cmv1.Cluster{cluster})),
+				RespondWithJSON(http.StatusOK, FormatResource(oldNodePool)),
+				RespondWithJSON(http.StatusOK, FormatNodePoolList([]*cmv1.NodePool{oldNodePool})),
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest(http.MethodPost, nodePoolsPath),
+					ghttp.VerifyJSON(`{
+						"kind": "NodePool",
+						"id": "mp-2",
+						"aws_node_pool": {"kind": "AWSNodePool", "instance_type": "m5.2xlarge"},
+						"labels": {"app": "db"},
+						"replicas": 2,
+						"subnet": "subnet-1",
+						"tuning_configs": ["tuned-1"]
+					}`),
+					RespondWithJSON(http.StatusCreated, FormatResource(mockNodePool("mp-2", "m5.2xlarge", 0))),
+				),
+				RespondWithJSON(http.StatusOK, FormatResource(mockNodePool("mp-2", "m5.2xlarge", 1))),
+				RespondWithJSON(http.StatusOK, FormatResource(mockNodePool("mp-2", "m5.2xlarge", 2))),
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest(http.MethodPatch, nodePoolsPath+"/mp-1"),
+					ghttp.VerifyJSON(`{"kind": "NodePool", "id": "mp-1", "replicas": 0}`),
+					RespondWithJSON(http.StatusOK, FormatResource(oldNodePool)),
+				),
+				RespondWithJSON(http.StatusOK, FormatResource(mockNodePool("mp-1", "m5.xlarge", 0))),
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest(http.MethodDelete, nodePoolsPath+"/mp-1"),
+					RespondWithJSON(http.StatusNoContent, ""),
+				),
+			)
+
+			err := ReplaceMachinePoolRunner()(context.Background(), t.RosaRuntime, cmd, nil)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(t.ApiServer.ReceivedRequests()).To(HaveLen(9))
+		})
+
+		It("Waits for the compute nodes of the new classic machine pool in surge mode", func() {
+			args.instanceType = "m5.2xlarge"
+			clusterWithNodes := func(current int) *cmv1.Cluster {
+				return MockCluster(func(c *cmv1.ClusterBuilder) {
+					c.State(cmv1.ClusterStateReady)
+					c.Status(cmv1.NewClusterStatus().CurrentCompute(current))
+				})
+			}
+			cluster := clusterWithNodes(4)
+			t.SetCluster(cluster.Name(), cluster)
+			machinePool, err := cmv1.NewMachinePool().ID("mp-1").InstanceType("m5.xlarge").Replicas(2).Build()
+			Expect(err).NotTo(HaveOccurred())
+			t.ApiServer.AppendHandlers(
+				RespondWithJSON(http.StatusOK, FormatClusterList([]*cmv1.Cluster{cluster})),
+				RespondWithJSON(http.StatusOK, FormatResource(machinePool)),
+				RespondWithJSON(http.StatusOK, FormatMachinePoolList([]*cmv1.MachinePool{machinePool})),
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest(http.MethodPost, machinePoolsPath),
+					RespondWithJSON(http.StatusCreated, FormatResource(machinePool)),
+				),
+				RespondWithJSON(http.StatusOK, FormatResource(clusterWithNodes(4))),
+				RespondWithJSON(http.StatusOK, FormatResource(clusterWithNodes(5))),
+				RespondWithJSON(http.StatusOK, FormatResource(clusterWithNodes(6))),
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest(http.MethodPatch, machinePoolsPath+"/mp-1"),
+					RespondWithJSON(http.StatusOK, FormatResource(machinePool)),
+				),
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest(http.MethodDelete, machinePoolsPath+"/mp-1"),
+					RespondWithJSON(http.StatusNoContent, ""),
+				),
+			)
+
+			stdout, _, err := RunWithOutputCapture(func(r *rosa.Runtime, cmd *cobra.Command) error {
+				return ReplaceMachinePoolRunner()(context.Background(), r, cmd, nil)
+			}, t.RosaRuntime, cmd)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(t.ApiServer.ReceivedRequests()).To(HaveLen(9))
+			Expect(stdout).To(ContainSubstring("Waiting for the 2 nodes of machine pool 'mp-2' to join the cluster"))
+			Expect(stdout).To(ContainSubstring("has 6/6 compute nodes"))
+		})
+
+		It("Removes the existing machine pool first in recreate mode", func() {
+			args.diskSize = "500GiB"
+			args.mode = modeRecreate
+			args.name = "large"
+			cluster := MockCluster(func(c *cmv1.ClusterBuilder) {
+				c.State(cmv1.ClusterStateReady)
+				c.Version(cmv1.NewVersion().RawID("4.14.0"))
+			})
+			t.SetCluster(cluster.Name(), cluster)
+			machinePool, err := cmv1.NewMachinePool().ID("mp-1").InstanceType("m5.xlarge").Replicas(3).
+				Taints(cmv1.NewTaint().Key("dedicated").Value("db").Effect("NoSchedule")).
+				Build()
+			Expect(err).NotTo(HaveOccurred())
+			t.ApiServer.AppendHandlers(
+				RespondWithJSON(http.StatusOK, FormatClusterList([]*cmv1.Cluster{cluster})),
+				RespondWithJSON(http.StatusOK, FormatResource(machinePool)),
+				RespondWithJSON(http.StatusOK, FormatMachinePoolList([]*cmv1.MachinePool{machinePool})),
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest(http.MethodPatch, machinePoolsPath+"/mp-1"),
+					RespondWithJSON(http.StatusOK, FormatResource(machinePool)),
+				),
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest(http.MethodDelete, machinePoolsPath+"/mp-1"),
+					RespondWithJSON(http.StatusNoContent, ""),
+				),
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest(http.MethodPost, machinePoolsPath),
+					ghttp.VerifyJSON(`{
+						"kind": "MachinePool",
+						"id": "large",
+						"instance_type": "m5.xlarge",
+						"replicas": 3,
+						"root_volume": {"aws": {"size": 500}},
+						"taints": [{"effect": "NoSchedule", "key": "dedicated", "value": "db"}]
+					}`),
+					RespondWithJSON(http.StatusCreated, FormatResource(machinePool)),
+				),
+			)
+
+			err = ReplaceMachinePoolRunner()(context.Background(), t.RosaRuntime, cmd, nil)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(t.ApiServer.ReceivedRequests()).To(HaveLen(6))
+		})
+
+		It("Rejects a negative settle time", func() {
+			args.instanceType = "m5.2xlarge"
+			Expect(cmd.Flags().Set(settleTimeFlag, "-1m")).To(Succeed())
+			err := ReplaceMachinePoolRunner()(context.Background(), t.RosaRuntime, cmd, nil)
+			Expect(err).To(MatchError("Settle time must not be negative"))
+		})
+
+		It("Requires a change", func() {
+			err := ReplaceMachinePoolRunner()(context.Background(), t.RosaRuntime, cmd, nil)
+			Expect(err).To(MatchError("At least one of '--instance-type' or '--disk-size' is required"))
+			Expect(errors.GetType(err)).To(Equal(errors.BadRequest))
+		})
+
+		It("Rejects the disk size on Hosted Control Plane clusters", func() {
+			args.diskSize = "300GiB"
+			cluster := MockCluster(func(c *cmv1.ClusterBuilder) {
+				c.State(cmv1.ClusterStateReady)
+				c.Hypershift(cmv1.NewHypershift().Enabled(true))
+			})
+			t.SetCluster(cluster.Name(), cluster)
+			t.ApiServer.AppendHandlers(
+				RespondWithJSON(http.StatusOK, FormatClusterList([]*cmv1.Cluster{cluster})),
+			)
+
+			err := ReplaceMachinePoolRunner()(context.Background(), t.RosaRuntime, cmd, nil)
+			Expect(err).To(MatchError(ContainSubstring("only supported on classic clusters")))
+		})
+	})
+
+	Context("New machine pool name", func() {
+		BeforeEach(func() {
+			args.name = ""
+		})
+
+		DescribeTable("Uses the next free numeric suffix",
+			func(id string, existing []string, maxLength int, expected string) {
+				name, err := newPoolID(id, existing, maxLength)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(name).To(Equal(expected))
+			},
+			Entry("without suffix", "workers", []string{"workers"}, 0, "workers-2"),
+			Entry("with a suffix", "mp-1", []string{"mp-1", "mp-2"}, 0, "mp-3"),
+			Entry("shortened to the maximum length", "database-nodes", []string{"database-nodes"}, 15,
+				"database-node-2"),
+		)
+
+		It("Rejects an existing name", func() {
+			args.name = "mp-2"
+			_, err := newPoolID("mp-1", []string{"mp-1", "mp-2"}, 0)
+			Expect(err).To(MatchError("Machine pool 'mp-2' already exists"))
+		})
+	})
+})
@@ -0,0 +1,363 @@
+/*
+Copyright (c) 2024 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package machinepool
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+	errors "github.com/zgalor/weberr"
+
+	"github.com/openshift/rosa/pkg/helper/machinepools"
+	"github.com/openshift/rosa/pkg/ocm"
+	"github.com/openshift/rosa/pkg/rosa"
+	"github.com/openshift/rosa/pkg/timeout"
+)
+
+// replacement contains the steps that replace a machine pool, which differ between classic machine pools
+// and the node pools of Hosted Control Plane clusters.
+type replacement struct {
+	oldID   string
+	newID   string
+	changes []string
+
+	// reportsReplicas is true when the readiness of the new machine pool can be checked on its own. Only
+	// the total number of compute nodes of classic clusters is reported, so classic machine pools can only
+	// be checked while the existing one still has its nodes.
+	reportsReplicas bool
+
+	create    func() error
+	waitReady func() error
+	scaleDown func() error
+	delete    func() error
+}
+
+// run replaces the machine pool. In surge mode the new machine pool is created and ready before the
+// existing one is removed; in recreate mode the existing one is removed first.
+func (p *replacement) run(r *rosa.Runtime, mode string) error {
+	var err error
+	if mode == modeRecreate {
+		err = p.removeOld(r)
+		if err != nil {
+			return err
+		}
+		err = p.createNew(r)
+		if err != nil {
+			return err
+		}
+		if p.reportsReplicas {
+			err = p.waitReady()
+			if err != nil {
+				return fmt.Errorf("Machine pool '%s' was created but isn't ready: %w", p.newID, err)
+			}
+		}
+	} else {
+		err = p.createNew(r)
+		if err != nil {
+			return err
+		}
+		err = p.waitReady()
+		if err != nil {
+			return fmt.Errorf("Machine pool '%s' was created but isn't ready, machine pool '%s' has been "+
+				"kept: %w", p.newID, p.oldID, err)
+		}
+		err = p.removeOld(r)
+		if err != nil {
+			return err
+		}
+	}
+	r.Reporter.Infof("Successfully replaced machine pool '%s' with machine pool '%s'", p.oldID, p.newID)
+	return nil
+}
+
+func (p *replacement) createNew(r *rosa.Runtime) error {
+	r.Reporter.Infof("Creating machine pool '%s'", p.newID)
+	err := p.create()
+	if err != nil {
+		return fmt.Errorf("Failed to create machine pool '%s': %w", p.newID, err)
+	}
+	return nil
+}
+
+// removeOld scales down the existing machine pool, so that its nodes are drained, and deletes it. A
+// machine pool that can't be scaled down, like one that can't have zero replicas, is deleted directly,
+// which also drains its nodes.
+func (p *replacement) removeOld(r *rosa.Runtime) error {
+	r.Reporter.Infof("Scaling down machine pool '%s'", p.oldID)
+	err := p.scaleDown()
+	if err != nil {
+		if errors.GetType(err) != errors.BadRequest {
+			return fmt.Errorf("Failed to scale down machine pool '%s': %w", p.oldID, err)
+		}
+		r.Reporter.Warnf("Failed to scale down machine pool '%s', deleting it directly: %v", p.oldID, err)
+	}
+	r.Reporter.Infof("Deleting machine pool '%s'", p.oldID)
+	err = p.delete()
+	if err != nil {
+		return fmt.Errorf("Failed to delete machine pool '%s': %w", p.oldID, err)
+	}
+	return nil
+}
+
+func newMachinePoolReplacement(r *rosa.Runtime, cluster *cmv1.Cluster, id string) (*replacement, error) {
+	machinePool, exists, err := r.OCMClient.GetMachinePool(cluster.ID(), id)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, errors.NotFound.Errorf("Machine pool '%s' does not exist for cluster '%s'", id, r.ClusterKey)
+	}
+	machinePools, err := r.OCMClient.GetMachinePools(cluster.ID())
+	if err != nil {
+		return nil, err
+	}
+	ids := []string{}
+	for _, machinePool := range machinePools {
+		ids = append(ids, machinePool.ID())
+	}
+	newID, err := newPoolID(id, ids, 0)
+	if err != nil {
+		return nil, err
+	}
+
+	builder := cmv1.NewMachinePool().Copy(machinePool).ID(newID)
+	changes := []string{}
+	if args.instanceType != "" {
+		if args.instanceType == machinePool.InstanceType() {
+			return nil, errors.BadRequest.Errorf("Machine pool '%s' already uses instance type '%s'",
+				id, args.instanceType)
+		}
+		builder.InstanceType(args.instanceType)
+		changes = append(changes, fmt.Sprintf("instance type '%s'", args.instanceType))
+	}
+	if args.diskSize != "" {
+		size, err := parseDiskSize(cluster)
+		if err != nil {
+			return nil, err
+		}
+		builder.RootVolume(cmv1.NewRootVolume().AWS(cmv1.NewAWSVolume().Size(size)))
+		changes = append(changes, fmt.Sprintf("disk size %d GiB", size))
+	}
+	newMachinePool, err := builder.Build()
+	if err != nil {
+		return nil, err
+	}
+
+	return &replacement{
+		oldID:   id,
+		newID:   newID,
+		changes: changes,
+		create: func() error {
+			_, err := r.OCMClient.CreateMachinePool(cluster.ID(), newMachinePool)
+			return err
+		},
+		waitReady: func() error {
+			err := waitComputeNodes(r, cluster, newID, newMachinePool)
+			if err != nil {
+				return err
+			}
+			return settle(r, newID)
+		},
+		scaleDown: func() error {
+			update, err := cmv1.NewMachinePool().ID(id).Replicas(0).Build()
+			if err != nil {
+				return err
+			}
+			_, err = r.OCMClient.UpdateMachinePool(cluster.ID(), update)
+			return err
+		},
+		delete: func() error {
+			return r.OCMClient.DeleteMachinePool(cluster.ID(), id)
+		},
+	}, nil
+}
+
+func newNodePoolReplacement(r *rosa.Runtime, cluster *cmv1.Cluster, id string) (*replacement, error) {
+	if args.diskSize != "" {
+		return nil, errors.BadRequest.Errorf("Changing the disk size of machine pools is only supported on " +
+			"classic clusters")
+	}
+	nodePool, exists, err := r.OCMClient.GetNodePool(cluster.ID(), id)
+	if err != nil {
+		return nil, err
+	}
+	if !exists {
+		return nil, errors.NotFound.Errorf("Machine pool '%s' does not exist for hosted cluster '%s'",
+			id, r.ClusterKey)
+	}
+	if args.instanceType == nodePool.AWSNodePool().InstanceType() {
+		return nil, errors.BadRequest.Errorf("Machine pool '%s' already uses instance type '%s'",
+			id, args.instanceType)
+	}
+	nodePools, err := r.OCMClient.GetNodePools(cluster.ID())
+	if err != nil {
+		return nil, err
+	}
+	ids := []string{}
+	for _, nodePool := range nodePools {
+		ids = append(ids, nodePool.ID())
+	}
+	newID, err := newPoolID(id, ids, machinepools.NodePoolNameMaxLength)
+	if err != nil {
+		return nil, err
+	}
+
+	newNodePool, err := cmv1.NewNodePool().
+		Copy(nodePool).
+		ID(newID).
+		Status(nil).
+		AWSNodePool(cmv1.NewAWSNodePool().Copy(nodePool.AWSNodePool()).InstanceType(args.instanceType)).
+		Build()
+	if err != nil {
+		return nil, err
+	}
+
+	return &replacement{
+		oldID:           id,
+		newID:           newID,
+		changes:         []string{fmt.Sprintf("instance type '%s'", args.instanceType)},
+		reportsReplicas: true,
+		create: func() error {
+			_, err := r.OCMClient.CreateNodePool(cluster.ID(), newNodePool)
+			return err
+		},
+		waitReady: func() error {
+			err := waitNodePool(r, cluster.ID(), newID, func(nodePool *cmv1.NodePool) (bool, string) {
+				return ocm.NodePoolReplicasStatus(nodePool)
+			})
+			if err != nil {
+				return err
+			}
+			return settle(r, newID)
+		},
+		scaleDown: func() error {
+			update, err := cmv1.NewNodePool().ID(id).Replicas(0).Build()
+			if err != nil {
+				return err
+			}
+			_, err = r.OCMClient.UpdateNodePool(cluster.ID(), update)
+			if err != nil {
+				return err
+			}
+			return waitNodePool(r, cluster.ID(), id, func(nodePool *cmv1.NodePool) (bool, string) {
+				current := nodePool.Status().CurrentReplicas()
+				return current == 0, fmt.Sprintf("%d replicas", current)
+			})
+		},
+		delete: func() error {
+			return r.OCMClient.DeleteNodePool(cluster.ID(), id)
+		},
+	}, nil
+}
+
+// waitNodePool polls the node pool until the given function returns true, printing the description of
+// its replicas whenever it changes.
+func waitNodePool(r *rosa.Runtime, clusterID string, id string,
+	status func(*cmv1.NodePool) (bool, string)) error {
+	var progress string
+	var deleted bool
+	err := r.OCMClient.PollNodePool(clusterID, id, args.interval, func(nodePool *cmv1.NodePool) bool {
+		if nodePool == nil {
+			deleted = true
+			return true
+		}
+		done, current := status(nodePool)
+		if current != progress {
+			r.Reporter.Infof("Machine pool '%s' has %s", id, current)
+		}
+		progress = current
+		return done
+	})
+	if err != nil {
+		return err
+	}
+	if deleted {
+		return errors.NotFound.Errorf("Machine pool '%s' was deleted while waiting for it", id)
+	}
+	return nil
+}
+
+// waitComputeNodes polls the cluster until it has the compute nodes of the new classic machine pool on
+// top of the ones it had when the command started, as classic machine pools don't report their replicas.
+func waitComputeNodes(r *rosa.Runtime, cluster *cmv1.Cluster, id string, machinePool *cmv1.MachinePool) error {
+	replicas := machinePool.Replicas()
+	if autoscaling, ok := machinePool.GetAutoscaling(); ok {
+		replicas = autoscaling.MinReplicas()
+	}
+	expected := cluster.Status().CurrentCompute() + replicas
+	r.Reporter.Infof("Waiting for the %d nodes of machine pool '%s' to join the cluster", replicas, id)
+
+	current := -1
+	var deleted bool
+	err := r.OCMClient.PollCluster(cluster.ID(), args.interval, func(cluster *cmv1.Cluster) bool {
+		if cluster == nil {
+			deleted = true
+			return true
+		}
+		if cluster.Status().CurrentCompute() != current {
+			current = cluster.Status().CurrentCompute()
+			r.Reporter.Infof("Cluster '%s' has %d/%d compute nodes", r.ClusterKey, current, expected)
+		}
+		return current >= expected
+	})
+	if err != nil {
+		return err
+	}
+	if deleted {
+		return errors.NotFound.Errorf("Cluster '%s' was deleted while waiting for it", r.ClusterKey)
+	}
+	return nil
+}
+
+// settle waits for the '--settle-time', if any, once the new machine pool is ready.
+func settle(r *rosa.Runtime, id string) error {
+	if args.settleTime <= 0 {
+		return nil
+	}
+	r.Reporter.Infof("Waiting %s for the nodes of machine pool '%s' to settle", args.settleTime, id)
+	return timeout.Sleep(args.settleTime)
+}
+
+var numericSuffixRE = regexp.MustCompile(`-[0-9]+$`)
+
+// newPoolID returns the ID of the new machine pool, either the one given with the '--name' flag or the
+// ID of the existing one with the next free numeric suffix, shortened to the maximum length if needed.
+func newPoolID(id string, existing []string, maxLength int) (string, error) {
+	taken := map[string]bool{}
+	for _, name := range existing {
+		taken[name] = true
+	}
+	if args.name != "" {
+		if taken[args.name] {
+			return "", errors.BadRequest.Errorf("Machine pool '%s' already exists", args.name)
+		}
+		return args.name, nil
+	}
+	base := numericSuffixRE.ReplaceAllString(id, "")
+	for n := 2; ; n++ {
+		suffix := fmt.Sprintf("-%d", n)
+		prefix := base
+		if maxLength > 0 && len(prefix)+len(suffix) > maxLength {
+			prefix = strings.TrimRight(prefix[:maxLength-len(suffix)], "-")
+		}
+		if name := prefix + suffix; name != id && !taken[name] {
+			return name, nil
+		}
+	}
+}
@@ -24,6 +24,7 @@ import (
 
 	"github.com/openshift/rosa/pkg/interactive/confirm"
 	"github.com/openshift/rosa/pkg/ocm"
+	"github.com/openshift/rosa/pkg/reporter"
 	"github.com/openshift/rosa/pkg/rosa"
 )
 
@@ -53,15 +54,15 @@ func run(_ *cobra.Command, _ []string) {
 		r.Reporter.Errorf("Resuming a cluster from hibernation is only supported for clusters in "+
 			"'Hibernating' state. Cluster '%s' is in '%s' state",
 			clusterKey, cluster.State())
-		os.Exit(1)
+		os.Exit(reporter.ExitCode())
 	}
 	if !confirm.Yes() && !confirm.Confirm("resume cluster %s", clusterKey) {
-		os.Exit(1)
+		os.Exit(reporter.ExitCode())
 	}
 	err := r.OCMClient.ResumeCluster(cluster.ID())
 	if err != nil {
 		r.Reporter.Errorf("Failed to update cluster: %v", err)
-		os.Exit(1)
+		os.Exit(reporter.ExitCode())
 	}
 	r.Reporter.Infof("Cluster '%s' is resuming.", clusterKey)
 }
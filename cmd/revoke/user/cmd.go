@@ -25,6 +25,7 @@ import (
 	"github.com/openshift/rosa/cmd/create/idp"
 	"github.com/openshift/rosa/pkg/interactive/confirm"
 	"github.com/openshift/rosa/pkg/ocm"
+	"github.com/openshift/rosa/pkg/reporter"
 	"github.com/openshift/rosa/pkg/rosa"
 )
 
@@ -84,13 +85,13 @@ func run(_ *cobra.Command, argv []string) {
 			"Username '%s' isn't valid: it must contain only letters, digits, dashes and underscores",
 			username,
 		)
-		os.Exit(1)
+		os.Exit(reporter.ExitCode())
 	}
 	if username == idp.ClusterAdminUsername {
 		r.Reporter.Errorf("Username '%s' is reserved for `rosa create/delete admin` command. "+
 			"Run `rosa delete admin -c %s` to delete user '%s'",
 			idp.ClusterAdminUsername, clusterKey, idp.ClusterAdminUsername)
-		os.Exit(1)
+		os.Exit(reporter.ExitCode())
 	}
 
 	role := argv[0]
@@ -109,7 +110,7 @@ func run(_ *cobra.Command, argv []string) {
 	}
 	if !isRoleValid {
 		r.Reporter.Errorf("Expected at least one of %s", validRoles)
-		os.Exit(1)
+		os.Exit(reporter.ExitCode())
 	}
 
 	cluster := r.FetchCluster()
@@ -118,7 +119,7 @@ func run(_ *cobra.Command, argv []string) {
 	user, err := r.OCMClient.GetUser(cluster.ID(), role, username)
 	if err != nil {
 		r.Reporter.Errorf(err.Error())
-		os.Exit(1)
+		os.Exit(reporter.ExitCode())
 	}
 
 	if user == nil {
@@ -135,7 +136,7 @@ func run(_ *cobra.Command, argv []string) {
 	if err != nil {
 		r.Reporter.Errorf("Failed to revoke '%s' from user '%s' in cluster '%s': %s",
 			role, username, clusterKey, err)
-		os.Exit(1)
+		os.Exit(reporter.ExitCode())
 	}
 	r.Reporter.Infof("Revoked role '%s' from user '%s' on cluster '%s'", role, username, clusterKey)
 }
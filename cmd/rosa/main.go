@@ -22,7 +22,10 @@ import (
 	"strings"
 
 	"github.com/spf13/cobra"
+	errors "github.com/zgalor/weberr"
 
+	"github.com/openshift/rosa/cmd/apply"
+	"github.com/openshift/rosa/cmd/cleanup"
 	"github.com/openshift/rosa/cmd/completion"
 	"github.com/openshift/rosa/cmd/config"
 	"github.com/openshift/rosa/cmd/create"
@@ -31,6 +34,7 @@ import (
 	"github.com/openshift/rosa/cmd/docs"
 	"github.com/openshift/rosa/cmd/download"
 	"github.com/openshift/rosa/cmd/edit"
+	"github.com/openshift/rosa/cmd/export"
 	"github.com/openshift/rosa/cmd/grant"
 	"github.com/openshift/rosa/cmd/hibernate"
 	"github.com/openshift/rosa/cmd/initialize"
@@ -40,18 +44,24 @@ import (
 	"github.com/openshift/rosa/cmd/login"
 	"github.com/openshift/rosa/cmd/logout"
 	"github.com/openshift/rosa/cmd/logs"
+	"github.com/openshift/rosa/cmd/migrate"
 	"github.com/openshift/rosa/cmd/register"
+	"github.com/openshift/rosa/cmd/replace"
 	"github.com/openshift/rosa/cmd/resume"
 	"github.com/openshift/rosa/cmd/revoke"
+	"github.com/openshift/rosa/cmd/scalingschedule"
 	"github.com/openshift/rosa/cmd/token"
 	"github.com/openshift/rosa/cmd/uninstall"
 	"github.com/openshift/rosa/cmd/unlink"
 	"github.com/openshift/rosa/cmd/upgrade"
 	"github.com/openshift/rosa/cmd/verify"
 	"github.com/openshift/rosa/cmd/version"
+	"github.com/openshift/rosa/cmd/wait"
 	"github.com/openshift/rosa/cmd/whoami"
 	"github.com/openshift/rosa/pkg/arguments"
 	"github.com/openshift/rosa/pkg/color"
+	"github.com/openshift/rosa/pkg/reporter"
+	"github.com/openshift/rosa/pkg/timeout"
 )
 
 var root = &cobra.Command{
@@ -61,15 +71,28 @@ var root = &cobra.Command{
 		"For further documentation visit " +
 		"https://access.redhat.com/documentation/en-us/red_hat_openshift_service_on_aws\n",
 	Args: cobra.NoArgs,
+	PersistentPreRun: func(cmd *cobra.Command, _ []string) {
+		cmd.SetContext(timeout.Start(cmd.Context()))
+		reporter.SetCommand(cmd.CommandPath())
+		err := reporter.ValidateErrorFormat()
+		if err != nil {
+			reporter.CreateReporter().Errorf("%v", err)
+			os.Exit(reporter.ExitCode())
+		}
+	},
 }
 
 func init() {
 	// Add the command line flags:
 	fs := root.PersistentFlags()
 	color.AddFlag(root)
+	reporter.AddErrorFormatFlag(root)
 	arguments.AddDebugFlag(fs)
+	timeout.AddFlag(fs)
+	arguments.AddContextFlag(fs)
 
 	// Register the subcommands:
+	root.AddCommand(cleanup.Cmd)
 	root.AddCommand(completion.Cmd)
 	root.AddCommand(create.Cmd)
 	root.AddCommand(describe.Cmd)
@@ -77,6 +100,7 @@ func init() {
 	root.AddCommand(docs.Cmd)
 	root.AddCommand(download.Cmd)
 	root.AddCommand(edit.Cmd)
+	root.AddCommand(export.Cmd)
 	root.AddCommand(grant.Cmd)
 	root.AddCommand(list.Cmd)
 	root.AddCommand(initialize.Cmd)
@@ -84,11 +108,15 @@ func init() {
 	root.AddCommand(login.Cmd)
 	root.AddCommand(logout.Cmd)
 	root.AddCommand(logs.Cmd)
+	root.AddCommand(migrate.Cmd)
 	root.AddCommand(register.Cmd)
+	root.AddCommand(replace.Cmd)
 	root.AddCommand(revoke.Cmd)
+	root.AddCommand(scalingschedule.Cmd)
 	root.AddCommand(uninstall.Cmd)
 	root.AddCommand(upgrade.Cmd)
 	root.AddCommand(verify.Cmd)
+	root.AddCommand(wait.Cmd)
 	root.AddCommand(version.Cmd)
 	root.AddCommand(whoami.Cmd)
 	root.AddCommand(hibernate.GenerateCommand())
@@ -97,6 +125,7 @@ func init() {
 	root.AddCommand(unlink.Cmd)
 	root.AddCommand(token.Cmd)
 	root.AddCommand(config.Cmd)
+	root.AddCommand(apply.NewApplyCommand())
 }
 
 func main() {
@@ -104,9 +133,16 @@ func main() {
 	root.SetArgs(os.Args[1:])
 	err := root.Execute()
 	if err != nil {
-		if !strings.Contains(err.Error(), "Did you mean this?") {
+		// Commands report their own errors, so the ones returned here are about invalid arguments or flags
+		err = errors.BadRequest.Set(err)
+		if reporter.JSONErrors() {
+			if cmd, _, findErr := root.Find(os.Args[1:]); findErr == nil {
+				reporter.SetCommand(cmd.CommandPath())
+			}
+			reporter.CreateReporter().Errorf("%v", err)
+		} else if !strings.Contains(err.Error(), "Did you mean this?") {
 			fmt.Fprintf(os.Stderr, "Failed to execute root command: %s\n", err)
 		}
-		os.Exit(1)
+		os.Exit(reporter.ExitCodeOf(err))
 	}
 }
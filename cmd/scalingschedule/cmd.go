@@ -0,0 +1,43 @@
+/*
+Copyright (c) 2024 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scalingschedule
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/openshift/rosa/cmd/scalingschedule/run"
+	"github.com/openshift/rosa/pkg/arguments"
+)
+
+var Cmd = &cobra.Command{
+	Use:     "scaling-schedule",
+	Aliases: []string{"scalingschedule", "scaling-schedules", "scalingschedules"},
+	Short:   "Apply the scaling schedules of machine pools",
+	Long: "Apply the scaling schedules of machine pools. Schedules are managed with " +
+		"'rosa create scaling-schedule', 'rosa list scaling-schedules' and 'rosa delete scaling-schedule'.",
+	Example: `  # Apply the scaling schedules of cluster 'mycluster' that are due
+  rosa scaling-schedule run --cluster=mycluster`,
+	Args: cobra.NoArgs,
+}
+
+func init() {
+	Cmd.AddCommand(run.NewRunScalingSchedulesCommand())
+
+	flags := Cmd.PersistentFlags()
+	arguments.AddProfileFlag(flags)
+	arguments.AddRegionFlag(flags)
+}
@@ -0,0 +1,220 @@
+/*
+Copyright (c) 2024 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package run
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"text/tabwriter"
+	"time"
+
+	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+	"github.com/spf13/cobra"
+
+	"github.com/openshift/rosa/pkg/ocm"
+	"github.com/openshift/rosa/pkg/rosa"
+)
+
+const (
+	use   = "run"
+	short = "Apply the scaling schedules that are due"
+	long  = "Set the replicas of the machine pools of a cluster whose scaling schedules have been due since " +
+		"they last ran. When several schedules of the same machine pool are due, only the one that was due " +
+		"last is applied. Each schedule records the last time it was applied, so the command can safely be " +
+		"invoked by any scheduler as often as needed: schedules that already ran are not applied again, and " +
+		"schedules that failed are retried on the next invocation."
+	example = `  # Apply the scaling schedules of cluster 'mycluster' that are due, every 5 minutes from cron
+  */5 * * * * rosa scaling-schedule run --cluster=mycluster`
+)
+
+// now returns the current time, it is replaced in tests.
+var now = time.Now
+
+// scheduleRun is the outcome of evaluating a scaling schedule.
+type scheduleRun struct {
+	schedule   *ocm.ScalingSchedule
+	activation time.Time
+	result     string
+	err        error
+}
+
+func NewRunScalingSchedulesCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     use,
+		Short:   short,
+		Long:    long,
+		Example: example,
+		Args:    cobra.NoArgs,
+		Run:     rosa.DefaultRunner(rosa.RuntimeWithOCM(), RunScalingSchedulesRunner()),
+	}
+
+	ocm.AddClusterFlag(cmd)
+	return cmd
+}
+
+func RunScalingSchedulesRunner() rosa.CommandRunner {
+	return func(_ context.Context, r *rosa.Runtime, _ *cobra.Command, _ []string) error {
+		clusterKey := r.GetClusterKey()
+		cluster, err := r.OCMClient.GetCluster(clusterKey, r.Creator)
+		if err != nil {
+			return err
+		}
+
+		schedules, err := r.OCMClient.GetScalingSchedules(cluster)
+		if err != nil {
+			return fmt.Errorf("Failed to get scaling schedules for cluster '%s': %v", clusterKey, err)
+		}
+		if len(schedules) == 0 {
+			r.Reporter.Infof("There are no scaling schedules for cluster '%s'", clusterKey)
+			return nil
+		}
+
+		runs, err := runScalingSchedules(r, cluster, schedules, now().UTC())
+		if err != nil {
+			return err
+		}
+
+		writer := tabwriter.NewWriter(os.Stdout, 0, 4, 2, ' ', 0)
+		fmt.Fprintf(writer, "SCHEDULE\tMACHINE POOL\tRESULT\n")
+		failed := 0
+		for _, run := range runs {
+			result := run.result
+			if run.err != nil {
+				failed++
+				result = fmt.Sprintf("Failed: %v", run.err)
+			}
+			fmt.Fprintf(writer, "%s\t%s\t%s\n", run.schedule.Name, run.schedule.MachinePool, result)
+		}
+		writer.Flush()
+
+		if failed > 0 {
+			return fmt.Errorf("Failed to apply %d of %d scaling schedules on cluster '%s'",
+				failed, len(runs), clusterKey)
+		}
+		return nil
+	}
+}
+
+// runScalingSchedules applies, for each machine pool, the due schedule with the latest activation
+// and records the activation as the last run of all the due schedules of that pool. Schedules are
+// only recorded when the machine pool was updated successfully, so failures are retried.
+func runScalingSchedules(r *rosa.Runtime, cluster *cmv1.Cluster, schedules []*ocm.ScalingSchedule,
+	at time.Time) ([]*scheduleRun, error) {
+	runs := []*scheduleRun{}
+	latest := map[string]*scheduleRun{}
+	for _, schedule := range schedules {
+		activation, err := schedule.LastActivation(at)
+		if err != nil {
+			return nil, fmt.Errorf("Failed to evaluate scaling schedule '%s': %v", schedule.Name, err)
+		}
+		run := &scheduleRun{schedule: schedule, activation: activation}
+		runs = append(runs, run)
+		if activation.IsZero() {
+			run.result = "Not due"
+			continue
+		}
+		current, ok := latest[schedule.MachinePool]
+		if !ok || activation.After(current.activation) {
+			latest[schedule.MachinePool] = run
+		}
+	}
+
+	for _, run := range runs {
+		if run.activation.IsZero() {
+			continue
+		}
+		applied := latest[run.schedule.MachinePool]
+		if applied != run {
+			continue
+		}
+		run.result, run.err = setReplicas(r, cluster, run.schedule.MachinePool, run.schedule.Replicas)
+	}
+
+	for _, run := range runs {
+		if run.activation.IsZero() {
+			continue
+		}
+		applied := latest[run.schedule.MachinePool]
+		if applied.err != nil {
+			if applied != run {
+				run.err = fmt.Errorf("Scaling schedule '%s' failed", applied.schedule.Name)
+			}
+			continue
+		}
+		if applied != run {
+			run.result = fmt.Sprintf("Superseded by '%s'", applied.schedule.Name)
+		}
+		lastRun := run.activation
+		run.schedule.LastRun = &lastRun
+		err := r.OCMClient.UpdateScalingSchedule(cluster, run.schedule)
+		if err != nil {
+			run.err = fmt.Errorf("Failed to record last run: %v", err)
+		}
+	}
+	return runs, nil
+}
+
+func setReplicas(r *rosa.Runtime, cluster *cmv1.Cluster, id string, replicas int) (string, error) {
+	if cluster.Hypershift().Enabled() {
+		nodePool, exists, err := r.OCMClient.GetNodePool(cluster.ID(), id)
+		if err != nil {
+			return "", err
+		}
+		if !exists {
+			return "", fmt.Errorf("Machine pool '%s' does not exist", id)
+		}
+		if nodePool.Autoscaling() != nil {
+			return "", fmt.Errorf("Machine pool '%s' has autoscaling enabled", id)
+		}
+		if nodePool.Replicas() == replicas {
+			return fmt.Sprintf("Already at %d replicas", replicas), nil
+		}
+		update, err := cmv1.NewNodePool().ID(id).Replicas(replicas).Build()
+		if err != nil {
+			return "", err
+		}
+		_, err = r.OCMClient.UpdateNodePool(cluster.ID(), update)
+		if err != nil {
+			return "", err
+		}
+		return fmt.Sprintf("Scaled from %d to %d replicas", nodePool.Replicas(), replicas), nil
+	}
+
+	machinePool, exists, err := r.OCMClient.GetMachinePool(cluster.ID(), id)
+	if err != nil {
+		return "", err
+	}
+	if !exists {
+		return "", fmt.Errorf("Machine pool '%s' does not exist", id)
+	}
+	if machinePool.Autoscaling() != nil {
+		return "", fmt.Errorf("Machine pool '%s' has autoscaling enabled", id)
+	}
+	if machinePool.Replicas() == replicas {
+		return fmt.Sprintf("Already at %d replicas", replicas), nil
+	}
+	update, err := cmv1.NewMachinePool().ID(id).Replicas(replicas).Build()
+	if err != nil {
+		return "", err
+	}
+	_, err = r.OCMClient.UpdateMachinePool(cluster.ID(), update)
+	if err != nil {
+		return "", err
+	}
+	return fmt.Sprintf("Scaled from %d to %d replicas", machinePool.Replicas(), replicas), nil
+}
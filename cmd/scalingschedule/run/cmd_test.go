@@ -0,0 +1,203 @@
+/*
+Copyright (c) 2024 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package run
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+	"testing"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/ghttp"
+	amsv1 "github.com/openshift-online/ocm-sdk-go/accountsmgmt/v1"
+	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+	. "github.com/openshift-online/ocm-sdk-go/testing"
+	"github.com/spf13/cobra"
+
+	"github.com/openshift/rosa/pkg/ocm"
+	"github.com/openshift/rosa/pkg/rosa"
+	"github.com/openshift/rosa/pkg/test"
+)
+
+func TestRunScalingSchedules(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "rosa scaling-schedule run")
+}
+
+var (
+	labelsPath       = "/api/accounts_mgmt/v1/subscriptions/sub-1/labels"
+	machinePoolsPath = "/api/clusters_mgmt/v1/clusters/" + test.MockClusterID + "/machine_pools"
+	nodePoolsPath    = "/api/clusters_mgmt/v1/clusters/" + test.MockClusterID + "/node_pools"
+)
+
+var _ = Describe("rosa scaling-schedule run", func() {
+	var testRuntime test.TestingRuntime
+	var cmd *cobra.Command
+	createdAt := time.Date(2024, 5, 6, 12, 0, 0, 0, time.UTC)
+
+	BeforeEach(func() {
+		cmd = NewRunScalingSchedulesCommand()
+		testRuntime.InitRuntime()
+		now = func() time.Time { return time.Date(2024, 5, 7, 7, 0, 0, 0, time.UTC) }
+		DeferCleanup(func() { now = time.Now })
+	})
+
+	run := func() (string, error) {
+		stdout, _, err := test.RunWithOutputCapture(func(r *rosa.Runtime, cmd *cobra.Command) error {
+			return RunScalingSchedulesRunner()(context.Background(), r, cmd, nil)
+		}, testRuntime.RosaRuntime, cmd)
+		return stdout, err
+	}
+
+	mockCluster := func(hosted bool) string {
+		return test.FormatClusterList([]*cmv1.Cluster{test.MockCluster(func(c *cmv1.ClusterBuilder) {
+			c.Subscription(cmv1.NewSubscription().ID("sub-1"))
+			c.Hypershift(cmv1.NewHypershift().Enabled(hosted))
+		})})
+	}
+
+	formatSchedules := func(schedules ...*ocm.ScalingSchedule) string {
+		labels := []*amsv1.Label{}
+		for _, schedule := range schedules {
+			value, err := json.Marshal(schedule)
+			Expect(err).NotTo(HaveOccurred())
+			label, err := amsv1.NewLabel().Key(ocm.ScalingScheduleLabelPrefix + schedule.Name).
+				Value(string(value)).Build()
+			Expect(err).NotTo(HaveOccurred())
+			labels = append(labels, label)
+		}
+		return test.FormatLabelList(labels)
+	}
+
+	// recordLastRun verifies the update of the label of a schedule and returns its last run.
+	recordLastRun := func(name string, lastRun *time.Time) http.HandlerFunc {
+		return ghttp.CombineHandlers(
+			ghttp.VerifyRequest(http.MethodPatch, labelsPath+"/"+ocm.ScalingScheduleLabelPrefix+name),
+			func(w http.ResponseWriter, req *http.Request) {
+				body, err := io.ReadAll(req.Body)
+				Expect(err).NotTo(HaveOccurred())
+				label, err := amsv1.UnmarshalLabel(body)
+				Expect(err).NotTo(HaveOccurred())
+				schedule := &ocm.ScalingSchedule{}
+				Expect(json.Unmarshal([]byte(label.Value()), schedule)).To(Succeed())
+				Expect(schedule.LastRun).NotTo(BeNil())
+				*lastRun = *schedule.LastRun
+			},
+			RespondWithJSON(http.StatusOK, "{}"),
+		)
+	}
+
+	schedules := func() []*ocm.ScalingSchedule {
+		return []*ocm.ScalingSchedule{
+			{Name: "morning-up", MachinePool: "workers", Cron: "0 6 * * *", Replicas: 3, CreatedAt: createdAt},
+			{Name: "nightly-down", MachinePool: "workers", Cron: "0 20 * * *", Replicas: 0, CreatedAt: createdAt},
+			{Name: "weekly", MachinePool: "batch", Cron: "0 0 * * 0", Replicas: 6, CreatedAt: createdAt},
+		}
+	}
+
+	It("Applies the latest due schedule of each machine pool and records all due schedules", func() {
+		machinePool, err := cmv1.NewMachinePool().ID("workers").Replicas(0).Build()
+		Expect(err).NotTo(HaveOccurred())
+		var morningLastRun, nightlyLastRun time.Time
+		testRuntime.ApiServer.AppendHandlers(
+			RespondWithJSON(http.StatusOK, mockCluster(false)),
+			RespondWithJSON(http.StatusOK, formatSchedules(schedules()...)),
+			ghttp.CombineHandlers(
+				ghttp.VerifyRequest(http.MethodGet, machinePoolsPath+"/workers"),
+				RespondWithJSON(http.StatusOK, test.FormatResource(machinePool)),
+			),
+			ghttp.CombineHandlers(
+				ghttp.VerifyRequest(http.MethodPatch, machinePoolsPath+"/workers"),
+				ghttp.VerifyJSON(`{"kind": "MachinePool", "id": "workers", "replicas": 3}`),
+				RespondWithJSON(http.StatusOK, "{}"),
+			),
+			recordLastRun("morning-up", &morningLastRun),
+			recordLastRun("nightly-down", &nightlyLastRun),
+		)
+
+		stdout, err := run()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(stdout).To(Equal(
+			"SCHEDULE      MACHINE POOL  RESULT\n" +
+				"morning-up    workers       Scaled from 0 to 3 replicas\n" +
+				"nightly-down  workers       Superseded by 'morning-up'\n" +
+				"weekly        batch         Not due\n"))
+		Expect(morningLastRun).To(Equal(time.Date(2024, 5, 7, 6, 0, 0, 0, time.UTC)))
+		Expect(nightlyLastRun).To(Equal(time.Date(2024, 5, 6, 20, 0, 0, 0, time.UTC)))
+	})
+
+	It("Doesn't apply schedules again once they ran", func() {
+		morningLastRun := time.Date(2024, 5, 7, 6, 0, 0, 0, time.UTC)
+		nightlyLastRun := time.Date(2024, 5, 6, 20, 0, 0, 0, time.UTC)
+		current := schedules()
+		current[0].LastRun = &morningLastRun
+		current[1].LastRun = &nightlyLastRun
+		testRuntime.ApiServer.AppendHandlers(
+			RespondWithJSON(http.StatusOK, mockCluster(false)),
+			RespondWithJSON(http.StatusOK, formatSchedules(current...)),
+		)
+
+		stdout, err := run()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(stdout).To(Equal(
+			"SCHEDULE      MACHINE POOL  RESULT\n" +
+				"morning-up    workers       Not due\n" +
+				"nightly-down  workers       Not due\n" +
+				"weekly        batch         Not due\n"))
+	})
+
+	It("Doesn't record schedules whose machine pool failed to scale", func() {
+		machinePool, err := cmv1.NewMachinePool().ID("workers").Replicas(0).Build()
+		Expect(err).NotTo(HaveOccurred())
+		testRuntime.ApiServer.AppendHandlers(
+			RespondWithJSON(http.StatusOK, mockCluster(false)),
+			RespondWithJSON(http.StatusOK, formatSchedules(schedules()...)),
+			RespondWithJSON(http.StatusOK, test.FormatResource(machinePool)),
+			RespondWithJSON(http.StatusBadRequest, `{"kind": "Error", "reason": "quota exceeded"}`),
+		)
+
+		stdout, err := run()
+		Expect(err).To(MatchError("Failed to apply 2 of 3 scaling schedules on cluster 'cluster1'"))
+		Expect(stdout).To(ContainSubstring("morning-up    workers       Failed: quota exceeded"))
+		Expect(stdout).To(ContainSubstring("nightly-down  workers       Failed: Scaling schedule 'morning-up' failed"))
+	})
+
+	It("Records schedules of node pools that already have the replicas", func() {
+		nodePool, err := cmv1.NewNodePool().ID("workers").Replicas(3).Build()
+		Expect(err).NotTo(HaveOccurred())
+		var morningLastRun, nightlyLastRun time.Time
+		testRuntime.ApiServer.AppendHandlers(
+			RespondWithJSON(http.StatusOK, mockCluster(true)),
+			RespondWithJSON(http.StatusOK, formatSchedules(schedules()[:2]...)),
+			ghttp.CombineHandlers(
+				ghttp.VerifyRequest(http.MethodGet, nodePoolsPath+"/workers"),
+				RespondWithJSON(http.StatusOK, test.FormatResource(nodePool)),
+			),
+			recordLastRun("morning-up", &morningLastRun),
+			recordLastRun("nightly-down", &nightlyLastRun),
+		)
+
+		stdout, err := run()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(stdout).To(ContainSubstring("morning-up    workers       Already at 3 replicas"))
+		Expect(morningLastRun).To(Equal(time.Date(2024, 5, 7, 6, 0, 0, 0, time.UTC)))
+	})
+})
@@ -30,6 +30,7 @@ import (
 	"github.com/openshift/rosa/pkg/interactive"
 	"github.com/openshift/rosa/pkg/interactive/confirm"
 	"github.com/openshift/rosa/pkg/ocm"
+	"github.com/openshift/rosa/pkg/reporter"
 	"github.com/openshift/rosa/pkg/rosa"
 )
 
@@ -141,7 +142,7 @@ func run(cmd *cobra.Command, _ []string) {
 	err := runWithRuntime(r, cmd)
 	if err != nil {
 		r.Reporter.Errorf(err.Error())
-		os.Exit(1)
+		os.Exit(reporter.ExitCode())
 	}
 }
 
@@ -244,7 +245,7 @@ func runWithRuntime(r *rosa.Runtime, cmd *cobra.Command) error {
 		mode, err = interactive.GetOptionMode(cmd, mode, "IAM Roles/Policies upgrade mode")
 		if err != nil {
 			r.Reporter.Errorf("Expected a valid role upgrade mode: %v", err)
-			os.Exit(1)
+			os.Exit(reporter.ExitCode())
 		}
 	}
 
@@ -518,7 +519,7 @@ func buildNodeDrainGracePeriod(r *rosa.Runtime, cmd *cobra.Command, cluster *cmv
 		})
 		if err != nil {
 			r.Reporter.Errorf("Expected a valid node drain grace period: %s", err)
-			os.Exit(1)
+			os.Exit(reporter.ExitCode())
 		}
 	}
 	isValidNodeDrainGracePeriod := false
@@ -531,13 +532,13 @@ func buildNodeDrainGracePeriod(r *rosa.Runtime, cmd *cobra.Command, cluster *cmv
 	if !isValidNodeDrainGracePeriod {
 		r.Reporter.Errorf("Expected a valid node drain grace period. Options are [%s]",
 			strings.Join(nodeDrainOptions, ", "))
-		os.Exit(1)
+		os.Exit(reporter.ExitCode())
 	}
 	nodeDrainParsed := strings.Split(nodeDrainGracePeriod, " ")
 	nodeDrainValue, err := strconv.ParseFloat(nodeDrainParsed[0], commonUtils.MaxByteSize)
 	if err != nil {
 		r.Reporter.Errorf("Expected a valid node drain grace period: %s", err)
-		os.Exit(1)
+		os.Exit(reporter.ExitCode())
 	}
 	if nodeDrainParsed[1] == "hours" || nodeDrainParsed[1] == "hour" {
 		nodeDrainValue = nodeDrainValue * 60
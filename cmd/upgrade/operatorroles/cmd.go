@@ -30,6 +30,7 @@ import (
 	"github.com/openshift/rosa/pkg/interactive"
 	"github.com/openshift/rosa/pkg/interactive/confirm"
 	"github.com/openshift/rosa/pkg/ocm"
+	"github.com/openshift/rosa/pkg/reporter"
 	"github.com/openshift/rosa/pkg/rosa"
 )
 
@@ -72,7 +73,7 @@ func run(cmd *cobra.Command, _ []string) {
 	mode, err := interactive.GetMode()
 	if err != nil {
 		r.Reporter.Errorf("%s", err)
-		os.Exit(1)
+		os.Exit(reporter.ExitCode())
 	}
 
 	clusterKey := r.GetClusterKey()
@@ -81,7 +82,7 @@ func run(cmd *cobra.Command, _ []string) {
 	latestPolicyVersion, err := r.OCMClient.GetLatestVersion(cluster.Version().ChannelGroup())
 	if err != nil {
 		r.Reporter.Errorf("Error getting latest version: %s", err)
-		os.Exit(1)
+		os.Exit(reporter.ExitCode())
 	}
 
 	/**
@@ -92,7 +93,7 @@ func run(cmd *cobra.Command, _ []string) {
 		availableUpgrades, err := r.OCMClient.GetAvailableUpgrades(ocm.GetVersionID(cluster))
 		if err != nil {
 			r.Reporter.Errorf("Failed to find available upgrades: %v", err)
-			os.Exit(1)
+			os.Exit(reporter.ExitCode())
 		}
 		if len(availableUpgrades) == 0 {
 			r.Reporter.Warnf("There are no available upgrades")
@@ -108,7 +109,7 @@ func run(cmd *cobra.Command, _ []string) {
 		}
 		if !validVersion {
 			r.Reporter.Errorf("Expected a valid version to upgrade the cluster")
-			os.Exit(1)
+			os.Exit(reporter.ExitCode())
 		}
 	}
 
@@ -116,25 +117,25 @@ func run(cmd *cobra.Command, _ []string) {
 	if !hasOperatorRoles || len(operatorRoles) == 0 {
 		r.Reporter.Errorf("Cluster '%s' doesn't have any operator roles associated with it",
 			clusterKey)
-		os.Exit(1)
+		os.Exit(reporter.ExitCode())
 	}
 
 	prefix, err := aws.GetPrefixFromInstallerAccountRole(cluster)
 	if err != nil {
 		r.Reporter.Errorf("Error getting account role prefix for the cluster '%s'",
 			clusterKey)
-		os.Exit(1)
+		os.Exit(reporter.ExitCode())
 	}
 	unifiedPath, err := aws.GetPathFromAccountRole(cluster, aws.AccountRoles[aws.InstallerAccountRole].Name)
 	if err != nil {
 		r.Reporter.Errorf("Expected a valid path for '%s': %v", cluster.AWS().STS().RoleARN(), err)
-		os.Exit(1)
+		os.Exit(reporter.ExitCode())
 	}
 
 	env, err := ocm.GetEnv()
 	if err != nil {
 		r.Reporter.Errorf("Failed to determine OCM environment: %v", err)
-		os.Exit(1)
+		os.Exit(reporter.ExitCode())
 	}
 
 	managedPolicies := cluster.AWS().STS().ManagedPolicies()
@@ -142,20 +143,20 @@ func run(cmd *cobra.Command, _ []string) {
 	credRequests, err := r.OCMClient.GetCredRequests(cluster.Hypershift().Enabled())
 	if err != nil {
 		r.Reporter.Errorf("Error getting operator credential request from OCM %s", err)
-		os.Exit(1)
+		os.Exit(reporter.ExitCode())
 	}
 
 	policies, err := r.OCMClient.GetPolicies("OperatorRole")
 	if err != nil {
 		r.Reporter.Errorf("Expected a valid role creation mode: %s", err)
-		os.Exit(1)
+		os.Exit(reporter.ExitCode())
 	}
 
 	if managedPolicies {
 		mode, err = handleModeFlag(cmd, mode)
 		if err != nil {
 			r.Reporter.Errorf("%s", err)
-			os.Exit(1)
+			os.Exit(reporter.ExitCode())
 		}
 
 		hostedCPPolicies := aws.IsHostedCPManagedPolicies(cluster)
@@ -164,7 +165,7 @@ func run(cmd *cobra.Command, _ []string) {
 			args.upgradeVersion, hostedCPPolicies)
 		if err != nil {
 			r.Reporter.Errorf("Failed while validating managed policies: %v", err)
-			os.Exit(1)
+			os.Exit(reporter.ExitCode())
 		}
 
 		r.Reporter.Infof("Cluster '%s' operator roles have attached managed policies. "+
@@ -180,20 +181,20 @@ func run(cmd *cobra.Command, _ []string) {
 		prefix, latestPolicyVersion)
 	if err != nil {
 		r.Reporter.Errorf("%s", err)
-		os.Exit(1)
+		os.Exit(reporter.ExitCode())
 	}
 	if isAccountRoleUpgradeNeed {
 		r.Reporter.Infof("Account roles with prefix '%s' need to be upgraded before operator roles. "+
 			"Roles can be upgraded with the following command :"+
 			"\n\n\trosa upgrade account-roles --prefix %s\n", prefix, prefix)
-		os.Exit(1)
+		os.Exit(reporter.ExitCode())
 	}
 
 	isOperatorPolicyUpgradeNeeded, err := r.AWSClient.IsUpgradedNeededForOperatorRolePoliciesUsingPrefix(prefix,
 		r.Creator.Partition, r.Creator.AccountID, latestPolicyVersion, credRequests, unifiedPath)
 	if err != nil {
 		r.Reporter.Errorf("%s", err)
-		os.Exit(1)
+		os.Exit(reporter.ExitCode())
 	}
 
 	version := args.upgradeVersion
@@ -205,7 +206,7 @@ func run(cmd *cobra.Command, _ []string) {
 	missingRolesInCS, err := r.OCMClient.FindMissingOperatorRolesForUpgrade(cluster, version)
 	if err != nil {
 		r.Reporter.Errorf("Error finding operator roles for upgrade '%s'", err)
-		os.Exit(1)
+		os.Exit(reporter.ExitCode())
 	}
 
 	if len(missingRolesInCS) <= 0 && !isOperatorPolicyUpgradeNeeded {
@@ -220,7 +221,7 @@ func run(cmd *cobra.Command, _ []string) {
 	mode, err = handleModeFlag(cmd, mode)
 	if err != nil {
 		r.Reporter.Errorf("%s", err)
-		os.Exit(1)
+		os.Exit(reporter.ExitCode())
 	}
 
 	if isOperatorPolicyUpgradeNeeded {
@@ -228,7 +229,7 @@ func run(cmd *cobra.Command, _ []string) {
 			policies, env, latestPolicyVersion, credRequests, cluster, unifiedPath)
 		if err != nil {
 			r.Reporter.Errorf("%s", err)
-			os.Exit(1)
+			os.Exit(reporter.ExitCode())
 		}
 	}
 
@@ -236,7 +237,7 @@ func run(cmd *cobra.Command, _ []string) {
 		err = roles.CreateMissingRoles(r, missingRolesInCS, cluster, mode, prefix, policies, unifiedPath, false)
 		if err != nil {
 			r.Reporter.Errorf("Error creating operator roles: %s", err)
-			os.Exit(1)
+			os.Exit(reporter.ExitCode())
 		}
 	}
 }
@@ -268,7 +269,7 @@ func upgradeOperatorPolicies(mode string, r *rosa.Runtime,
 			cluster.AWS().PrivateHostedZoneRoleARN(), r.Creator.Partition)
 		if err != nil {
 			r.Reporter.Errorf("There was an error generating the policy files: %s", err)
-			os.Exit(1)
+			os.Exit(reporter.ExitCode())
 		}
 
 		if r.Reporter.IsTerminal() {
@@ -128,7 +128,7 @@ func run(cmd *cobra.Command, argv []string) {
 	mode, err := interactive.GetMode()
 	if err != nil {
 		reporter.Errorf("%s", err)
-		os.Exit(1)
+		os.Exit(rprtr.ExitCode())
 	}
 
 	clusterUpgradeVersion := args.clusterUpgradeVersion
@@ -136,7 +136,7 @@ func run(cmd *cobra.Command, argv []string) {
 	availableUpgrades, err := r.OCMClient.GetAvailableUpgrades(ocm.GetVersionID(cluster))
 	if err != nil {
 		r.Reporter.Errorf("Failed to find available upgrades: %v", err)
-		os.Exit(1)
+		os.Exit(rprtr.ExitCode())
 	}
 	if len(availableUpgrades) == 0 {
 		r.Reporter.Warnf("There are no available upgrades")
@@ -145,13 +145,13 @@ func run(cmd *cobra.Command, argv []string) {
 	err = ocmClient.CheckUpgradeClusterVersion(availableUpgrades, clusterUpgradeVersion, cluster)
 	if err != nil {
 		reporter.Errorf("%v", err)
-		os.Exit(1)
+		os.Exit(rprtr.ExitCode())
 	}
 
 	env, err := ocm.GetEnv()
 	if err != nil {
 		reporter.Errorf("Failed to determine OCM environment: %v", err)
-		os.Exit(1)
+		os.Exit(rprtr.ExitCode())
 	}
 
 	managedPolicies := cluster.AWS().STS().ManagedPolicies()
@@ -159,13 +159,13 @@ func run(cmd *cobra.Command, argv []string) {
 	unifiedPath, err := aws.GetPathFromAccountRole(cluster, aws.AccountRoles[aws.InstallerAccountRole].Name)
 	if err != nil {
 		r.Reporter.Errorf("Expected a valid path for '%s': %v", cluster.AWS().STS().RoleARN(), err)
-		os.Exit(1)
+		os.Exit(rprtr.ExitCode())
 	}
 
 	credRequests, err := ocmClient.GetCredRequests(cluster.Hypershift().Enabled())
 	if err != nil {
 		r.Reporter.Errorf("Error getting operator credential request from OCM %s", err)
-		os.Exit(1)
+		os.Exit(rprtr.ExitCode())
 	}
 
 	// Determine if interactive mode is needed
@@ -178,7 +178,7 @@ func run(cmd *cobra.Command, argv []string) {
 		mode, err = interactive.GetOptionMode(cmd, mode, "Roles upgrade mode")
 		if err != nil {
 			r.Reporter.Errorf("expected a valid Account role upgrade mode: %s", err)
-			os.Exit(1)
+			os.Exit(rprtr.ExitCode())
 		}
 	}
 
@@ -187,7 +187,7 @@ func run(cmd *cobra.Command, argv []string) {
 		accountRolePrefix, err = aws.GetPrefixFromAccountRole(cluster, "Installer")
 		if err != nil {
 			r.Reporter.Errorf("Failed while trying to get account role prefix: '%v'", err)
-			os.Exit(1)
+			os.Exit(rprtr.ExitCode())
 		}
 
 		hostedCPPolicies := aws.IsHostedCPManagedPolicies(cluster)
@@ -195,20 +195,20 @@ func run(cmd *cobra.Command, argv []string) {
 		err = roles.ValidateAccountRolesManagedPolicies(r, accountRolePrefix, hostedCPPolicies)
 		if err != nil {
 			r.Reporter.Errorf("Failed while validating managed policies: %v", err)
-			os.Exit(1)
+			os.Exit(rprtr.ExitCode())
 		}
 		r.Reporter.Infof("Account roles with the prefix '%s' have attached managed policies.", accountRolePrefix)
 
 		policies, err := r.OCMClient.GetPolicies("OperatorRole")
 		if err != nil {
 			r.Reporter.Errorf("Expected a valid role creation mode: %s", err)
-			os.Exit(1)
+			os.Exit(rprtr.ExitCode())
 		}
 		err = roles.ValidateOperatorRolesManagedPolicies(r, cluster, credRequests, policies, mode,
 			accountRolePrefix, unifiedPath, clusterUpgradeVersion, hostedCPPolicies)
 		if err != nil {
 			r.Reporter.Errorf("Failed while validating managed policies: %v", err)
-			os.Exit(1)
+			os.Exit(rprtr.ExitCode())
 		}
 		r.Reporter.Infof("Cluster '%s' operator roles have attached managed policies. "+
 			"An upgrade isn't needed", cluster.Name())
@@ -221,19 +221,19 @@ func run(cmd *cobra.Command, argv []string) {
 	policyVersion, err = ocmClient.GetPolicyVersion(policyVersion, channelGroup)
 	if err != nil {
 		reporter.Errorf("Error getting version: %s", err)
-		os.Exit(1)
+		os.Exit(rprtr.ExitCode())
 	}
 
 	err = checkPolicyAndClusterVersionCompatibility(policyVersion, clusterUpgradeVersion)
 	if err != nil {
 		reporter.Errorf("%v", err)
-		os.Exit(1)
+		os.Exit(rprtr.ExitCode())
 	}
 
 	creator, err := awsClient.GetCreator()
 	if err != nil {
 		reporter.Errorf("Failed to get IAM credentials: %s", err)
-		os.Exit(1)
+		os.Exit(rprtr.ExitCode())
 	}
 
 	var spin *spinner.Spinner
@@ -257,7 +257,7 @@ func run(cmd *cobra.Command, argv []string) {
 	if err != nil {
 		reporter.Errorf("%s", err)
 		LogError(roles.RosaUpgradeAccRolesModeAuto, ocmClient, policyVersion, err, reporter)
-		os.Exit(1)
+		os.Exit(rprtr.ExitCode())
 	}
 
 	if spin != nil {
@@ -270,7 +270,7 @@ func run(cmd *cobra.Command, argv []string) {
 		accountRolePolicies, err := ocmClient.GetPolicies("")
 		if err != nil {
 			reporter.Errorf("Expected a valid role creation mode: %s", err)
-			os.Exit(1)
+			os.Exit(rprtr.ExitCode())
 		}
 
 		switch mode {
@@ -295,10 +295,10 @@ func run(cmd *cobra.Command, argv []string) {
 						if reporter.IsTerminal() {
 							reporter.Infof(generateClusterUpgradeInfo(clusterKey, args.clusterUpgradeVersion, mode))
 						}
-						os.Exit(1)
+						os.Exit(rprtr.ExitCode())
 					}
 					reporter.Errorf("Error upgrading the account role policies: %s", err)
-					os.Exit(1)
+					os.Exit(rprtr.ExitCode())
 				}
 			}
 		case interactive.ModeManual:
@@ -307,7 +307,7 @@ func run(cmd *cobra.Command, argv []string) {
 					aws.AccountRoles, creator.Partition)
 				if err != nil {
 					reporter.Errorf("There was an error generating the policy files: %s", err)
-					os.Exit(1)
+					os.Exit(rprtr.ExitCode())
 				}
 			}
 			if reporter.IsTerminal() {
@@ -326,13 +326,13 @@ func run(cmd *cobra.Command, argv []string) {
 			)
 			if err != nil {
 				reporter.Errorf("%s", err)
-				os.Exit(1)
+				os.Exit(rprtr.ExitCode())
 			}
 
 			fmt.Println(commands)
 		default:
 			reporter.Errorf("Invalid mode. Allowed values are %s", interactive.Modes)
-			os.Exit(1)
+			os.Exit(rprtr.ExitCode())
 		}
 	}
 
@@ -349,13 +349,13 @@ func run(cmd *cobra.Command, argv []string) {
 	if !hasOperatorRoles || len(operatorRoles) == 0 {
 		r.Reporter.Errorf("Cluster '%s' doesn't have any operator roles associated with it",
 			r.ClusterKey)
-		os.Exit(1)
+		os.Exit(rprtr.ExitCode())
 	}
 
 	operatorRolePolicyPrefix, err := aws.GetOperatorRolePolicyPrefixFromCluster(cluster, r.AWSClient)
 	if err != nil {
 		r.Reporter.Errorf("Error getting operator role policy prefix: %s", err)
-		os.Exit(1)
+		os.Exit(rprtr.ExitCode())
 	}
 
 	isOperatorPolicyUpgradeNeeded := false
@@ -369,13 +369,13 @@ func run(cmd *cobra.Command, argv []string) {
 	)
 	if err != nil {
 		r.Reporter.Errorf("%s", err)
-		os.Exit(1)
+		os.Exit(rprtr.ExitCode())
 	}
 
 	missingRolesInCS, err := ocmClient.FindMissingOperatorRolesForUpgrade(cluster, clusterUpgradeVersion)
 	if err != nil {
 		r.Reporter.Errorf("Error finding operator roles for upgrade '%s'", err)
-		os.Exit(1)
+		os.Exit(rprtr.ExitCode())
 	}
 
 	if spin != nil {
@@ -396,7 +396,7 @@ func run(cmd *cobra.Command, argv []string) {
 	operatorRolePolicies, err := ocmClient.GetPolicies("OperatorRole")
 	if err != nil {
 		r.Reporter.Errorf("Expected a valid role creation mode: %s", err)
-		os.Exit(1)
+		os.Exit(rprtr.ExitCode())
 	}
 
 	if isOperatorPolicyUpgradeNeeded {
@@ -416,7 +416,7 @@ func run(cmd *cobra.Command, argv []string) {
 			if args.isInvokedFromClusterUpgrade && reporter.IsTerminal() {
 				reporter.Infof(generateClusterUpgradeInfo(clusterKey, args.clusterUpgradeVersion, mode))
 			}
-			os.Exit(1)
+			os.Exit(rprtr.ExitCode())
 		}
 	}
 
@@ -427,7 +427,7 @@ func run(cmd *cobra.Command, argv []string) {
 			exists, _, err := r.AWSClient.CheckRoleExists(roleName)
 			if err != nil {
 				r.Reporter.Errorf("Error when detecting checking missing operator IAM roles %s", err)
-				os.Exit(1)
+				os.Exit(rprtr.ExitCode())
 			}
 			if !exists {
 				err = createOperatorRole(
@@ -445,7 +445,7 @@ func run(cmd *cobra.Command, argv []string) {
 					if args.isInvokedFromClusterUpgrade && reporter.IsTerminal() {
 						reporter.Infof(generateClusterUpgradeInfo(clusterKey, args.clusterUpgradeVersion, mode))
 					}
-					os.Exit(1)
+					os.Exit(rprtr.ExitCode())
 				}
 				createdMissingRoles++
 			}
@@ -1074,7 +1074,10 @@ func createOperatorRole(
 		if err != nil {
 			return err
 		}
-		helper.DisplaySpinnerWithDelay(r.Reporter, "Waiting for operator roles to reconcile", 5*time.Second)
+		err = helper.DisplaySpinnerWithDelay(r.Reporter, "Waiting for operator roles to reconcile", 5*time.Second)
+		if err != nil {
+			return err
+		}
 	case interactive.ModeManual:
 		commands, err := roles.BuildMissingOperatorRoleCommand(
 			missingRoles,
@@ -1095,7 +1098,7 @@ func createOperatorRole(
 		fmt.Println(commands)
 	default:
 		r.Reporter.Errorf("Invalid mode. Allowed values are %s", interactive.Modes)
-		os.Exit(1)
+		os.Exit(rprtr.ExitCode())
 	}
 	return nil
 }
@@ -0,0 +1,360 @@
+/*
+Copyright (c) 2024 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clusterprereqs
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	awssdk "github.com/aws/aws-sdk-go-v2/aws"
+	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+
+	"github.com/openshift/rosa/cmd/verify/network"
+	"github.com/openshift/rosa/pkg/aws"
+	"github.com/openshift/rosa/pkg/aws/tags"
+	"github.com/openshift/rosa/pkg/helper"
+	"github.com/openshift/rosa/pkg/rosa"
+	"github.com/openshift/rosa/pkg/timeout"
+)
+
+type Status string
+
+const (
+	StatusPass Status = "pass"
+	StatusWarn Status = "warn"
+	StatusFail Status = "fail"
+)
+
+// Check is the result of one of the verifications.
+type Check struct {
+	Name    string `json:"name"`
+	Status  Status `json:"status"`
+	Message string `json:"message"`
+}
+
+// CheckReport contains the results of all the verifications.
+type CheckReport struct {
+	Checks   []*Check `json:"checks"`
+	Passed   int      `json:"passed"`
+	Warnings int      `json:"warnings"`
+	Failures int      `json:"failures"`
+}
+
+func newReport() *CheckReport {
+	return &CheckReport{Checks: []*Check{}}
+}
+
+func (r *CheckReport) add(checks ...*Check) {
+	for _, check := range checks {
+		r.Checks = append(r.Checks, check)
+		switch check.Status {
+		case StatusPass:
+			r.Passed++
+		case StatusWarn:
+			r.Warnings++
+		case StatusFail:
+			r.Failures++
+		}
+	}
+}
+
+func newCheck(name string, status Status, format string, a ...interface{}) *Check {
+	return &Check{Name: name, Status: status, Message: fmt.Sprintf(format, a...)}
+}
+
+var (
+	// networkVerificationDelay is the time between checks of the state of the network verification.
+	networkVerificationDelay = 5 * time.Second
+
+	// networkVerificationTimeout is the maximum time to wait for the network verification when the
+	// timeout flag isn't set.
+	networkVerificationTimeout = 10 * time.Minute
+)
+
+func checkQuota(r *rosa.Runtime) *Check {
+	const name = "AWS quota"
+	_, err := r.AWSClient.ValidateQuota()
+	if err != nil {
+		return newCheck(name, StatusFail, "%v", err)
+	}
+	return newCheck(name, StatusPass, "Quota is enough for a cluster")
+}
+
+func checkSCP(r *rosa.Runtime) *Check {
+	const name = "SCP policies"
+	policies, err := r.OCMClient.GetPolicies("OSDSCPPolicy")
+	if err != nil {
+		return newCheck(name, StatusFail, "Failed to get the SCP policies: %v", err)
+	}
+	ok, err := r.AWSClient.ValidateSCP(nil, policies)
+	if err != nil {
+		return newCheck(name, StatusFail, "Unable to validate SCP policies. Make sure that an organizational "+
+			"SCP is not preventing this account from performing the required checks: %v", err)
+	}
+	if !ok {
+		return newCheck(name, StatusWarn, "Some of the permissions needed by clusters are denied")
+	}
+	return newCheck(name, StatusPass, "Permissions needed by clusters are allowed")
+}
+
+// checkAccountRoles checks that there are account roles compatible with the given minor version, and
+// returns the installer role found, if any.
+func checkAccountRoles(r *rosa.Runtime, minor string) (string, []*Check) {
+	roleTypes := []string{aws.InstallerAccountRole, aws.SupportAccountRole, aws.WorkerAccountRole}
+	if !args.hostedCP {
+		roleTypes = append(roleTypes, aws.ControlPlaneAccountRole)
+	}
+	createCommand := "rosa create account-roles"
+	if args.hostedCP {
+		createCommand += " --hosted-cp"
+	}
+	if args.prefix != "" {
+		createCommand += fmt.Sprintf(" --prefix %s", args.prefix)
+	}
+
+	installerRoleARN := ""
+	checks := []*Check{}
+	for _, roleType := range roleTypes {
+		name := fmt.Sprintf("%s account role", aws.AccountRoles[roleType].Name)
+		var roleARNs []string
+		var err error
+		if args.hostedCP {
+			roleARNs, err = r.AWSClient.FindRoleARNsHostedCp(roleType, minor)
+		} else {
+			roleARNs, err = r.AWSClient.FindRoleARNsClassic(roleType, minor)
+		}
+		if err != nil {
+			checks = append(checks, newCheck(name, StatusFail, "Failed to find roles: %v", err))
+			continue
+		}
+		roleARNs = filterRolesByPrefix(roleARNs, args.prefix)
+		if len(roleARNs) == 0 {
+			checks = append(checks, newCheck(name, StatusFail, "No role compatible with version '%s' found. "+
+				"Run '%s' to create it, or 'rosa upgrade account-roles' to upgrade it", minor, createCommand))
+			continue
+		}
+		if roleType == aws.InstallerAccountRole {
+			installerRoleARN = roleARNs[0]
+		}
+		if len(roleARNs) > 1 {
+			checks = append(checks, newCheck(name, StatusWarn, "Found %d compatible roles, use '--prefix' to "+
+				"choose one of them: %s", len(roleARNs), strings.Join(roleARNs, ", ")))
+			continue
+		}
+		checks = append(checks, newCheck(name, StatusPass, "%s", roleARNs[0]))
+	}
+	return installerRoleARN, checks
+}
+
+// filterRolesByPrefix returns the roles whose name starts with the given prefix, or all of them when
+// the prefix is empty.
+func filterRolesByPrefix(roleARNs []string, prefix string) []string {
+	if prefix == "" {
+		return roleARNs
+	}
+	result := []string{}
+	for _, roleARN := range roleARNs {
+		roleName, err := aws.GetResourceIdFromARN(roleARN)
+		if err == nil && strings.HasPrefix(roleName, prefix+"-") {
+			result = append(result, roleARN)
+		}
+	}
+	return result
+}
+
+func checkOidcConfig(r *rosa.Runtime) *Check {
+	const name = "OIDC configuration"
+	// Classic clusters can create an OIDC configuration along with the cluster
+	missing := StatusWarn
+	if args.hostedCP {
+		missing = StatusFail
+	}
+	if args.oidcConfigID != "" {
+		oidcConfig, err := r.OCMClient.GetOidcConfig(args.oidcConfigID)
+		if err != nil {
+			return newCheck(name, StatusFail, "Failed to get OIDC configuration '%s': %v", args.oidcConfigID, err)
+		}
+		return newCheck(name, StatusPass, "%s", oidcConfig.IssuerUrl())
+	}
+	oidcConfigs, err := r.OCMClient.ListOidcConfigs(r.Creator.AccountID)
+	if err != nil {
+		return newCheck(name, StatusFail, "Failed to list OIDC configurations: %v", err)
+	}
+	if len(oidcConfigs) == 0 {
+		return newCheck(name, missing, "There are no OIDC configurations. Run 'rosa create oidc-config' "+
+			"to create one")
+	}
+	return newCheck(name, StatusPass, "Found %d OIDC configurations, use '--%s' to check a specific one",
+		len(oidcConfigs), oidcConfigIDFlag)
+}
+
+// uniqueSubnetIDs returns the subnets given in the command line without duplicates, in the same order.
+func uniqueSubnetIDs() []string {
+	seen := map[string]bool{}
+	subnetIDs := []string{}
+	for _, subnetID := range args.subnetIDs {
+		if !seen[subnetID] {
+			seen[subnetID] = true
+			subnetIDs = append(subnetIDs, subnetID)
+		}
+	}
+	return subnetIDs
+}
+
+func checkSubnets(r *rosa.Runtime) []*Check {
+	const zonesName = "Subnet availability zones"
+	const routingName = "Subnet routing"
+	subnetIDs := uniqueSubnetIDs()
+	subnets, err := r.AWSClient.ListSubnets(subnetIDs...)
+	if err != nil {
+		return []*Check{newCheck(zonesName, StatusFail, "Failed to get subnets: %v", err)}
+	}
+	if len(subnets) != len(subnetIDs) {
+		found := map[string]bool{}
+		for _, subnet := range subnets {
+			found[awssdk.ToString(subnet.SubnetId)] = true
+		}
+		missing := []string{}
+		for _, subnetID := range subnetIDs {
+			if !found[subnetID] {
+				missing = append(missing, subnetID)
+			}
+		}
+		return []*Check{newCheck(zonesName, StatusFail, "Subnets not found: %s", helper.SliceToSortedString(missing))}
+	}
+
+	checks := []*Check{}
+	zones := map[string]bool{}
+	invalidZones := []string{}
+	managed := []string{}
+	for _, subnet := range subnets {
+		subnetID := awssdk.ToString(subnet.SubnetId)
+		if tags.Ec2ResourceHasTag(subnet.Tags, tags.RedHatManaged, tags.True) {
+			managed = append(managed, subnetID)
+			continue
+		}
+		zone := awssdk.ToString(subnet.AvailabilityZone)
+		zoneType, err := r.AWSClient.GetAvailabilityZoneType(zone)
+		if err != nil {
+			return append(checks, newCheck(zonesName, StatusFail, "Failed to get the type of availability zone '%s': %v",
+				zone, err))
+		}
+		if zoneType == aws.LocalZone || zoneType == aws.WavelengthZone {
+			invalidZones = append(invalidZones, subnetID)
+			continue
+		}
+		zones[zone] = true
+	}
+	switch {
+	case len(managed) > 0:
+		checks = append(checks, newCheck(zonesName, StatusFail, "Subnets belong to a VPC managed by Red Hat: %s",
+			helper.SliceToSortedString(managed)))
+	case len(invalidZones) > 0:
+		checks = append(checks, newCheck(zonesName, StatusFail, "Subnets are in local or wavelength zones, "+
+			"which can only be used by machine pools: %s", helper.SliceToSortedString(invalidZones)))
+	default:
+		checks = append(checks, newCheck(zonesName, StatusPass, "Subnets are in availability zones %s",
+			helper.SliceToSortedString(helper.MapKeys(zones))))
+	}
+
+	publicSubnetMap, err := r.AWSClient.FetchPublicSubnetMap(subnets)
+	if err != nil {
+		return append(checks, newCheck(routingName, StatusFail, "Unable to check if subnets have an IGW: %v", err))
+	}
+	public := []string{}
+	for _, subnetID := range subnetIDs {
+		if publicSubnetMap[subnetID] {
+			public = append(public, subnetID)
+		}
+	}
+	private := len(subnetIDs) - len(public)
+	switch {
+	case args.private && len(public) > 0:
+		checks = append(checks, newCheck(routingName, StatusFail, "Private clusters can't use subnets with a route "+
+			"to an Internet Gateway: %s", helper.SliceToSortedString(public)))
+	case !args.private && len(public) == 0:
+		checks = append(checks, newCheck(routingName, StatusFail, "Public clusters need at least one subnet with a "+
+			"route to an Internet Gateway"))
+	case private == 0:
+		checks = append(checks, newCheck(routingName, StatusFail, "At least one private subnet is needed"))
+	default:
+		checks = append(checks, newCheck(routingName, StatusPass, "%d private and %d public subnets",
+			private, len(public)))
+	}
+	return checks
+}
+
+// checkNetwork runs the OCM network verification of the subnets and waits for its results.
+func checkNetwork(r *rosa.Runtime, installerRoleARN string) []*Check {
+	const name = "Network verification"
+	if installerRoleARN == "" {
+		return []*Check{newCheck(name, StatusWarn, "Skipped because there is no installer role to run it")}
+	}
+	subnetIDs := uniqueSubnetIDs()
+	platform := cmv1.PlatformAwsClassic
+	if args.hostedCP {
+		platform = cmv1.PlatformAwsHostedCp
+	}
+	_, err := r.OCMClient.VerifyNetworkSubnets(installerRoleARN, r.AWSClient.GetRegion(), subnetIDs,
+		map[string]string{}, platform)
+	if err != nil {
+		return []*Check{newCheck(name, StatusFail, "Failed to start the network verification: %v", err)}
+	}
+
+	ctx, cancel := timeout.WithDefault(networkVerificationTimeout)
+	defer cancel()
+	checks := map[string]*Check{}
+	for len(checks) < len(subnetIDs) {
+		for _, subnetID := range subnetIDs {
+			if checks[subnetID] != nil {
+				continue
+			}
+			checkName := fmt.Sprintf("%s of %s", name, subnetID)
+			status, err := r.OCMClient.GetVerifyNetworkSubnet(subnetID)
+			switch {
+			case err != nil:
+				checks[subnetID] = newCheck(checkName, StatusFail, "Failed to get the result: %v", err)
+			case status.State() == string(network.NetworkVerifyPassed):
+				checks[subnetID] = newCheck(checkName, StatusPass, "Egress is allowed")
+			case status.State() == string(network.NetworkVerifyFailed):
+				checks[subnetID] = newCheck(checkName, StatusFail, "Unable to verify egress to: %s",
+					strings.Join(status.Details(), ", "))
+			}
+		}
+		if len(checks) == len(subnetIDs) {
+			break
+		}
+		select {
+		case <-time.After(networkVerificationDelay):
+		case <-ctx.Done():
+			for _, subnetID := range subnetIDs {
+				if checks[subnetID] == nil {
+					checks[subnetID] = newCheck(fmt.Sprintf("%s of %s", name, subnetID), StatusWarn,
+						"Stopped waiting for the result. Run 'rosa verify network --watch --status-only "+
+							"--subnet-ids %s' to check it", subnetID)
+				}
+			}
+		}
+	}
+
+	result := []*Check{}
+	for _, subnetID := range subnetIDs {
+		result = append(result, checks[subnetID])
+	}
+	return result
+}
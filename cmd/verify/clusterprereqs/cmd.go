@@ -0,0 +1,204 @@
+/*
+Copyright (c) 2024 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clusterprereqs
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"text/tabwriter"
+
+	"github.com/spf13/cobra"
+	errors "github.com/zgalor/weberr"
+
+	"github.com/openshift/rosa/pkg/arguments"
+	"github.com/openshift/rosa/pkg/ocm"
+	"github.com/openshift/rosa/pkg/output"
+	"github.com/openshift/rosa/pkg/rosa"
+)
+
+const (
+	use   = "cluster-prereqs"
+	short = "Verify that an account is ready for cluster creation"
+	long  = "Run the checks that are needed before creating a cluster and print a single report with the " +
+		"result of each of them: AWS quota, SCP policies, account roles compatible with the OpenShift " +
+		"version, OIDC configuration and, when subnets are given, their availability zones, routing and " +
+		"the OCM network verification.\n\n" +
+		"Every check passes, warns or fails. The command exits with a non-zero code when any check fails."
+	example = `  # Verify the prerequisites of a classic cluster with the latest version
+  rosa verify cluster-prereqs
+
+  # Verify the prerequisites of a Hosted Control Plane cluster using account roles with prefix 'myprefix'
+  rosa verify cluster-prereqs --hosted-cp --prefix myprefix --oidc-config-id 2a1bc3 \
+    --subnet-ids subnet-0b761d44d3d9a4663,subnet-0f87f640e56934cbc
+
+  # Print the report as JSON
+  rosa verify cluster-prereqs --version 4.15.2 -o json`
+
+	hostedCPFlag                = "hosted-cp"
+	nonSTSFlag                  = "non-sts"
+	versionFlag                 = "version"
+	channelGroupFlag            = "channel-group"
+	prefixFlag                  = "prefix"
+	oidcConfigIDFlag            = "oidc-config-id"
+	subnetIDsFlag               = "subnet-ids"
+	privateFlag                 = "private"
+	skipNetworkVerificationFlag = "skip-network-verification"
+)
+
+var args struct {
+	hostedCP                bool
+	nonSTS                  bool
+	version                 string
+	channelGroup            string
+	prefix                  string
+	oidcConfigID            string
+	subnetIDs               []string
+	private                 bool
+	skipNetworkVerification bool
+}
+
+func NewVerifyClusterPrereqsCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     use,
+		Aliases: []string{"prereqs"},
+		Short:   short,
+		Long:    long,
+		Example: example,
+		Args:    cobra.NoArgs,
+		Run:     rosa.DefaultRunner(rosa.RuntimeWithOCMAndAWS(), VerifyClusterPrereqsRunner()),
+	}
+
+	flags := cmd.Flags()
+	flags.SortFlags = false
+	flags.BoolVar(
+		&args.hostedCP,
+		hostedCPFlag,
+		false,
+		"Verify the prerequisites of a cluster with a Hosted Control Plane.",
+	)
+	flags.BoolVar(
+		&args.nonSTS,
+		nonSTSFlag,
+		false,
+		"Verify the prerequisites of a cluster that uses IAM user credentials instead of STS, "+
+			"which doesn't need account roles nor an OIDC configuration.",
+	)
+	flags.StringVar(
+		&args.version,
+		versionFlag,
+		"",
+		"Version of OpenShift of the cluster, used to check the account roles. Defaults to the latest version.",
+	)
+	flags.StringVar(
+		&args.channelGroup,
+		channelGroupFlag,
+		ocm.DefaultChannelGroup,
+		"Channel group of the version.",
+	)
+	flags.MarkHidden(channelGroupFlag)
+	flags.StringVar(
+		&args.prefix,
+		prefixFlag,
+		"",
+		"Prefix of the account roles to check. By default any compatible account role is accepted.",
+	)
+	flags.StringVar(
+		&args.oidcConfigID,
+		oidcConfigIDFlag,
+		"",
+		"ID of the OIDC configuration to check. By default any OIDC configuration of the account is accepted.",
+	)
+	flags.StringSliceVar(
+		&args.subnetIDs,
+		subnetIDsFlag,
+		nil,
+		"The Subnet IDs to use when installing the cluster. "+
+			"Format should be a comma-separated list. Subnets are only checked when they are given.",
+	)
+	flags.BoolVar(
+		&args.private,
+		privateFlag,
+		false,
+		"Verify the subnets of a private cluster, which can't use public subnets.",
+	)
+	flags.BoolVar(
+		&args.skipNetworkVerification,
+		skipNetworkVerificationFlag,
+		false,
+		"Don't run the OCM network verification of the subnets, which takes a few minutes.",
+	)
+	arguments.AddProfileFlag(flags)
+	arguments.AddRegionFlag(flags)
+	output.AddFlag(cmd)
+	return cmd
+}
+
+func VerifyClusterPrereqsRunner() rosa.CommandRunner {
+	return func(_ context.Context, r *rosa.Runtime, _ *cobra.Command, _ []string) error {
+		if args.hostedCP && args.nonSTS {
+			return errors.BadRequest.Errorf("Hosted Control Plane clusters always use STS")
+		}
+		version, err := r.OCMClient.GetPolicyVersion(args.version, args.channelGroup)
+		if err != nil {
+			return fmt.Errorf("Failed to get the OpenShift version: %v", err)
+		}
+		if r.Reporter.IsTerminal() && !output.HasFlag() {
+			r.Reporter.Infof("Verifying the prerequisites of a cluster with version '%s' in region '%s'",
+				version, r.AWSClient.GetRegion())
+		}
+
+		report := newReport()
+		report.add(checkQuota(r))
+		report.add(checkSCP(r))
+		installerRoleARN := ""
+		if !args.nonSTS {
+			var checks []*Check
+			installerRoleARN, checks = checkAccountRoles(r, ocm.GetVersionMinor(version))
+			report.add(checks...)
+			report.add(checkOidcConfig(r))
+		}
+		if len(args.subnetIDs) > 0 {
+			report.add(checkSubnets(r)...)
+			if !args.skipNetworkVerification {
+				report.add(checkNetwork(r, installerRoleARN)...)
+			}
+		}
+
+		if output.HasFlag() {
+			err = output.Print(report)
+			if err != nil {
+				return err
+			}
+		} else {
+			printReport(report)
+		}
+		if report.Failures > 0 {
+			return fmt.Errorf("%d of %d checks failed", report.Failures, len(report.Checks))
+		}
+		return nil
+	}
+}
+
+func printReport(report *CheckReport) {
+	writer := tabwriter.NewWriter(os.Stdout, 0, 4, 2, ' ', 0)
+	fmt.Fprintf(writer, "CHECK\tSTATUS\tDETAILS\n")
+	for _, check := range report.Checks {
+		fmt.Fprintf(writer, "%s\t%s\t%s\n", check.Name, check.Status, check.Message)
+	}
+	writer.Flush()
+}
@@ -0,0 +1,220 @@
+package clusterprereqs
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	awssdk "github.com/aws/aws-sdk-go-v2/aws"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/ghttp"
+	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+	. "github.com/openshift-online/ocm-sdk-go/testing"
+	"github.com/spf13/cobra"
+	errors "github.com/zgalor/weberr"
+	"go.uber.org/mock/gomock"
+
+	"github.com/openshift/rosa/pkg/aws"
+	"github.com/openshift/rosa/pkg/rosa"
+	. "github.com/openshift/rosa/pkg/test"
+)
+
+func TestVerifyClusterPrereqs(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "rosa verify cluster-prereqs")
+}
+
+var _ = Describe("rosa verify cluster-prereqs", func() {
+	Context("Execute command", func() {
+		var t *TestingRuntime
+		var awsClient *aws.MockClient
+		var cmd *cobra.Command
+
+		versions := `{"kind": "VersionList", "page": 1, "size": 1, "total": 1, "items": [` +
+			`{"kind": "Version", "id": "openshift-v4.15.1", "raw_id": "4.15.1", "enabled": true}]}`
+
+		run := func() (string, error) {
+			stdout, _, err := RunWithOutputCapture(func(r *rosa.Runtime, cmd *cobra.Command) error {
+				return VerifyClusterPrereqsRunner()(context.Background(), r, cmd, nil)
+			}, t.RosaRuntime, cmd)
+			return stdout, err
+		}
+
+		BeforeEach(func() {
+			t = NewTestRuntime()
+			awsClient = aws.NewMockClient(gomock.NewController(GinkgoT()))
+			t.RosaRuntime.AWSClient = awsClient
+			cmd = NewVerifyClusterPrereqsCommand()
+			Expect(cmd.Flags().Set(nonSTSFlag, "true")).To(Succeed())
+		})
+
+		It("Rejects Hosted Control Plane clusters without STS", func() {
+			Expect(cmd.Flags().Set(hostedCPFlag, "true")).To(Succeed())
+			_, err := run()
+			Expect(err).To(MatchError("Hosted Control Plane clusters always use STS"))
+			Expect(errors.GetType(err)).To(Equal(errors.BadRequest))
+			Expect(t.ApiServer.ReceivedRequests()).To(BeEmpty())
+		})
+
+		It("Prints the report of the checks", func() {
+			t.ApiServer.AppendHandlers(
+				RespondWithJSON(http.StatusOK, versions),
+				RespondWithJSON(http.StatusOK, `{"kind": "STSPolicyList", "page": 1, "size": 0, "total": 0}`),
+			)
+			awsClient.EXPECT().ValidateQuota().Return(true, nil)
+			awsClient.EXPECT().ValidateSCP(nil, gomock.Any()).Return(false, nil)
+
+			stdout, err := run()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(stdout).To(Equal("CHECK         STATUS  DETAILS\n" +
+				"AWS quota     pass    Quota is enough for a cluster\n" +
+				"SCP policies  warn    Some of the permissions needed by clusters are denied\n"))
+		})
+
+		It("Fails when a check fails", func() {
+			t.ApiServer.AppendHandlers(
+				RespondWithJSON(http.StatusOK, versions),
+				RespondWithJSON(http.StatusOK, `{"kind": "STSPolicyList", "page": 1, "size": 0, "total": 0}`),
+			)
+			awsClient.EXPECT().ValidateQuota().Return(false, fmt.Errorf("Not enough VPCs"))
+			awsClient.EXPECT().ValidateSCP(nil, gomock.Any()).Return(true, nil)
+
+			stdout, err := run()
+			Expect(err).To(MatchError("1 of 2 checks failed"))
+			Expect(stdout).To(ContainSubstring("AWS quota     fail    Not enough VPCs"))
+		})
+	})
+
+	Context("Report", func() {
+		It("Counts the results", func() {
+			report := newReport()
+			report.add(newCheck("a", StatusPass, ""), newCheck("b", StatusFail, ""), newCheck("c", StatusWarn, ""),
+				newCheck("d", StatusPass, ""))
+			Expect(report.Checks).To(HaveLen(4))
+			Expect(report.Passed).To(Equal(2))
+			Expect(report.Warnings).To(Equal(1))
+			Expect(report.Failures).To(Equal(1))
+		})
+	})
+
+	Context("Checks", func() {
+		var t *TestingRuntime
+		var awsClient *aws.MockClient
+
+		BeforeEach(func() {
+			t = NewTestRuntime()
+			awsClient = aws.NewMockClient(gomock.NewController(GinkgoT()))
+			t.RosaRuntime.AWSClient = awsClient
+			NewVerifyClusterPrereqsCommand()
+		})
+
+		It("Fails when the quota isn't enough", func() {
+			awsClient.EXPECT().ValidateQuota().Return(false, fmt.Errorf("Not enough VPCs"))
+			Expect(checkQuota(t.RosaRuntime)).To(Equal(newCheck("AWS quota", StatusFail, "Not enough VPCs")))
+		})
+
+		It("Finds the account roles with the prefix", func() {
+			args.hostedCP = true
+			args.prefix = "team"
+			installer := "arn:aws:iam::123:role/team-HCP-ROSA-Installer-Role"
+			awsClient.EXPECT().FindRoleARNsHostedCp(aws.InstallerAccountRole, "4.15").Return([]string{
+				"arn:aws:iam::123:role/other-HCP-ROSA-Installer-Role", installer}, nil)
+			awsClient.EXPECT().FindRoleARNsHostedCp(aws.SupportAccountRole, "4.15").Return([]string{
+				"arn:aws:iam::123:role/team-HCP-ROSA-Support-Role"}, nil)
+			awsClient.EXPECT().FindRoleARNsHostedCp(aws.WorkerAccountRole, "4.15").Return([]string{
+				"arn:aws:iam::123:role/other-HCP-ROSA-Worker-Role"}, nil)
+
+			installerRoleARN, checks := checkAccountRoles(t.RosaRuntime, "4.15")
+			Expect(installerRoleARN).To(Equal(installer))
+			Expect(checks).To(HaveLen(3))
+			Expect(checks[0].Status).To(Equal(StatusPass))
+			Expect(checks[1].Status).To(Equal(StatusPass))
+			Expect(checks[2]).To(Equal(newCheck("Worker account role", StatusFail, "No role compatible with "+
+				"version '4.15' found. Run 'rosa create account-roles --hosted-cp --prefix team' to create it, "+
+				"or 'rosa upgrade account-roles' to upgrade it")))
+		})
+
+		It("Fails when Hosted Control Plane clusters have no OIDC configuration", func() {
+			args.hostedCP = true
+			t.ApiServer.AppendHandlers(RespondWithJSON(http.StatusOK,
+				`{"kind": "OidcConfigList", "page": 1, "size": 0, "total": 0, "items": []}`))
+			Expect(checkOidcConfig(t.RosaRuntime).Status).To(Equal(StatusFail))
+		})
+
+		Context("Subnets", func() {
+			BeforeEach(func() {
+				args.subnetIDs = []string{"subnet-1", "subnet-2"}
+				awsClient.EXPECT().ListSubnets("subnet-1", "subnet-2").Return([]ec2types.Subnet{
+					{SubnetId: awssdk.String("subnet-1"), AvailabilityZone: awssdk.String("us-east-1a")},
+					{SubnetId: awssdk.String("subnet-2"), AvailabilityZone: awssdk.String("us-east-1b")},
+				}, nil)
+				awsClient.EXPECT().GetAvailabilityZoneType(gomock.Any()).Return("availability-zone", nil).Times(2)
+				awsClient.EXPECT().FetchPublicSubnetMap(gomock.Any()).Return(map[string]bool{
+					"subnet-1": false,
+					"subnet-2": true,
+				}, nil)
+			})
+
+			It("Passes with private and public subnets", func() {
+				checks := checkSubnets(t.RosaRuntime)
+				Expect(checks).To(Equal([]*Check{
+					newCheck("Subnet availability zones", StatusPass, "Subnets are in availability zones "+
+						"[us-east-1a, us-east-1b]"),
+					newCheck("Subnet routing", StatusPass, "1 private and 1 public subnets"),
+				}))
+			})
+
+			It("Rejects public subnets for private clusters", func() {
+				args.private = true
+				checks := checkSubnets(t.RosaRuntime)
+				Expect(checks[1]).To(Equal(newCheck("Subnet routing", StatusFail, "Private clusters can't use "+
+					"subnets with a route to an Internet Gateway: [subnet-2]")))
+			})
+		})
+
+		It("Waits for the network verification", func() {
+			networkVerificationDelay = time.Millisecond
+			args.subnetIDs = []string{"subnet-1", "subnet-2"}
+			awsClient.EXPECT().GetRegion().Return("us-east-1")
+			t.ApiServer.AppendHandlers(
+				RespondWithJSON(http.StatusAccepted, `{"items": [{"id": "subnet-1", "state": "pending"}, `+
+					`{"id": "subnet-2", "state": "pending"}]}`),
+				RespondWithJSON(http.StatusOK, `{"id": "subnet-1", "state": "passed"}`),
+				RespondWithJSON(http.StatusOK, `{"id": "subnet-2", "state": "running"}`),
+				RespondWithJSON(http.StatusOK, `{"id": "subnet-2", "state": "failed", "details": ["quay.io:443"]}`),
+			)
+
+			checks := checkNetwork(t.RosaRuntime, "arn:aws:iam::123:role/ManagedOpenShift-Installer-Role")
+			Expect(checks).To(Equal([]*Check{
+				newCheck("Network verification of subnet-1", StatusPass, "Egress is allowed"),
+				newCheck("Network verification of subnet-2", StatusFail, "Unable to verify egress to: quay.io:443"),
+			}))
+		})
+
+		It("Verifies a subnet given twice only once", func() {
+			networkVerificationDelay = time.Millisecond
+			args.subnetIDs = []string{"subnet-1", "subnet-1"}
+			awsClient.EXPECT().GetRegion().Return("us-east-1")
+			t.ApiServer.AppendHandlers(
+				ghttp.CombineHandlers(
+					func(_ http.ResponseWriter, req *http.Request) {
+						verification, err := cmv1.UnmarshalNetworkVerification(req.Body)
+						Expect(err).ToNot(HaveOccurred())
+						Expect(verification.CloudProviderData().Subnets()).To(Equal([]string{"subnet-1"}))
+					},
+					RespondWithJSON(http.StatusAccepted, `{"items": [{"id": "subnet-1", "state": "pending"}]}`),
+				),
+				RespondWithJSON(http.StatusOK, `{"id": "subnet-1", "state": "passed"}`),
+			)
+
+			checks := checkNetwork(t.RosaRuntime, "arn:aws:iam::123:role/ManagedOpenShift-Installer-Role")
+			Expect(checks).To(Equal([]*Check{
+				newCheck("Network verification of subnet-1", StatusPass, "Egress is allowed"),
+			}))
+		})
+	})
+})
@@ -19,10 +19,13 @@ package verify
 import (
 	"github.com/spf13/cobra"
 
+	"github.com/openshift/rosa/cmd/verify/clusterprereqs"
 	"github.com/openshift/rosa/cmd/verify/network"
 	"github.com/openshift/rosa/cmd/verify/oc"
 	"github.com/openshift/rosa/cmd/verify/permissions"
+	"github.com/openshift/rosa/cmd/verify/permissionsboundary"
 	"github.com/openshift/rosa/cmd/verify/quota"
+	"github.com/openshift/rosa/cmd/verify/roles"
 	"github.com/openshift/rosa/cmd/verify/rosa"
 )
 
@@ -34,9 +37,12 @@ var Cmd = &cobra.Command{
 }
 
 func init() {
+	Cmd.AddCommand(clusterprereqs.NewVerifyClusterPrereqsCommand())
 	Cmd.AddCommand(network.Cmd)
 	Cmd.AddCommand(oc.Cmd)
 	Cmd.AddCommand(permissions.Cmd)
+	Cmd.AddCommand(permissionsboundary.NewVerifyPermissionsBoundaryCommand())
 	Cmd.AddCommand(quota.Cmd)
+	Cmd.AddCommand(roles.NewVerifyRolesCommand())
 	Cmd.AddCommand(rosa.Cmd)
 }
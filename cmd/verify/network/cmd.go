@@ -32,7 +32,9 @@ import (
 	"github.com/openshift/rosa/pkg/helper"
 	"github.com/openshift/rosa/pkg/ocm"
 	"github.com/openshift/rosa/pkg/output"
+	"github.com/openshift/rosa/pkg/reporter"
 	"github.com/openshift/rosa/pkg/rosa"
+	"github.com/openshift/rosa/pkg/timeout"
 )
 
 var args struct {
@@ -142,7 +144,7 @@ func run(cmd *cobra.Command, _ []string) {
 	err := runWithRuntime(r, cmd)
 	if err != nil {
 		r.Reporter.Errorf(err.Error())
-		os.Exit(1)
+		os.Exit(reporter.ExitCode())
 	}
 }
 
@@ -261,7 +263,14 @@ func runWithRuntime(r *rosa.Runtime, cmd *cobra.Command) error {
 			}
 
 			if len(args.subnetIDs) > 0 {
-				time.Sleep(delay)
+				err := timeout.Sleep(delay)
+				if err != nil {
+					if spin != nil {
+						spin.Stop()
+					}
+					return fmt.Errorf("Stopped waiting for the verification of subnets %s: %v",
+						strings.Join(args.subnetIDs, ", "), err)
+				}
 			}
 		}
 
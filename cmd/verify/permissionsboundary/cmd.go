@@ -0,0 +1,257 @@
+/*
+Copyright (c) 2024 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package permissionsboundary
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"sort"
+	"text/tabwriter"
+
+	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+	"github.com/spf13/cobra"
+	errors "github.com/zgalor/weberr"
+
+	"github.com/openshift/rosa/pkg/arguments"
+	"github.com/openshift/rosa/pkg/aws"
+	"github.com/openshift/rosa/pkg/output"
+	"github.com/openshift/rosa/pkg/rosa"
+)
+
+const (
+	use   = "permissions-boundary"
+	short = "Verify that a permissions boundary allows the actions required by ROSA"
+	long  = "Evaluate the document of a permissions boundary policy against each action and resource " +
+		"allowed by the policies of the account and operator roles, and list the ones that the boundary " +
+		"would block, either because it explicitly denies them or because it doesn't allow them.\n\n" +
+		"The evaluation is done locally: wildcards, 'NotAction', 'NotResource' and explicit denies are " +
+		"supported, but conditions aren't evaluated, so actions denied by statements with conditions are " +
+		"reported as conditionally denied. The command exits with a non-zero code when any action is " +
+		"blocked, but not when actions are only conditionally denied."
+	example = `  # Verify a permissions boundary for the account and operator roles of classic clusters
+  rosa verify permissions-boundary --arn arn:aws:iam::123456789012:policy/perm-boundary
+
+  # Verify a permissions boundary for the roles of Hosted Control Plane clusters
+  rosa verify permissions-boundary --arn arn:aws:iam::123456789012:policy/perm-boundary --hosted-cp`
+
+	arnFlag      = "arn"
+	hostedCPFlag = "hosted-cp"
+)
+
+var args struct {
+	arn      string
+	hostedCP bool
+}
+
+func NewVerifyPermissionsBoundaryCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     use,
+		Aliases: []string{"permissionsboundary"},
+		Short:   short,
+		Long:    long,
+		Example: example,
+		Args:    cobra.NoArgs,
+		Run:     rosa.DefaultRunner(rosa.RuntimeWithOCMAndAWS(), VerifyPermissionsBoundaryRunner()),
+	}
+
+	flags := cmd.Flags()
+	flags.SortFlags = false
+	flags.StringVar(
+		&args.arn,
+		arnFlag,
+		"",
+		"ARN of the permissions boundary policy to verify.",
+	)
+	flags.BoolVar(
+		&args.hostedCP,
+		hostedCPFlag,
+		false,
+		"Verify the boundary against the policies of the Hosted Control Plane account and operator roles.",
+	)
+	arguments.AddProfileFlag(flags)
+	output.AddFlag(cmd)
+	return cmd
+}
+
+// requiredPolicy is a policy that ROSA attaches to a role, and that the permissions boundary must allow.
+type requiredPolicy struct {
+	role string
+	key  string
+}
+
+// BlockedRequest is an action on a resource required by a role that the permissions boundary blocks.
+type BlockedRequest struct {
+	Role string `json:"role"`
+	aws.PolicyRequest
+	Decision string `json:"decision"`
+}
+
+func VerifyPermissionsBoundaryRunner() rosa.CommandRunner {
+	return func(_ context.Context, r *rosa.Runtime, _ *cobra.Command, _ []string) error {
+		if args.arn == "" {
+			return errors.BadRequest.Errorf("The '--%s' flag is required", arnFlag)
+		}
+		err := aws.ARNValidator(args.arn)
+		if err != nil {
+			return errors.BadRequest.Errorf("Invalid permissions boundary ARN '%s': %v", args.arn, err)
+		}
+
+		document, err := r.AWSClient.GetDefaultPolicyDocument(args.arn)
+		if err != nil {
+			return fmt.Errorf("Failed to get the document of policy '%s': %v", args.arn, err)
+		}
+		boundary, err := aws.ParsePolicyDocument(document)
+		if err != nil {
+			return fmt.Errorf("Failed to parse the document of policy '%s': %v", args.arn, err)
+		}
+
+		policies, err := r.OCMClient.GetPolicies("")
+		if err != nil {
+			return fmt.Errorf("Failed to get the policies of the current version: %v", err)
+		}
+		credRequests, err := r.OCMClient.GetCredRequests(args.hostedCP)
+		if err != nil {
+			return fmt.Errorf("Failed to get the operator roles: %v", err)
+		}
+
+		blocked := []*BlockedRequest{}
+		requests := 0
+		for _, required := range getRequiredPolicies(args.hostedCP, credRequests) {
+			policy, err := getRequiredPolicyDocument(r, policies, required.key, args.hostedCP)
+			if err != nil {
+				return fmt.Errorf("Failed to get the policy of role '%s': %v", required.role, err)
+			}
+			if policy == nil {
+				r.Reporter.Debugf("There is no policy '%s' for role '%s'", required.key, required.role)
+				continue
+			}
+			for _, request := range policy.GetAllowedRequests() {
+				requests++
+				decision := boundary.EvaluateRequest(request)
+				if decision != aws.PolicyDecisionAllowed {
+					blocked = append(blocked, &BlockedRequest{
+						Role:          required.role,
+						PolicyRequest: request,
+						Decision:      decision,
+					})
+				}
+			}
+		}
+
+		if output.HasFlag() {
+			err = output.Print(blocked)
+			if err != nil {
+				return err
+			}
+		} else if len(blocked) > 0 {
+			printBlocked(blocked)
+		}
+		denied := []*BlockedRequest{}
+		for _, request := range blocked {
+			if request.Decision != aws.PolicyDecisionConditionalDeny {
+				denied = append(denied, request)
+			}
+		}
+		if len(denied) > 0 {
+			return fmt.Errorf("The permissions boundary blocks %d actions required by %d roles",
+				len(denied), countRoles(denied))
+		}
+		if len(blocked) > 0 {
+			r.Reporter.Warnf("The permissions boundary denies %d actions required by %d roles under some "+
+				"conditions, check that the conditions don't apply to the roles", len(blocked), countRoles(blocked))
+		} else if !output.HasFlag() {
+			r.Reporter.Infof("The permissions boundary allows the %d actions required by the account and "+
+				"operator roles", requests)
+		}
+		return nil
+	}
+}
+
+// getRequiredPolicies returns the permission policies of the account roles and of the operator roles.
+func getRequiredPolicies(hostedCP bool, credRequests map[string]*cmv1.STSOperator) []requiredPolicy {
+	accountRoles := aws.AccountRoles
+	accountPolicyKey := "sts_%s_permission_policy"
+	if hostedCP {
+		accountRoles = aws.HCPAccountRoles
+		accountPolicyKey = "sts_hcp_%s_permission_policy"
+	}
+	required := []requiredPolicy{}
+	for _, file := range aws.GetSortedRoleFiles(accountRoles) {
+		required = append(required, requiredPolicy{
+			role: accountRoles[file].Name,
+			key:  fmt.Sprintf(accountPolicyKey, file),
+		})
+	}
+	keys := make([]string, 0, len(credRequests))
+	for key := range credRequests {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		operator := credRequests[key]
+		required = append(required, requiredPolicy{
+			role: fmt.Sprintf("%s/%s", operator.Namespace(), operator.Name()),
+			key:  aws.GetOperatorPolicyKey(key, hostedCP, false),
+		})
+	}
+	return required
+}
+
+// getRequiredPolicyDocument returns the document of a policy. Hosted Control Plane roles use policies
+// managed by AWS, so their documents are read from AWS. It returns nil when there is no such policy.
+func getRequiredPolicyDocument(r *rosa.Runtime, policies map[string]*cmv1.AWSSTSPolicy, key string,
+	hostedCP bool) (*aws.PolicyDocument, error) {
+	var document string
+	if hostedCP {
+		policy, ok := policies[key]
+		if !ok || policy.ARN() == "" {
+			return nil, nil
+		}
+		var err error
+		document, err = r.AWSClient.GetDefaultPolicyDocument(policy.ARN())
+		if err != nil {
+			return nil, err
+		}
+	} else {
+		document = aws.GetPolicyDetails(policies, key)
+		if document == "" {
+			return nil, nil
+		}
+		document = aws.InterpolatePolicyDocument(r.Creator.Partition, document, map[string]string{
+			"partition": r.Creator.Partition,
+		})
+	}
+	return aws.ParsePolicyDocument(document)
+}
+
+func printBlocked(blocked []*BlockedRequest) {
+	writer := tabwriter.NewWriter(os.Stdout, 0, 4, 2, ' ', 0)
+	fmt.Fprintf(writer, "ROLE\tACTION\tRESOURCE\tDECISION\n")
+	for _, request := range blocked {
+		fmt.Fprintf(writer, "%s\t%s\t%s\t%s\n", request.Role, request.Action, request.Resource, request.Decision)
+	}
+	writer.Flush()
+}
+
+func countRoles(blocked []*BlockedRequest) int {
+	roles := map[string]bool{}
+	for _, request := range blocked {
+		roles[request.Role] = true
+	}
+	return len(roles)
+}
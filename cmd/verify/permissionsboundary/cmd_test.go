@@ -0,0 +1,176 @@
+/*
+Copyright (c) 2024 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package permissionsboundary
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/openshift-online/ocm-sdk-go/testing"
+	"github.com/spf13/cobra"
+	errors "github.com/zgalor/weberr"
+	"go.uber.org/mock/gomock"
+
+	"github.com/openshift/rosa/pkg/aws"
+	"github.com/openshift/rosa/pkg/rosa"
+	. "github.com/openshift/rosa/pkg/test"
+)
+
+func TestVerifyPermissionsBoundary(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "rosa verify permissions-boundary")
+}
+
+const (
+	boundaryARN = "arn:aws:iam::123:policy/boundary"
+
+	installerPolicy = `{"Version": "2012-10-17", "Statement": [{"Effect": "Allow", ` +
+		`"Action": ["ec2:RunInstances", "ec2:TerminateInstances", "iam:PassRole"], "Resource": "*"}]}`
+	ingressPolicy = `{"Version": "2012-10-17", "Statement": [{"Effect": "Allow", ` +
+		`"Action": ["route53:ChangeResourceRecordSets"], "Resource": "arn:aws:route53:::hostedzone/*"}]}`
+)
+
+var _ = Describe("rosa verify permissions-boundary", func() {
+	var t *TestingRuntime
+	var awsClient *aws.MockClient
+	var cmd *cobra.Command
+
+	BeforeEach(func() {
+		cmd = NewVerifyPermissionsBoundaryCommand()
+		t = NewTestRuntime()
+		awsClient = aws.NewMockClient(gomock.NewController(GinkgoT()))
+		t.RosaRuntime.AWSClient = awsClient
+		t.RosaRuntime.Creator.Partition = "aws"
+	})
+
+	run := func() (string, error) {
+		stdout, _, err := RunWithOutputCapture(func(r *rosa.Runtime, cmd *cobra.Command) error {
+			return VerifyPermissionsBoundaryRunner()(context.Background(), r, cmd, nil)
+		}, t.RosaRuntime, cmd)
+		return stdout, err
+	}
+
+	Context("Validation", func() {
+		It("Fails without an ARN", func() {
+			_, err := run()
+			Expect(err).To(MatchError("The '--arn' flag is required"))
+		})
+
+		It("Fails with an invalid ARN", func() {
+			Expect(cmd.Flags().Set(arnFlag, "boundary")).To(Succeed())
+			_, err := run()
+			Expect(err).To(MatchError(ContainSubstring("Invalid permissions boundary ARN 'boundary'")))
+			Expect(errors.GetType(err)).To(Equal(errors.BadRequest))
+		})
+
+		It("Fails when the boundary can't be read", func() {
+			Expect(cmd.Flags().Set(arnFlag, boundaryARN)).To(Succeed())
+			awsClient.EXPECT().GetDefaultPolicyDocument(boundaryARN).Return("", fmt.Errorf("AccessDenied"))
+			_, err := run()
+			Expect(err).To(MatchError("Failed to get the document of policy '" + boundaryARN + "': AccessDenied"))
+			Expect(t.ApiServer.ReceivedRequests()).To(BeEmpty())
+		})
+
+		It("Fails when the boundary isn't a policy document", func() {
+			Expect(cmd.Flags().Set(arnFlag, boundaryARN)).To(Succeed())
+			awsClient.EXPECT().GetDefaultPolicyDocument(boundaryARN).Return("boundary", nil)
+			_, err := run()
+			Expect(err).To(MatchError(ContainSubstring("Failed to parse the document of policy '" + boundaryARN +
+				"'")))
+		})
+	})
+
+	Context("Runner", func() {
+		BeforeEach(func() {
+			Expect(cmd.Flags().Set(arnFlag, boundaryARN)).To(Succeed())
+			t.ApiServer.AppendHandlers(
+				RespondWithJSON(http.StatusOK, fmt.Sprintf(`{
+					"kind": "STSPolicyList",
+					"page": 1,
+					"size": 2,
+					"total": 2,
+					"items": [
+						{"id": "sts_installer_permission_policy", "details": %q},
+						{"id": "openshift_ingress_operator_cloud_credentials_policy", "details": %q}
+					]
+				}`, installerPolicy, ingressPolicy)),
+				RespondWithJSON(http.StatusOK, `{
+					"kind": "STSCredentialRequestList",
+					"page": 1,
+					"size": 1,
+					"total": 1,
+					"items": [{
+						"name": "ingress_operator_cloud_credentials",
+						"operator": {"namespace": "openshift-ingress-operator", "name": "cloud-credentials"}
+					}]
+				}`),
+			)
+		})
+
+		It("Lists the actions blocked by the boundary", func() {
+			awsClient.EXPECT().GetDefaultPolicyDocument(boundaryARN).Return(`{"Version": "2012-10-17",
+				"Statement": [
+					{"Effect": "Allow", "NotAction": "iam:*", "Resource": "*"},
+					{"Effect": "Deny", "Action": "ec2:Terminate*", "Resource": "*"},
+					{"Effect": "Deny", "Action": "route53:*", "NotResource": "arn:aws:route53:::hostedzone/Z1"}
+				]}`, nil)
+			stdout, err := run()
+			Expect(err).To(MatchError("The permissions boundary blocks 3 actions required by 2 roles"))
+			Expect(stdout).To(Equal("" +
+				"ROLE                                          ACTION                            " +
+				"RESOURCE                        DECISION\n" +
+				"Installer                                     ec2:TerminateInstances            " +
+				"*                               explicitly denied\n" +
+				"Installer                                     iam:PassRole                      " +
+				"*                               not allowed\n" +
+				"openshift-ingress-operator/cloud-credentials  route53:ChangeResourceRecordSets  " +
+				"arn:aws:route53:::hostedzone/*  explicitly denied\n"))
+		})
+
+		It("Warns about the actions denied with conditions", func() {
+			awsClient.EXPECT().GetDefaultPolicyDocument(boundaryARN).Return(`{"Version": "2012-10-17",
+				"Statement": [
+					{"Effect": "Allow", "Action": ["ec2:*", "iam:PassRole", "route53:*"], "Resource": "*"},
+					{"Effect": "Deny", "Action": "ec2:RunInstances", "Resource": "*",
+						"Condition": {"StringNotEquals": {"aws:RequestedRegion": "us-east-1"}}}
+				]}`, nil)
+			stdout, stderr, err := RunWithOutputCapture(func(r *rosa.Runtime, cmd *cobra.Command) error {
+				return VerifyPermissionsBoundaryRunner()(context.Background(), r, cmd, nil)
+			}, t.RosaRuntime, cmd)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(stdout).To(Equal("" +
+				"ROLE       ACTION            RESOURCE  DECISION\n" +
+				"Installer  ec2:RunInstances  *         conditionally denied\n"))
+			Expect(stderr).To(Equal("WARN: The permissions boundary denies 1 actions required by 1 roles " +
+				"under some conditions, check that the conditions don't apply to the roles\n"))
+		})
+
+		It("Succeeds when the boundary allows all the actions", func() {
+			awsClient.EXPECT().GetDefaultPolicyDocument(boundaryARN).Return(`{"Version": "2012-10-17",
+				"Statement": [{"Effect": "Allow", "Action": ["ec2:*", "iam:PassRole", "route53:*"], "Resource": "*"}]
+			}`, nil)
+			stdout, err := run()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(stdout).To(Equal("INFO: The permissions boundary allows the 4 actions required by the " +
+				"account and operator roles\n"))
+		})
+	})
+})
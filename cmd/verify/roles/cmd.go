@@ -0,0 +1,372 @@
+/*
+Copyright (c) 2024 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package roles
+
+import (
+	"context"
+	"fmt"
+	"net/url"
+	"os"
+	"sort"
+	"text/tabwriter"
+
+	awssdk "github.com/aws/aws-sdk-go-v2/aws"
+	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+	"github.com/spf13/cobra"
+	errors "github.com/zgalor/weberr"
+
+	"github.com/openshift/rosa/pkg/arguments"
+	"github.com/openshift/rosa/pkg/aws"
+	"github.com/openshift/rosa/pkg/ocm"
+	"github.com/openshift/rosa/pkg/output"
+	"github.com/openshift/rosa/pkg/rosa"
+)
+
+const (
+	use   = "roles"
+	short = "Verify that the IAM roles match the policies expected by ROSA"
+	long  = "Compare the trust policy and the attached permission policies of the account and operator " +
+		"roles of a cluster, or of the account roles with a prefix, statement by statement with the " +
+		"policies of the current version, and report the actions, principals and conditions that were " +
+		"added or removed, for example by editing the roles in the AWS console.\n\n" +
+		"Policies managed by AWS aren't compared. The command exits with a non-zero code when any " +
+		"difference is found."
+	example = `  # Verify the account and operator roles of cluster 'mycluster'
+  rosa verify roles --cluster mycluster
+
+  # Verify the Hosted Control Plane account roles with prefix 'myprefix'
+  rosa verify roles --prefix myprefix --hosted-cp`
+
+	prefixFlag   = "prefix"
+	hostedCPFlag = "hosted-cp"
+
+	trustPolicy = "trust policy"
+)
+
+var args struct {
+	prefix   string
+	hostedCP bool
+}
+
+func NewVerifyRolesCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     use,
+		Short:   short,
+		Long:    long,
+		Example: example,
+		Args:    cobra.NoArgs,
+		Run:     rosa.DefaultRunner(rosa.RuntimeWithOCMAndAWS(), VerifyRolesRunner()),
+	}
+
+	flags := cmd.Flags()
+	flags.SortFlags = false
+	ocm.AddOptionalClusterFlag(cmd)
+	flags.StringVar(
+		&args.prefix,
+		prefixFlag,
+		"",
+		"Prefix of the account roles to verify, instead of the roles of a cluster.",
+	)
+	flags.BoolVar(
+		&args.hostedCP,
+		hostedCPFlag,
+		false,
+		"Verify the Hosted Control Plane account roles with the prefix.",
+	)
+	arguments.AddProfileFlag(flags)
+	output.AddFlag(cmd)
+	return cmd
+}
+
+// expectedRole is a role with the documents of the policies that ROSA expects it to have. The
+// permission policy is empty when the role uses policies managed by AWS.
+type expectedRole struct {
+	name             string
+	arn              string
+	trustPolicy      string
+	permissionPolicy string
+	// policyName is the name of the permission policy that ROSA created for the role. Other policies
+	// attached to the role aren't compared.
+	policyName string
+}
+
+// RoleDrift is a difference between a policy of a role and the policy expected by ROSA.
+type RoleDrift struct {
+	Role   string `json:"role"`
+	Policy string `json:"policy"`
+	aws.PolicyDrift
+}
+
+func VerifyRolesRunner() rosa.CommandRunner {
+	return func(_ context.Context, r *rosa.Runtime, cmd *cobra.Command, _ []string) error {
+		isClusterSet := cmd.Flags().Changed("cluster")
+		if isClusterSet == (args.prefix != "") {
+			return errors.BadRequest.Errorf("Either '--cluster' or '--%s' must be specified", prefixFlag)
+		}
+		if isClusterSet && args.hostedCP {
+			return errors.BadRequest.Errorf("The '--%s' flag can only be used with '--%s'", hostedCPFlag,
+				prefixFlag)
+		}
+
+		policies, err := r.OCMClient.GetPolicies("")
+		if err != nil {
+			return fmt.Errorf("Failed to get the expected policies: %v", err)
+		}
+		env, err := ocm.GetEnv()
+		if err != nil {
+			return fmt.Errorf("Failed to determine the OCM environment: %v", err)
+		}
+
+		var roles []*expectedRole
+		if isClusterSet {
+			cluster := r.FetchCluster()
+			roles, err = getClusterRoles(r, cluster, policies, env)
+		} else {
+			roles, err = getPrefixRoles(r, args.prefix, args.hostedCP, policies, env)
+		}
+		if err != nil {
+			return err
+		}
+
+		drift := []*RoleDrift{}
+		for _, role := range roles {
+			r.Reporter.Debugf("Verifying role '%s'", role.arn)
+			roleDrift, err := verifyRole(r, role)
+			if err != nil {
+				return fmt.Errorf("Failed to verify role '%s': %v", role.name, err)
+			}
+			drift = append(drift, roleDrift...)
+		}
+
+		if output.HasFlag() {
+			err = output.Print(drift)
+			if err != nil {
+				return err
+			}
+		} else if len(drift) > 0 {
+			printDrift(drift)
+		}
+		if len(drift) > 0 {
+			return fmt.Errorf("Found %d differences with the expected policies in %d roles",
+				len(drift), countRoles(drift))
+		}
+		if !output.HasFlag() {
+			r.Reporter.Infof("The policies of the %d roles match the expected policies", len(roles))
+		}
+		return nil
+	}
+}
+
+// getPrefixRoles returns the account roles with the prefix.
+func getPrefixRoles(r *rosa.Runtime, prefix string, hostedCP bool, policies map[string]*cmv1.AWSSTSPolicy,
+	env string) ([]*expectedRole, error) {
+	accountRoles := aws.AccountRoles
+	if hostedCP {
+		accountRoles = aws.HCPAccountRoles
+	}
+	roles := []*expectedRole{}
+	for _, file := range aws.GetSortedRoleFiles(accountRoles) {
+		roleARN, err := r.AWSClient.GetAccountRoleARN(prefix, accountRoles[file].Name)
+		if err != nil {
+			return nil, err
+		}
+		role, err := newAccountRole(r, file, roleARN, !hostedCP, policies, env)
+		if err != nil {
+			return nil, err
+		}
+		roles = append(roles, role)
+	}
+	return roles, nil
+}
+
+// getClusterRoles returns the account and operator roles used by the cluster.
+func getClusterRoles(r *rosa.Runtime, cluster *cmv1.Cluster, policies map[string]*cmv1.AWSSTSPolicy,
+	env string) ([]*expectedRole, error) {
+	if cluster.AWS().STS().RoleARN() == "" {
+		return nil, errors.BadRequest.Errorf("Cluster '%s' doesn't use STS roles", r.ClusterKey)
+	}
+	isHostedCP := aws.IsHostedCP(cluster)
+
+	roles := []*expectedRole{}
+	roleARNs := aws.GetAccountRolesArnsMap(cluster)
+	for _, file := range aws.GetSortedRoleFiles(aws.AccountRoles) {
+		roleARN := roleARNs[aws.AccountRoles[file].Name]
+		if roleARN == "" {
+			continue
+		}
+		role, err := newAccountRole(r, file, roleARN, !isHostedCP, policies, env)
+		if err != nil {
+			return nil, err
+		}
+		roles = append(roles, role)
+	}
+
+	credRequests, err := r.OCMClient.GetCredRequests(isHostedCP)
+	if err != nil {
+		return nil, fmt.Errorf("Failed to get the operator roles of cluster '%s': %v", r.ClusterKey, err)
+	}
+	sharedVpcRoleARN := cluster.AWS().PrivateHostedZoneRoleARN()
+	keys := make([]string, 0, len(credRequests))
+	for key := range credRequests {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	for _, key := range keys {
+		operator := credRequests[key]
+		roleARN := aws.FindOperatorRoleBySTSOperator(cluster.AWS().STS().OperatorIAMRoles(), operator)
+		if roleARN == "" {
+			continue
+		}
+		role, err := newExpectedRole(roleARN)
+		if err != nil {
+			return nil, err
+		}
+		role.trustPolicy, err = aws.GenerateOperatorRolePolicyDoc(r.Creator.Partition, cluster,
+			r.Creator.AccountID, operator, aws.GetPolicyDetails(policies, "operator_iam_role_policy"))
+		if err != nil {
+			return nil, err
+		}
+		if !cluster.AWS().STS().ManagedPolicies() {
+			role.policyName = aws.GetOperatorPolicyName(cluster.AWS().STS().OperatorRolePrefix(),
+				operator.Namespace(), operator.Name())
+			policyKey := aws.GetOperatorPolicyKey(key, isHostedCP, sharedVpcRoleARN != "")
+			role.permissionPolicy = aws.InterpolatePolicyDocument(r.Creator.Partition,
+				aws.GetPolicyDetails(policies, policyKey), map[string]string{
+					"shared_vpc_role_arn": sharedVpcRoleARN,
+				})
+		}
+		roles = append(roles, role)
+	}
+	return roles, nil
+}
+
+func newExpectedRole(roleARN string) (*expectedRole, error) {
+	name, err := aws.GetResourceIdFromARN(roleARN)
+	if err != nil {
+		return nil, err
+	}
+	return &expectedRole{name: name, arn: roleARN}, nil
+}
+
+func newAccountRole(r *rosa.Runtime, file string, roleARN string, withPermissionPolicy bool,
+	policies map[string]*cmv1.AWSSTSPolicy, env string) (*expectedRole, error) {
+	role, err := newExpectedRole(roleARN)
+	if err != nil {
+		return nil, err
+	}
+	role.trustPolicy = aws.InterpolatePolicyDocument(r.Creator.Partition,
+		aws.GetPolicyDetails(policies, fmt.Sprintf("sts_%s_trust_policy", file)), map[string]string{
+			"partition":      r.Creator.Partition,
+			"aws_account_id": aws.GetJumpAccount(env),
+		})
+	if withPermissionPolicy {
+		role.permissionPolicy = aws.GetPolicyDetails(policies, fmt.Sprintf("sts_%s_permission_policy", file))
+		role.policyName = aws.GetPolicyName(role.name)
+	}
+	return role, nil
+}
+
+// verifyRole compares the trust policy and the permission policy that ROSA attached to the role with the
+// expected ones. Other policies attached to the role aren't compared.
+func verifyRole(r *rosa.Runtime, role *expectedRole) ([]*RoleDrift, error) {
+	iamRole, err := r.AWSClient.GetRoleByARN(role.arn)
+	if err != nil {
+		return nil, err
+	}
+	document, err := url.QueryUnescape(awssdk.ToString(iamRole.AssumeRolePolicyDocument))
+	if err != nil {
+		return nil, err
+	}
+	drift, err := comparePolicy(role.name, trustPolicy, role.trustPolicy, document)
+	if err != nil {
+		return nil, err
+	}
+	if role.permissionPolicy == "" {
+		return drift, nil
+	}
+
+	attachedPolicies, err := r.AWSClient.GetAttachedPolicy(iamRole.RoleName)
+	if err != nil {
+		return nil, err
+	}
+	policyFound := false
+	usesAWSManagedPolicies := false
+	for _, policy := range attachedPolicies {
+		if policy.PolicyType != aws.Attached {
+			continue
+		}
+		if policy.PolicyName != role.policyName {
+			// The role can use the policies managed by AWS instead of the ones created by ROSA
+			usesAWSManagedPolicies = usesAWSManagedPolicies || aws.IsAWSManagedPolicy(policy.PolicyArn)
+			r.Reporter.Debugf("Skipping policy '%s' of role '%s', it wasn't created by ROSA",
+				policy.PolicyName, role.name)
+			continue
+		}
+		document, err = r.AWSClient.GetDefaultPolicyDocument(policy.PolicyArn)
+		if err != nil {
+			return nil, err
+		}
+		policyDrift, err := comparePolicy(role.name, policy.PolicyName, role.permissionPolicy, document)
+		if err != nil {
+			return nil, err
+		}
+		drift = append(drift, policyDrift...)
+		policyFound = true
+	}
+	if !policyFound && !usesAWSManagedPolicies {
+		drift = append(drift, &RoleDrift{
+			Role:   role.name,
+			Policy: role.policyName,
+			PolicyDrift: aws.PolicyDrift{
+				Element: "Policy",
+				Change:  aws.PolicyDriftRemoved,
+				Value:   "The permission policy isn't attached",
+			},
+		})
+	}
+	return drift, nil
+}
+
+func comparePolicy(roleName string, policyName string, expected string, actual string) ([]*RoleDrift, error) {
+	policyDrift, err := aws.ComparePolicyDocuments(expected, actual)
+	if err != nil {
+		return nil, fmt.Errorf("Failed to compare policy '%s' of role '%s': %v", policyName, roleName, err)
+	}
+	drift := []*RoleDrift{}
+	for _, item := range policyDrift {
+		drift = append(drift, &RoleDrift{Role: roleName, Policy: policyName, PolicyDrift: item})
+	}
+	return drift, nil
+}
+
+func printDrift(drift []*RoleDrift) {
+	writer := tabwriter.NewWriter(os.Stdout, 0, 4, 2, ' ', 0)
+	fmt.Fprintf(writer, "ROLE\tPOLICY\tSTATEMENT\tCHANGE\tELEMENT\tVALUE\n")
+	for _, item := range drift {
+		fmt.Fprintf(writer, "%s\t%s\t%s\t%s\t%s\t%s\n", item.Role, item.Policy, item.Statement, item.Change,
+			item.Element, item.Value)
+	}
+	writer.Flush()
+}
+
+func countRoles(drift []*RoleDrift) int {
+	roles := map[string]bool{}
+	for _, item := range drift {
+		roles[item.Role] = true
+	}
+	return len(roles)
+}
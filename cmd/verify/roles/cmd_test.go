@@ -0,0 +1,273 @@
+/*
+Copyright (c) 2024 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package roles
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+
+	awssdk "github.com/aws/aws-sdk-go-v2/aws"
+	iamtypes "github.com/aws/aws-sdk-go-v2/service/iam/types"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+	. "github.com/openshift-online/ocm-sdk-go/testing"
+	"github.com/spf13/cobra"
+	errors "github.com/zgalor/weberr"
+	"go.uber.org/mock/gomock"
+
+	"github.com/openshift/rosa/pkg/aws"
+	"github.com/openshift/rosa/pkg/config"
+	"github.com/openshift/rosa/pkg/rosa"
+	. "github.com/openshift/rosa/pkg/test"
+)
+
+func TestVerifyRoles(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "rosa verify roles")
+}
+
+const (
+	trustPolicyTemplate = `{"Version": "2012-10-17", "Statement": [{"Effect": "Allow", ` +
+		`"Principal": {"AWS": ["arn:aws:iam::%{aws_account_id}:role/RH-Managed-OpenShift-Installer"]}, ` +
+		`"Action": ["sts:AssumeRole"]}]}`
+	trustPolicyDocument = `{"Version": "2012-10-17", "Statement": [{"Effect": "Allow", ` +
+		`"Principal": {"AWS": "arn:aws:iam::710019948333:role/RH-Managed-OpenShift-Installer"}, ` +
+		`"Action": "sts:AssumeRole"}]}`
+	permissionPolicy = `{"Version": "2012-10-17", "Statement": [{"Effect": "Allow", ` +
+		`"Action": ["ec2:DescribeInstances"], "Resource": "*"}]}`
+	editedPermissionPolicy = `{"Version": "2012-10-17", "Statement": [{"Effect": "Allow", ` +
+		`"Action": ["ec2:DescribeInstances", "iam:*"], "Resource": "*"}]}`
+)
+
+var _ = Describe("rosa verify roles", func() {
+	var t *TestingRuntime
+	var awsClient *aws.MockClient
+	var cmd *cobra.Command
+
+	BeforeEach(func() {
+		cmd = NewVerifyRolesCommand()
+		t = NewTestRuntime()
+		awsClient = aws.NewMockClient(gomock.NewController(GinkgoT()))
+		t.RosaRuntime.AWSClient = awsClient
+		t.RosaRuntime.Creator.Partition = "aws"
+	})
+
+	Context("Runner", func() {
+		run := func() error {
+			return VerifyRolesRunner()(context.Background(), t.RosaRuntime, cmd, nil)
+		}
+
+		It("Fails without a cluster or a prefix", func() {
+			Expect(run()).To(MatchError("Either '--cluster' or '--prefix' must be specified"))
+		})
+
+		It("Fails with a cluster and a prefix", func() {
+			Expect(cmd.Flags().Set("cluster", "mycluster")).To(Succeed())
+			Expect(cmd.Flags().Set(prefixFlag, "team")).To(Succeed())
+			Expect(run()).To(MatchError("Either '--cluster' or '--prefix' must be specified"))
+		})
+
+		It("Fails with a cluster and the hosted CP flag", func() {
+			Expect(cmd.Flags().Set("cluster", "mycluster")).To(Succeed())
+			Expect(cmd.Flags().Set(hostedCPFlag, "true")).To(Succeed())
+			err := run()
+			Expect(err).To(MatchError("The '--hosted-cp' flag can only be used with '--prefix'"))
+			Expect(errors.GetType(err)).To(Equal(errors.BadRequest))
+			Expect(t.ApiServer.ReceivedRequests()).To(BeEmpty())
+		})
+
+		It("Fails when the expected policies can't be loaded", func() {
+			Expect(cmd.Flags().Set(prefixFlag, "team")).To(Succeed())
+			t.ApiServer.AppendHandlers(RespondWithJSON(http.StatusBadRequest,
+				`{"kind": "Error", "reason": "Invalid search"}`))
+			Expect(run()).To(MatchError(ContainSubstring("Failed to get the expected policies: ")))
+		})
+
+		Context("With the account roles of a prefix", func() {
+			// The test runtime connects to a local OCM environment, which uses this jump account
+			const localTrustPolicy = `{"Version": "2012-10-17", "Statement": [{"Effect": "Allow", ` +
+				`"Principal": {"AWS": "arn:aws:iam::765374464689:role/RH-Managed-OpenShift-Installer"}, ` +
+				`"Action": "sts:AssumeRole"}]}`
+
+			BeforeEach(func() {
+				GinkgoT().Setenv("OCM_CONFIG", GinkgoT().TempDir()+"/ocm.json")
+				Expect(config.Save(&config.Config{URL: "http://localhost:8000"})).To(Succeed())
+
+				Expect(cmd.Flags().Set(prefixFlag, "team")).To(Succeed())
+				Expect(cmd.Flags().Set(hostedCPFlag, "true")).To(Succeed())
+				policies := []*cmv1.AWSSTSPolicy{}
+				for _, file := range []string{"installer", "support", "instance_worker"} {
+					policy, err := cmv1.NewAWSSTSPolicy().ID("sts_" + file + "_trust_policy").
+						Details(trustPolicyTemplate).Build()
+					Expect(err).NotTo(HaveOccurred())
+					policies = append(policies, policy)
+				}
+				var buffer bytes.Buffer
+				Expect(cmv1.MarshalAWSSTSPolicyList(policies, &buffer)).To(Succeed())
+				t.ApiServer.AppendHandlers(RespondWithJSON(http.StatusOK, fmt.Sprintf(
+					`{"kind": "STSPolicyList", "page": 1, "size": 3, "total": 3, "items": %s}`, buffer.String())))
+
+				for _, name := range []string{"Installer", "Support", "Worker"} {
+					awsClient.EXPECT().GetAccountRoleARN("team", "HCP-ROSA-"+name).Return(
+						"arn:aws:iam::123:role/team-HCP-ROSA-"+name+"-Role", nil)
+				}
+			})
+
+			expectRoles := func(workerTrustPolicy string) {
+				for _, name := range []string{"Installer", "Support", "Worker"} {
+					document := localTrustPolicy
+					if name == "Worker" {
+						document = workerTrustPolicy
+					}
+					awsClient.EXPECT().GetRoleByARN("arn:aws:iam::123:role/team-HCP-ROSA-"+name+"-Role").Return(
+						iamtypes.Role{
+							RoleName:                 awssdk.String("team-HCP-ROSA-" + name + "-Role"),
+							AssumeRolePolicyDocument: awssdk.String(document),
+						}, nil)
+				}
+			}
+
+			It("Reports that the policies match", func() {
+				expectRoles(localTrustPolicy)
+
+				stdout, _, err := RunWithOutputCapture(func(r *rosa.Runtime, cmd *cobra.Command) error {
+					return VerifyRolesRunner()(context.Background(), r, cmd, nil)
+				}, t.RosaRuntime, cmd)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(stdout).To(Equal("INFO: The policies of the 3 roles match the expected policies\n"))
+			})
+
+			It("Prints the drift and fails", func() {
+				expectRoles(trustPolicyDocument)
+
+				stdout, _, err := RunWithOutputCapture(func(r *rosa.Runtime, cmd *cobra.Command) error {
+					return VerifyRolesRunner()(context.Background(), r, cmd, nil)
+				}, t.RosaRuntime, cmd)
+				Expect(err).To(MatchError("Found 2 differences with the expected policies in 1 roles"))
+				Expect(stdout).To(ContainSubstring("team-HCP-ROSA-Worker-Role  trust policy"))
+			})
+		})
+	})
+
+	Context("Roles", func() {
+		policies := map[string]*cmv1.AWSSTSPolicy{}
+
+		BeforeEach(func() {
+			for key, details := range map[string]string{
+				"sts_installer_trust_policy":      trustPolicyTemplate,
+				"sts_installer_permission_policy": permissionPolicy,
+			} {
+				policy, err := cmv1.NewAWSSTSPolicy().ID(key).Details(details).Build()
+				Expect(err).NotTo(HaveOccurred())
+				policies[key] = policy
+			}
+		})
+
+		It("Finds the account roles with the prefix", func() {
+			awsClient.EXPECT().GetAccountRoleARN("team", "HCP-ROSA-Installer").Return(
+				"arn:aws:iam::123:role/team-HCP-ROSA-Installer-Role", nil)
+			awsClient.EXPECT().GetAccountRoleARN("team", "HCP-ROSA-Support").Return(
+				"arn:aws:iam::123:role/team-HCP-ROSA-Support-Role", nil)
+			awsClient.EXPECT().GetAccountRoleARN("team", "HCP-ROSA-Worker").Return(
+				"arn:aws:iam::123:role/team-HCP-ROSA-Worker-Role", nil)
+
+			roles, err := getPrefixRoles(t.RosaRuntime, "team", true, policies, "production")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(roles).To(HaveLen(3))
+			Expect(roles[0].name).To(Equal("team-HCP-ROSA-Installer-Role"))
+			Expect(roles[0].trustPolicy).To(ContainSubstring("arn:aws:iam::710019948333:role/"))
+			// Hosted Control Plane account roles use the policies managed by AWS
+			Expect(roles[0].permissionPolicy).To(BeEmpty())
+		})
+
+		verify := func(attachedPolicy string, document string) ([]*RoleDrift, error) {
+			role, err := newAccountRole(t.RosaRuntime, aws.InstallerAccountRole,
+				"arn:aws:iam::123:role/team-Installer-Role", true, policies, "production")
+			Expect(err).NotTo(HaveOccurred())
+			roleName := awssdk.String("team-Installer-Role")
+			awsClient.EXPECT().GetRoleByARN(role.arn).Return(iamtypes.Role{
+				RoleName:                 roleName,
+				AssumeRolePolicyDocument: awssdk.String(trustPolicyDocument),
+			}, nil)
+			policyName, err := aws.GetResourceIdFromARN(attachedPolicy)
+			Expect(err).NotTo(HaveOccurred())
+			awsClient.EXPECT().GetAttachedPolicy(roleName).Return([]aws.PolicyDetail{
+				{PolicyName: "inline", PolicyType: aws.Inline},
+				// Policies attached by the user aren't compared
+				{PolicyName: "custom", PolicyArn: "arn:aws:iam::123:policy/custom", PolicyType: aws.Attached},
+				{PolicyName: policyName, PolicyArn: attachedPolicy, PolicyType: aws.Attached},
+			}, nil)
+			if document != "" {
+				awsClient.EXPECT().GetDefaultPolicyDocument(attachedPolicy).Return(document, nil)
+			}
+			return verifyRole(t.RosaRuntime, role)
+		}
+
+		It("Finds no drift in a role with the expected policies", func() {
+			drift, err := verify("arn:aws:iam::123:policy/team-Installer-Role-Policy", permissionPolicy)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(drift).To(BeEmpty())
+		})
+
+		It("Reports the actions added to the permission policy", func() {
+			drift, err := verify("arn:aws:iam::123:policy/team-Installer-Role-Policy", editedPermissionPolicy)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(drift).To(Equal([]*RoleDrift{{
+				Role:        "team-Installer-Role",
+				Policy:      "team-Installer-Role-Policy",
+				PolicyDrift: aws.PolicyDrift{Statement: "#1", Element: "Action", Change: "added", Value: "iam:*"},
+			}}))
+		})
+
+		It("Reports a missing permission policy", func() {
+			drift, err := verify("arn:aws:iam::123:policy/other-Installer-Role-Policy", "")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(drift).To(Equal([]*RoleDrift{{
+				Role:   "team-Installer-Role",
+				Policy: "team-Installer-Role-Policy",
+				PolicyDrift: aws.PolicyDrift{Element: "Policy", Change: "removed",
+					Value: "The permission policy isn't attached"},
+			}}))
+		})
+
+		It("Doesn't compare policies managed by AWS", func() {
+			drift, err := verify("arn:aws:iam::aws:policy/service-role/ROSAInstallerPolicy", "")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(drift).To(BeEmpty())
+		})
+
+		It("Prints the drift", func() {
+			stdout, _, err := RunWithOutputCapture(func(_ *rosa.Runtime, _ *cobra.Command) error {
+				printDrift([]*RoleDrift{{
+					Role:   "team-Installer-Role",
+					Policy: trustPolicy,
+					PolicyDrift: aws.PolicyDrift{Statement: "#1", Element: "Principal", Change: "added",
+						Value: "AWS:arn:aws:iam::456:root"},
+				}})
+				return nil
+			}, t.RosaRuntime, cmd)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(stdout).To(Equal("" +
+				"ROLE                 POLICY        STATEMENT  CHANGE  ELEMENT    VALUE\n" +
+				"team-Installer-Role  trust policy  #1         added   Principal  AWS:arn:aws:iam::456:root\n"))
+		})
+	})
+})
@@ -55,12 +55,12 @@ func run(_ *cobra.Command, _ []string) {
 	currVersion, err := version.NewVersion(info.Version)
 	if err != nil {
 		rprtr.Errorf("There was a problem retrieving current version: %s", err)
-		os.Exit(1)
+		os.Exit(reporter.ExitCode())
 	}
 	latestVersionFromMirror, err := retrieveLatestVersionFromMirror()
 	if err != nil {
 		rprtr.Errorf("There was a problem retrieving latest version from mirror: %s", err)
-		os.Exit(1)
+		os.Exit(reporter.ExitCode())
 	}
 	if currVersion.LessThan(latestVersionFromMirror) {
 		rprtr.Infof(
@@ -0,0 +1,168 @@
+/*
+Copyright (c) 2024 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cluster
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+	"github.com/spf13/cobra"
+	errors "github.com/zgalor/weberr"
+
+	"github.com/openshift/rosa/pkg/ocm"
+	"github.com/openshift/rosa/pkg/rosa"
+)
+
+const (
+	use   = "cluster"
+	short = "Wait for a cluster to reach a state"
+	long  = "Wait for a cluster to reach a state, printing its state whenever it changes.\n\n" +
+		"The command exits with code 0 when the cluster reaches the state, 4 when the cluster doesn't " +
+		"exist, 7 when the timeout expires and 1 when the cluster reaches a state from which the " +
+		"requested one can't be reached, like 'error' when waiting for 'ready'. Unless '--timeout' is " +
+		"set, it stops waiting after 2 hours."
+	example = `  # Wait for cluster 'mycluster' to be ready
+  rosa wait cluster --cluster=mycluster --for state=ready
+
+  # Wait at most 30 minutes for cluster 'mycluster' to be uninstalled
+  rosa wait cluster --cluster=mycluster --for state=uninstalled --timeout 30m`
+
+	forFlag          = "for"
+	intervalFlag     = "interval"
+	statePrefix      = "state="
+	uninstalledState = "uninstalled"
+)
+
+var conditions = []string{
+	statePrefix + string(cmv1.ClusterStateReady),
+	statePrefix + uninstalledState,
+	statePrefix + string(cmv1.ClusterStateHibernating),
+}
+
+var args struct {
+	condition string
+	interval  time.Duration
+}
+
+func NewWaitClusterCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     use,
+		Short:   short,
+		Long:    long,
+		Example: example,
+		Args:    cobra.NoArgs,
+		Run:     rosa.DefaultRunner(rosa.RuntimeWithOCM(), WaitClusterRunner()),
+	}
+
+	flags := cmd.Flags()
+	ocm.AddClusterFlag(cmd)
+	flags.StringVar(
+		&args.condition,
+		forFlag,
+		"",
+		fmt.Sprintf("Condition to wait for. Allowed values are %s.", strings.Join(conditions, ", ")),
+	)
+	cmd.MarkFlagRequired(forFlag)
+	cmd.RegisterFlagCompletionFunc(forFlag, conditionCompletion)
+	flags.DurationVar(
+		&args.interval,
+		intervalFlag,
+		15*time.Second,
+		"Time between checks of the state of the cluster.",
+	)
+	return cmd
+}
+
+func conditionCompletion(_ *cobra.Command, _ []string, _ string) ([]string, cobra.ShellCompDirective) {
+	return conditions, cobra.ShellCompDirectiveDefault
+}
+
+func WaitClusterRunner() rosa.CommandRunner {
+	return func(_ context.Context, r *rosa.Runtime, _ *cobra.Command, _ []string) error {
+		state, err := parseCondition(args.condition)
+		if err != nil {
+			return err
+		}
+		if args.interval <= 0 {
+			return errors.BadRequest.Errorf("Interval must be greater than zero")
+		}
+
+		clusterKey := r.GetClusterKey()
+		cluster, err := r.OCMClient.GetCluster(clusterKey, r.Creator)
+		if err != nil {
+			if state == uninstalledState && errors.GetType(err) == errors.NotFound {
+				r.Reporter.Infof("Cluster '%s' is uninstalled", clusterKey)
+				return nil
+			}
+			return err
+		}
+
+		var current string
+		var failure error
+		err = r.OCMClient.PollCluster(cluster.ID(), args.interval, func(cluster *cmv1.Cluster) bool {
+			previous := current
+			current = uninstalledState
+			if cluster != nil {
+				current = string(cluster.State())
+			}
+			if current != previous && current != state {
+				r.Reporter.Infof("Cluster '%s' is %s", clusterKey, current)
+			}
+			if current == state {
+				return true
+			}
+			failure = checkState(clusterKey, state, current)
+			return failure != nil
+		})
+		if err != nil {
+			return err
+		}
+		if failure != nil {
+			return failure
+		}
+		r.Reporter.Infof("Cluster '%s' is %s", clusterKey, state)
+		return nil
+	}
+}
+
+// parseCondition returns the state of a condition like 'state=ready'.
+func parseCondition(condition string) (string, error) {
+	for _, allowed := range conditions {
+		if condition == allowed {
+			return strings.TrimPrefix(condition, statePrefix), nil
+		}
+	}
+	return "", errors.BadRequest.Errorf("Invalid condition '%s'. Allowed values are %s",
+		condition, strings.Join(conditions, ", "))
+}
+
+// checkState returns an error if the requested state can't be reached from the current one.
+func checkState(clusterKey string, state string, current string) error {
+	if state == uninstalledState {
+		return nil
+	}
+	switch current {
+	case uninstalledState:
+		return errors.NotFound.Errorf("Cluster '%s' has been uninstalled", clusterKey)
+	case string(cmv1.ClusterStateError), string(cmv1.ClusterStateUninstalling):
+		return fmt.Errorf("Cluster '%s' is %s, it will not become %s", clusterKey, current, state)
+	}
+	return nil
+}
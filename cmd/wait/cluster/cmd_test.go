@@ -0,0 +1,126 @@
+package cluster
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+	. "github.com/openshift-online/ocm-sdk-go/testing"
+	"github.com/spf13/cobra"
+	errors "github.com/zgalor/weberr"
+
+	"github.com/openshift/rosa/pkg/rosa"
+	. "github.com/openshift/rosa/pkg/test"
+)
+
+func TestWaitCluster(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "rosa wait cluster")
+}
+
+var _ = Describe("rosa wait cluster", func() {
+	Context("Execute command", func() {
+		var t *TestingRuntime
+
+		mockCluster := func(state cmv1.ClusterState) *cmv1.Cluster {
+			return MockCluster(func(c *cmv1.ClusterBuilder) {
+				c.State(state)
+			})
+		}
+
+		BeforeEach(func() {
+			t = NewTestRuntime()
+			args.interval = 10 * time.Millisecond
+		})
+
+		It("Rejects unknown conditions", func() {
+			args.condition = "state=installing"
+			err := WaitClusterRunner()(context.Background(), t.RosaRuntime, nil, nil)
+			Expect(err).To(MatchError("Invalid condition 'state=installing'. Allowed values are " +
+				"state=ready, state=uninstalled, state=hibernating"))
+			Expect(errors.GetType(err)).To(Equal(errors.BadRequest))
+		})
+
+		It("Rejects a zero interval", func() {
+			args.condition = "state=ready"
+			args.interval = 0
+			err := WaitClusterRunner()(context.Background(), t.RosaRuntime, nil, nil)
+			Expect(err).To(MatchError("Interval must be greater than zero"))
+			Expect(errors.GetType(err)).To(Equal(errors.BadRequest))
+			Expect(t.ApiServer.ReceivedRequests()).To(BeEmpty())
+		})
+
+		It("Waits until the cluster is ready", func() {
+			args.condition = "state=ready"
+			cluster := mockCluster(cmv1.ClusterStateInstalling)
+			t.SetCluster(cluster.Name(), cluster)
+			t.ApiServer.AppendHandlers(
+				RespondWithJSON(http.StatusOK, FormatClusterList([]*cmv1.Cluster{cluster})),
+				RespondWithJSON(http.StatusOK, FormatResource(mockCluster(cmv1.ClusterStateInstalling))),
+				RespondWithJSON(http.StatusInternalServerError, "{}"),
+				RespondWithJSON(http.StatusOK, FormatResource(mockCluster(cmv1.ClusterStateReady))),
+			)
+
+			stdout, _, err := RunWithOutputCapture(func(r *rosa.Runtime, cmd *cobra.Command) error {
+				return WaitClusterRunner()(context.Background(), r, cmd, nil)
+			}, t.RosaRuntime, nil)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(t.ApiServer.ReceivedRequests()).To(HaveLen(4))
+			Expect(stdout).To(Equal("INFO: Cluster 'cluster' is installing\nINFO: Cluster 'cluster' is ready\n"))
+		})
+
+		It("Fails when the cluster can't become ready", func() {
+			args.condition = "state=ready"
+			cluster := mockCluster(cmv1.ClusterStateInstalling)
+			t.SetCluster(cluster.Name(), cluster)
+			t.ApiServer.AppendHandlers(
+				RespondWithJSON(http.StatusOK, FormatClusterList([]*cmv1.Cluster{cluster})),
+				RespondWithJSON(http.StatusOK, FormatResource(mockCluster(cmv1.ClusterStateError))),
+			)
+
+			err := WaitClusterRunner()(context.Background(), t.RosaRuntime, nil, nil)
+			Expect(err).To(MatchError("Cluster 'cluster' is error, it will not become ready"))
+		})
+
+		It("Waits until the cluster is uninstalled", func() {
+			args.condition = "state=uninstalled"
+			cluster := mockCluster(cmv1.ClusterStateUninstalling)
+			t.SetCluster(cluster.Name(), cluster)
+			t.ApiServer.AppendHandlers(
+				RespondWithJSON(http.StatusOK, FormatClusterList([]*cmv1.Cluster{cluster})),
+				RespondWithJSON(http.StatusOK, FormatResource(cluster)),
+				RespondWithJSON(http.StatusNotFound, "{}"),
+			)
+
+			err := WaitClusterRunner()(context.Background(), t.RosaRuntime, nil, nil)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(t.ApiServer.ReceivedRequests()).To(HaveLen(3))
+		})
+
+		It("Succeeds when waiting for a cluster that doesn't exist to be uninstalled", func() {
+			args.condition = "state=uninstalled"
+			t.SetCluster("cluster", nil)
+			t.ApiServer.AppendHandlers(
+				RespondWithJSON(http.StatusOK, FormatClusterList([]*cmv1.Cluster{})),
+			)
+
+			err := WaitClusterRunner()(context.Background(), t.RosaRuntime, nil, nil)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("Reports clusters that don't exist as not found", func() {
+			args.condition = "state=hibernating"
+			t.SetCluster("cluster", nil)
+			t.ApiServer.AppendHandlers(
+				RespondWithJSON(http.StatusOK, FormatClusterList([]*cmv1.Cluster{})),
+			)
+
+			err := WaitClusterRunner()(context.Background(), t.RosaRuntime, nil, nil)
+			Expect(errors.GetType(err)).To(Equal(errors.NotFound))
+		})
+	})
+})
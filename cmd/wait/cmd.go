@@ -0,0 +1,49 @@
+/*
+Copyright (c) 2024 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package wait
+
+import (
+	"github.com/spf13/cobra"
+
+	"github.com/openshift/rosa/cmd/wait/cluster"
+	"github.com/openshift/rosa/cmd/wait/machinepool"
+	"github.com/openshift/rosa/cmd/wait/upgrade"
+	"github.com/openshift/rosa/pkg/arguments"
+)
+
+var Cmd = &cobra.Command{
+	Use:   "wait",
+	Short: "Wait for a condition on a cluster or its resources",
+	Long: "Wait for a condition on a cluster or its resources, like a cluster becoming ready. The exit code " +
+		"tells whether the condition was met, so that scripts don't need to poll 'rosa describe'.",
+	Example: `  # Wait for cluster 'mycluster' to be ready
+  rosa wait cluster --cluster=mycluster --for state=ready
+
+  # Wait for the upgrade of cluster 'mycluster' to complete
+  rosa wait upgrade --cluster=mycluster --for completed`,
+	Args: cobra.NoArgs,
+}
+
+func init() {
+	Cmd.AddCommand(cluster.NewWaitClusterCommand())
+	Cmd.AddCommand(machinepool.NewWaitMachinePoolCommand())
+	Cmd.AddCommand(upgrade.NewWaitUpgradeCommand())
+
+	flags := Cmd.PersistentFlags()
+	arguments.AddProfileFlag(flags)
+	arguments.AddRegionFlag(flags)
+}
@@ -0,0 +1,149 @@
+/*
+Copyright (c) 2024 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package machinepool
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+	"github.com/spf13/cobra"
+	errors "github.com/zgalor/weberr"
+
+	"github.com/openshift/rosa/pkg/ocm"
+	"github.com/openshift/rosa/pkg/rosa"
+)
+
+const (
+	use   = "machinepool"
+	short = "Wait for a machine pool to reach a condition"
+	long  = "Wait for a machine pool to reach a condition, printing its replicas whenever they change.\n\n" +
+		"The 'replicas-ready' condition is met when the current replicas of the machine pool match the " +
+		"desired replicas, or are within the limits when autoscaling is enabled. It is only supported on " +
+		"Hosted Control Plane clusters, as the replicas of classic machine pools aren't reported.\n\n" +
+		"The command exits with code 0 when the condition is met, 4 when the cluster or the machine pool " +
+		"doesn't exist and 7 when the timeout expires. Unless '--timeout' is set, it stops waiting after " +
+		"2 hours."
+	example = `  # Wait for the replicas of machine pool 'mp-1' of cluster 'mycluster' to be ready
+  rosa wait machinepool --cluster=mycluster --machinepool=mp-1 --for replicas-ready`
+
+	forFlag         = "for"
+	intervalFlag    = "interval"
+	machinePoolFlag = "machinepool"
+	replicasReady   = "replicas-ready"
+)
+
+var args struct {
+	machinePool string
+	condition   string
+	interval    time.Duration
+}
+
+func NewWaitMachinePoolCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     use,
+		Aliases: []string{"machine-pool"},
+		Short:   short,
+		Long:    long,
+		Example: example,
+		Args:    cobra.MaximumNArgs(1),
+		Run:     rosa.DefaultRunner(rosa.RuntimeWithOCM(), WaitMachinePoolRunner()),
+	}
+
+	flags := cmd.Flags()
+	ocm.AddClusterFlag(cmd)
+	flags.StringVar(
+		&args.machinePool,
+		machinePoolFlag,
+		"",
+		"Machine pool of the cluster to target",
+	)
+	flags.StringVar(
+		&args.condition,
+		forFlag,
+		"",
+		fmt.Sprintf("Condition to wait for. The only allowed value is %s.", replicasReady),
+	)
+	cmd.MarkFlagRequired(forFlag)
+	cmd.RegisterFlagCompletionFunc(forFlag, conditionCompletion)
+	flags.DurationVar(
+		&args.interval,
+		intervalFlag,
+		15*time.Second,
+		"Time between checks of the replicas of the machine pool.",
+	)
+	return cmd
+}
+
+func conditionCompletion(_ *cobra.Command, _ []string, _ string) ([]string, cobra.ShellCompDirective) {
+	return []string{replicasReady}, cobra.ShellCompDirectiveDefault
+}
+
+func WaitMachinePoolRunner() rosa.CommandRunner {
+	return func(_ context.Context, r *rosa.Runtime, cmd *cobra.Command, argv []string) error {
+		machinePoolID := args.machinePool
+		// Allow the use also directly the machine pool id as positional parameter
+		if len(argv) == 1 && !cmd.Flag(machinePoolFlag).Changed {
+			machinePoolID = argv[0]
+		}
+		if machinePoolID == "" {
+			return errors.BadRequest.Errorf("You need to specify a machine pool name")
+		}
+		if args.condition != replicasReady {
+			return errors.BadRequest.Errorf("Invalid condition '%s'. The only allowed value is %s",
+				args.condition, replicasReady)
+		}
+		if args.interval <= 0 {
+			return errors.BadRequest.Errorf("Interval must be greater than zero")
+		}
+
+		clusterKey := r.GetClusterKey()
+		cluster, err := r.OCMClient.GetCluster(clusterKey, r.Creator)
+		if err != nil {
+			return err
+		}
+		if !cluster.Hypershift().Enabled() {
+			return errors.BadRequest.Errorf("Waiting for the replicas of machine pools is only supported on " +
+				"Hosted Control Plane clusters")
+		}
+
+		var progress string
+		var deleted bool
+		err = r.OCMClient.PollNodePool(cluster.ID(), machinePoolID, args.interval, func(nodePool *cmv1.NodePool) bool {
+			if nodePool == nil {
+				deleted = true
+				return true
+			}
+			ready, current := ocm.NodePoolReplicasStatus(nodePool)
+			if current != progress && !ready {
+				r.Reporter.Infof("Machine pool '%s' has %s", machinePoolID, current)
+			}
+			progress = current
+			return ready
+		})
+		if err != nil {
+			return err
+		}
+		if deleted {
+			return errors.NotFound.Errorf("Machine pool '%s' does not exist on cluster '%s'",
+				machinePoolID, clusterKey)
+		}
+		r.Reporter.Infof("Machine pool '%s' has %s", machinePoolID, progress)
+		return nil
+	}
+}
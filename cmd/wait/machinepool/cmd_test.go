@@ -0,0 +1,140 @@
+package machinepool
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/ghttp"
+	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+	. "github.com/openshift-online/ocm-sdk-go/testing"
+	"github.com/spf13/cobra"
+	errors "github.com/zgalor/weberr"
+
+	"github.com/openshift/rosa/pkg/ocm"
+	"github.com/openshift/rosa/pkg/rosa"
+	. "github.com/openshift/rosa/pkg/test"
+)
+
+func TestWaitMachinePool(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "rosa wait machinepool")
+}
+
+var _ = Describe("rosa wait machinepool", func() {
+	Context("Execute command", func() {
+		var t *TestingRuntime
+		var cmd *cobra.Command
+
+		mockNodePool := func(current int, modifyFn func(*cmv1.NodePoolBuilder)) *cmv1.NodePool {
+			builder := cmv1.NewNodePool().ID("mp-1").Replicas(3).
+				Status(cmv1.NewNodePoolStatus().CurrentReplicas(current))
+			if modifyFn != nil {
+				modifyFn(builder)
+			}
+			nodePool, err := builder.Build()
+			Expect(err).NotTo(HaveOccurred())
+			return nodePool
+		}
+
+		hostedCluster := MockCluster(func(c *cmv1.ClusterBuilder) {
+			c.Hypershift(cmv1.NewHypershift().Enabled(true))
+		})
+
+		BeforeEach(func() {
+			t = NewTestRuntime()
+			cmd = NewWaitMachinePoolCommand()
+			Expect(cmd.Flags().Set(machinePoolFlag, "mp-1")).To(Succeed())
+			Expect(cmd.Flags().Set(forFlag, replicasReady)).To(Succeed())
+			Expect(cmd.Flags().Set(intervalFlag, "10ms")).To(Succeed())
+		})
+
+		DescribeTable("Rejects invalid flags before calling OCM",
+			func(flag string, value string, expectedError string) {
+				Expect(cmd.Flags().Set(flag, value)).To(Succeed())
+
+				err := WaitMachinePoolRunner()(context.Background(), t.RosaRuntime, cmd, nil)
+				Expect(err).To(MatchError(expectedError))
+				Expect(errors.GetType(err)).To(Equal(errors.BadRequest))
+				Expect(t.ApiServer.ReceivedRequests()).To(BeEmpty())
+			},
+			Entry("Missing machine pool", machinePoolFlag, "", "You need to specify a machine pool name"),
+			Entry("Unknown condition", forFlag, "ready", "Invalid condition 'ready'. The only allowed value is "+
+				"replicas-ready"),
+			Entry("Zero interval", intervalFlag, "0s", "Interval must be greater than zero"),
+		)
+
+		It("Takes the machine pool from the argument when the flag isn't set", func() {
+			cmd = NewWaitMachinePoolCommand()
+			Expect(cmd.Flags().Set(forFlag, replicasReady)).To(Succeed())
+			Expect(cmd.Flags().Set(intervalFlag, "10ms")).To(Succeed())
+			t.SetCluster(hostedCluster.Name(), hostedCluster)
+			t.ApiServer.AppendHandlers(
+				RespondWithJSON(http.StatusOK, FormatClusterList([]*cmv1.Cluster{hostedCluster})),
+				ghttp.CombineHandlers(
+					ghttp.VerifyRequest(http.MethodGet, MockClusterHREF+"/node_pools/mp-2"),
+					RespondWithJSON(http.StatusOK, FormatResource(mockNodePool(3, nil))),
+				),
+			)
+
+			err := WaitMachinePoolRunner()(context.Background(), t.RosaRuntime, cmd, []string{"mp-2"})
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("Waits until the replicas are ready", func() {
+			t.SetCluster(hostedCluster.Name(), hostedCluster)
+			t.ApiServer.AppendHandlers(
+				RespondWithJSON(http.StatusOK, FormatClusterList([]*cmv1.Cluster{hostedCluster})),
+				RespondWithJSON(http.StatusOK, FormatResource(mockNodePool(1, nil))),
+				RespondWithJSON(http.StatusOK, FormatResource(mockNodePool(1, nil))),
+				RespondWithJSON(http.StatusOK, FormatResource(mockNodePool(3, nil))),
+			)
+
+			stdout, _, err := RunWithOutputCapture(func(r *rosa.Runtime, cmd *cobra.Command) error {
+				return WaitMachinePoolRunner()(context.Background(), r, cmd, nil)
+			}, t.RosaRuntime, cmd)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(t.ApiServer.ReceivedRequests()).To(HaveLen(4))
+			Expect(stdout).To(Equal("INFO: Machine pool 'mp-1' has 1/3 replicas\n" +
+				"INFO: Machine pool 'mp-1' has 3/3 replicas\n"))
+		})
+
+		It("Reports machine pools that are deleted while waiting as not found", func() {
+			t.SetCluster(hostedCluster.Name(), hostedCluster)
+			t.ApiServer.AppendHandlers(
+				RespondWithJSON(http.StatusOK, FormatClusterList([]*cmv1.Cluster{hostedCluster})),
+				RespondWithJSON(http.StatusNotFound, "{}"),
+			)
+
+			err := WaitMachinePoolRunner()(context.Background(), t.RosaRuntime, cmd, nil)
+			Expect(err).To(MatchError("Machine pool 'mp-1' does not exist on cluster 'cluster'"))
+			Expect(errors.GetType(err)).To(Equal(errors.NotFound))
+		})
+
+		It("Rejects classic clusters", func() {
+			cluster := MockCluster(nil)
+			t.SetCluster(cluster.Name(), cluster)
+			t.ApiServer.AppendHandlers(
+				RespondWithJSON(http.StatusOK, FormatClusterList([]*cmv1.Cluster{cluster})),
+			)
+
+			err := WaitMachinePoolRunner()(context.Background(), t.RosaRuntime, cmd, nil)
+			Expect(err).To(MatchError(ContainSubstring("only supported on Hosted Control Plane clusters")))
+		})
+	})
+
+	Context("Replicas", func() {
+		It("Compares the current replicas with the autoscaling limits", func() {
+			nodePool, err := cmv1.NewNodePool().
+				Autoscaling(cmv1.NewNodePoolAutoscaling().MinReplica(2).MaxReplica(4)).
+				Status(cmv1.NewNodePoolStatus().CurrentReplicas(1)).
+				Build()
+			Expect(err).NotTo(HaveOccurred())
+			ready, description := ocm.NodePoolReplicasStatus(nodePool)
+			Expect(ready).To(BeFalse())
+			Expect(description).To(Equal("1 replicas, autoscaling between 2 and 4"))
+		})
+	})
+})
@@ -0,0 +1,161 @@
+/*
+Copyright (c) 2024 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package upgrade
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+	"github.com/spf13/cobra"
+	errors "github.com/zgalor/weberr"
+
+	"github.com/openshift/rosa/pkg/ocm"
+	"github.com/openshift/rosa/pkg/rosa"
+)
+
+const (
+	use   = "upgrade"
+	short = "Wait for the upgrade of a cluster to complete"
+	long  = "Wait for the scheduled upgrade of a cluster, or of the control plane of a Hosted Control " +
+		"Plane cluster, to complete, printing its state whenever it changes.\n\n" +
+		"The command exits with code 0 when the upgrade completes or when there is no scheduled upgrade, " +
+		"1 when the upgrade fails or is cancelled, 4 when the cluster doesn't exist and 7 when the timeout " +
+		"expires. Unless '--timeout' is set, it stops waiting after 2 hours."
+	example = `  # Wait for the upgrade of cluster 'mycluster' to complete
+  rosa wait upgrade --cluster=mycluster --for completed`
+
+	forFlag      = "for"
+	intervalFlag = "interval"
+	completed    = "completed"
+)
+
+var args struct {
+	condition string
+	interval  time.Duration
+}
+
+func NewWaitUpgradeCommand() *cobra.Command {
+	cmd := &cobra.Command{
+		Use:     use,
+		Short:   short,
+		Long:    long,
+		Example: example,
+		Args:    cobra.NoArgs,
+		Run:     rosa.DefaultRunner(rosa.RuntimeWithOCM(), WaitUpgradeRunner()),
+	}
+
+	flags := cmd.Flags()
+	ocm.AddClusterFlag(cmd)
+	flags.StringVar(
+		&args.condition,
+		forFlag,
+		"",
+		fmt.Sprintf("Condition to wait for. The only allowed value is %s.", completed),
+	)
+	cmd.MarkFlagRequired(forFlag)
+	cmd.RegisterFlagCompletionFunc(forFlag, conditionCompletion)
+	flags.DurationVar(
+		&args.interval,
+		intervalFlag,
+		15*time.Second,
+		"Time between checks of the state of the upgrade.",
+	)
+	return cmd
+}
+
+func conditionCompletion(_ *cobra.Command, _ []string, _ string) ([]string, cobra.ShellCompDirective) {
+	return []string{completed}, cobra.ShellCompDirectiveDefault
+}
+
+func WaitUpgradeRunner() rosa.CommandRunner {
+	return func(_ context.Context, r *rosa.Runtime, _ *cobra.Command, _ []string) error {
+		if args.condition != completed {
+			return errors.BadRequest.Errorf("Invalid condition '%s'. The only allowed value is %s",
+				args.condition, completed)
+		}
+		if args.interval <= 0 {
+			return errors.BadRequest.Errorf("Interval must be greater than zero")
+		}
+
+		clusterKey := r.GetClusterKey()
+		cluster, err := r.OCMClient.GetCluster(clusterKey, r.Creator)
+		if err != nil {
+			return err
+		}
+
+		var policyID, version string
+		if cluster.Hypershift().Enabled() {
+			policy, err := r.OCMClient.GetControlPlaneScheduledUpgrade(cluster.ID())
+			if err != nil {
+				return fmt.Errorf("Failed to get scheduled upgrades for cluster '%s': %v", clusterKey, err)
+			}
+			if policy != nil {
+				policyID, version = policy.ID(), policy.Version()
+			}
+		} else {
+			policy, _, err := r.OCMClient.GetScheduledUpgrade(cluster.ID())
+			if err != nil {
+				return fmt.Errorf("Failed to get scheduled upgrades for cluster '%s': %v", clusterKey, err)
+			}
+			if policy != nil {
+				policyID, version = policy.ID(), policy.Version()
+			}
+		}
+		if policyID == "" {
+			r.Reporter.Infof("There is no scheduled upgrade for cluster '%s'", clusterKey)
+			return nil
+		}
+
+		var current cmv1.UpgradePolicyStateValue
+		progress := func(state *cmv1.UpgradePolicyState) bool {
+			previous := current
+			current = cmv1.UpgradePolicyStateValueCompleted
+			// Policies are removed once the upgrade completes
+			if state != nil {
+				current = state.Value()
+			}
+			if current != previous && current != cmv1.UpgradePolicyStateValueCompleted {
+				r.Reporter.Infof("Upgrade of cluster '%s' to version '%s' is %s", clusterKey, version, current)
+			}
+			return current == cmv1.UpgradePolicyStateValueCompleted ||
+				current == cmv1.UpgradePolicyStateValueFailed ||
+				current == cmv1.UpgradePolicyStateValueCancelled
+		}
+		if cluster.Hypershift().Enabled() {
+			err = r.OCMClient.PollControlPlaneUpgradePolicy(cluster.ID(), policyID, args.interval,
+				func(policy *cmv1.ControlPlaneUpgradePolicy) bool {
+					if policy == nil {
+						return progress(nil)
+					}
+					return progress(policy.State())
+				})
+		} else {
+			err = r.OCMClient.PollUpgradePolicyState(cluster.ID(), policyID, args.interval, progress)
+		}
+		if err != nil {
+			return err
+		}
+		if current != cmv1.UpgradePolicyStateValueCompleted {
+			return fmt.Errorf("Upgrade of cluster '%s' to version '%s' is %s. "+
+				"Run 'rosa describe upgrade --cluster %s' for details", clusterKey, version, current, clusterKey)
+		}
+		r.Reporter.Infof("Upgrade of cluster '%s' to version '%s' is completed", clusterKey, version)
+		return nil
+	}
+}
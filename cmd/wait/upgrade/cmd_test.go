@@ -0,0 +1,110 @@
+package upgrade
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"net/http"
+	"testing"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+	. "github.com/openshift-online/ocm-sdk-go/testing"
+	"github.com/spf13/cobra"
+	errors "github.com/zgalor/weberr"
+
+	"github.com/openshift/rosa/pkg/rosa"
+	. "github.com/openshift/rosa/pkg/test"
+)
+
+func TestWaitUpgrade(t *testing.T) {
+	RegisterFailHandler(Fail)
+	RunSpecs(t, "rosa wait upgrade")
+}
+
+var _ = Describe("rosa wait upgrade", func() {
+	Context("Execute command", func() {
+		var t *TestingRuntime
+
+		upgradePolicies := func() string {
+			policy, err := cmv1.NewUpgradePolicy().ID("policy-1").Version("4.15.1").
+				UpgradeType(cmv1.UpgradeTypeOSD).Build()
+			Expect(err).NotTo(HaveOccurred())
+			var buffer bytes.Buffer
+			Expect(cmv1.MarshalUpgradePolicyList([]*cmv1.UpgradePolicy{policy}, &buffer)).To(Succeed())
+			return fmt.Sprintf(`{"kind": "UpgradePolicyList", "page": 1, "size": 1, "total": 1, "items": %s}`,
+				buffer.String())
+		}
+
+		upgradeState := func(value cmv1.UpgradePolicyStateValue) string {
+			state, err := cmv1.NewUpgradePolicyState().Value(value).Build()
+			Expect(err).NotTo(HaveOccurred())
+			return FormatResource(state)
+		}
+
+		BeforeEach(func() {
+			t = NewTestRuntime()
+			args.condition = completed
+			args.interval = 10 * time.Millisecond
+			cluster := MockCluster(nil)
+			t.SetCluster(cluster.Name(), cluster)
+			t.ApiServer.AppendHandlers(
+				RespondWithJSON(http.StatusOK, FormatClusterList([]*cmv1.Cluster{cluster})),
+			)
+		})
+
+		DescribeTable("Rejects invalid flags before getting the upgrades",
+			func(condition string, interval time.Duration, expectedError string) {
+				args.condition = condition
+				args.interval = interval
+				err := WaitUpgradeRunner()(context.Background(), t.RosaRuntime, nil, nil)
+				Expect(err).To(MatchError(expectedError))
+				Expect(errors.GetType(err)).To(Equal(errors.BadRequest))
+				Expect(t.ApiServer.ReceivedRequests()).To(BeEmpty())
+			},
+			Entry("Unknown condition", "started", time.Second,
+				"Invalid condition 'started'. The only allowed value is completed"),
+			Entry("Zero interval", completed, time.Duration(0), "Interval must be greater than zero"),
+		)
+
+		It("Succeeds when there is no scheduled upgrade", func() {
+			t.ApiServer.AppendHandlers(
+				RespondWithJSON(http.StatusOK, `{"kind": "UpgradePolicyList", "page": 1, "size": 0, "total": 0}`),
+			)
+
+			err := WaitUpgradeRunner()(context.Background(), t.RosaRuntime, nil, nil)
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		It("Waits until the upgrade policy is removed", func() {
+			t.ApiServer.AppendHandlers(
+				RespondWithJSON(http.StatusOK, upgradePolicies()),
+				RespondWithJSON(http.StatusOK, upgradeState(cmv1.UpgradePolicyStateValueScheduled)),
+				RespondWithJSON(http.StatusOK, upgradeState(cmv1.UpgradePolicyStateValueStarted)),
+				RespondWithJSON(http.StatusNotFound, "{}"),
+			)
+
+			stdout, _, err := RunWithOutputCapture(func(r *rosa.Runtime, cmd *cobra.Command) error {
+				return WaitUpgradeRunner()(context.Background(), r, cmd, nil)
+			}, t.RosaRuntime, nil)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(t.ApiServer.ReceivedRequests()).To(HaveLen(5))
+			Expect(stdout).To(Equal("INFO: Upgrade of cluster 'cluster' to version '4.15.1' is started\n" +
+				"INFO: Upgrade of cluster 'cluster' to version '4.15.1' is completed\n"))
+		})
+
+		It("Fails when the upgrade fails", func() {
+			t.ApiServer.AppendHandlers(
+				RespondWithJSON(http.StatusOK, upgradePolicies()),
+				RespondWithJSON(http.StatusOK, upgradeState(cmv1.UpgradePolicyStateValueStarted)),
+				RespondWithJSON(http.StatusOK, upgradeState(cmv1.UpgradePolicyStateValueFailed)),
+			)
+
+			err := WaitUpgradeRunner()(context.Background(), t.RosaRuntime, nil, nil)
+			Expect(err).To(MatchError("Upgrade of cluster 'cluster' to version '4.15.1' is failed. " +
+				"Run 'rosa describe upgrade --cluster cluster' for details"))
+		})
+	})
+})
@@ -30,6 +30,7 @@ import (
 	"github.com/openshift/rosa/pkg/object"
 	"github.com/openshift/rosa/pkg/ocm"
 	"github.com/openshift/rosa/pkg/output"
+	"github.com/openshift/rosa/pkg/reporter"
 	"github.com/openshift/rosa/pkg/rosa"
 )
 
@@ -57,14 +58,14 @@ func run(_ *cobra.Command, _ []string) {
 	awsRegion, err := aws.GetRegion("")
 	if err != nil {
 		r.Reporter.Errorf("Error getting AWS region: %v", err)
-		os.Exit(1)
+		os.Exit(reporter.ExitCode())
 	}
 
 	// Load the configuration file:
 	cfg, err := config.Load()
 	if err != nil {
 		r.Reporter.Errorf("Failed to load config file: %v", err)
-		os.Exit(1)
+		os.Exit(reporter.ExitCode())
 	}
 	if cfg == nil {
 		r.Reporter.Errorf("User is not logged in to OCM")
@@ -75,7 +76,7 @@ func run(_ *cobra.Command, _ []string) {
 	loggedIn, err := cfg.Armed()
 	if err != nil {
 		r.Reporter.Errorf("Failed to verify configuration: %v", err)
-		os.Exit(1)
+		os.Exit(reporter.ExitCode())
 	}
 	if !loggedIn {
 		r.Reporter.Errorf("User is not logged in to OCM")
@@ -89,7 +90,7 @@ func run(_ *cobra.Command, _ []string) {
 		Build()
 	if err != nil {
 		r.Reporter.Errorf("Failed to create OCM connection: %v", err)
-		os.Exit(1)
+		os.Exit(reporter.ExitCode())
 	}
 	defer r.Cleanup()
 
@@ -97,14 +98,14 @@ func run(_ *cobra.Command, _ []string) {
 	account, err := r.OCMClient.GetCurrentAccount()
 	if err != nil {
 		r.Reporter.Errorf("Failed to get current account: %s", err)
-		os.Exit(1)
+		os.Exit(reporter.ExitCode())
 	}
 
 	if account == nil {
 		account, err = getAccountDataFromToken(cfg)
 		if err != nil {
 			r.Reporter.Errorf("Failed to get account data from token: %v", err)
-			os.Exit(1)
+			os.Exit(reporter.ExitCode())
 		}
 	}
 	outputObject := object.Object{
@@ -127,7 +128,7 @@ func run(_ *cobra.Command, _ []string) {
 		err = output.Print(outputObject)
 		if err != nil {
 			r.Reporter.Errorf("%s", err)
-			os.Exit(1)
+			os.Exit(reporter.ExitCode())
 		}
 		return
 	}
@@ -0,0 +1,21 @@
+package aws_test
+
+import (
+	"github.com/aws/aws-sdk-go-v2/service/pricing"
+	. "github.com/onsi/ginkgo/v2"
+
+	client "github.com/openshift/rosa/pkg/aws/api_interface"
+	m "github.com/openshift/rosa/pkg/aws/mocks"
+)
+
+var _ = Describe("PricingApiClient", func() {
+	It("is implemented by AWS SDK Pricing Client", func() {
+		awsPricingClient := &pricing.Client{}
+		var _ client.PricingApiClient = awsPricingClient
+	})
+
+	It("is implemented by MockPricingApiClient", func() {
+		mockPricingApiClient := &m.MockPricingApiClient{}
+		var _ client.PricingApiClient = mockPricingApiClient
+	})
+})
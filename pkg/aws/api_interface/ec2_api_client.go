@@ -39,6 +39,10 @@ type Ec2ApiClient interface {
 	DescribeInstanceTypeOfferings(ctx context.Context,
 		params *ec2.DescribeInstanceTypeOfferingsInput, optFns ...func(*ec2.Options),
 	) (*ec2.DescribeInstanceTypeOfferingsOutput, error)
+
+	DescribeSpotPriceHistory(ctx context.Context,
+		params *ec2.DescribeSpotPriceHistoryInput, optFns ...func(*ec2.Options),
+	) (*ec2.DescribeSpotPriceHistoryOutput, error)
 }
 
 // interface guard to ensure that all methods defined in the Ec2ApiClient
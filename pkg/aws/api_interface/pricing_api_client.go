@@ -0,0 +1,26 @@
+package aws
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/pricing"
+)
+
+// PricingApiClient is an interface that defines the methods that we want to use
+// from the Client type in the AWS SDK ("github.com/aws/aws-sdk-go-v2/service/pricing")
+// The aim is to only contain methods that are defined in the AWS SDK's Pricing
+// Client.
+// For the cases where logic is desired to be implemened combining Pricing calls
+// and other logic use the pkg/aws.Client type.
+// If you need to use a method provided by the AWS SDK's Pricing Client but it
+// is not defined in this interface then it has to be added and all
+// the types implementing this interface have to implement the new method.
+// The reason this interface has been defined is so we can perform unit testing
+// on methods that make use of the AWS Pricing service.
+//
+
+type PricingApiClient interface {
+	GetProducts(ctx context.Context,
+		params *pricing.GetProductsInput, optFns ...func(*pricing.Options),
+	) (*pricing.GetProductsOutput, error)
+}
@@ -17,7 +17,6 @@ limitations under the License.
 package aws
 
 import (
-	"context"
 	"errors"
 	"fmt"
 	"io"
@@ -37,6 +36,7 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/iam"
 	iamtypes "github.com/aws/aws-sdk-go-v2/service/iam/types"
 	"github.com/aws/aws-sdk-go-v2/service/organizations"
+	"github.com/aws/aws-sdk-go-v2/service/pricing"
 	"github.com/aws/aws-sdk-go-v2/service/s3"
 	s3types "github.com/aws/aws-sdk-go-v2/service/s3/types"
 	"github.com/aws/aws-sdk-go-v2/service/secretsmanager"
@@ -58,7 +58,8 @@ import (
 	"github.com/openshift/rosa/pkg/helper"
 	"github.com/openshift/rosa/pkg/info"
 	"github.com/openshift/rosa/pkg/logging"
-	"github.com/openshift/rosa/pkg/reporter"
+	rprtr "github.com/openshift/rosa/pkg/reporter"
+	"github.com/openshift/rosa/pkg/timeout"
 )
 
 var (
@@ -127,6 +128,7 @@ type Client interface {
 	AttachRolePolicy(roleName string, policyARN string) error
 	CreateOpenIDConnectProvider(issuerURL string, thumbprint string, clusterID string) (string, error)
 	DeleteOpenIDConnectProvider(providerURL string) error
+	GetOpenIDConnectProviderCreateDate(oidcProviderARN string) (time.Time, error)
 	HasOpenIDConnectProvider(issuerURL string, partition string, accountID string) (bool, error)
 	FindRoleARNs(roleType string, version string) ([]string, error)
 	FindRoleARNsClassic(roleType string, version string) ([]string, error)
@@ -188,6 +190,8 @@ type Client interface {
 	GetRoleARNPath(prefix string) (string, error)
 	DescribeAvailabilityZones() ([]string, error)
 	IsLocalAvailabilityZone(availabilityZoneName string) (bool, error)
+	GetSpotPrices(instanceTypes []string, availabilityZones []string, since time.Time) (map[string]*SpotPrice, error)
+	GetOnDemandPrices(region string, instanceTypes []string) (map[string]float64, error)
 	DetachRolePolicies(roleName string) error
 	HasManagedPolicies(roleARN string) (bool, error)
 	HasHostedCPPolicies(roleARN string) (bool, error)
@@ -232,17 +236,18 @@ type awsClient struct {
 	stsClient           client.StsApiClient
 	cfClient            client.CloudFormationApiClient
 	serviceQuotasClient client.ServiceQuotasApiClient
+	pricingClient       client.PricingApiClient
 	awsAccessKeys       *AccessKey
 	useLocalCredentials bool
 }
 
-func CreateNewClientOrExit(logger *logrus.Logger, reporter *reporter.Object) Client {
+func CreateNewClientOrExit(logger *logrus.Logger, reporter *rprtr.Object) Client {
 	awsClient, err := NewClient().
 		Logger(logger).
 		Build()
 	if err != nil {
 		reporter.Errorf("Failed to create AWS client: %v", err)
-		os.Exit(1)
+		os.Exit(rprtr.ExitCode())
 	}
 
 	return awsClient
@@ -264,6 +269,7 @@ func New(
 	stsClient client.StsApiClient,
 	cfClient client.CloudFormationApiClient,
 	serviceQuotasClient client.ServiceQuotasApiClient,
+	pricingClient client.PricingApiClient,
 	awsAccessKeys *AccessKey,
 	useLocalCredentials bool,
 
@@ -279,6 +285,7 @@ func New(
 		stsClient,
 		cfClient,
 		serviceQuotasClient,
+		pricingClient,
 		awsAccessKeys,
 		useLocalCredentials,
 	}
@@ -308,7 +315,7 @@ func (b *ClientBuilder) UseLocalCredentials(value bool) *ClientBuilder {
 // Create AWS session with a specific set of credentials
 func (b *ClientBuilder) BuildSessionWithOptionsCredentials(value *AccessKey,
 	logLevel aws.ClientLogMode) (aws.Config, error) {
-	cfg, err := config.LoadDefaultConfig(context.TODO(),
+	cfg, err := config.LoadDefaultConfig(timeout.Context(),
 		config.WithCredentialsProvider(credentials.NewStaticCredentialsProvider(value.AccessKeyID,
 			value.SecretAccessKey, "")),
 		config.WithRegion(*b.region),
@@ -339,7 +346,7 @@ func (b *ClientBuilder) BuildSessionWithOptionsCredentials(value *AccessKey,
 }
 
 func (b *ClientBuilder) BuildSessionWithOptions(logLevel aws.ClientLogMode) (aws.Config, error) {
-	cfg, err := config.LoadDefaultConfig(context.TODO(),
+	cfg, err := config.LoadDefaultConfig(timeout.Context(),
 		config.WithSharedConfigProfile(profile.Profile()),
 		config.WithRegion(*b.region),
 		config.WithHTTPClient(&http.Client{
@@ -434,6 +441,10 @@ func (b *ClientBuilder) Build() (Client, error) {
 		stsClient:           sts.NewFromConfig(cfg),
 		cfClient:            cloudformation.NewFromConfig(cfg),
 		serviceQuotasClient: servicequotas.NewFromConfig(cfg),
+		// The price list API is only available in a few regions, and has the prices of all of them
+		pricingClient: pricing.NewFromConfig(cfg, func(o *pricing.Options) {
+			o.Region = pricingRegion
+		}),
 		useLocalCredentials: b.useLocalCredentials,
 	}
 
@@ -450,7 +461,7 @@ func (b *ClientBuilder) Build() (Client, error) {
 }
 
 func (c *awsClient) GetIAMCredentials() (aws.Credentials, error) {
-	return c.cfg.Credentials.Retrieve(context.TODO())
+	return c.cfg.Credentials.Retrieve(timeout.Context())
 }
 
 func (c *awsClient) GetRegion() string {
@@ -470,7 +481,7 @@ func (c *awsClient) FetchPublicSubnetMap(subnets []ec2types.Subnet) (map[string]
 		for _, subnet := range curChunk {
 			subnetIds = append(subnetIds, subnet.SubnetId)
 		}
-		routeTablesResp, err := c.ec2Client.DescribeRouteTables(context.Background(), &ec2.DescribeRouteTablesInput{
+		routeTablesResp, err := c.ec2Client.DescribeRouteTables(timeout.Context(), &ec2.DescribeRouteTablesInput{
 			Filters: []ec2types.Filter{
 				{
 					Name:   aws.String("association.subnet-id"),
@@ -524,7 +535,7 @@ func (c *awsClient) ListSubnets(subnetIds ...string) ([]ec2types.Subnet, error)
 }
 
 func (c *awsClient) GetSubnetAvailabilityZone(subnetID string) (string, error) {
-	res, err := c.ec2Client.DescribeSubnets(context.Background(), &ec2.DescribeSubnetsInput{SubnetIds: []string{subnetID}})
+	res, err := c.ec2Client.DescribeSubnets(timeout.Context(), &ec2.DescribeSubnetsInput{SubnetIds: []string{subnetID}})
 	if err != nil {
 		return "", err
 	}
@@ -587,7 +598,7 @@ func (c *awsClient) GetVPCSubnets(subnetID string) ([]ec2types.Subnet, error) {
 func (c *awsClient) FilterVPCsPrivateSubnets(subnets []ec2types.Subnet) ([]ec2types.Subnet, error) {
 	// Fetch VPC route tables
 	vpcID := subnets[0].VpcId
-	describeRouteTablesOutput, err := c.ec2Client.DescribeRouteTables(context.Background(), &ec2.DescribeRouteTablesInput{
+	describeRouteTablesOutput, err := c.ec2Client.DescribeRouteTables(timeout.Context(), &ec2.DescribeRouteTablesInput{
 		Filters: []ec2types.Filter{
 			{
 				Name:   aws.String("vpc-id"),
@@ -665,7 +676,7 @@ func (c *awsClient) getSubnetRouteTable(subnetID *string,
 // getSubnetIDs will return the list of subnetsIDs supported for the region picked.
 // It is possible to pass non-empty `describeSubnetsInput` to filter results.
 func (c *awsClient) getSubnetIDs(describeSubnetsInput *ec2.DescribeSubnetsInput) ([]ec2types.Subnet, error) {
-	res, err := c.ec2Client.DescribeSubnets(context.Background(), describeSubnetsInput)
+	res, err := c.ec2Client.DescribeSubnets(timeout.Context(), describeSubnetsInput)
 	if err != nil {
 		return nil, err
 	}
@@ -682,7 +693,7 @@ type Creator struct {
 }
 
 func (c *awsClient) GetCreator() (*Creator, error) {
-	getCallerIdentityOutput, err := c.stsClient.GetCallerIdentity(context.Background(), &sts.GetCallerIdentityInput{})
+	getCallerIdentityOutput, err := c.stsClient.GetCallerIdentity(timeout.Context(), &sts.GetCallerIdentityInput{})
 	if err != nil {
 		return nil, err
 	}
@@ -730,7 +741,7 @@ func (c *awsClient) ValidateCredentials() (bool, error) {
 	// This will fail if the AWS access key and secret key are invalid. This
 	// will also work for STS credentials with access key, secret key and session
 	// token
-	_, err := c.stsClient.GetCallerIdentity(context.Background(), &sts.GetCallerIdentityInput{})
+	_, err := c.stsClient.GetCallerIdentity(timeout.Context(), &sts.GetCallerIdentityInput{})
 	if err != nil {
 		if strings.Contains(fmt.Sprintf("%s", err), "InvalidClientTokenId") {
 			awsErr := fmt.Errorf("Invalid AWS Credentials: %s.\n For help configuring your credentials, see %s",
@@ -746,7 +757,7 @@ func (c *awsClient) ValidateCredentials() (bool, error) {
 }
 
 func (c *awsClient) CheckAdminUserNotExisting(userName string) (err error) {
-	userList, err := c.iamClient.ListUsers(context.Background(), &iam.ListUsersInput{})
+	userList, err := c.iamClient.ListUsers(timeout.Context(), &iam.ListUsersInput{})
 	if err != nil {
 		return err
 	}
@@ -762,7 +773,7 @@ func (c *awsClient) CheckAdminUserNotExisting(userName string) (err error) {
 }
 
 func (c *awsClient) CheckAdminUserExists(userName string) (err error) {
-	_, err = c.iamClient.GetUser(context.Background(), &iam.GetUserInput{UserName: aws.String(userName)})
+	_, err = c.iamClient.GetUser(timeout.Context(), &iam.GetUserInput{UserName: aws.String(userName)})
 	if err != nil {
 		return err
 	}
@@ -770,7 +781,7 @@ func (c *awsClient) CheckAdminUserExists(userName string) (err error) {
 }
 
 func (c *awsClient) GetClusterRegionTagForUser(username string) (string, error) {
-	user, err := c.iamClient.GetUser(context.Background(), &iam.GetUserInput{UserName: aws.String(username)})
+	user, err := c.iamClient.GetUser(timeout.Context(), &iam.GetUserInput{UserName: aws.String(username)})
 	if err != nil {
 		return "", err
 	}
@@ -783,7 +794,7 @@ func (c *awsClient) GetClusterRegionTagForUser(username string) (string, error)
 }
 
 func (c *awsClient) TagUserRegion(username string, region string) error {
-	_, err := c.iamClient.TagUser(context.Background(), &iam.TagUserInput{
+	_, err := c.iamClient.TagUser(timeout.Context(), &iam.TagUserInput{
 		UserName: aws.String(username),
 		Tags: []iamtypes.Tag{
 			{
@@ -833,7 +844,7 @@ func (c *awsClient) GetAWSAccessKeys() (*AccessKey, error) {
 }
 
 func (c *awsClient) GetLocalAWSAccessKeys() (*AccessKey, error) {
-	creds, err := c.cfg.Credentials.Retrieve(context.Background())
+	creds, err := c.cfg.Credentials.Retrieve(timeout.Context())
 	if err != nil {
 		return nil, err
 	}
@@ -868,14 +879,18 @@ func (c *awsClient) ValidateAccessKeys(AccessKey *AccessKey) error {
 				wait := time.Duration((i * 200)) * time.Millisecond
 				waited := time.Since(start)
 				logger.Debug(fmt.Printf("InvalidClientTokenId, waited %.2f\n", waited.Seconds()))
-				time.Sleep(wait)
+				if err := timeout.Sleep(wait); err != nil {
+					return err
+				}
 			}
 
 			if awserr.IsAccessDeniedException(err) {
 				wait := time.Duration((i * 200)) * time.Millisecond
 				waited := time.Since(start)
 				logger.Debug(fmt.Printf("AccessDenied, waited %.2f\n", waited.Seconds()))
-				time.Sleep(wait)
+				if err := timeout.Sleep(wait); err != nil {
+					return err
+				}
 			}
 
 			if i == maxAttempts {
@@ -914,7 +929,7 @@ func (c *awsClient) UpsertAccessKey(username string) (*AccessKey, error) {
 // CreateAccessKey creates an IAM access key for `username`
 func (c *awsClient) CreateAccessKey(username string) (*iam.CreateAccessKeyOutput, error) {
 	// Create access key for IAM user
-	createIAMUserAccessKeyOutput, err := c.iamClient.CreateAccessKey(context.Background(),
+	createIAMUserAccessKeyOutput, err := c.iamClient.CreateAccessKey(timeout.Context(),
 		&iam.CreateAccessKeyInput{
 			UserName: aws.String(username),
 		},
@@ -931,7 +946,7 @@ func (c *awsClient) CreateAccessKey(username string) (*iam.CreateAccessKeyOutput
 func (c *awsClient) DeleteAccessKeys(username string) error {
 	// List all access keys for user. Result wont be truncated since IAM users
 	// can only have 2 access keys
-	listAccessKeysOutput, err := c.iamClient.ListAccessKeys(context.Background(),
+	listAccessKeysOutput, err := c.iamClient.ListAccessKeys(timeout.Context(),
 		&iam.ListAccessKeysInput{
 			UserName: aws.String(username),
 		},
@@ -943,7 +958,7 @@ func (c *awsClient) DeleteAccessKeys(username string) error {
 	// Delete all access keys. Moactl owns this user since the CloudFormation stack
 	// at this point is complete and the user is tagged by use on creation
 	for _, key := range listAccessKeysOutput.AccessKeyMetadata {
-		_, err = c.iamClient.DeleteAccessKey(context.Background(),
+		_, err = c.iamClient.DeleteAccessKey(timeout.Context(),
 			&iam.DeleteAccessKeyInput{
 				UserName:    aws.String(username),
 				AccessKeyId: key.AccessKeyId,
@@ -961,7 +976,7 @@ func (c *awsClient) DeleteAccessKeys(username string) error {
 // CheckRoleExists checks to see if an IAM role with the same name
 // already exists
 func (c *awsClient) CheckRoleExists(roleName string) (bool, string, error) {
-	role, err := c.iamClient.GetRole(context.Background(),
+	role, err := c.iamClient.GetRole(timeout.Context(),
 		&iam.GetRoleInput{
 			RoleName: aws.String(roleName),
 		})
@@ -994,7 +1009,7 @@ func (c *awsClient) GetRoleByARN(roleARN string) (iamtypes.Role, error) {
 	m := strings.LastIndex(resource, "/")
 	roleName := resource[m+1:]
 
-	roleOutput, err := c.iamClient.GetRole(context.Background(),
+	roleOutput, err := c.iamClient.GetRole(timeout.Context(),
 		&iam.GetRoleInput{
 			RoleName: aws.String(roleName),
 		})
@@ -1006,7 +1021,7 @@ func (c *awsClient) GetRoleByARN(roleARN string) (iamtypes.Role, error) {
 
 // DescribeAvailabilityZones fetches the region's availability zones with type `availability-zone`
 func (c *awsClient) DescribeAvailabilityZones() ([]string, error) {
-	describeAvailabilityZonesOutput, err := c.ec2Client.DescribeAvailabilityZones(context.Background(),
+	describeAvailabilityZonesOutput, err := c.ec2Client.DescribeAvailabilityZones(timeout.Context(),
 		&ec2.DescribeAvailabilityZonesInput{
 			Filters: []ec2types.Filter{
 				{
@@ -1028,7 +1043,7 @@ func (c *awsClient) DescribeAvailabilityZones() ([]string, error) {
 }
 
 func (c *awsClient) IsLocalAvailabilityZone(availabilityZoneName string) (bool, error) {
-	availabilityZones, err := c.ec2Client.DescribeAvailabilityZones(context.Background(),
+	availabilityZones, err := c.ec2Client.DescribeAvailabilityZones(timeout.Context(),
 		&ec2.DescribeAvailabilityZonesInput{ZoneNames: []string{availabilityZoneName}})
 	if err != nil {
 		return false, err
@@ -1041,7 +1056,7 @@ func (c *awsClient) IsLocalAvailabilityZone(availabilityZoneName string) (bool,
 }
 
 func (c *awsClient) GetAvailabilityZoneType(availabilityZoneName string) (string, error) {
-	availabilityZones, err := c.ec2Client.DescribeAvailabilityZones(context.Background(),
+	availabilityZones, err := c.ec2Client.DescribeAvailabilityZones(timeout.Context(),
 		&ec2.DescribeAvailabilityZonesInput{ZoneNames: []string{availabilityZoneName}})
 	if err != nil {
 		return "", err
@@ -1057,7 +1072,7 @@ func (c *awsClient) DetachRolePolicies(roleName string) error {
 	isTruncated := true
 	var marker *string
 	for isTruncated {
-		resp, err := c.iamClient.ListAttachedRolePolicies(context.Background(),
+		resp, err := c.iamClient.ListAttachedRolePolicies(timeout.Context(),
 			&iam.ListAttachedRolePoliciesInput{
 				Marker:   marker,
 				RoleName: &roleName,
@@ -1079,7 +1094,7 @@ func (c *awsClient) DetachRolePolicies(roleName string) error {
 }
 
 func (c *awsClient) detachRolePolicy(policyArn string, roleName string) error {
-	_, err := c.iamClient.DetachRolePolicy(context.Background(),
+	_, err := c.iamClient.DetachRolePolicy(timeout.Context(),
 		&iam.DetachRolePolicyInput{PolicyArn: &policyArn, RoleName: &roleName})
 	if err != nil {
 		return err
@@ -1105,7 +1120,7 @@ const ReadOnlyAnonUserPolicyTemplate = `{
 }`
 
 func (c *awsClient) CreateS3Bucket(bucketName string, region string) error {
-	_, err := c.s3Client.HeadBucket(context.TODO(), &s3.HeadBucketInput{
+	_, err := c.s3Client.HeadBucket(timeout.Context(), &s3.HeadBucketInput{
 		Bucket: aws.String(bucketName),
 	})
 	if err == nil {
@@ -1120,12 +1135,13 @@ func (c *awsClient) CreateS3Bucket(bucketName string, region string) error {
 			LocationConstraint: s3types.BucketLocationConstraint(region),
 		}
 	}
-	_, err = c.s3Client.CreateBucket(context.TODO(), bucketInput)
+	_, err = c.s3Client.CreateBucket(timeout.Context(), bucketInput)
 	if err != nil {
 		return err
 	}
+	timeout.AddCreatedResource("S3 bucket '%s'", bucketName)
 
-	_, err = c.s3Client.PutPublicAccessBlock(context.TODO(), &s3.PutPublicAccessBlockInput{
+	_, err = c.s3Client.PutPublicAccessBlock(timeout.Context(), &s3.PutPublicAccessBlockInput{
 		Bucket: aws.String(bucketName),
 		PublicAccessBlockConfiguration: &s3types.PublicAccessBlockConfiguration{
 			BlockPublicAcls:       aws.Bool(true),
@@ -1138,7 +1154,7 @@ func (c *awsClient) CreateS3Bucket(bucketName string, region string) error {
 		return err
 	}
 
-	_, err = c.s3Client.PutBucketPolicy(context.TODO(), &s3.PutBucketPolicyInput{
+	_, err = c.s3Client.PutBucketPolicy(timeout.Context(), &s3.PutBucketPolicyInput{
 		Bucket: aws.String(bucketName),
 		Policy: aws.String(fmt.Sprintf(ReadOnlyAnonUserPolicyTemplate, bucketName)),
 	})
@@ -1146,7 +1162,7 @@ func (c *awsClient) CreateS3Bucket(bucketName string, region string) error {
 		return err
 	}
 
-	_, err = c.s3Client.PutBucketTagging(context.TODO(), &s3.PutBucketTaggingInput{
+	_, err = c.s3Client.PutBucketTagging(timeout.Context(), &s3.PutBucketTaggingInput{
 		Bucket: aws.String(bucketName),
 		Tagging: &s3types.Tagging{
 			TagSet: []s3types.Tag{
@@ -1165,7 +1181,7 @@ func (c *awsClient) CreateS3Bucket(bucketName string, region string) error {
 }
 
 func (c *awsClient) DeleteS3Bucket(bucketName string) error {
-	_, err := c.s3Client.HeadBucket(context.Background(),
+	_, err := c.s3Client.HeadBucket(timeout.Context(),
 		&s3.HeadBucketInput{
 			Bucket: aws.String(bucketName),
 		})
@@ -1180,7 +1196,7 @@ func (c *awsClient) DeleteS3Bucket(bucketName string) error {
 	if err != nil {
 		return err
 	}
-	_, err = c.s3Client.DeleteBucket(context.Background(),
+	_, err = c.s3Client.DeleteBucket(timeout.Context(),
 		&s3.DeleteBucketInput{
 			Bucket: aws.String(bucketName),
 		})
@@ -1191,7 +1207,7 @@ func (c *awsClient) DeleteS3Bucket(bucketName string) error {
 }
 
 func (c *awsClient) emptyS3Bucket(bucketName string) error {
-	objects, err := c.s3Client.ListObjects(context.Background(),
+	objects, err := c.s3Client.ListObjects(timeout.Context(),
 		&s3.ListObjectsInput{
 			Bucket: aws.String(bucketName),
 		})
@@ -1199,7 +1215,7 @@ func (c *awsClient) emptyS3Bucket(bucketName string) error {
 		return err
 	}
 	for _, object := range (*objects).Contents {
-		_, err = c.s3Client.DeleteObject(context.Background(),
+		_, err = c.s3Client.DeleteObject(timeout.Context(),
 			&s3.DeleteObjectInput{
 				Bucket: aws.String(bucketName),
 				Key:    object.Key,
@@ -1212,7 +1228,7 @@ func (c *awsClient) emptyS3Bucket(bucketName string) error {
 }
 
 func (c *awsClient) PutPublicReadObjectInS3Bucket(bucketName string, body io.ReadSeeker, key string) error {
-	_, err := c.s3Client.PutObject(context.Background(),
+	_, err := c.s3Client.PutObject(timeout.Context(),
 		&s3.PutObjectInput{
 			Body:    body,
 			Bucket:  aws.String(bucketName),
@@ -1226,7 +1242,7 @@ func (c *awsClient) PutPublicReadObjectInS3Bucket(bucketName string, body io.Rea
 }
 
 func (c *awsClient) CreateSecretInSecretsManager(name string, secret string) (string, error) {
-	createSecretResponse, err := c.smClient.CreateSecret(context.Background(),
+	createSecretResponse, err := c.smClient.CreateSecret(timeout.Context(),
 		&secretsmanager.CreateSecretInput{
 			Description:  aws.String(fmt.Sprintf("Secret for %s", name)),
 			Name:         aws.String(name),
@@ -1239,11 +1255,12 @@ func (c *awsClient) CreateSecretInSecretsManager(name string, secret string) (st
 	if err != nil {
 		return "", err
 	}
+	timeout.AddCreatedResource("Secrets Manager secret '%s'", *createSecretResponse.ARN)
 	return *createSecretResponse.ARN, nil
 }
 
 func (c *awsClient) DeleteSecretInSecretsManager(secretArn string) error {
-	_, err := c.smClient.DescribeSecret(context.Background(),
+	_, err := c.smClient.DescribeSecret(timeout.Context(),
 		&secretsmanager.DescribeSecretInput{
 			SecretId: aws.String(secretArn),
 		})
@@ -1253,7 +1270,7 @@ func (c *awsClient) DeleteSecretInSecretsManager(secretArn string) error {
 			return nil
 		}
 	}
-	_, err = c.smClient.DeleteSecret(context.Background(),
+	_, err = c.smClient.DeleteSecret(timeout.Context(),
 		&secretsmanager.DeleteSecretInput{
 			ForceDeleteWithoutRecovery: aws.Bool(true),
 			SecretId:                   aws.String(secretArn),
@@ -1273,7 +1290,7 @@ func (c *awsClient) GetSecurityGroupIds(vpcId string) ([]ec2types.SecurityGroup,
 			},
 		},
 	}
-	resp, err := c.ec2Client.DescribeSecurityGroups(context.Background(), describeSecurityGroupsInput)
+	resp, err := c.ec2Client.DescribeSecurityGroups(timeout.Context(), describeSecurityGroupsInput)
 	if err != nil {
 		return []ec2types.SecurityGroup{}, err
 	}
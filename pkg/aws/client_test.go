@@ -3,6 +3,7 @@ package aws
 import (
 	"context"
 	"fmt"
+	"time"
 
 	gomock "go.uber.org/mock/gomock"
 
@@ -13,6 +14,8 @@ import (
 	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
 	"github.com/aws/aws-sdk-go-v2/service/iam"
 	iamtypes "github.com/aws/aws-sdk-go-v2/service/iam/types"
+	"github.com/aws/aws-sdk-go-v2/service/pricing"
+	pricingtypes "github.com/aws/aws-sdk-go-v2/service/pricing/types"
 	"github.com/aws/aws-sdk-go-v2/service/sts"
 	"github.com/aws/smithy-go"
 	. "github.com/onsi/ginkgo/v2"
@@ -26,6 +29,7 @@ import (
 	"github.com/openshift/rosa/pkg/aws/mocks"
 	rosaTags "github.com/openshift/rosa/pkg/aws/tags"
 	"github.com/openshift/rosa/pkg/test/matchers"
+	"github.com/openshift/rosa/pkg/timeout"
 )
 
 var _ = Describe("Client", func() {
@@ -39,6 +43,7 @@ var _ = Describe("Client", func() {
 		mockS3API             *mocks.MockS3ApiClient
 		mockSecretsManagerAPI *mocks.MockSecretsManagerApiClient
 		mockSTSApi            *mocks.MockStsApiClient
+		mockPricingAPI        *mocks.MockPricingApiClient
 
 		mockedOidcProviderArns []string
 		mockedOidcConfigs      []*cmv1.OidcConfig
@@ -52,6 +57,7 @@ var _ = Describe("Client", func() {
 		mockS3API = mocks.NewMockS3ApiClient(mockCtrl)
 		mockSTSApi = mocks.NewMockStsApiClient(mockCtrl)
 		mockSecretsManagerAPI = mocks.NewMockSecretsManagerApiClient(mockCtrl)
+		mockPricingAPI = mocks.NewMockPricingApiClient(mockCtrl)
 		client = New(
 			awsSdk.Config{},
 			logrus.New(),
@@ -63,6 +69,7 @@ var _ = Describe("Client", func() {
 			mockSTSApi,
 			mockCfAPI,
 			mocks.NewMockServiceQuotasApiClient(mockCtrl),
+			mockPricingAPI,
 			&AccessKey{},
 			false,
 		)
@@ -231,7 +238,7 @@ var _ = Describe("Client", func() {
 						_ ...func(*cloudformation.Options)) (*cloudformation.DescribeStacksOutput, error) {
 						return describeStacksOutput, nil
 					}).AnyTimes()
-				mockCfAPI.EXPECT().CreateStack(context.Background(), gomock.Any()).Return(nil, nil)
+				mockCfAPI.EXPECT().CreateStack(timeout.Context(), gomock.Any()).Return(nil, nil)
 			})
 
 			It("Creates a cloudformation stack", func() {
@@ -248,7 +255,7 @@ var _ = Describe("Client", func() {
 		)
 		BeforeEach(func() {
 			adminUserName = "fake-admin-username"
-			mockIamAPI.EXPECT().ListUsers(context.Background(), gomock.Any()).Return(&iam.ListUsersOutput{
+			mockIamAPI.EXPECT().ListUsers(timeout.Context(), gomock.Any()).Return(&iam.ListUsersOutput{
 				Users: []iamtypes.User{
 					{
 						UserName: &adminUserName,
@@ -289,14 +296,14 @@ var _ = Describe("Client", func() {
 
 		It("Finds and Returns Account Role", func() {
 
-			mockIamAPI.EXPECT().GetRole(context.Background(), gomock.Any()).Return(&iam.GetRoleOutput{
+			mockIamAPI.EXPECT().GetRole(timeout.Context(), gomock.Any()).Return(&iam.GetRoleOutput{
 				Role: &iamtypes.Role{
 					Arn:      &testArn,
 					RoleName: &testName,
 				},
 			}, nil)
 
-			mockIamAPI.EXPECT().ListRoleTags(context.Background(), gomock.Any()).Return(&iam.ListRoleTagsOutput{
+			mockIamAPI.EXPECT().ListRoleTags(timeout.Context(), gomock.Any()).Return(&iam.ListRoleTagsOutput{
 				Tags: tags,
 			}, nil)
 
@@ -311,7 +318,7 @@ var _ = Describe("Client", func() {
 		})
 
 		It("Returns empty when No Role with ARN exists", func() {
-			mockIamAPI.EXPECT().GetRole(context.Background(), gomock.Any()).Return(nil, fmt.Errorf("role Doesn't Exist"))
+			mockIamAPI.EXPECT().GetRole(timeout.Context(), gomock.Any()).Return(nil, fmt.Errorf("role Doesn't Exist"))
 
 			role, err := client.GetAccountRoleByArn(testArn)
 
@@ -323,7 +330,7 @@ var _ = Describe("Client", func() {
 
 			var roleName = "not-an-account-role"
 
-			mockIamAPI.EXPECT().GetRole(context.Background(), gomock.Any()).Return(&iam.GetRoleOutput{
+			mockIamAPI.EXPECT().GetRole(timeout.Context(), gomock.Any()).Return(&iam.GetRoleOutput{
 				Role: &iamtypes.Role{
 					Arn:      &testArn,
 					RoleName: &roleName,
@@ -526,6 +533,95 @@ var _ = Describe("Client", func() {
 			Expect(err).To(BeNil())
 		})
 	})
+	Context("GetSpotPrices", func() {
+		since := time.Now().Add(-4 * time.Hour)
+
+		spotPrice := func(instanceType string, zone string, price string, hour int) ec2types.SpotPrice {
+			return ec2types.SpotPrice{
+				InstanceType:     ec2types.InstanceType(instanceType),
+				AvailabilityZone: awsSdk.String(zone),
+				SpotPrice:        awsSdk.String(price),
+				Timestamp:        awsSdk.Time(since.Add(time.Duration(hour) * time.Hour)),
+			}
+		}
+
+		It("Returns the highest latest price of the zones and the average price weighted by duration", func() {
+			mockEC2API.EXPECT().DescribeSpotPriceHistory(gomock.Any(), &ec2.DescribeSpotPriceHistoryInput{
+				InstanceTypes:       []ec2types.InstanceType{"m5.xlarge", "r5.xlarge"},
+				ProductDescriptions: []string{SpotPriceProductDescription},
+				StartTime:           awsSdk.Time(since),
+				Filters: []ec2types.Filter{
+					{Name: awsSdk.String("availability-zone"), Values: []string{"us-east-1a", "us-east-1b"}},
+				},
+			}, gomock.Any()).Return(&ec2.DescribeSpotPriceHistoryOutput{
+				SpotPriceHistory: []ec2types.SpotPrice{
+					spotPrice("m5.xlarge", "us-east-1a", "0.0600", 3),
+					spotPrice("m5.xlarge", "us-east-1b", "0.0700", 2),
+					spotPrice("m5.xlarge", "us-east-1a", "0.0500", 1),
+					spotPrice("m5.xlarge", "us-east-1b", "0.0800", 0),
+				},
+				NextToken: awsSdk.String("next"),
+			}, nil)
+			mockEC2API.EXPECT().DescribeSpotPriceHistory(gomock.Any(), gomock.Any(), gomock.Any()).Return(
+				&ec2.DescribeSpotPriceHistoryOutput{}, nil)
+
+			prices, err := client.GetSpotPrices([]string{"m5.xlarge", "r5.xlarge"},
+				[]string{"us-east-1a", "us-east-1b"}, since)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(prices).To(HaveLen(1))
+			Expect(prices["m5.xlarge"].Current).To(BeNumerically("~", 0.07))
+			// 0.05 for 2 hours and 0.06 for 1 hour in the first zone, 0.08 and 0.07 for 2 hours each in the
+			// second one
+			Expect(prices["m5.xlarge"].Average).To(BeNumerically("~", 0.46/7, 0.0001))
+		})
+
+		It("Counts the price in effect at the start of the period from the start", func() {
+			mockEC2API.EXPECT().DescribeSpotPriceHistory(gomock.Any(), gomock.Any(), gomock.Any()).Return(
+				&ec2.DescribeSpotPriceHistoryOutput{
+					SpotPriceHistory: []ec2types.SpotPrice{
+						spotPrice("m5.xlarge", "us-east-1a", "0.0400", 1),
+						spotPrice("m5.xlarge", "us-east-1a", "0.1000", -20),
+					},
+				}, nil)
+
+			prices, err := client.GetSpotPrices([]string{"m5.xlarge"}, nil, since)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(prices["m5.xlarge"].Current).To(BeNumerically("~", 0.04))
+			Expect(prices["m5.xlarge"].Average).To(BeNumerically("~", 0.055, 0.0001))
+		})
+	})
+	Context("GetOnDemandPrices", func() {
+		product := func(instanceType string, unit string, price string) string {
+			return fmt.Sprintf(`{"product": {"attributes": {"instanceType": "%s"}}, "terms": {"OnDemand": `+
+				`{"SKU.TERM": {"priceDimensions": {"SKU.TERM.DIM": {"unit": "%s", "pricePerUnit": `+
+				`{"USD": "%s"}}}}}}}`, instanceType, unit, price)
+		}
+
+		It("Returns the hourly prices of the requested instance types", func() {
+			mockPricingAPI.EXPECT().GetProducts(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+				func(_ context.Context, input *pricing.GetProductsInput,
+					_ ...func(*pricing.Options)) (*pricing.GetProductsOutput, error) {
+					Expect(awsSdk.ToString(input.ServiceCode)).To(Equal("AmazonEC2"))
+					Expect(input.Filters).To(ContainElement(pricingtypes.Filter{
+						Type:  pricingtypes.FilterTypeTermMatch,
+						Field: awsSdk.String("regionCode"),
+						Value: awsSdk.String("us-east-1"),
+					}))
+					return &pricing.GetProductsOutput{
+						PriceList: []string{
+							product("m5.xlarge", "Hrs", "0.1920000000"),
+							product("r5.xlarge", "Hrs", "0.2520000000"),
+							product("m5.2xlarge", "Hrs", "0.3840000000"),
+						},
+					}, nil
+				})
+
+			prices, err := client.GetOnDemandPrices("us-east-1", []string{"m5.xlarge", "r5.xlarge", "p3.2xlarge"})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(prices).To(Equal(map[string]float64{"m5.xlarge": 0.192, "r5.xlarge": 0.252}))
+		})
+	})
+
 	Describe("Creator", func() {
 		DescribeTable("should be adapted from STS caller identity", func(
 			identity *sts.GetCallerIdentityOutput,
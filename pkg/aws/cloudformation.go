@@ -17,7 +17,6 @@ limitations under the License.
 package aws
 
 import (
-	"context"
 	"errors"
 	"fmt"
 	"strings"
@@ -29,6 +28,7 @@ import (
 	"github.com/aws/smithy-go"
 
 	"github.com/openshift/rosa/assets"
+	"github.com/openshift/rosa/pkg/timeout"
 )
 
 func readCloudFormationTemplate(path string) (string, error) {
@@ -115,12 +115,13 @@ func (c *awsClient) EnsureOsdCcsAdminUser(stackName string, adminUserName string
 
 func (c *awsClient) CreateStack(cfTemplateBody, stackName string) (bool, error) {
 	// Create cloudformation stack
-	_, err := c.cfClient.CreateStack(context.Background(), buildCreateStackInput(cfTemplateBody, stackName))
+	_, err := c.cfClient.CreateStack(timeout.Context(), buildCreateStackInput(cfTemplateBody, stackName))
 	if err != nil {
 		return false, err
 	}
+	timeout.AddCreatedResource("CloudFormation stack '%s'", stackName)
 
-	err = waitForStackCreateComplete(context.Background(), c.cfClient, stackName)
+	err = waitForStackCreateComplete(timeout.Context(), c.cfClient, stackName)
 	if err != nil {
 		return false, err
 	}
@@ -129,7 +130,7 @@ func (c *awsClient) CreateStack(cfTemplateBody, stackName string) (bool, error)
 }
 
 func (c *awsClient) UpdateStack(cfTemplateBody, stackName string) error {
-	_, err := c.cfClient.UpdateStack(context.TODO(), buildUpdateStackInput(cfTemplateBody, stackName))
+	_, err := c.cfClient.UpdateStack(timeout.Context(), buildUpdateStackInput(cfTemplateBody, stackName))
 	if err != nil {
 		var apiErr smithy.APIError
 		if errors.As(err, &apiErr) {
@@ -143,7 +144,7 @@ func (c *awsClient) UpdateStack(cfTemplateBody, stackName string) error {
 	}
 
 	// Wait for CloudFormation update to complete
-	err = waitForStackUpdateComplete(context.TODO(), c.cfClient, stackName)
+	err = waitForStackUpdateComplete(timeout.Context(), c.cfClient, stackName)
 	if err != nil {
 		return err
 	}
@@ -152,7 +153,7 @@ func (c *awsClient) UpdateStack(cfTemplateBody, stackName string) error {
 }
 
 func (c *awsClient) CheckStackReadyOrNotExisting(stackName string) (stackReady bool, status *string, err error) {
-	stackList, err := c.cfClient.ListStacks(context.TODO(), &cloudformation.ListStacksInput{})
+	stackList, err := c.cfClient.ListStacks(timeout.Context(), &cloudformation.ListStacksInput{})
 	if err != nil {
 		return false, nil, err
 	}
@@ -183,7 +184,7 @@ func (c *awsClient) DeleteOsdCcsAdminUser(stackName string) error {
 	}
 
 	// Delete cloudformation stack
-	_, err := c.cfClient.DeleteStack(context.Background(), deleteStackInput)
+	_, err := c.cfClient.DeleteStack(timeout.Context(), deleteStackInput)
 	if err != nil {
 		var tokenExistsErr *cloudformationtypes.TokenAlreadyExistsException
 		if errors.As(err, &tokenExistsErr) {
@@ -193,7 +194,7 @@ func (c *awsClient) DeleteOsdCcsAdminUser(stackName string) error {
 	}
 
 	// Wait until cloudformation stack deletes
-	err = waitForStackDeleteComplete(context.Background(), c.cfClient, stackName)
+	err = waitForStackDeleteComplete(timeout.Context(), c.cfClient, stackName)
 	if err != nil {
 		return err
 	}
@@ -7,6 +7,7 @@ import (
 	"path/filepath"
 	"reflect"
 	"regexp"
+	"sort"
 	"strings"
 	"time"
 
@@ -31,6 +32,7 @@ import (
 	"github.com/openshift/rosa/pkg/fedramp"
 	"github.com/openshift/rosa/pkg/helper"
 	rprtr "github.com/openshift/rosa/pkg/reporter"
+	"github.com/openshift/rosa/pkg/timeout"
 )
 
 // AWS accepted role name: https://docs.aws.amazon.com/AWSCloudFormation/latest/UserGuide/aws-resource-iam-role.html
@@ -135,7 +137,7 @@ func ARNPathValidator(input interface{}) error {
 // prompt for user input.
 func GetRegion(region string) (string, error) {
 	if region == "" {
-		cfg, err := config.LoadDefaultConfig(context.TODO())
+		cfg, err := config.LoadDefaultConfig(timeout.Context())
 		if err != nil {
 			return "", fmt.Errorf("Error loading default AWS configuration: %v", err)
 		}
@@ -156,7 +158,7 @@ func getClientDetails(awsClient *awsClient) (*sts.GetCallerIdentityOutput, bool,
 		return nil, rootUser, err
 	}
 
-	user, err := awsClient.stsClient.GetCallerIdentity(context.Background(), &sts.GetCallerIdentityInput{})
+	user, err := awsClient.stsClient.GetCallerIdentity(timeout.Context(), &sts.GetCallerIdentityInput{})
 	if err != nil {
 		return nil, rootUser, err
 	}
@@ -181,16 +183,16 @@ func GetAWSClientForUserRegion(reporter *rprtr.Object, logger *logrus.Logger,
 	awsRegionInUserConfig, err := GetRegion(arguments.GetRegion())
 	if err != nil {
 		reporter.Errorf("Error getting region: %v", err)
-		os.Exit(1)
+		os.Exit(rprtr.ExitCode())
 	}
 	if awsRegionInUserConfig == "" {
 		reporter.Errorf("AWS Region not set")
-		os.Exit(1)
+		os.Exit(rprtr.ExitCode())
 	}
 	if !helper.Contains(supportedRegions, awsRegionInUserConfig) {
 		reporter.Errorf("Unsupported region '%s', available regions: %s",
 			awsRegionInUserConfig, helper.SliceToSortedString(supportedRegions))
-		os.Exit(1)
+		os.Exit(rprtr.ExitCode())
 	}
 
 	// Create the AWS client:
@@ -201,7 +203,7 @@ func GetAWSClientForUserRegion(reporter *rprtr.Object, logger *logrus.Logger,
 		Build()
 	if err != nil {
 		reporter.Errorf("Error creating aws client for stack validation: %v", err)
-		os.Exit(1)
+		os.Exit(rprtr.ExitCode())
 	}
 	regionUsedForInit, err := client.GetClusterRegionTagForUser(AdminUserName)
 	if err != nil || regionUsedForInit == "" {
@@ -212,7 +214,7 @@ func GetAWSClientForUserRegion(reporter *rprtr.Object, logger *logrus.Logger,
 		if !helper.Contains(supportedRegions, regionUsedForInit) {
 			reporter.Errorf("Unsupported region '%s', available regions: %s",
 				regionUsedForInit, helper.SliceToSortedString(supportedRegions))
-			os.Exit(1)
+			os.Exit(rprtr.ExitCode())
 		}
 		// Create the AWS client with the region used in the init
 		//So we can check for the stack in that region
@@ -223,7 +225,7 @@ func GetAWSClientForUserRegion(reporter *rprtr.Object, logger *logrus.Logger,
 			Build()
 		if err != nil {
 			reporter.Errorf("Error creating aws client for stack validation: %v", err)
-			os.Exit(1)
+			os.Exit(rprtr.ExitCode())
 		}
 		return awsClient
 	}
@@ -618,12 +620,7 @@ func GenerateOperatorRolePolicyFiles(reporter *rprtr.Object, policies map[string
 	isSharedVpc := sharedVpcRoleArn != ""
 	for credrequest := range credRequests {
 		filename := GetOperatorPolicyKey(credrequest, false, isSharedVpc)
-		policyDetail := GetPolicyDetails(policies, filename)
-		if isSharedVpc {
-			policyDetail = InterpolatePolicyDocument(partition, policyDetail, map[string]string{
-				"shared_vpc_role_arn": sharedVpcRoleArn,
-			})
-		}
+		policyDetail := GetOperatorPolicyDocument(policies, filename, sharedVpcRoleArn, partition)
 		//In case any missing policy we don't want to block the user.This might not happen
 		if policyDetail == "" {
 			continue
@@ -639,6 +636,19 @@ func GenerateOperatorRolePolicyFiles(reporter *rprtr.Object, policies map[string
 	return nil
 }
 
+// GetOperatorPolicyDocument returns the permission policy of an operator role, with the role of the shared
+// VPC account when there is one.
+func GetOperatorPolicyDocument(policies map[string]*cmv1.AWSSTSPolicy, policyKey string,
+	sharedVpcRoleArn string, partition string) string {
+	policyDetail := GetPolicyDetails(policies, policyKey)
+	if sharedVpcRoleArn != "" {
+		policyDetail = InterpolatePolicyDocument(partition, policyDetail, map[string]string{
+			"shared_vpc_role_arn": sharedVpcRoleArn,
+		})
+	}
+	return policyDetail
+}
+
 func GenerateAccountRolePolicyFiles(reporter *rprtr.Object, env string, policies map[string]*cmv1.AWSSTSPolicy,
 	skipPermissionFiles bool, accountRoles map[string]AccountRole, partition string) error {
 	for file := range accountRoles {
@@ -955,6 +965,17 @@ func GetAccountRolePolicyKeys(roleType string) []string {
 	return []string{fmt.Sprintf("sts_%s_permission_policy", roleType)}
 }
 
+// GetSortedRoleFiles returns the files of the account roles sorted, so that roles are always processed in
+// the same order.
+func GetSortedRoleFiles(accountRoles map[string]AccountRole) []string {
+	files := make([]string, 0, len(accountRoles))
+	for file := range accountRoles {
+		files = append(files, file)
+	}
+	sort.Strings(files)
+	return files
+}
+
 func ComputeOperatorRoleArn(prefix string, operator *cmv1.STSOperator, creator *Creator, path string) string {
 	role := fmt.Sprintf("%s-%s-%s", prefix, operator.Namespace(), operator.Name())
 	role = awsCommonUtils.TruncateRoleName(role)
@@ -0,0 +1,203 @@
+package iac
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"strings"
+)
+
+type cfnResource struct {
+	Type       string                 `json:"Type"`
+	Properties map[string]interface{} `json:"Properties"`
+}
+
+type cfnParameter struct {
+	Type        string `json:"Type"`
+	NoEcho      bool   `json:"NoEcho,omitempty"`
+	Description string `json:"Description,omitempty"`
+}
+
+type cfnTemplate struct {
+	AWSTemplateFormatVersion string                   `json:"AWSTemplateFormatVersion"`
+	Description              string                   `json:"Description"`
+	Parameters               map[string]*cfnParameter `json:"Parameters,omitempty"`
+	Resources                map[string]*cfnResource  `json:"Resources"`
+}
+
+// cloudFormation returns the template as a CloudFormation template in JSON format.
+func (t *Template) cloudFormation() (string, error) {
+	if len(t.Objects) > 0 {
+		return "", fmt.Errorf("CloudFormation can't create S3 objects like '%s', use --%s=%s instead",
+			t.Objects[0].Key, FlagName, Terraform)
+	}
+
+	template := cfnTemplate{
+		AWSTemplateFormatVersion: "2010-09-09",
+		Description:              "Resources generated by the ROSA CLI",
+		Resources:                map[string]*cfnResource{},
+	}
+	names := newNames(cfnName)
+
+	policies := map[*Policy]string{}
+	for _, policy := range t.Policies {
+		name := names.get("", "Policy-"+policy.Name)
+		policies[policy] = name
+		// Managed policies don't support tags in CloudFormation
+		template.Resources[name] = &cfnResource{
+			Type: "AWS::IAM::ManagedPolicy",
+			Properties: properties(map[string]interface{}{
+				"ManagedPolicyName": policy.Name,
+				"Path":              policy.Path,
+				"PolicyDocument":    policy.Document,
+			}),
+		}
+	}
+
+	// Attachments are declared in the roles, as CloudFormation has no resource for them
+	attachments := map[string][]interface{}{}
+	for _, attachment := range t.Attachments {
+		if t.findRole(attachment.Role) == nil {
+			return "", fmt.Errorf("Role '%s' is not created by the template", attachment.Role)
+		}
+		var arn interface{} = attachment.PolicyARN
+		if policy := t.findPolicy(attachment.PolicyARN); policy != nil {
+			arn = map[string]string{"Ref": policies[policy]}
+		}
+		attachments[attachment.Role] = append(attachments[attachment.Role], arn)
+	}
+
+	for _, role := range t.Roles {
+		name := names.get("", "Role-"+role.Name)
+		template.Resources[name] = &cfnResource{
+			Type: "AWS::IAM::Role",
+			Properties: properties(map[string]interface{}{
+				"RoleName":                 role.Name,
+				"Path":                     role.Path,
+				"AssumeRolePolicyDocument": role.AssumeRolePolicy,
+				"PermissionsBoundary":      role.PermissionsBoundary,
+				"ManagedPolicyArns":        attachments[role.Name],
+				"Tags":                     cfnTags(role.Tags),
+			}),
+		}
+	}
+
+	for _, provider := range t.OIDCProviders {
+		name := names.get("", "OIDCProvider-"+strings.TrimPrefix(provider.URL, "https://"))
+		template.Resources[name] = &cfnResource{
+			Type: "AWS::IAM::OIDCProvider",
+			Properties: properties(map[string]interface{}{
+				"Url":            provider.URL,
+				"ClientIdList":   provider.ClientIDs,
+				"ThumbprintList": provider.Thumbprints,
+				"Tags":           cfnTags(provider.Tags),
+			}),
+		}
+	}
+
+	for _, bucket := range t.Buckets {
+		name := names.get("", "Bucket-"+bucket.Name)
+		var publicAccessBlock map[string]bool
+		if len(bucket.PublicAccessBlock) > 0 {
+			publicAccessBlock = bucket.PublicAccessBlock
+		}
+		template.Resources[name] = &cfnResource{
+			Type: "AWS::S3::Bucket",
+			Properties: properties(map[string]interface{}{
+				"BucketName":                     bucket.Name,
+				"PublicAccessBlockConfiguration": publicAccessBlock,
+				"Tags":                           cfnTags(bucket.Tags),
+			}),
+		}
+		if len(bucket.Policy) > 0 {
+			template.Resources[names.get("", name+"Policy")] = &cfnResource{
+				Type: "AWS::S3::BucketPolicy",
+				Properties: map[string]interface{}{
+					"Bucket":         map[string]string{"Ref": name},
+					"PolicyDocument": bucket.Policy,
+				},
+			}
+		}
+	}
+
+	// CloudFormation can't read local files, so the values of the secrets are parameters of the stack
+	for _, secret := range t.Secrets {
+		name := names.get("", "Secret-"+secret.Name)
+		if template.Parameters == nil {
+			template.Parameters = map[string]*cfnParameter{}
+		}
+		template.Parameters[name+"Value"] = &cfnParameter{
+			Type:        "String",
+			NoEcho:      true,
+			Description: fmt.Sprintf("Content of the file '%s'", secret.ValueFile),
+		}
+		template.Resources[name] = &cfnResource{
+			Type: "AWS::SecretsManager::Secret",
+			Properties: properties(map[string]interface{}{
+				"Name":         secret.Name,
+				"Description":  secret.Description,
+				"SecretString": map[string]string{"Ref": name + "Value"},
+				"Tags":         cfnTags(secret.Tags),
+			}),
+		}
+	}
+
+	data, err := json.MarshalIndent(template, "", "  ")
+	if err != nil {
+		return "", err
+	}
+	return string(data) + "\n", nil
+}
+
+// properties removes the properties that aren't set, like the parameters of the AWS CLI commands.
+func properties(values map[string]interface{}) map[string]interface{} {
+	result := map[string]interface{}{}
+	for key, value := range values {
+		switch v := value.(type) {
+		case string:
+			if v == "" {
+				continue
+			}
+		case json.RawMessage:
+			if len(v) == 0 {
+				continue
+			}
+		case []interface{}:
+			if len(v) == 0 {
+				continue
+			}
+		case []map[string]string:
+			if len(v) == 0 {
+				continue
+			}
+		case map[string]bool:
+			if v == nil {
+				continue
+			}
+		}
+		result[key] = value
+	}
+	return result
+}
+
+func cfnTags(tags map[string]string) []map[string]string {
+	result := []map[string]string{}
+	for _, key := range sortedKeys(tags) {
+		result = append(result, map[string]string{"Key": key, "Value": tags[key]})
+	}
+	return result
+}
+
+var cfnSeparators = regexp.MustCompile(`[^a-zA-Z0-9]+`)
+
+// cfnName returns a valid logical ID, which can only contain alphanumeric characters, for the
+// given AWS name.
+func cfnName(value string) string {
+	var b strings.Builder
+	for _, part := range cfnSeparators.Split(value, -1) {
+		if part != "" {
+			b.WriteString(strings.ToUpper(part[:1]) + part[1:])
+		}
+	}
+	return b.String()
+}
@@ -0,0 +1,92 @@
+/*
+Copyright (c) 2024 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// This file contains functions used to implement the '--output-format' command line option.
+
+package iac
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/spf13/cobra"
+
+	"github.com/openshift/rosa/pkg/interactive"
+)
+
+const (
+	CloudFormation = "cloudformation"
+	Terraform      = "terraform"
+	JSON           = "json"
+	FlagName       = "output-format"
+)
+
+var formats = []string{CloudFormation, Terraform, JSON}
+
+var format string
+
+// AddFlag adds the output format flag to the given command.
+func AddFlag(cmd *cobra.Command) {
+	cmd.Flags().StringVar(
+		&format,
+		FlagName,
+		"",
+		fmt.Sprintf("Print the resources of manual mode as a template instead of AWS CLI commands. "+
+			"Allowed formats are %s", strings.Join(formats, ", ")),
+	)
+
+	cmd.RegisterFlagCompletionFunc(FlagName, completion)
+}
+
+func completion(_ *cobra.Command, _ []string, _ string) ([]string, cobra.ShellCompDirective) {
+	return formats, cobra.ShellCompDirectiveDefault
+}
+
+// Enabled returns true if the resources should be printed as a template.
+func Enabled() bool {
+	return format != ""
+}
+
+func Format() string {
+	return format
+}
+
+func SetFormat(value string) {
+	format = value
+}
+
+// Validate checks that the output format is supported and that it is only used in manual mode.
+func Validate(mode string) error {
+	if !Enabled() {
+		return nil
+	}
+	if !isValidFormat(format) {
+		return fmt.Errorf("Invalid output format '%s'. Allowed formats are %s", format, strings.Join(formats, ", "))
+	}
+	if mode != interactive.ModeManual {
+		return fmt.Errorf("--%s param is only supported in manual mode", FlagName)
+	}
+	return nil
+}
+
+func isValidFormat(value string) bool {
+	for _, f := range formats {
+		if f == value {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,266 @@
+/*
+Copyright (c) 2024 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package iac builds infrastructure as code templates with the roles, policies and OIDC resources that
+// manual mode would create with AWS CLI commands, so that they can be provisioned with CloudFormation or
+// Terraform instead.
+package iac
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+)
+
+// Template contains the AWS resources that the manual mode commands would create.
+type Template struct {
+	Roles         []*Role         `json:"roles,omitempty"`
+	Policies      []*Policy       `json:"policies,omitempty"`
+	Attachments   []*Attachment   `json:"role_policy_attachments,omitempty"`
+	OIDCProviders []*OIDCProvider `json:"oidc_providers,omitempty"`
+	Buckets       []*Bucket       `json:"buckets,omitempty"`
+	Objects       []*Object       `json:"objects,omitempty"`
+	Secrets       []*Secret       `json:"secrets,omitempty"`
+}
+
+type Role struct {
+	Name                string            `json:"name"`
+	Path                string            `json:"path,omitempty"`
+	PermissionsBoundary string            `json:"permissions_boundary,omitempty"`
+	AssumeRolePolicy    json.RawMessage   `json:"assume_role_policy"`
+	Tags                map[string]string `json:"tags,omitempty"`
+}
+
+type Policy struct {
+	Name     string            `json:"name"`
+	Path     string            `json:"path,omitempty"`
+	Document json.RawMessage   `json:"document"`
+	Tags     map[string]string `json:"tags,omitempty"`
+}
+
+type Attachment struct {
+	Role      string `json:"role"`
+	PolicyARN string `json:"policy_arn"`
+}
+
+type OIDCProvider struct {
+	URL         string            `json:"url"`
+	ClientIDs   []string          `json:"client_ids"`
+	Thumbprints []string          `json:"thumbprints"`
+	Tags        map[string]string `json:"tags,omitempty"`
+}
+
+type Bucket struct {
+	Name              string            `json:"name"`
+	Region            string            `json:"region,omitempty"`
+	PublicAccessBlock map[string]bool   `json:"public_access_block,omitempty"`
+	Policy            json.RawMessage   `json:"policy,omitempty"`
+	Tags              map[string]string `json:"tags,omitempty"`
+}
+
+type Object struct {
+	Bucket  string            `json:"bucket"`
+	Key     string            `json:"key"`
+	Content string            `json:"content"`
+	Tags    map[string]string `json:"tags,omitempty"`
+}
+
+// Secret is a secret whose value is read from a local file when the template is applied, so that it
+// isn't printed with the template.
+type Secret struct {
+	Name        string            `json:"name"`
+	Description string            `json:"description,omitempty"`
+	Region      string            `json:"region,omitempty"`
+	ValueFile   string            `json:"value_file"`
+	Tags        map[string]string `json:"tags,omitempty"`
+}
+
+// pending holds the resources added by the commands that have run so far, so that commands that
+// call each other, like 'create oidc-config' and 'create oidc-provider', print a single template.
+var pending = &Template{}
+
+// Current returns the template with the resources added so far, that Print writes.
+func Current() *Template {
+	return pending
+}
+
+// AddRole adds a role with the given JSON trust policy.
+func (t *Template) AddRole(name string, path string, permissionsBoundary string, assumeRolePolicy string,
+	tags map[string]string) error {
+	document, err := parseDocument(assumeRolePolicy)
+	if err != nil {
+		return fmt.Errorf("Invalid trust policy of role '%s': %v", name, err)
+	}
+	t.Roles = append(t.Roles, &Role{
+		Name:                name,
+		Path:                path,
+		PermissionsBoundary: permissionsBoundary,
+		AssumeRolePolicy:    document,
+		Tags:                copyTags(tags),
+	})
+	return nil
+}
+
+// AddPolicy adds a customer managed policy with the given JSON document.
+func (t *Template) AddPolicy(name string, path string, document string, tags map[string]string) error {
+	parsed, err := parseDocument(document)
+	if err != nil {
+		return fmt.Errorf("Invalid document of policy '%s': %v", name, err)
+	}
+	t.Policies = append(t.Policies, &Policy{
+		Name:     name,
+		Path:     path,
+		Document: parsed,
+		Tags:     copyTags(tags),
+	})
+	return nil
+}
+
+// AddAttachment attaches a policy to a role. When the policy is created by the template, the
+// attachment references it instead of its ARN.
+func (t *Template) AddAttachment(role string, policyARN string) {
+	t.Attachments = append(t.Attachments, &Attachment{
+		Role:      role,
+		PolicyARN: policyARN,
+	})
+}
+
+// AddOIDCProvider adds an OpenID Connect provider for the given issuer URL.
+func (t *Template) AddOIDCProvider(url string, clientIDs []string, thumbprints []string,
+	tags map[string]string) {
+	t.OIDCProviders = append(t.OIDCProviders, &OIDCProvider{
+		URL:         url,
+		ClientIDs:   clientIDs,
+		Thumbprints: thumbprints,
+		Tags:        copyTags(tags),
+	})
+}
+
+// AddBucket adds an S3 bucket, with an optional JSON bucket policy.
+func (t *Template) AddBucket(name string, region string, publicAccessBlock map[string]bool, policy string,
+	tags map[string]string) error {
+	bucket := &Bucket{
+		Name:              name,
+		Region:            region,
+		PublicAccessBlock: publicAccessBlock,
+		Tags:              copyTags(tags),
+	}
+	if policy != "" {
+		var err error
+		bucket.Policy, err = parseDocument(policy)
+		if err != nil {
+			return fmt.Errorf("Invalid policy of bucket '%s': %v", name, err)
+		}
+	}
+	t.Buckets = append(t.Buckets, bucket)
+	return nil
+}
+
+// AddObject adds an object with the given content to a bucket.
+func (t *Template) AddObject(bucket string, key string, content string, tags map[string]string) {
+	t.Objects = append(t.Objects, &Object{
+		Bucket:  bucket,
+		Key:     key,
+		Content: content,
+		Tags:    copyTags(tags),
+	})
+}
+
+// AddSecret adds a Secrets Manager secret whose value is the content of the given file.
+func (t *Template) AddSecret(name string, description string, region string, valueFile string,
+	tags map[string]string) {
+	t.Secrets = append(t.Secrets, &Secret{
+		Name:        name,
+		Description: description,
+		Region:      region,
+		ValueFile:   valueFile,
+		Tags:        copyTags(tags),
+	})
+}
+
+// findPolicy returns the policy of the template with the given ARN, if any.
+func (t *Template) findPolicy(arn string) *Policy {
+	for _, policy := range t.Policies {
+		path := policy.Path
+		if path == "" {
+			path = "/"
+		}
+		if strings.HasSuffix(arn, fmt.Sprintf(":policy%s%s", path, policy.Name)) {
+			return policy
+		}
+	}
+	return nil
+}
+
+func (t *Template) findRole(name string) *Role {
+	for _, role := range t.Roles {
+		if role.Name == name {
+			return role
+		}
+	}
+	return nil
+}
+
+// parseDocument checks that a policy document is valid JSON.
+func parseDocument(document string) (json.RawMessage, error) {
+	document = strings.TrimSpace(document)
+	if !json.Valid([]byte(document)) {
+		return nil, fmt.Errorf("the document isn't valid JSON")
+	}
+	return json.RawMessage(document), nil
+}
+
+// copyTags returns a copy of the tags, or nil if there are none, so that later changes to the map of
+// the caller don't change the template.
+func copyTags(tags map[string]string) map[string]string {
+	if len(tags) == 0 {
+		return nil
+	}
+	result := make(map[string]string, len(tags))
+	for key, value := range tags {
+		result[key] = value
+	}
+	return result
+}
+
+// Render returns the template in the given format.
+func (t *Template) Render(format string) (string, error) {
+	switch format {
+	case JSON:
+		data, err := json.MarshalIndent(t, "", "  ")
+		if err != nil {
+			return "", err
+		}
+		return string(data) + "\n", nil
+	case Terraform:
+		return t.terraform()
+	case CloudFormation:
+		return t.cloudFormation()
+	}
+	return "", fmt.Errorf("Invalid output format '%s'. Allowed formats are %s", format, strings.Join(formats, ", "))
+}
+
+// Print writes the resources added so far to the standard output, in the format selected with the
+// '--output-format' flag, and forgets them.
+func Print() error {
+	result, err := pending.Render(format)
+	if err != nil {
+		return err
+	}
+	fmt.Print(result)
+	pending = &Template{}
+	return nil
+}
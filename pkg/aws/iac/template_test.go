@@ -0,0 +1,179 @@
+package iac
+
+import (
+	"encoding/json"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/openshift/rosa/pkg/interactive"
+)
+
+const trustPolicy = `{"Version": "2012-10-17", "Statement": [{"Effect": "Allow", "Action": "sts:AssumeRole"}]}`
+const permissionPolicy = `{"Version": "2012-10-17", "Statement": [{"Effect": "Allow", "Action": "s3:GetObject", ` +
+	`"Resource": "arn:aws:s3:::${aws:username}/*"}]}`
+
+var _ = Describe("Template", func() {
+	var template *Template
+
+	BeforeEach(func() {
+		template = &Template{}
+		Expect(template.AddRole("prefix-Installer-Role", "/rosa/", "arn:aws:iam::123456789012:policy/boundary",
+			trustPolicy, map[string]string{
+				"red-hat-managed": "true",
+				"rosa_role_type":  "installer",
+				"description":     "role with spaces in a tag",
+			})).To(Succeed())
+		Expect(template.AddPolicy("prefix-Installer-Role-Policy", "/rosa/", permissionPolicy,
+			map[string]string{"red-hat-managed": "true"})).To(Succeed())
+		template.AddAttachment("prefix-Installer-Role",
+			"arn:aws:iam::123456789012:policy/rosa/prefix-Installer-Role-Policy")
+		template.AddAttachment("prefix-Installer-Role", "arn:aws:iam::aws:policy/service-role/ROSAInstallerPolicy")
+		template.AddOIDCProvider("https://oidc.example.com/abc", []string{"openshift", "sts.amazonaws.com"},
+			[]string{"0123456789"}, map[string]string{"red-hat-managed": "true"})
+	})
+
+	It("OK: records the resources", func() {
+		Expect(template.Roles).To(HaveLen(1))
+		Expect(template.Roles[0].Name).To(Equal("prefix-Installer-Role"))
+		Expect(template.Roles[0].Path).To(Equal("/rosa/"))
+		Expect(template.Roles[0].PermissionsBoundary).To(Equal("arn:aws:iam::123456789012:policy/boundary"))
+		Expect(template.Roles[0].Tags).To(HaveKeyWithValue("rosa_role_type", "installer"))
+		Expect(template.Roles[0].Tags).To(HaveKeyWithValue("description", "role with spaces in a tag"))
+		Expect(string(template.Roles[0].AssumeRolePolicy)).To(Equal(trustPolicy))
+		Expect(template.Policies).To(HaveLen(1))
+		Expect(template.Attachments).To(HaveLen(2))
+		Expect(template.findPolicy(template.Attachments[0].PolicyARN)).To(Equal(template.Policies[0]))
+		Expect(template.findPolicy(template.Attachments[1].PolicyARN)).To(BeNil())
+		Expect(template.OIDCProviders[0].ClientIDs).To(Equal([]string{"openshift", "sts.amazonaws.com"}))
+	})
+
+	It("OK: renders Terraform", func() {
+		result, err := template.Render(Terraform)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result).To(ContainSubstring(`resource "aws_iam_role" "prefix-Installer-Role" {
+  name               = "prefix-Installer-Role"
+  path               = "/rosa/"
+  assume_role_policy = <<EOT
+{
+  "Version": "2012-10-17",`))
+		Expect(result).To(ContainSubstring(`EOT
+  permissions_boundary = "arn:aws:iam::123456789012:policy/boundary"
+  tags                 = {
+    "description"     = "role with spaces in a tag"
+    "red-hat-managed" = "true"
+    "rosa_role_type"  = "installer"
+  }`))
+		Expect(result).To(ContainSubstring(`"Resource": "arn:aws:s3:::$${aws:username}/*"`))
+		Expect(result).To(ContainSubstring(
+			`  role       = aws_iam_role.prefix-Installer-Role.name
+  policy_arn = aws_iam_policy.prefix-Installer-Role-Policy.arn`))
+		Expect(result).To(ContainSubstring(`  policy_arn = "arn:aws:iam::aws:policy/service-role/ROSAInstallerPolicy"`))
+		Expect(result).To(ContainSubstring(`  client_id_list  = ["openshift", "sts.amazonaws.com"]`))
+	})
+
+	It("OK: renders CloudFormation", func() {
+		result, err := template.Render(CloudFormation)
+		Expect(err).NotTo(HaveOccurred())
+		var parsed cfnTemplate
+		Expect(json.Unmarshal([]byte(result), &parsed)).To(Succeed())
+		Expect(parsed.Resources).To(HaveLen(3))
+		role := parsed.Resources["RolePrefixInstallerRole"]
+		Expect(role.Type).To(Equal("AWS::IAM::Role"))
+		Expect(role.Properties["ManagedPolicyArns"]).To(Equal([]interface{}{
+			map[string]interface{}{"Ref": "PolicyPrefixInstallerRolePolicy"},
+			"arn:aws:iam::aws:policy/service-role/ROSAInstallerPolicy",
+		}))
+		Expect(role.Properties["Tags"]).To(ContainElement(
+			map[string]interface{}{"Key": "description", "Value": "role with spaces in a tag"}))
+		Expect(parsed.Resources["PolicyPrefixInstallerRolePolicy"].Type).To(Equal("AWS::IAM::ManagedPolicy"))
+		Expect(parsed.Resources["OIDCProviderOidcExampleComAbc"].Type).To(Equal("AWS::IAM::OIDCProvider"))
+	})
+
+	It("OK: renders JSON", func() {
+		result, err := template.Render(JSON)
+		Expect(err).NotTo(HaveOccurred())
+		var parsed Template
+		Expect(json.Unmarshal([]byte(result), &parsed)).To(Succeed())
+		Expect(parsed.Roles[0].Name).To(Equal("prefix-Installer-Role"))
+	})
+
+	It("KO: fails for invalid policy documents", func() {
+		err := template.AddPolicy("broken", "", "file://broken.json", nil)
+		Expect(err).To(MatchError(ContainSubstring("Invalid document of policy 'broken'")))
+	})
+
+	Context("OIDC configuration", func() {
+		BeforeEach(func() {
+			template = &Template{}
+			Expect(template.AddBucket("bucket", "us-east-2", map[string]bool{"BlockPublicAcls": true},
+				`{"Version": "2012-10-17", "Statement": []}`,
+				map[string]string{"red-hat-managed": "true"})).To(Succeed())
+			template.AddObject("bucket", "keys.json", "{}", map[string]string{"red-hat-managed": "true"})
+			template.AddSecret("bucket-key", "Secret for bucket", "us-east-2", "bucket.key",
+				map[string]string{"red-hat-managed": "true"})
+		})
+
+		It("OK: renders Terraform that reads the secret from its file", func() {
+			result, err := template.Render(Terraform)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result).To(ContainSubstring(`resource "aws_s3_object" "bucket-keys_json" {
+  bucket  = aws_s3_bucket.bucket.id
+  key     = "keys.json"
+  content = <<EOT
+{}
+EOT`))
+			Expect(result).To(ContainSubstring(`secret_string = file("bucket.key")`))
+		})
+
+		It("OK: renders JSON that references the secret file", func() {
+			result, err := template.Render(JSON)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result).To(ContainSubstring(`"value_file": "bucket.key"`))
+		})
+
+		It("KO: fails for S3 objects in CloudFormation", func() {
+			_, err := template.Render(CloudFormation)
+			Expect(err).To(MatchError(ContainSubstring("CloudFormation can't create S3 objects")))
+		})
+
+		It("OK: renders CloudFormation that takes the secret as a parameter", func() {
+			template.Objects = nil
+			result, err := template.Render(CloudFormation)
+			Expect(err).NotTo(HaveOccurred())
+			var parsed cfnTemplate
+			Expect(json.Unmarshal([]byte(result), &parsed)).To(Succeed())
+			Expect(parsed.Parameters).To(HaveLen(1))
+			for name, parameter := range parsed.Parameters {
+				Expect(parameter.NoEcho).To(BeTrue())
+				Expect(parsed.Resources[name[:len(name)-len("Value")]].Properties["SecretString"]).To(Equal(
+					map[string]interface{}{"Ref": name}))
+			}
+		})
+	})
+})
+
+var _ = Describe("Validate", func() {
+	AfterEach(func() {
+		SetFormat("")
+	})
+
+	It("OK: allows no format in any mode", func() {
+		Expect(Validate(interactive.ModeAuto)).To(Succeed())
+	})
+
+	It("OK: allows a format in manual mode", func() {
+		SetFormat(Terraform)
+		Expect(Validate(interactive.ModeManual)).To(Succeed())
+	})
+
+	It("KO: fails outside manual mode", func() {
+		SetFormat(Terraform)
+		Expect(Validate(interactive.ModeAuto)).To(MatchError("--output-format param is only supported in manual mode"))
+	})
+
+	It("KO: fails for unknown formats", func() {
+		SetFormat("pulumi")
+		Expect(Validate(interactive.ModeManual)).To(MatchError(ContainSubstring("Invalid output format 'pulumi'")))
+	})
+})
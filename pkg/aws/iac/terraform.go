@@ -0,0 +1,289 @@
+package iac
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// hclBlock is a Terraform block whose attributes are aligned like 'terraform fmt' does.
+type hclBlock struct {
+	header string
+	names  []string
+	values []string
+}
+
+func (b *hclBlock) set(name string, value string) {
+	b.names = append(b.names, name)
+	b.values = append(b.values, value)
+}
+
+// setString sets the attribute only when the value isn't empty, like the parameters of the AWS
+// CLI commands.
+func (b *hclBlock) setString(name string, value string) {
+	if value != "" {
+		b.set(name, hclString(value))
+	}
+}
+
+func (b *hclBlock) setTags(tags map[string]string) {
+	if len(tags) > 0 {
+		b.set("tags", hclMap(tags, "  "))
+	}
+}
+
+func (b *hclBlock) String() string {
+	var s strings.Builder
+	fmt.Fprintf(&s, "%s {\n", b.header)
+	// Like 'terraform fmt', attributes are aligned in groups that end after a multi-line value
+	start := 0
+	for start < len(b.names) {
+		end := start
+		width := 0
+		for end < len(b.names) {
+			if len(b.names[end]) > width {
+				width = len(b.names[end])
+			}
+			end++
+			if strings.Contains(b.values[end-1], "\n") {
+				break
+			}
+		}
+		for i := start; i < end; i++ {
+			fmt.Fprintf(&s, "  %-*s = %s\n", width, b.names[i], b.values[i])
+		}
+		start = end
+	}
+	s.WriteString("}\n")
+	return s.String()
+}
+
+// terraform returns the template as Terraform configuration for the AWS provider.
+func (t *Template) terraform() (string, error) {
+	blocks := []*hclBlock{}
+	names := newNames(tfName)
+
+	roles := map[*Role]string{}
+	for _, role := range t.Roles {
+		name := names.get("aws_iam_role", role.Name)
+		roles[role] = name
+		block := &hclBlock{header: fmt.Sprintf("resource \"aws_iam_role\" \"%s\"", name)}
+		block.setString("name", role.Name)
+		block.setString("path", role.Path)
+		block.set("assume_role_policy", hclHeredoc(indentJSON(role.AssumeRolePolicy)))
+		block.setString("permissions_boundary", role.PermissionsBoundary)
+		block.setTags(role.Tags)
+		blocks = append(blocks, block)
+	}
+
+	policies := map[*Policy]string{}
+	for _, policy := range t.Policies {
+		name := names.get("aws_iam_policy", policy.Name)
+		policies[policy] = name
+		block := &hclBlock{header: fmt.Sprintf("resource \"aws_iam_policy\" \"%s\"", name)}
+		block.setString("name", policy.Name)
+		block.setString("path", policy.Path)
+		block.set("policy", hclHeredoc(indentJSON(policy.Document)))
+		block.setTags(policy.Tags)
+		blocks = append(blocks, block)
+	}
+
+	for _, attachment := range t.Attachments {
+		name := names.get("aws_iam_role_policy_attachment", attachment.Role+"-"+arnName(attachment.PolicyARN))
+		block := &hclBlock{header: fmt.Sprintf("resource \"aws_iam_role_policy_attachment\" \"%s\"", name)}
+		if role := t.findRole(attachment.Role); role != nil {
+			block.set("role", fmt.Sprintf("aws_iam_role.%s.name", roles[role]))
+		} else {
+			block.setString("role", attachment.Role)
+		}
+		if policy := t.findPolicy(attachment.PolicyARN); policy != nil {
+			block.set("policy_arn", fmt.Sprintf("aws_iam_policy.%s.arn", policies[policy]))
+		} else {
+			block.setString("policy_arn", attachment.PolicyARN)
+		}
+		blocks = append(blocks, block)
+	}
+
+	for _, provider := range t.OIDCProviders {
+		name := names.get("aws_iam_openid_connect_provider", strings.TrimPrefix(provider.URL, "https://"))
+		block := &hclBlock{header: fmt.Sprintf("resource \"aws_iam_openid_connect_provider\" \"%s\"", name)}
+		block.setString("url", provider.URL)
+		block.set("client_id_list", hclList(provider.ClientIDs))
+		block.set("thumbprint_list", hclList(provider.Thumbprints))
+		block.setTags(provider.Tags)
+		blocks = append(blocks, block)
+	}
+
+	buckets := map[string]string{}
+	for _, bucket := range t.Buckets {
+		name := names.get("aws_s3_bucket", bucket.Name)
+		buckets[bucket.Name] = name
+		block := &hclBlock{header: fmt.Sprintf("resource \"aws_s3_bucket\" \"%s\"", name)}
+		if bucket.Region != "" {
+			// The region of the bucket is the region of the provider
+			block.header = fmt.Sprintf("# Region: %s\n%s", bucket.Region, block.header)
+		}
+		block.setString("bucket", bucket.Name)
+		block.setTags(bucket.Tags)
+		blocks = append(blocks, block)
+
+		reference := fmt.Sprintf("aws_s3_bucket.%s.id", name)
+		if len(bucket.PublicAccessBlock) > 0 {
+			block = &hclBlock{header: fmt.Sprintf("resource \"aws_s3_bucket_public_access_block\" \"%s\"", name)}
+			block.set("bucket", reference)
+			for _, setting := range sortedKeys(bucket.PublicAccessBlock) {
+				block.set(snakeCase(setting), strconv.FormatBool(bucket.PublicAccessBlock[setting]))
+			}
+			blocks = append(blocks, block)
+		}
+		if len(bucket.Policy) > 0 {
+			block = &hclBlock{header: fmt.Sprintf("resource \"aws_s3_bucket_policy\" \"%s\"", name)}
+			block.set("bucket", reference)
+			block.set("policy", hclHeredoc(indentJSON(bucket.Policy)))
+			blocks = append(blocks, block)
+		}
+	}
+
+	for _, object := range t.Objects {
+		name := names.get("aws_s3_object", object.Bucket+"-"+object.Key)
+		block := &hclBlock{header: fmt.Sprintf("resource \"aws_s3_object\" \"%s\"", name)}
+		if bucket, ok := buckets[object.Bucket]; ok {
+			block.set("bucket", fmt.Sprintf("aws_s3_bucket.%s.id", bucket))
+		} else {
+			block.setString("bucket", object.Bucket)
+		}
+		block.setString("key", object.Key)
+		block.set("content", hclHeredoc(object.Content))
+		block.setTags(object.Tags)
+		blocks = append(blocks, block)
+	}
+
+	for _, secret := range t.Secrets {
+		name := names.get("aws_secretsmanager_secret", secret.Name)
+		block := &hclBlock{header: fmt.Sprintf("resource \"aws_secretsmanager_secret\" \"%s\"", name)}
+		if secret.Region != "" {
+			block.header = fmt.Sprintf("# Region: %s\n%s", secret.Region, block.header)
+		}
+		block.setString("name", secret.Name)
+		block.setString("description", secret.Description)
+		block.setTags(secret.Tags)
+		blocks = append(blocks, block)
+
+		block = &hclBlock{header: fmt.Sprintf("resource \"aws_secretsmanager_secret_version\" \"%s\"", name)}
+		block.set("secret_id", fmt.Sprintf("aws_secretsmanager_secret.%s.id", name))
+		block.set("secret_string", fmt.Sprintf("file(%s)", hclString(secret.ValueFile)))
+		blocks = append(blocks, block)
+	}
+
+	result := make([]string, len(blocks))
+	for i, block := range blocks {
+		result[i] = block.String()
+	}
+	return strings.Join(result, "\n"), nil
+}
+
+// hclEscape escapes the sequences that Terraform would interpret as templates, like the policy
+// variables of IAM documents.
+func hclEscape(value string) string {
+	value = strings.ReplaceAll(value, "${", "$${")
+	return strings.ReplaceAll(value, "%{", "%%{")
+}
+
+func hclString(value string) string {
+	return hclEscape(strconv.Quote(value))
+}
+
+func hclHeredoc(value string) string {
+	return fmt.Sprintf("<<EOT\n%s\nEOT", hclEscape(strings.TrimRight(value, "\n")))
+}
+
+func hclList(values []string) string {
+	quoted := make([]string, len(values))
+	for i, value := range values {
+		quoted[i] = hclString(value)
+	}
+	return fmt.Sprintf("[%s]", strings.Join(quoted, ", "))
+}
+
+func hclMap(values map[string]string, indent string) string {
+	keys := sortedKeys(values)
+	width := 0
+	for _, key := range keys {
+		if len(hclString(key)) > width {
+			width = len(hclString(key))
+		}
+	}
+	var s strings.Builder
+	s.WriteString("{\n")
+	for _, key := range keys {
+		fmt.Fprintf(&s, "%s  %-*s = %s\n", indent, width, hclString(key), hclString(values[key]))
+	}
+	fmt.Fprintf(&s, "%s}", indent)
+	return s.String()
+}
+
+var tfInvalidChars = regexp.MustCompile(`[^a-zA-Z0-9_-]`)
+
+// tfName returns a valid Terraform resource name for the given AWS name.
+func tfName(value string) string {
+	value = tfInvalidChars.ReplaceAllString(value, "_")
+	if value == "" || !(value[0] == '_' || value[0] >= 'a' && value[0] <= 'z' || value[0] >= 'A' && value[0] <= 'Z') {
+		value = "_" + value
+	}
+	return value
+}
+
+var upperCase = regexp.MustCompile(`([a-z0-9])([A-Z])`)
+
+// snakeCase converts the names used by the AWS CLI, like 'BlockPublicAcls', to the ones used by
+// Terraform, like 'block_public_acls'.
+func snakeCase(value string) string {
+	return strings.ToLower(upperCase.ReplaceAllString(value, "${1}_${2}"))
+}
+
+func indentJSON(document json.RawMessage) string {
+	var b bytes.Buffer
+	err := json.Indent(&b, document, "", "  ")
+	if err != nil {
+		return string(document)
+	}
+	return b.String()
+}
+
+// arnName returns the last segment of the resource of an ARN, like the name of a policy.
+func arnName(arn string) string {
+	return arn[strings.LastIndexAny(arn, "/:")+1:]
+}
+
+func sortedKeys[V any](values map[string]V) []string {
+	keys := make([]string, 0, len(values))
+	for key := range values {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	return keys
+}
+
+// names generates unique resource names for every resource type.
+type names struct {
+	convert func(string) string
+	used    map[string]bool
+}
+
+func newNames(convert func(string) string) *names {
+	return &names{convert: convert, used: map[string]bool{}}
+}
+
+func (n *names) get(resourceType string, value string) string {
+	name := n.convert(value)
+	candidate := name
+	for i := 2; n.used[resourceType+"."+candidate]; i++ {
+		candidate = fmt.Sprintf("%s%d", name, i)
+	}
+	n.used[resourceType+"."+candidate] = true
+	return candidate
+}
@@ -0,0 +1,162 @@
+package aws
+
+// iamActionCatalog contains the IAM actions of the services that are used by the ROSA policies, indexed by
+// service. It is used to expand the wildcards in the actions of a policy, so it only needs to be updated
+// when the ROSA policies start using a new service. Actions of other services are reported as written in
+// the policy.
+var iamActionCatalog = map[string][]string{
+	"autoscaling": {
+		"AttachInstances", "AttachLoadBalancerTargetGroups", "AttachLoadBalancers",
+		"CompleteLifecycleAction", "CreateAutoScalingGroup", "CreateLaunchConfiguration",
+		"CreateOrUpdateTags", "DeleteAutoScalingGroup", "DeleteLaunchConfiguration", "DeleteLifecycleHook",
+		"DeleteTags", "DescribeAccountLimits", "DescribeAutoScalingGroups", "DescribeAutoScalingInstances",
+		"DescribeLaunchConfigurations", "DescribeLifecycleHooks", "DescribeLoadBalancerTargetGroups",
+		"DescribeLoadBalancers", "DescribeScalingActivities", "DescribeTags", "DetachInstances",
+		"DetachLoadBalancerTargetGroups", "DetachLoadBalancers", "PutLifecycleHook", "SetDesiredCapacity",
+		"TerminateInstanceInAutoScalingGroup", "UpdateAutoScalingGroup",
+	},
+	"cloudtrail": {
+		"CreateTrail", "DeleteTrail", "DescribeTrails", "GetEventSelectors", "GetTrail", "GetTrailStatus",
+		"ListTags", "ListTrails", "LookupEvents", "PutEventSelectors", "StartLogging", "StopLogging",
+		"UpdateTrail",
+	},
+	"cloudwatch": {
+		"DeleteAlarms", "DescribeAlarmHistory", "DescribeAlarms", "DescribeAlarmsForMetric",
+		"GetDashboard", "GetMetricData", "GetMetricStatistics", "ListDashboards", "ListMetrics",
+		"PutDashboard", "PutMetricAlarm", "PutMetricData",
+	},
+	"ec2": {
+		"AllocateAddress", "AllocateHosts", "AssignPrivateIpAddresses", "AssociateAddress",
+		"AssociateDhcpOptions", "AssociateIamInstanceProfile", "AssociateRouteTable",
+		"AssociateSubnetCidrBlock", "AssociateVpcCidrBlock", "AttachInternetGateway",
+		"AttachNetworkInterface", "AttachVolume", "AuthorizeSecurityGroupEgress",
+		"AuthorizeSecurityGroupIngress", "CancelSpotInstanceRequests", "CopyImage", "CopySnapshot",
+		"CreateCarrierGateway", "CreateDhcpOptions", "CreateEgressOnlyInternetGateway", "CreateFleet",
+		"CreateImage", "CreateInternetGateway", "CreateKeyPair", "CreateLaunchTemplate",
+		"CreateLaunchTemplateVersion", "CreateNatGateway", "CreateNetworkAcl", "CreateNetworkAclEntry",
+		"CreateNetworkInterface", "CreateNetworkInterfacePermission", "CreatePlacementGroup", "CreateRoute",
+		"CreateRouteTable", "CreateSecurityGroup", "CreateSnapshot", "CreateSubnet", "CreateTags",
+		"CreateVolume", "CreateVpc", "CreateVpcEndpoint", "CreateVpcEndpointServiceConfiguration",
+		"DeleteCarrierGateway", "DeleteDhcpOptions", "DeleteEgressOnlyInternetGateway", "DeleteFleets",
+		"DeleteInternetGateway", "DeleteKeyPair", "DeleteLaunchTemplate", "DeleteLaunchTemplateVersions",
+		"DeleteNatGateway", "DeleteNetworkAcl", "DeleteNetworkAclEntry", "DeleteNetworkInterface",
+		"DeletePlacementGroup", "DeleteRoute", "DeleteRouteTable", "DeleteSecurityGroup", "DeleteSnapshot",
+		"DeleteSubnet", "DeleteTags", "DeleteVolume", "DeleteVpc", "DeleteVpcEndpointServiceConfigurations",
+		"DeleteVpcEndpoints", "DeregisterImage", "DescribeAccountAttributes", "DescribeAddresses",
+		"DescribeAvailabilityZones", "DescribeCapacityReservations", "DescribeCarrierGateways",
+		"DescribeDhcpOptions", "DescribeEgressOnlyInternetGateways", "DescribeFleets", "DescribeHosts",
+		"DescribeIamInstanceProfileAssociations", "DescribeImages", "DescribeInstanceAttribute",
+		"DescribeInstanceCreditSpecifications", "DescribeInstanceStatus", "DescribeInstanceTypeOfferings",
+		"DescribeInstanceTypes", "DescribeInstances", "DescribeInternetGateways", "DescribeKeyPairs",
+		"DescribeLaunchTemplateVersions", "DescribeLaunchTemplates", "DescribeNatGateways",
+		"DescribeNetworkAcls", "DescribeNetworkInterfaceAttribute", "DescribeNetworkInterfaces",
+		"DescribePlacementGroups", "DescribePrefixLists", "DescribeRegions", "DescribeReservedInstances",
+		"DescribeRouteTables", "DescribeSecurityGroupRules", "DescribeSecurityGroups",
+		"DescribeSnapshots", "DescribeSpotInstanceRequests", "DescribeSpotPriceHistory", "DescribeSubnets",
+		"DescribeTags", "DescribeVolumeStatus", "DescribeVolumes", "DescribeVolumesModifications",
+		"DescribeVpcAttribute", "DescribeVpcClassicLink", "DescribeVpcClassicLinkDnsSupport",
+		"DescribeVpcEndpointConnections", "DescribeVpcEndpointServiceConfigurations",
+		"DescribeVpcEndpointServicePermissions", "DescribeVpcEndpointServices", "DescribeVpcEndpoints",
+		"DescribeVpcPeeringConnections", "DescribeVpcs", "DetachInternetGateway", "DetachNetworkInterface",
+		"DetachVolume", "DisassociateAddress", "DisassociateIamInstanceProfile",
+		"DisassociateRouteTable", "DisassociateSubnetCidrBlock", "DisassociateVpcCidrBlock",
+		"GetConsoleOutput", "GetEbsDefaultKmsKeyId", "GetLaunchTemplateData", "GetPasswordData",
+		"ImportKeyPair", "ModifyInstanceAttribute", "ModifyInstanceMetadataOptions",
+		"ModifyNetworkInterfaceAttribute", "ModifySubnetAttribute", "ModifyVolume", "ModifyVpcAttribute",
+		"ModifyVpcEndpoint", "ModifyVpcEndpointServiceConfiguration",
+		"ModifyVpcEndpointServicePermissions", "RebootInstances", "RegisterImage", "ReleaseAddress",
+		"ReleaseHosts", "ReplaceRoute", "ReplaceRouteTableAssociation", "RequestSpotInstances",
+		"ResetInstanceAttribute", "RevokeSecurityGroupEgress", "RevokeSecurityGroupIngress",
+		"RunInstances", "StartInstances", "StopInstances", "TerminateInstances",
+		"UnassignPrivateIpAddresses",
+	},
+	"elasticloadbalancing": {
+		"AddListenerCertificates", "AddTags", "ApplySecurityGroupsToLoadBalancer",
+		"AttachLoadBalancerToSubnets", "ConfigureHealthCheck", "CreateListener", "CreateLoadBalancer",
+		"CreateLoadBalancerListeners", "CreateLoadBalancerPolicy", "CreateRule", "CreateTargetGroup",
+		"DeleteListener", "DeleteLoadBalancer", "DeleteLoadBalancerListeners", "DeleteLoadBalancerPolicy",
+		"DeleteRule", "DeleteTargetGroup", "DeregisterInstancesFromLoadBalancer", "DeregisterTargets",
+		"DescribeAccountLimits", "DescribeInstanceHealth", "DescribeListenerCertificates",
+		"DescribeListeners", "DescribeLoadBalancerAttributes", "DescribeLoadBalancerPolicies",
+		"DescribeLoadBalancerPolicyTypes", "DescribeLoadBalancers", "DescribeRules",
+		"DescribeSSLPolicies", "DescribeTags", "DescribeTargetGroupAttributes", "DescribeTargetGroups",
+		"DescribeTargetHealth", "DetachLoadBalancerFromSubnets", "DisableAvailabilityZonesForLoadBalancer",
+		"EnableAvailabilityZonesForLoadBalancer", "ModifyListener", "ModifyLoadBalancerAttributes",
+		"ModifyRule", "ModifyTargetGroup", "ModifyTargetGroupAttributes", "RegisterInstancesWithLoadBalancer",
+		"RegisterTargets", "RemoveListenerCertificates", "RemoveTags", "SetIpAddressType",
+		"SetLoadBalancerListenerSSLCertificate", "SetLoadBalancerPoliciesForBackendServer",
+		"SetLoadBalancerPoliciesOfListener", "SetRulePriorities", "SetSecurityGroups", "SetSubnets",
+	},
+	"iam": {
+		"AddRoleToInstanceProfile", "AttachRolePolicy", "AttachUserPolicy", "CreateAccessKey",
+		"CreateInstanceProfile", "CreateOpenIDConnectProvider", "CreatePolicy", "CreatePolicyVersion",
+		"CreateRole", "CreateServiceLinkedRole", "CreateUser", "DeleteAccessKey", "DeleteInstanceProfile",
+		"DeleteOpenIDConnectProvider", "DeletePolicy", "DeletePolicyVersion", "DeleteRole",
+		"DeleteRolePermissionsBoundary", "DeleteRolePolicy", "DeleteServiceLinkedRole", "DeleteUser",
+		"DeleteUserPolicy", "DetachRolePolicy", "DetachUserPolicy", "GetAccessKeyLastUsed",
+		"GetAccountSummary", "GetContextKeysForPrincipalPolicy", "GetInstanceProfile",
+		"GetOpenIDConnectProvider", "GetPolicy", "GetPolicyVersion", "GetRole", "GetRolePolicy", "GetUser",
+		"GetUserPolicy", "ListAccessKeys", "ListAttachedRolePolicies", "ListAttachedUserPolicies",
+		"ListInstanceProfileTags", "ListInstanceProfiles", "ListInstanceProfilesForRole",
+		"ListOpenIDConnectProviderTags", "ListOpenIDConnectProviders", "ListPolicies", "ListPolicyTags",
+		"ListPolicyVersions", "ListRolePolicies", "ListRoleTags", "ListRoles", "ListUserPolicies",
+		"ListUserTags", "ListUsers", "PassRole", "PutRolePermissionsBoundary", "PutRolePolicy",
+		"PutUserPolicy", "RemoveRoleFromInstanceProfile", "SetDefaultPolicyVersion",
+		"SimulatePrincipalPolicy", "TagInstanceProfile", "TagOpenIDConnectProvider", "TagPolicy", "TagRole",
+		"TagUser", "UntagInstanceProfile", "UntagOpenIDConnectProvider", "UntagPolicy", "UntagRole",
+		"UntagUser", "UpdateAssumeRolePolicy", "UpdateOpenIDConnectProviderThumbprint", "UpdateRole",
+	},
+	"kms": {
+		"CancelKeyDeletion", "CreateAlias", "CreateGrant", "CreateKey", "Decrypt", "DeleteAlias",
+		"DescribeKey", "DisableKey", "EnableKey", "Encrypt", "GenerateDataKey",
+		"GenerateDataKeyWithoutPlaintext", "GetKeyPolicy", "GetKeyRotationStatus", "ListAliases",
+		"ListGrants", "ListKeyPolicies", "ListKeys", "ListResourceTags", "PutKeyPolicy", "ReEncryptFrom",
+		"ReEncryptTo", "RetireGrant", "RevokeGrant", "ScheduleKeyDeletion", "TagResource", "UntagResource",
+		"UpdateAlias",
+	},
+	"route53": {
+		"ActivateKeySigningKey", "AssociateVPCWithHostedZone", "ChangeResourceRecordSets",
+		"ChangeTagsForResource", "CreateHealthCheck", "CreateHostedZone",
+		"CreateVPCAssociationAuthorization", "DeleteHealthCheck", "DeleteHostedZone",
+		"DeleteVPCAssociationAuthorization", "DisassociateVPCFromHostedZone", "GetAccountLimit", "GetChange",
+		"GetHealthCheck", "GetHostedZone", "GetHostedZoneCount", "GetHostedZoneLimit", "ListHealthChecks",
+		"ListHostedZones", "ListHostedZonesByName", "ListHostedZonesByVPC", "ListResourceRecordSets",
+		"ListTagsForResource", "ListTagsForResources", "ListVPCAssociationAuthorizations",
+		"UpdateHealthCheck", "UpdateHostedZoneComment",
+	},
+	"s3": {
+		"AbortMultipartUpload", "CreateBucket", "DeleteBucket", "DeleteBucketPolicy", "DeleteObject",
+		"DeleteObjectTagging", "DeleteObjectVersion", "GetAccelerateConfiguration", "GetBucketAcl",
+		"GetBucketCORS", "GetBucketLocation", "GetBucketLogging", "GetBucketObjectLockConfiguration",
+		"GetBucketOwnershipControls", "GetBucketPolicy", "GetBucketPolicyStatus",
+		"GetBucketPublicAccessBlock", "GetBucketRequestPayment", "GetBucketTagging", "GetBucketVersioning",
+		"GetBucketWebsite", "GetEncryptionConfiguration", "GetLifecycleConfiguration", "GetObject",
+		"GetObjectAcl", "GetObjectTagging", "GetObjectVersion", "GetReplicationConfiguration",
+		"ListAllMyBuckets", "ListBucket", "ListBucketMultipartUploads", "ListBucketVersions",
+		"ListMultipartUploadParts", "PutBucketAcl", "PutBucketOwnershipControls", "PutBucketPolicy",
+		"PutBucketPublicAccessBlock", "PutBucketTagging", "PutBucketVersioning",
+		"PutEncryptionConfiguration", "PutLifecycleConfiguration", "PutObject", "PutObjectAcl",
+		"PutObjectTagging", "ReplicateObject", "RestoreObject",
+	},
+	"secretsmanager": {
+		"CreateSecret", "DeleteSecret", "DescribeSecret", "GetResourcePolicy", "GetSecretValue",
+		"ListSecretVersionIds", "ListSecrets", "PutResourcePolicy", "PutSecretValue", "RestoreSecret",
+		"TagResource", "UntagResource", "UpdateSecret",
+	},
+	"servicequotas": {
+		"GetAWSDefaultServiceQuota", "GetAssociationForServiceQuotaTemplate", "GetRequestedServiceQuotaChange",
+		"GetServiceQuota", "GetServiceQuotaIncreaseRequestFromTemplate", "ListAWSDefaultServiceQuotas",
+		"ListRequestedServiceQuotaChangeHistory", "ListRequestedServiceQuotaChangeHistoryByQuota",
+		"ListServiceQuotaIncreaseRequestsInTemplate", "ListServiceQuotas", "ListServices",
+		"ListTagsForResource", "RequestServiceQuotaIncrease",
+	},
+	"sts": {
+		"AssumeRole", "AssumeRoleWithSAML", "AssumeRoleWithWebIdentity", "DecodeAuthorizationMessage",
+		"GetAccessKeyInfo", "GetCallerIdentity", "GetFederationToken", "GetServiceBearerToken",
+		"GetSessionToken", "TagSession",
+	},
+	"tag": {
+		"GetComplianceSummary", "GetResources", "GetTagKeys", "GetTagValues", "TagResources",
+		"UntagResources",
+	},
+}
@@ -0,0 +1,158 @@
+package aws
+
+import (
+	"regexp"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// Risk classes of the IAM actions allowed by a policy.
+const (
+	PermissionRead     = "read"
+	PermissionWrite    = "write"
+	PermissionDelete   = "delete"
+	PermissionPassRole = "pass-role"
+)
+
+var readActionPrefixes = []string{"Describe", "Get", "List", "Lookup", "Search", "Simulate"}
+
+var deleteActionPrefixes = []string{
+	"Cancel", "Delete", "Deregister", "Detach", "Disable", "Disassociate", "Release", "Remove", "Retire",
+	"Revoke", "Schedule", "Terminate",
+}
+
+// Permission is an IAM action allowed by a policy and its risk class.
+type Permission struct {
+	Action string `json:"action"`
+	Class  string `json:"class"`
+}
+
+// Service returns the service of the action, for example 'ec2' for 'ec2:RunInstances'.
+func (p Permission) Service() string {
+	service, _, _ := strings.Cut(p.Action, ":")
+	return service
+}
+
+// GetPermissions returns the actions allowed by the policy, with the wildcards expanded using the catalog
+// of IAM actions, and their risk class.
+func (p *PolicyDocument) GetPermissions() []Permission {
+	permissions := []Permission{}
+	for _, action := range ExpandActions(p.GetAllowedActions()) {
+		permissions = append(permissions, Permission{
+			Action: action,
+			Class:  ClassifyAction(action),
+		})
+	}
+	return permissions
+}
+
+// ExpandActions returns the actions that match the given ones, which can contain wildcards, sorted and
+// without duplicates. Actions are compared ignoring case, as IAM does, and returned with the case used in
+// the catalog. Actions and wildcards that aren't in the catalog are returned as they are.
+func ExpandActions(actions []string) []string {
+	found := map[string]bool{}
+	result := []string{}
+	for _, action := range actions {
+		for _, expanded := range expandAction(action) {
+			if !found[expanded] {
+				found[expanded] = true
+				result = append(result, expanded)
+			}
+		}
+	}
+	sort.Strings(result)
+	return result
+}
+
+func expandAction(action string) []string {
+	if !strings.ContainsAny(action, "*?") {
+		service, name, _ := strings.Cut(action, ":")
+		for _, catalogName := range iamActionCatalog[strings.ToLower(service)] {
+			if strings.EqualFold(name, catalogName) {
+				return []string{strings.ToLower(service) + ":" + catalogName}
+			}
+		}
+		return []string{action}
+	}
+
+	re := wildcardRegexp(action, true)
+	matches := []string{}
+	for service, names := range iamActionCatalog {
+		for _, name := range names {
+			if re.MatchString(service + ":" + name) {
+				matches = append(matches, service+":"+name)
+			}
+		}
+	}
+	if len(matches) == 0 {
+		return []string{action}
+	}
+	return matches
+}
+
+// wildcardRegexps caches the regular expressions of the IAM patterns, because the same patterns are
+// matched against every action and resource of the evaluated policies.
+var wildcardRegexps sync.Map
+
+// wildcardRegexp returns a regular expression that matches the values matched by an IAM pattern, where '*'
+// matches any sequence of characters and '?' any single character.
+func wildcardRegexp(pattern string, ignoreCase bool) *regexp.Regexp {
+	expression := regexp.QuoteMeta(pattern)
+	expression = strings.ReplaceAll(expression, `\*`, ".*")
+	expression = strings.ReplaceAll(expression, `\?`, ".")
+	expression = "^" + expression + "$"
+	if ignoreCase {
+		expression = "(?i)" + expression
+	}
+	if re, ok := wildcardRegexps.Load(expression); ok {
+		return re.(*regexp.Regexp)
+	}
+	re := regexp.MustCompile(expression)
+	wildcardRegexps.Store(expression, re)
+	return re
+}
+
+// ClassifyAction returns the risk class of an action. Actions that can pass roles to services are the
+// riskiest, because they can be used to escalate privileges. Wildcards that couldn't be expanded are
+// classified as write actions.
+func ClassifyAction(action string) string {
+	service, name, _ := strings.Cut(action, ":")
+	if strings.EqualFold(service, "iam") && strings.HasPrefix(strings.ToLower(name), "pass") {
+		return PermissionPassRole
+	}
+	if strings.ContainsAny(action, "*?") {
+		return PermissionWrite
+	}
+	for _, prefix := range readActionPrefixes {
+		if strings.HasPrefix(name, prefix) {
+			return PermissionRead
+		}
+	}
+	for _, prefix := range deleteActionPrefixes {
+		if strings.HasPrefix(name, prefix) {
+			return PermissionDelete
+		}
+	}
+	return PermissionWrite
+}
+
+// DiffPermissions returns the permissions that are only in the new list and the ones that are only in the
+// old list.
+func DiffPermissions(old []Permission, new []Permission) ([]Permission, []Permission) {
+	return subtractPermissions(new, old), subtractPermissions(old, new)
+}
+
+func subtractPermissions(a []Permission, b []Permission) []Permission {
+	inB := map[string]bool{}
+	for _, permission := range b {
+		inB[permission.Action] = true
+	}
+	result := []Permission{}
+	for _, permission := range a {
+		if !inB[permission.Action] {
+			result = append(result, permission)
+		}
+	}
+	return result
+}
@@ -0,0 +1,52 @@
+package aws
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("IAM actions", func() {
+	It("Expands wildcards using the catalog", func() {
+		Expect(ExpandActions([]string{"iam:Pass*", "route53:get*", "EC2:describevpcs", "sts:AssumeRole",
+			"support:*", "sts:AssumeRole"})).To(Equal([]string{
+			"ec2:DescribeVpcs",
+			"iam:PassRole",
+			"route53:GetAccountLimit",
+			"route53:GetChange",
+			"route53:GetHealthCheck",
+			"route53:GetHostedZone",
+			"route53:GetHostedZoneCount",
+			"route53:GetHostedZoneLimit",
+			"sts:AssumeRole",
+			"support:*",
+		}))
+	})
+
+	It("Classifies the actions", func() {
+		Expect(ClassifyAction("ec2:DescribeInstances")).To(Equal(PermissionRead))
+		Expect(ClassifyAction("ec2:RunInstances")).To(Equal(PermissionWrite))
+		Expect(ClassifyAction("ec2:TerminateInstances")).To(Equal(PermissionDelete))
+		Expect(ClassifyAction("iam:PassRole")).To(Equal(PermissionPassRole))
+		Expect(ClassifyAction("support:*")).To(Equal(PermissionWrite))
+	})
+
+	It("Returns the permissions of a policy and the differences with another one", func() {
+		current, err := ParsePolicyDocument(`{"Statement": [
+			{"Effect": "Allow", "Action": ["ec2:DescribeVpcs", "ec2:DeleteVpc"], "Resource": "*"},
+			{"Effect": "Deny", "Action": "iam:PassRole", "Resource": "*"}
+		]}`)
+		Expect(err).NotTo(HaveOccurred())
+		next, err := ParsePolicyDocument(`{"Statement": [
+			{"Effect": "Allow", "Action": ["ec2:DescribeVpcs", "iam:PassRole"], "Resource": "*"}
+		]}`)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(current.GetPermissions()).To(Equal([]Permission{
+			{Action: "ec2:DeleteVpc", Class: PermissionDelete},
+			{Action: "ec2:DescribeVpcs", Class: PermissionRead},
+		}))
+
+		added, removed := DiffPermissions(current.GetPermissions(), next.GetPermissions())
+		Expect(added).To(Equal([]Permission{{Action: "iam:PassRole", Class: PermissionPassRole}}))
+		Expect(removed).To(Equal([]Permission{{Action: "ec2:DeleteVpc", Class: PermissionDelete}}))
+	})
+})
@@ -17,9 +17,9 @@ limitations under the License.
 package aws
 
 import (
-	"context"
 	"fmt"
 	"net/url"
+	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/iam"
@@ -27,6 +27,7 @@ import (
 	awserr "github.com/openshift-online/ocm-common/pkg/aws/errors"
 
 	"github.com/openshift/rosa/pkg/aws/tags"
+	"github.com/openshift/rosa/pkg/timeout"
 )
 
 const (
@@ -48,7 +49,7 @@ func (c *awsClient) CreateOpenIDConnectProvider(providerURL string, thumbprint s
 			Value: aws.String(clusterID),
 		})
 	}
-	output, err := c.iamClient.CreateOpenIDConnectProvider(context.TODO(), &iam.CreateOpenIDConnectProviderInput{
+	output, err := c.iamClient.CreateOpenIDConnectProvider(timeout.Context(), &iam.CreateOpenIDConnectProviderInput{
 		ClientIDList: []string{
 			OIDCClientIDOpenShift,
 			OIDCClientIDSTSAWS,
@@ -60,6 +61,7 @@ func (c *awsClient) CreateOpenIDConnectProvider(providerURL string, thumbprint s
 	if err != nil {
 		return "", err
 	}
+	timeout.AddCreatedResource("OIDC provider '%s'", aws.ToString(output.OpenIDConnectProviderArn))
 
 	return aws.ToString(output.OpenIDConnectProviderArn), nil
 }
@@ -72,7 +74,7 @@ func (c *awsClient) HasOpenIDConnectProvider(issuerURL string, partition string,
 	providerURL := fmt.Sprintf("%s%s", parsedIssuerURL.Host, parsedIssuerURL.Path)
 
 	oidcProviderARN := GetOIDCProviderARN(partition, accountID, providerURL)
-	output, err := c.iamClient.GetOpenIDConnectProvider(context.TODO(), &iam.GetOpenIDConnectProviderInput{
+	output, err := c.iamClient.GetOpenIDConnectProvider(timeout.Context(), &iam.GetOpenIDConnectProviderInput{
 		OpenIDConnectProviderArn: aws.String(oidcProviderARN),
 	})
 	if err != nil {
@@ -87,8 +89,22 @@ func (c *awsClient) HasOpenIDConnectProvider(issuerURL string, partition string,
 	return true, nil
 }
 
+// GetOpenIDConnectProviderCreateDate returns the date when the OIDC provider was created.
+func (c *awsClient) GetOpenIDConnectProviderCreateDate(oidcProviderARN string) (time.Time, error) {
+	output, err := c.iamClient.GetOpenIDConnectProvider(timeout.Context(), &iam.GetOpenIDConnectProviderInput{
+		OpenIDConnectProviderArn: aws.String(oidcProviderARN),
+	})
+	if err != nil {
+		if awserr.IsNoSuchEntityException(err) {
+			return time.Time{}, fmt.Errorf("The OIDC provider '%s' does not exist", oidcProviderARN)
+		}
+		return time.Time{}, err
+	}
+	return aws.ToTime(output.CreateDate), nil
+}
+
 func (c *awsClient) DeleteOpenIDConnectProvider(oidcProviderARN string) error {
-	_, err := c.iamClient.DeleteOpenIDConnectProvider(context.TODO(), &iam.DeleteOpenIDConnectProviderInput{
+	_, err := c.iamClient.DeleteOpenIDConnectProvider(timeout.Context(), &iam.DeleteOpenIDConnectProviderInput{
 		OpenIDConnectProviderArn: aws.String(oidcProviderARN),
 	})
 	if err != nil {
@@ -12,6 +12,7 @@ package aws
 import (
 	io "io"
 	reflect "reflect"
+	time "time"
 
 	aws "github.com/aws/aws-sdk-go-v2/aws"
 	types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
@@ -721,6 +722,21 @@ func (mr *MockClientMockRecorder) GetLocalAWSAccessKeys() *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetLocalAWSAccessKeys", reflect.TypeOf((*MockClient)(nil).GetLocalAWSAccessKeys))
 }
 
+// GetOnDemandPrices mocks base method.
+func (m *MockClient) GetOnDemandPrices(region string, instanceTypes []string) (map[string]float64, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOnDemandPrices", region, instanceTypes)
+	ret0, _ := ret[0].(map[string]float64)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOnDemandPrices indicates an expected call of GetOnDemandPrices.
+func (mr *MockClientMockRecorder) GetOnDemandPrices(region, instanceTypes any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOnDemandPrices", reflect.TypeOf((*MockClient)(nil).GetOnDemandPrices), region, instanceTypes)
+}
+
 // GetOpenIDConnectProviderByClusterIdTag mocks base method.
 func (m *MockClient) GetOpenIDConnectProviderByClusterIdTag(clusterID string) (string, error) {
 	m.ctrl.T.Helper()
@@ -751,6 +767,21 @@ func (mr *MockClientMockRecorder) GetOpenIDConnectProviderByOidcEndpointUrl(oidc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOpenIDConnectProviderByOidcEndpointUrl", reflect.TypeOf((*MockClient)(nil).GetOpenIDConnectProviderByOidcEndpointUrl), oidcEndpointUrl)
 }
 
+// GetOpenIDConnectProviderCreateDate mocks base method.
+func (m *MockClient) GetOpenIDConnectProviderCreateDate(oidcProviderARN string) (time.Time, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetOpenIDConnectProviderCreateDate", oidcProviderARN)
+	ret0, _ := ret[0].(time.Time)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetOpenIDConnectProviderCreateDate indicates an expected call of GetOpenIDConnectProviderCreateDate.
+func (mr *MockClientMockRecorder) GetOpenIDConnectProviderCreateDate(oidcProviderARN any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetOpenIDConnectProviderCreateDate", reflect.TypeOf((*MockClient)(nil).GetOpenIDConnectProviderCreateDate), oidcProviderARN)
+}
+
 // GetOperatorRolesFromAccountByClusterID mocks base method.
 func (m *MockClient) GetOperatorRolesFromAccountByClusterID(clusterID string, credRequests map[string]*v1.STSOperator) ([]string, error) {
 	m.ctrl.T.Helper()
@@ -855,6 +886,21 @@ func (mr *MockClientMockRecorder) GetSecurityGroupIds(vpcId any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSecurityGroupIds", reflect.TypeOf((*MockClient)(nil).GetSecurityGroupIds), vpcId)
 }
 
+// GetSpotPrices mocks base method.
+func (m *MockClient) GetSpotPrices(instanceTypes, availabilityZones []string, since time.Time) (map[string]*SpotPrice, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSpotPrices", instanceTypes, availabilityZones, since)
+	ret0, _ := ret[0].(map[string]*SpotPrice)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSpotPrices indicates an expected call of GetSpotPrices.
+func (mr *MockClientMockRecorder) GetSpotPrices(instanceTypes, availabilityZones, since any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSpotPrices", reflect.TypeOf((*MockClient)(nil).GetSpotPrices), instanceTypes, availabilityZones, since)
+}
+
 // GetSubnetAvailabilityZone mocks base method.
 func (m *MockClient) GetSubnetAvailabilityZone(subnetID string) (string, error) {
 	m.ctrl.T.Helper()
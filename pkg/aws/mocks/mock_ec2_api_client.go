@@ -120,6 +120,26 @@ func (mr *MockEc2ApiClientMockRecorder) DescribeSecurityGroups(ctx, params any,
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeSecurityGroups", reflect.TypeOf((*MockEc2ApiClient)(nil).DescribeSecurityGroups), varargs...)
 }
 
+// DescribeSpotPriceHistory mocks base method.
+func (m *MockEc2ApiClient) DescribeSpotPriceHistory(ctx context.Context, params *ec2.DescribeSpotPriceHistoryInput, optFns ...func(*ec2.Options)) (*ec2.DescribeSpotPriceHistoryOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, params}
+	for _, a := range optFns {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "DescribeSpotPriceHistory", varargs...)
+	ret0, _ := ret[0].(*ec2.DescribeSpotPriceHistoryOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeSpotPriceHistory indicates an expected call of DescribeSpotPriceHistory.
+func (mr *MockEc2ApiClientMockRecorder) DescribeSpotPriceHistory(ctx, params any, optFns ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, params}, optFns...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeSpotPriceHistory", reflect.TypeOf((*MockEc2ApiClient)(nil).DescribeSpotPriceHistory), varargs...)
+}
+
 // DescribeSubnets mocks base method.
 func (m *MockEc2ApiClient) DescribeSubnets(ctx context.Context, params *ec2.DescribeSubnetsInput, optFns ...func(*ec2.Options)) (*ec2.DescribeSubnetsOutput, error) {
 	m.ctrl.T.Helper()
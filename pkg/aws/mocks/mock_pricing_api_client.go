@@ -0,0 +1,61 @@
+// Code generated by MockGen. DO NOT EDIT.
+// Source: pkg/aws/api_interface/pricing_api_client.go
+//
+// Generated by this command:
+//
+//	mockgen-v0.4.0 -source=pkg/aws/api_interface/pricing_api_client.go -package=mocks -destination=pkg/aws/mocks/mock_pricing_api_client.go
+//
+
+// Package mocks is a generated GoMock package.
+package mocks
+
+import (
+	context "context"
+	reflect "reflect"
+
+	pricing "github.com/aws/aws-sdk-go-v2/service/pricing"
+	gomock "go.uber.org/mock/gomock"
+)
+
+// MockPricingApiClient is a mock of PricingApiClient interface.
+type MockPricingApiClient struct {
+	ctrl     *gomock.Controller
+	recorder *MockPricingApiClientMockRecorder
+}
+
+// MockPricingApiClientMockRecorder is the mock recorder for MockPricingApiClient.
+type MockPricingApiClientMockRecorder struct {
+	mock *MockPricingApiClient
+}
+
+// NewMockPricingApiClient creates a new mock instance.
+func NewMockPricingApiClient(ctrl *gomock.Controller) *MockPricingApiClient {
+	mock := &MockPricingApiClient{ctrl: ctrl}
+	mock.recorder = &MockPricingApiClientMockRecorder{mock}
+	return mock
+}
+
+// EXPECT returns an object that allows the caller to indicate expected use.
+func (m *MockPricingApiClient) EXPECT() *MockPricingApiClientMockRecorder {
+	return m.recorder
+}
+
+// GetProducts mocks base method.
+func (m *MockPricingApiClient) GetProducts(ctx context.Context, params *pricing.GetProductsInput, optFns ...func(*pricing.Options)) (*pricing.GetProductsOutput, error) {
+	m.ctrl.T.Helper()
+	varargs := []any{ctx, params}
+	for _, a := range optFns {
+		varargs = append(varargs, a)
+	}
+	ret := m.ctrl.Call(m, "GetProducts", varargs...)
+	ret0, _ := ret[0].(*pricing.GetProductsOutput)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetProducts indicates an expected call of GetProducts.
+func (mr *MockPricingApiClientMockRecorder) GetProducts(ctx, params any, optFns ...any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	varargs := append([]any{ctx, params}, optFns...)
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetProducts", reflect.TypeOf((*MockPricingApiClient)(nil).GetProducts), varargs...)
+}
@@ -0,0 +1,105 @@
+package aws
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/pricing"
+	pricingtypes "github.com/aws/aws-sdk-go-v2/service/pricing/types"
+
+	"github.com/openshift/rosa/pkg/timeout"
+)
+
+// pricingRegion is the region of the price list API endpoint used for the prices of all the regions.
+const pricingRegion = "us-east-1"
+
+// onDemandPriceFilters select the price of Linux instances on shared hardware without pre-installed
+// software, which is what the nodes of machine pools use.
+var onDemandPriceFilters = map[string]string{
+	"operatingSystem": "Linux",
+	"tenancy":         "Shared",
+	"preInstalledSw":  "NA",
+	"capacitystatus":  "Used",
+	"licenseModel":    "No License required",
+}
+
+// priceListProduct is the part of a product of the price list API that contains the on-demand price.
+type priceListProduct struct {
+	Product struct {
+		Attributes struct {
+			InstanceType string `json:"instanceType"`
+		} `json:"attributes"`
+	} `json:"product"`
+	Terms struct {
+		OnDemand map[string]struct {
+			PriceDimensions map[string]struct {
+				Unit         string            `json:"unit"`
+				PricePerUnit map[string]string `json:"pricePerUnit"`
+			} `json:"priceDimensions"`
+		} `json:"OnDemand"`
+	} `json:"terms"`
+}
+
+// GetOnDemandPrices returns the hourly on-demand prices, in US dollars, of the given instance types in
+// the given region. Instance types without a price are not included in the result.
+func (c *awsClient) GetOnDemandPrices(region string, instanceTypes []string) (map[string]float64, error) {
+	requested := map[string]bool{}
+	for _, instanceType := range instanceTypes {
+		requested[instanceType] = true
+	}
+
+	input := &pricing.GetProductsInput{
+		ServiceCode:   aws.String("AmazonEC2"),
+		FormatVersion: aws.String("aws_v1"),
+		Filters: []pricingtypes.Filter{
+			{
+				Type:  pricingtypes.FilterTypeTermMatch,
+				Field: aws.String("regionCode"),
+				Value: aws.String(region),
+			},
+		},
+	}
+	for field, value := range onDemandPriceFilters {
+		input.Filters = append(input.Filters, pricingtypes.Filter{
+			Type:  pricingtypes.FilterTypeTermMatch,
+			Field: aws.String(field),
+			Value: aws.String(value),
+		})
+	}
+
+	prices := map[string]float64{}
+	paginator := pricing.NewGetProductsPaginator(c.pricingClient, input)
+	for paginator.HasMorePages() {
+		output, err := paginator.NextPage(timeout.Context())
+		if err != nil {
+			return nil, err
+		}
+		for _, entry := range output.PriceList {
+			var product priceListProduct
+			err = json.Unmarshal([]byte(entry), &product)
+			if err != nil {
+				return nil, fmt.Errorf("failed to parse the price list: %v", err)
+			}
+			instanceType := product.Product.Attributes.InstanceType
+			if !requested[instanceType] {
+				continue
+			}
+			for _, term := range product.Terms.OnDemand {
+				for _, dimension := range term.PriceDimensions {
+					if dimension.Unit != "Hrs" {
+						continue
+					}
+					price, err := strconv.ParseFloat(dimension.PricePerUnit["USD"], 64)
+					if err != nil {
+						return nil, fmt.Errorf("failed to parse on-demand price '%s' of instance type '%s': %v",
+							dimension.PricePerUnit["USD"], instanceType, err)
+					}
+					prices[instanceType] = price
+				}
+			}
+		}
+	}
+	return prices, nil
+}
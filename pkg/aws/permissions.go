@@ -1,12 +1,13 @@
 package aws
 
 import (
-	"context"
 	"fmt"
 
 	"github.com/aws/aws-sdk-go-v2/aws/arn"
 	"github.com/aws/aws-sdk-go-v2/service/iam"
 	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+
+	"github.com/openshift/rosa/pkg/timeout"
 )
 
 // SimulateParams captures any additional details that should be used
@@ -57,7 +58,7 @@ func (c *awsClient) ValidateSCP(target *string, policies map[string]*cmv1.AWSSTS
 			}
 		}
 	} else {
-		targetIAMOutput, err := c.iamClient.GetUser(context.Background(), &iam.GetUserInput{UserName: target})
+		targetIAMOutput, err := c.iamClient.GetUser(timeout.Context(), &iam.GetUserInput{UserName: target})
 		if err != nil {
 			return false, fmt.Errorf("iamClient.GetUser: %v\n"+
 				"To reset the '%s' account, run 'rosa init --delete-stack' and try again", *target, err)
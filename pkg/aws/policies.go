@@ -17,11 +17,11 @@ limitations under the License.
 package aws
 
 import (
-	"context"
 	"fmt"
 	"net/url"
 	"regexp"
 	"strings"
+	"time"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/iam"
@@ -34,6 +34,7 @@ import (
 
 	"github.com/openshift/rosa/pkg/aws/tags"
 	"github.com/openshift/rosa/pkg/helper"
+	"github.com/openshift/rosa/pkg/timeout"
 )
 
 var DefaultPrefix = "ManagedOpenShift"
@@ -64,14 +65,18 @@ type Role struct {
 }
 
 type OperatorRoleDetail struct {
-	OperatorName      string   `json:"Name,omitempty"`
-	OperatorNamespace string   `json:"Namespace,omitempty"`
-	Version           string   `json:"Version,omitempty"`
-	RoleName          string   `json:"RoleName,omitempty"`
-	RoleARN           string   `json:"RoleARN,omitempty"`
-	ClusterID         string   `json:"ClusterID,omitempty"`
-	AttachedPolicies  []string `json:"Policy,omitempty"`
-	ManagedPolicy     bool     `json:"ManagedPolicy,omitempty"`
+	OperatorName      string    `json:"Name,omitempty"`
+	OperatorNamespace string    `json:"Namespace,omitempty"`
+	Version           string    `json:"Version,omitempty"`
+	RoleName          string    `json:"RoleName,omitempty"`
+	RoleARN           string    `json:"RoleARN,omitempty"`
+	ClusterID         string    `json:"ClusterID,omitempty"`
+	AttachedPolicies  []string  `json:"Policy,omitempty"`
+	ManagedPolicy     bool      `json:"ManagedPolicy,omitempty"`
+	CreateDate        time.Time `json:"-"`
+	RedHatManaged     bool      `json:"-"`
+	RolePrefix        string    `json:"-"`
+	TrustPolicy       string    `json:"-"`
 }
 
 type PolicyDetail struct {
@@ -145,7 +150,7 @@ var roleTypeMap = map[string]string{
 
 func (c *awsClient) EnsureRole(name string, policy string, permissionsBoundary string,
 	version string, tagList map[string]string, path string, managedPolicies bool) (string, error) {
-	output, err := c.iamClient.GetRole(context.Background(), &iam.GetRoleInput{
+	output, err := c.iamClient.GetRole(timeout.Context(), &iam.GetRoleInput{
 		RoleName: aws.String(name),
 	})
 	if err != nil {
@@ -169,12 +174,12 @@ func (c *awsClient) EnsureRole(name string, policy string, permissionsBoundary s
 	}
 
 	if permissionsBoundary != "" {
-		_, err = c.iamClient.PutRolePermissionsBoundary(context.Background(), &iam.PutRolePermissionsBoundaryInput{
+		_, err = c.iamClient.PutRolePermissionsBoundary(timeout.Context(), &iam.PutRolePermissionsBoundaryInput{
 			RoleName:            aws.String(name),
 			PermissionsBoundary: aws.String(permissionsBoundary),
 		})
 	} else if output.Role.PermissionsBoundary != nil {
-		_, err = c.iamClient.DeleteRolePermissionsBoundary(context.Background(),
+		_, err = c.iamClient.DeleteRolePermissionsBoundary(timeout.Context(),
 			&iam.DeleteRolePermissionsBoundaryInput{
 				RoleName: aws.String(name),
 			})
@@ -197,7 +202,7 @@ func (c *awsClient) EnsureRole(name string, policy string, permissionsBoundary s
 	}
 
 	if needsUpdate || !isCompatible {
-		_, err = c.iamClient.UpdateAssumeRolePolicy(context.Background(), &iam.UpdateAssumeRolePolicyInput{
+		_, err = c.iamClient.UpdateAssumeRolePolicy(timeout.Context(), &iam.UpdateAssumeRolePolicyInput{
 			RoleName:       aws.String(name),
 			PolicyDocument: aws.String(policy),
 		})
@@ -205,7 +210,7 @@ func (c *awsClient) EnsureRole(name string, policy string, permissionsBoundary s
 			return roleArn, err
 		}
 
-		_, err = c.iamClient.TagRole(context.Background(), &iam.TagRoleInput{
+		_, err = c.iamClient.TagRole(timeout.Context(), &iam.TagRoleInput{
 			RoleName: aws.String(name),
 			Tags:     getTags(tagList),
 		})
@@ -218,7 +223,7 @@ func (c *awsClient) EnsureRole(name string, policy string, permissionsBoundary s
 }
 
 func (c *awsClient) ValidateRoleNameAvailable(name string) (err error) {
-	_, err = c.iamClient.GetRole(context.Background(), &iam.GetRoleInput{
+	_, err = c.iamClient.GetRole(timeout.Context(), &iam.GetRoleInput{
 		RoleName: aws.String(name),
 	})
 	if err == nil {
@@ -252,13 +257,14 @@ func (c *awsClient) createRole(name string, policy string, permissionsBoundary s
 	if permissionsBoundary != "" {
 		createRoleInput.PermissionsBoundary = aws.String(permissionsBoundary)
 	}
-	output, err := c.iamClient.CreateRole(context.Background(), createRoleInput)
+	output, err := c.iamClient.CreateRole(timeout.Context(), createRoleInput)
 	if err != nil {
 		if awserr.IsEntityAlreadyExistsException(err) {
 			return "", nil
 		}
 		return "", err
 	}
+	timeout.AddCreatedResource("IAM role '%s'", aws.ToString(output.Role.Arn))
 	return aws.ToString(output.Role.Arn), nil
 }
 
@@ -267,7 +273,7 @@ func (c *awsClient) isRoleCompatible(name string, version string) (bool, error)
 	if version == "" {
 		return true, nil
 	}
-	output, err := c.iamClient.ListRoleTags(context.Background(), &iam.ListRoleTagsInput{
+	output, err := c.iamClient.ListRoleTags(timeout.Context(), &iam.ListRoleTagsInput{
 		RoleName: aws.String(name),
 	})
 	if err != nil {
@@ -278,7 +284,7 @@ func (c *awsClient) isRoleCompatible(name string, version string) (bool, error)
 }
 
 func (c *awsClient) PutRolePolicy(roleName string, policyName string, policy string) error {
-	_, err := c.iamClient.PutRolePolicy(context.Background(), &iam.PutRolePolicyInput{
+	_, err := c.iamClient.PutRolePolicy(timeout.Context(), &iam.PutRolePolicyInput{
 		RoleName:       aws.String(roleName),
 		PolicyName:     aws.String(policyName),
 		PolicyDocument: aws.String(policy),
@@ -335,7 +341,7 @@ func (c *awsClient) ensurePolicyHelper(policyArn string, document string,
 			return policyArn, err
 		}
 
-		_, err = c.iamClient.CreatePolicyVersion(context.Background(), &iam.CreatePolicyVersionInput{
+		_, err = c.iamClient.CreatePolicyVersion(timeout.Context(), &iam.CreatePolicyVersionInput{
 			PolicyArn:      aws.String(policyArn),
 			PolicyDocument: aws.String(document),
 			SetAsDefault:   true,
@@ -344,7 +350,7 @@ func (c *awsClient) ensurePolicyHelper(policyArn string, document string,
 			return policyArn, err
 		}
 
-		_, err = c.iamClient.TagPolicy(context.Background(), &iam.TagPolicyInput{
+		_, err = c.iamClient.TagPolicy(timeout.Context(), &iam.TagPolicyInput{
 			PolicyArn: aws.String(policyArn),
 			Tags:      getTags(tagList),
 		})
@@ -357,7 +363,7 @@ func (c *awsClient) ensurePolicyHelper(policyArn string, document string,
 }
 
 func (c *awsClient) IsPolicyExists(policyArn string) (*iam.GetPolicyOutput, error) {
-	output, err := c.iamClient.GetPolicy(context.Background(),
+	output, err := c.iamClient.GetPolicy(timeout.Context(),
 		&iam.GetPolicyInput{
 			PolicyArn: aws.String(policyArn),
 		})
@@ -365,7 +371,7 @@ func (c *awsClient) IsPolicyExists(policyArn string) (*iam.GetPolicyOutput, erro
 }
 
 func (c *awsClient) IsRolePolicyExists(roleName string, policyName string) (*iam.GetRolePolicyOutput, error) {
-	output, err := c.iamClient.GetRolePolicy(context.Background(), &iam.GetRolePolicyInput{
+	output, err := c.iamClient.GetRolePolicy(timeout.Context(), &iam.GetRolePolicyInput{
 		PolicyName: aws.String(policyName),
 		RoleName:   aws.String(roleName),
 	})
@@ -387,16 +393,17 @@ func (c *awsClient) createPolicy(policyArn string, document string, tagList map[
 		createPolicyInput.Path = aws.String(path)
 	}
 
-	output, err := c.iamClient.CreatePolicy(context.Background(), createPolicyInput)
+	output, err := c.iamClient.CreatePolicy(timeout.Context(), createPolicyInput)
 
 	if err != nil {
 		return "", err
 	}
+	timeout.AddCreatedResource("IAM policy '%s'", aws.ToString(output.Policy.Arn))
 	return aws.ToString(output.Policy.Arn), nil
 }
 
 func (c *awsClient) IsPolicyCompatible(policyArn string, version string) (bool, error) {
-	output, err := c.iamClient.ListPolicyTags(context.Background(), &iam.ListPolicyTagsInput{
+	output, err := c.iamClient.ListPolicyTags(timeout.Context(), &iam.ListPolicyTagsInput{
 		PolicyArn: aws.String(policyArn),
 	})
 	if err != nil {
@@ -439,7 +446,7 @@ func (c *awsClient) hasCompatibleMajorMinorVersionTags(iamTags []iamtypes.Tag, v
 }
 
 func (c *awsClient) AttachRolePolicy(roleName string, policyARN string) error {
-	_, err := c.iamClient.AttachRolePolicy(context.Background(), &iam.AttachRolePolicyInput{
+	_, err := c.iamClient.AttachRolePolicy(timeout.Context(), &iam.AttachRolePolicyInput{
 		RoleName:  aws.String(roleName),
 		PolicyArn: aws.String(policyARN),
 	})
@@ -481,7 +488,7 @@ func (c *awsClient) FindRoleARNsClassic(roleType string, version string) ([]stri
 		if !strings.Contains(aws.ToString(role.RoleName), AccountRoles[roleType].Name) {
 			continue
 		}
-		listRoleTagsOutput, err := c.iamClient.ListRoleTags(context.Background(), &iam.ListRoleTagsInput{
+		listRoleTagsOutput, err := c.iamClient.ListRoleTags(timeout.Context(), &iam.ListRoleTagsInput{
 			RoleName: role.RoleName,
 		})
 		if err != nil {
@@ -510,7 +517,7 @@ func (c *awsClient) FindRoleARNsHostedCp(roleType string, version string) ([]str
 		if !strings.Contains(aws.ToString(role.RoleName), AccountRoles[roleType].Name) {
 			continue
 		}
-		listRoleTagsOutput, err := c.iamClient.ListRoleTags(context.Background(), &iam.ListRoleTagsInput{
+		listRoleTagsOutput, err := c.iamClient.ListRoleTags(timeout.Context(), &iam.ListRoleTagsInput{
 			RoleName: role.RoleName,
 		})
 		if err != nil {
@@ -532,7 +539,7 @@ func (c *awsClient) FindRoleARNsHostedCp(roleType string, version string) ([]str
 // FIXME: refactor similar calls to use this instead
 func (c *awsClient) ValidateAccountRoleVersionCompatibility(
 	roleName string, roleType string, minVersion string) (bool, error) {
-	listRoleTagsOutput, err := c.iamClient.ListRoleTags(context.Background(), &iam.ListRoleTagsInput{
+	listRoleTagsOutput, err := c.iamClient.ListRoleTags(timeout.Context(), &iam.ListRoleTagsInput{
 		RoleName: aws.String(roleName),
 	})
 	if err != nil {
@@ -626,7 +633,7 @@ func (c *awsClient) ListRoles() ([]iamtypes.Role, error) {
 	roles := []iamtypes.Role{}
 	paginator := iam.NewListRolesPaginator(c.iamClient, &iam.ListRolesInput{})
 	for paginator.HasMorePages() {
-		output, err := paginator.NextPage(context.TODO())
+		output, err := paginator.NextPage(timeout.Context())
 		if err != nil {
 			return nil, err
 		}
@@ -643,12 +650,12 @@ func (c *awsClient) FindPolicyARN(operator Operator, version string) (string, er
 		Scope: iamtypes.PolicyScopeTypeLocal,
 	})
 	for paginator.HasMorePages() {
-		output, err := paginator.NextPage(context.TODO())
+		output, err := paginator.NextPage(timeout.Context())
 		if err != nil {
 			return "", err
 		}
 		for _, policy := range output.Policies {
-			listPolicyTagsOutput, err := c.iamClient.ListPolicyTags(context.TODO(), &iam.ListPolicyTagsInput{
+			listPolicyTagsOutput, err := c.iamClient.ListPolicyTags(timeout.Context(), &iam.ListPolicyTagsInput{
 				PolicyArn: policy.Arn,
 			})
 			if err != nil {
@@ -705,7 +712,7 @@ func IsOCMRole(roleName *string) bool {
 // IsUserRole checks the role tags in addition to the role name, because the word 'user' is common
 func (c *awsClient) IsUserRole(roleName *string) (bool, error) {
 	if strings.Contains(aws.ToString(roleName), OCMUserRole) {
-		roleTags, err := c.iamClient.ListRoleTags(context.Background(), &iam.ListRoleTagsInput{
+		roleTags, err := c.iamClient.ListRoleTags(timeout.Context(), &iam.ListRoleTagsInput{
 			RoleName: roleName,
 		})
 		if err != nil {
@@ -756,7 +763,7 @@ func (c *awsClient) ListOCMRoles() ([]Role, error) {
 			ocmRole.RoleName = aws.ToString(role.RoleName)
 			ocmRole.RoleARN = aws.ToString(role.Arn)
 
-			roleTags, err := c.iamClient.ListRoleTags(context.Background(), &iam.ListRoleTagsInput{
+			roleTags, err := c.iamClient.ListRoleTags(timeout.Context(), &iam.ListRoleTagsInput{
 				RoleName: role.RoleName,
 			})
 			if err != nil {
@@ -801,7 +808,7 @@ func (c *awsClient) mapToAccountRole(version string, role iamtypes.Role) (Role,
 
 	accountRole := Role{}
 
-	listRoleTagsOutput, err := c.iamClient.ListRoleTags(context.Background(), &iam.ListRoleTagsInput{
+	listRoleTagsOutput, err := c.iamClient.ListRoleTags(timeout.Context(), &iam.ListRoleTagsInput{
 		RoleName: role.RoleName,
 	})
 	if err != nil {
@@ -870,7 +877,14 @@ func (c *awsClient) ListOperatorRoles(version string,
 	}
 	prefixOperatorRoleRE := regexp.MustCompile(`(?i)(?P<Prefix>[\w+=,.@-]+)-(openshift|kube-system)`)
 	for _, role := range roles {
-		operatorRole := OperatorRoleDetail{}
+		trustPolicy, err := url.QueryUnescape(aws.ToString(role.AssumeRolePolicyDocument))
+		if err != nil {
+			return operatorMap, err
+		}
+		operatorRole := OperatorRoleDetail{
+			CreateDate:  aws.ToTime(role.CreateDate),
+			TrustPolicy: trustPolicy,
+		}
 		matches := prefixOperatorRoleRE.FindStringSubmatch(*role.RoleName)
 		if len(matches) == 0 {
 			continue
@@ -880,7 +894,7 @@ func (c *awsClient) ListOperatorRoles(version string,
 		if _, mapOk := operatorMap[foundPrefix]; !mapOk {
 			operatorMap[foundPrefix] = []OperatorRoleDetail{}
 		}
-		listRoleTagsOutput, err := c.iamClient.ListRoleTags(context.Background(),
+		listRoleTagsOutput, err := c.iamClient.ListRoleTags(timeout.Context(),
 			&iam.ListRoleTagsInput{
 				RoleName: role.RoleName,
 			})
@@ -905,10 +919,14 @@ func (c *awsClient) ListOperatorRoles(version string,
 
 			case tags.OperatorNamespace:
 				operatorRole.OperatorNamespace = *tag.Value
+			case tags.RedHatManaged:
+				operatorRole.RedHatManaged = aws.ToString(tag.Value) == tags.True
+			case tags.RolePrefix:
+				operatorRole.RolePrefix = aws.ToString(tag.Value)
 			}
 		}
 
-		attachedPoliciesOutput, err := c.iamClient.ListAttachedRolePolicies(context.Background(),
+		attachedPoliciesOutput, err := c.iamClient.ListAttachedRolePolicies(timeout.Context(),
 			&iam.ListAttachedRolePoliciesInput{
 				RoleName: role.RoleName,
 			})
@@ -935,7 +953,7 @@ func (c *awsClient) ListOperatorRoles(version string,
 		}
 
 		for _, policy := range attachedPoliciesOutput.AttachedPolicies {
-			listPolicyTagsOutput, err := c.iamClient.ListPolicyTags(context.Background(),
+			listPolicyTagsOutput, err := c.iamClient.ListPolicyTags(timeout.Context(),
 				&iam.ListPolicyTagsInput{
 					PolicyArn: policy.PolicyArn,
 				})
@@ -954,6 +972,11 @@ func (c *awsClient) ListOperatorRoles(version string,
 					}
 					isTagged = true
 					operatorRole.Version = tagValue
+				case tags.RolePrefix:
+					// Roles created before the prefix was added to their tags have it only in the policy
+					if operatorRole.RolePrefix == "" {
+						operatorRole.RolePrefix = aws.ToString(tag.Value)
+					}
 				}
 			}
 			if isTagged && !skip {
@@ -1028,7 +1051,7 @@ func (c *awsClient) DeleteOperatorRole(roleName string, managedPolicies bool) er
 }
 
 func (c *awsClient) DeleteRole(role string) error {
-	_, err := c.iamClient.DeleteRole(context.Background(),
+	_, err := c.iamClient.DeleteRole(timeout.Context(),
 		&iam.DeleteRoleInput{RoleName: aws.String(role)})
 	if err != nil {
 		if err != nil {
@@ -1044,7 +1067,7 @@ func (c *awsClient) DeleteRole(role string) error {
 
 func (c *awsClient) GetInstanceProfilesForRole(r string) ([]string, error) {
 	instanceProfiles := []string{}
-	profiles, err := c.iamClient.ListInstanceProfilesForRole(context.Background(),
+	profiles, err := c.iamClient.ListInstanceProfilesForRole(timeout.Context(),
 		&iam.ListInstanceProfilesForRoleInput{
 			RoleName: aws.String(r),
 		})
@@ -1098,7 +1121,7 @@ func (c *awsClient) DeleteAccountRole(roleName string, managedPolicies bool) err
 }
 
 func (c *awsClient) detachAttachedRolePolicies(role *string) error {
-	attachedPoliciesOutput, err := c.iamClient.ListAttachedRolePolicies(context.Background(),
+	attachedPoliciesOutput, err := c.iamClient.ListAttachedRolePolicies(timeout.Context(),
 		&iam.ListAttachedRolePoliciesInput{
 			RoleName: role,
 		})
@@ -1106,7 +1129,7 @@ func (c *awsClient) detachAttachedRolePolicies(role *string) error {
 		return err
 	}
 	for _, policy := range attachedPoliciesOutput.AttachedPolicies {
-		_, err = c.iamClient.DetachRolePolicy(context.Background(),
+		_, err = c.iamClient.DetachRolePolicy(timeout.Context(),
 			&iam.DetachRolePolicyInput{
 				PolicyArn: policy.PolicyArn,
 				RoleName:  role,
@@ -1123,13 +1146,13 @@ func (c *awsClient) detachAttachedRolePolicies(role *string) error {
 }
 
 func (c *awsClient) DeleteInlineRolePolicies(role string) error {
-	listRolePolicyOutput, err := c.iamClient.ListRolePolicies(context.Background(),
+	listRolePolicyOutput, err := c.iamClient.ListRolePolicies(timeout.Context(),
 		&iam.ListRolePoliciesInput{RoleName: aws.String(role)})
 	if err != nil {
 		return err
 	}
 	for _, policyName := range listRolePolicyOutput.PolicyNames {
-		_, err = c.iamClient.DeleteRolePolicy(context.Background(),
+		_, err = c.iamClient.DeleteRolePolicy(timeout.Context(),
 			&iam.DeleteRolePolicyInput{
 				PolicyName: aws.String(policyName),
 				RoleName:   aws.String(role),
@@ -1146,7 +1169,7 @@ func (c *awsClient) DeleteInlineRolePolicies(role string) error {
 }
 
 func (c *awsClient) isPolicyAttachedToEntity(policyArn string) (bool, error) {
-	policyOutput, err := c.iamClient.GetPolicy(context.Background(),
+	policyOutput, err := c.iamClient.GetPolicy(timeout.Context(),
 		&iam.GetPolicyInput{PolicyArn: aws.String(policyArn)})
 	if err != nil {
 		return false, err
@@ -1176,7 +1199,7 @@ func (c *awsClient) deletePolicies(policies []string) (*iam.DeletePolicyOutput,
 			return output, err
 		}
 
-		output, err = c.iamClient.DeletePolicy(context.Background(),
+		output, err = c.iamClient.DeletePolicy(timeout.Context(),
 			&iam.DeletePolicyInput{PolicyArn: &policies[i]})
 		if err != nil {
 			return output, err
@@ -1192,7 +1215,7 @@ func (c *awsClient) GetDefaultPolicyDocument(policyArn string) (string, error) {
 		return "", err
 	}
 
-	policyVersionOutput, err := c.iamClient.GetPolicyVersion(context.Background(),
+	policyVersionOutput, err := c.iamClient.GetPolicyVersion(timeout.Context(),
 		&iam.GetPolicyVersionInput{
 			VersionId: aws.String(versionId),
 			PolicyArn: aws.String(policyArn),
@@ -1205,7 +1228,7 @@ func (c *awsClient) GetDefaultPolicyDocument(policyArn string) (string, error) {
 }
 
 func (c *awsClient) getDefaultPolicyVersionId(policyArn string) (string, error) {
-	policyVersionsOutput, err := c.iamClient.ListPolicyVersions(context.Background(),
+	policyVersionsOutput, err := c.iamClient.ListPolicyVersions(timeout.Context(),
 		&iam.ListPolicyVersionsInput{
 			PolicyArn: aws.String(policyArn),
 		})
@@ -1224,7 +1247,7 @@ func (c *awsClient) getDefaultPolicyVersionId(policyArn string) (string, error)
 }
 
 func (c *awsClient) deletePolicyVersions(policyArn string) error {
-	policyVersionsOutput, err := c.iamClient.ListPolicyVersions(context.Background(),
+	policyVersionsOutput, err := c.iamClient.ListPolicyVersions(timeout.Context(),
 		&iam.ListPolicyVersionsInput{
 			PolicyArn: aws.String(policyArn),
 		})
@@ -1236,7 +1259,7 @@ func (c *awsClient) deletePolicyVersions(policyArn string) error {
 		if version.IsDefaultVersion {
 			continue
 		}
-		_, err := c.iamClient.DeletePolicyVersion(context.Background(),
+		_, err := c.iamClient.DeletePolicyVersion(timeout.Context(),
 			&iam.DeletePolicyVersionInput{
 				PolicyArn: aws.String(policyArn),
 				VersionId: version.VersionId,
@@ -1252,7 +1275,7 @@ func (c *awsClient) deletePolicyVersions(policyArn string) error {
 func (c *awsClient) GetAttachedPolicy(role *string) ([]PolicyDetail, error) {
 	policies := []PolicyDetail{}
 	attachedPoliciesOutput, err := c.iamClient.ListAttachedRolePolicies(
-		context.Background(),
+		timeout.Context(),
 		&iam.ListAttachedRolePoliciesInput{RoleName: role},
 	)
 	if err != nil && !awserr.IsNoSuchEntityException(err) {
@@ -1268,7 +1291,7 @@ func (c *awsClient) GetAttachedPolicy(role *string) ([]PolicyDetail, error) {
 		policies = append(policies, policyDetail)
 	}
 
-	rolePolicyOutput, err := c.iamClient.ListRolePolicies(context.Background(),
+	rolePolicyOutput, err := c.iamClient.ListRolePolicies(timeout.Context(),
 		&iam.ListRolePoliciesInput{RoleName: role})
 	if err != nil && !awserr.IsNoSuchEntityException(err) {
 		return policies, err
@@ -1286,7 +1309,7 @@ func (c *awsClient) GetAttachedPolicy(role *string) ([]PolicyDetail, error) {
 
 func (c *awsClient) detachOperatorRolePolicies(role *string) error {
 	// get attached role policies as operator roles have managed policies
-	policiesOutput, err := c.iamClient.ListAttachedRolePolicies(context.Background(),
+	policiesOutput, err := c.iamClient.ListAttachedRolePolicies(timeout.Context(),
 		&iam.ListAttachedRolePoliciesInput{
 			RoleName: role,
 		})
@@ -1294,7 +1317,7 @@ func (c *awsClient) detachOperatorRolePolicies(role *string) error {
 		return err
 	}
 	for _, policy := range policiesOutput.AttachedPolicies {
-		_, err := c.iamClient.DetachRolePolicy(context.Background(),
+		_, err := c.iamClient.DetachRolePolicy(timeout.Context(),
 			&iam.DetachRolePolicyInput{PolicyArn: policy.PolicyArn, RoleName: role})
 		if err != nil {
 			return err
@@ -1314,7 +1337,7 @@ func (c *awsClient) GetOperatorRolesFromAccountByClusterID(clusterID string,
 		if !checkIfROSAOperatorRole(role.RoleName, credRequest) {
 			continue
 		}
-		listRoleTagsOutput, err := c.iamClient.ListRoleTags(context.Background(),
+		listRoleTagsOutput, err := c.iamClient.ListRoleTags(timeout.Context(),
 			&iam.ListRoleTagsInput{
 				RoleName: role.RoleName,
 			})
@@ -1361,7 +1384,7 @@ func (c *awsClient) GetPolicies(roles []string) (map[string][]string, error) {
 	roleMap := make(map[string][]string)
 	for _, role := range roles {
 		policyArr := []string{}
-		policiesOutput, err := c.iamClient.ListAttachedRolePolicies(context.Background(),
+		policiesOutput, err := c.iamClient.ListAttachedRolePolicies(timeout.Context(),
 			&iam.ListAttachedRolePoliciesInput{
 				RoleName: aws.String(role),
 			})
@@ -1420,7 +1443,7 @@ func (c *awsClient) GetAccountRolesForCurrentEnv(env string, accountID string) (
 func (c *awsClient) GetAccountRoleForCurrentEnv(env string, roleName string) (Role, error) {
 	role := Role{}
 	// This is done to ensure user did not provide invalid role before we check for installer role
-	accountRoleResponse, err := c.iamClient.GetRole(context.Background(),
+	accountRoleResponse, err := c.iamClient.GetRole(timeout.Context(),
 		&iam.GetRoleInput{RoleName: aws.String(roleName)})
 	if err != nil {
 		if awserr.IsNoSuchEntityException(err) {
@@ -1476,7 +1499,7 @@ func (c *awsClient) checkInstallerRoleExists(roleName string) (*iamtypes.Role, e
 		}
 	}
 	installerRole := fmt.Sprintf("%s%s-Role", rolePrefix, "Installer")
-	installerRoleResponse, err := c.iamClient.GetRole(context.Background(),
+	installerRoleResponse, err := c.iamClient.GetRole(timeout.Context(),
 		&iam.GetRoleInput{RoleName: aws.String(installerRole)})
 	//We try our best to determine the environment based on the trust policy in the installer
 	//If the installer role is deleted we can assume that there is no cluster using the role
@@ -1517,7 +1540,7 @@ func (c *awsClient) buildRoles(roleName string, accountID string) ([]Role, error
 		roleARN := GetRoleARN(accountID, roleName, "", creator.Partition)
 
 		if prefix.Name != "Installer" {
-			_, err := c.iamClient.GetRole(context.Background(), &iam.GetRoleInput{RoleName: aws.String(roleName)})
+			_, err := c.iamClient.GetRole(timeout.Context(), &iam.GetRoleInput{RoleName: aws.String(roleName)})
 			if err != nil && !awserr.IsNoSuchEntityException(err) {
 				return roles, err
 			}
@@ -1545,14 +1568,14 @@ func (c *awsClient) GetAccountRolePolicies(roles []string) (map[string][]PolicyD
 }
 
 func (c *awsClient) GetOpenIDConnectProviderByClusterIdTag(clusterID string) (string, error) {
-	providers, err := c.iamClient.ListOpenIDConnectProviders(context.Background(),
+	providers, err := c.iamClient.ListOpenIDConnectProviders(timeout.Context(),
 		&iam.ListOpenIDConnectProvidersInput{})
 	if err != nil {
 		return "", err
 	}
 	for _, provider := range providers.OpenIDConnectProviderList {
 		providerValue := aws.ToString(provider.Arn)
-		connectProvider, err := c.iamClient.GetOpenIDConnectProvider(context.Background(),
+		connectProvider, err := c.iamClient.GetOpenIDConnectProvider(timeout.Context(),
 			&iam.GetOpenIDConnectProviderInput{
 				OpenIDConnectProviderArn: provider.Arn,
 			})
@@ -1580,7 +1603,7 @@ func (c *awsClient) GetOpenIDConnectProviderByClusterIdTag(clusterID string) (st
 }
 
 func (c *awsClient) GetOpenIDConnectProviderByOidcEndpointUrl(oidcEndpointUrl string) (string, error) {
-	providers, err := c.iamClient.ListOpenIDConnectProviders(context.Background(),
+	providers, err := c.iamClient.ListOpenIDConnectProviders(timeout.Context(),
 		&iam.ListOpenIDConnectProvidersInput{})
 	if err != nil {
 		return "", err
@@ -1606,7 +1629,7 @@ func (c *awsClient) GetOpenIDConnectProviderByOidcEndpointUrl(oidcEndpointUrl st
 func (c *awsClient) GetRoleARNPath(prefix string) (string, error) {
 	for _, accountRole := range AccountRoles {
 		roleName := fmt.Sprintf("%s-%s-Role", prefix, accountRole.Name)
-		role, err := c.iamClient.GetRole(context.Background(), &iam.GetRoleInput{
+		role, err := c.iamClient.GetRole(timeout.Context(), &iam.GetRoleInput{
 			RoleName: aws.String(roleName),
 		})
 		if awserr.IsNoSuchEntityException(err) {
@@ -1620,7 +1643,7 @@ func (c *awsClient) GetRoleARNPath(prefix string) (string, error) {
 func (c *awsClient) IsUpgradedNeededForAccountRolePolicies(prefix string, version string) (bool, error) {
 	for _, accountRole := range AccountRoles {
 		roleName := fmt.Sprintf("%s-%s-Role", prefix, accountRole.Name)
-		role, err := c.iamClient.GetRole(context.Background(), &iam.GetRoleInput{
+		role, err := c.iamClient.GetRole(timeout.Context(), &iam.GetRoleInput{
 			RoleName: aws.String(roleName),
 		})
 		if err != nil {
@@ -1695,13 +1718,13 @@ func (c *awsClient) UpdateTag(roleName string, defaultPolicyVersion string) erro
 }
 
 func (c *awsClient) AddRoleTag(roleName string, key string, value string) error {
-	role, err := c.iamClient.GetRole(context.Background(), &iam.GetRoleInput{
+	role, err := c.iamClient.GetRole(timeout.Context(), &iam.GetRoleInput{
 		RoleName: aws.String(roleName),
 	})
 	if err != nil {
 		return err
 	}
-	_, err = c.iamClient.TagRole(context.Background(), &iam.TagRoleInput{
+	_, err = c.iamClient.TagRole(timeout.Context(), &iam.TagRoleInput{
 		RoleName: role.Role.RoleName,
 		Tags: []iamtypes.Tag{
 			{
@@ -1748,7 +1771,7 @@ func (c *awsClient) IsUpgradedNeededForOperatorRolePoliciesUsingCluster(
 		if err != nil {
 			return true, err
 		}
-		_, err = c.iamClient.GetRole(context.Background(), &iam.GetRoleInput{
+		_, err = c.iamClient.GetRole(timeout.Context(), &iam.GetRoleInput{
 			RoleName: aws.String(roleName),
 		})
 		if err != nil {
@@ -1823,7 +1846,7 @@ func (c *awsClient) isRolePolicyUpToDate(policyARN string, policyVersion string)
 }
 
 func (c *awsClient) isRolePoliciesCompatibleForUpgrade(policyARN string, version string) (bool, error) {
-	policyTagOutput, err := c.iamClient.ListPolicyTags(context.Background(), &iam.ListPolicyTagsInput{
+	policyTagOutput, err := c.iamClient.ListPolicyTags(timeout.Context(), &iam.ListPolicyTagsInput{
 		PolicyArn: aws.String(policyARN),
 	})
 	if err != nil {
@@ -1833,7 +1856,7 @@ func (c *awsClient) isRolePoliciesCompatibleForUpgrade(policyARN string, version
 }
 
 func (c *awsClient) GetAccountRoleVersion(roleName string) (string, error) {
-	role, err := c.iamClient.GetRole(context.Background(), &iam.GetRoleInput{
+	role, err := c.iamClient.GetRole(timeout.Context(), &iam.GetRoleInput{
 		RoleName: aws.String(roleName),
 	})
 	if err != nil {
@@ -1844,7 +1867,7 @@ func (c *awsClient) GetAccountRoleVersion(roleName string) (string, error) {
 }
 
 func (c *awsClient) IsAdminRole(roleName string) (bool, error) {
-	role, err := c.iamClient.GetRole(context.Background(), &iam.GetRoleInput{
+	role, err := c.iamClient.GetRole(timeout.Context(), &iam.GetRoleInput{
 		RoleName: aws.String(roleName),
 	})
 	if err != nil {
@@ -1861,7 +1884,7 @@ func (c *awsClient) IsAdminRole(roleName string) (bool, error) {
 }
 
 func (c *awsClient) GetAccountRoleARN(prefix string, roleType string) (string, error) {
-	output, err := c.iamClient.GetRole(context.Background(), &iam.GetRoleInput{
+	output, err := c.iamClient.GetRole(timeout.Context(), &iam.GetRoleInput{
 		RoleName: aws.String(common.GetRoleName(prefix, roleType)),
 	})
 	if err != nil {
@@ -1962,7 +1985,7 @@ func (c *awsClient) isManagedPolicyAttached(roleName string, managedPolicyARN st
 
 func (c *awsClient) listRoleAttachedPolicies(roleName string) ([]iamtypes.AttachedPolicy, error) {
 	attachedPoliciesOutput, err := c.iamClient.ListAttachedRolePolicies(
-		context.Background(),
+		timeout.Context(),
 		&iam.ListAttachedRolePoliciesInput{RoleName: aws.String(roleName)},
 	)
 	if err != nil {
@@ -1,7 +1,6 @@
 package aws
 
 import (
-	"context"
 	"encoding/json"
 	"fmt"
 	"net/url"
@@ -12,6 +11,8 @@ import (
 	"github.com/aws/aws-sdk-go-v2/service/iam"
 	iamtypes "github.com/aws/aws-sdk-go-v2/service/iam/types"
 	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+
+	"github.com/openshift/rosa/pkg/timeout"
 )
 
 // PolicyDocument models an AWS IAM policy document
@@ -39,11 +40,18 @@ type PolicyStatement struct {
 	// Include a list of actions that the policy allows or denies.
 	// (i.e. ec2:StartInstances, iam:ChangePassword)
 	Action interface{} `json:"Action,omitempty"`
+	// Include a list of actions that the statement doesn't apply to, instead of the actions that it applies to.
+	NotAction interface{} `json:"NotAction,omitempty"`
 	// If you create an IAM permissions policy, you must specify a list of resources to which
 	// the actions apply. If you create a resource-based policy, this element is optional. If
 	// you do not include this element, then the resource to which the action applies is the
 	// resource to which the policy is attached.
 	Resource interface{} `json:"Resource,omitempty"`
+	// Include a list of resources that the statement doesn't apply to, instead of the resources that it
+	// applies to.
+	NotResource interface{} `json:"NotResource,omitempty"`
+	// Include the conditions under which the statement applies.
+	Condition interface{} `json:"Condition,omitempty"`
 }
 
 type PolicyStatementPrincipal struct {
@@ -170,7 +178,7 @@ func (p *PolicyDocument) checkPermissionsUsingQueryClient(queryClient *awsClient
 	var failedActions []string
 	paginator := iam.NewSimulatePrincipalPolicyPaginator(client, input)
 	for paginator.HasMorePages() {
-		output, err := paginator.NextPage(context.TODO())
+		output, err := paginator.NextPage(timeout.Context())
 		if err != nil {
 			return false, fmt.Errorf("Error simulating policy: %v", err)
 		}
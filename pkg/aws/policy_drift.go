@@ -0,0 +1,211 @@
+package aws
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+)
+
+const (
+	PolicyDriftAdded   = "added"
+	PolicyDriftRemoved = "removed"
+)
+
+// PolicyDrift is a difference between the expected document of a policy and the document found in AWS,
+// for example an action that was added to a statement of the policy.
+type PolicyDrift struct {
+	Statement string `json:"statement"`
+	Element   string `json:"element"`
+	Change    string `json:"change"`
+	Value     string `json:"value"`
+}
+
+// driftStatement is the subset of a policy statement that is compared to find drift. Unlike
+// PolicyStatement it keeps the conditions, and accepts a single principal, action or resource as a
+// string.
+type driftStatement struct {
+	Sid         string                            `json:"Sid"`
+	Effect      string                            `json:"Effect"`
+	Principal   interface{}                       `json:"Principal"`
+	Action      interface{}                       `json:"Action"`
+	NotAction   interface{}                       `json:"NotAction"`
+	Resource    interface{}                       `json:"Resource"`
+	NotResource interface{}                       `json:"NotResource"`
+	Condition   map[string]map[string]interface{} `json:"Condition"`
+}
+
+// ComparePolicyDocuments compares the statements of the actual document of a policy with the ones of
+// the expected document, and returns the actions, resources, principals and conditions that were
+// added or removed. Statements are matched by their 'Sid', or by their position when they don't have one.
+func ComparePolicyDocuments(expected string, actual string) ([]PolicyDrift, error) {
+	expectedStatements, err := parseDriftStatements(expected)
+	if err != nil {
+		return nil, fmt.Errorf("Failed to parse expected policy document: %v", err)
+	}
+	actualStatements, err := parseDriftStatements(actual)
+	if err != nil {
+		return nil, fmt.Errorf("Failed to parse policy document: %v", err)
+	}
+
+	actualByKey := map[string]*driftStatement{}
+	actualKeys := []string{}
+	for i, statement := range actualStatements {
+		key := statementKey(i, statement)
+		actualByKey[key] = statement
+		actualKeys = append(actualKeys, key)
+	}
+
+	drift := []PolicyDrift{}
+	matched := map[string]bool{}
+	for i, statement := range expectedStatements {
+		key := statementKey(i, statement)
+		matched[key] = true
+		drift = append(drift, compareStatements(key, statement, actualByKey[key])...)
+	}
+	for _, key := range actualKeys {
+		if !matched[key] {
+			drift = append(drift, compareStatements(key, nil, actualByKey[key])...)
+		}
+	}
+	return drift, nil
+}
+
+func parseDriftStatements(doc string) ([]*driftStatement, error) {
+	var policy struct {
+		Statement json.RawMessage `json:"Statement"`
+	}
+	err := json.Unmarshal([]byte(doc), &policy)
+	if err != nil {
+		return nil, err
+	}
+	statements := []*driftStatement{}
+	// A policy with a single statement doesn't need to wrap it in a list
+	if bytes.HasPrefix(bytes.TrimSpace(policy.Statement), []byte("{")) {
+		statement := &driftStatement{}
+		err = json.Unmarshal(policy.Statement, statement)
+		statements = append(statements, statement)
+	} else if len(policy.Statement) > 0 {
+		err = json.Unmarshal(policy.Statement, &statements)
+	}
+	return statements, err
+}
+
+func statementKey(index int, statement *driftStatement) string {
+	if statement.Sid != "" {
+		return statement.Sid
+	}
+	return fmt.Sprintf("#%d", index+1)
+}
+
+// compareStatements returns the differences between two statements. A missing statement is compared
+// as an empty one, so all the elements of the other statement are reported.
+func compareStatements(key string, expected *driftStatement, actual *driftStatement) []PolicyDrift {
+	if expected == nil {
+		expected = &driftStatement{}
+	}
+	if actual == nil {
+		actual = &driftStatement{}
+	}
+	drift := []PolicyDrift{}
+	if expected.Effect != actual.Effect {
+		if expected.Effect != "" {
+			drift = append(drift, PolicyDrift{key, "Effect", PolicyDriftRemoved, expected.Effect})
+		}
+		if actual.Effect != "" {
+			drift = append(drift, PolicyDrift{key, "Effect", PolicyDriftAdded, actual.Effect})
+		}
+	}
+	drift = append(drift, compareValues(key, "Action",
+		stringValues(expected.Action), stringValues(actual.Action))...)
+	drift = append(drift, compareValues(key, "NotAction",
+		stringValues(expected.NotAction), stringValues(actual.NotAction))...)
+	drift = append(drift, compareValues(key, "Resource",
+		stringValues(expected.Resource), stringValues(actual.Resource))...)
+	drift = append(drift, compareValues(key, "NotResource",
+		stringValues(expected.NotResource), stringValues(actual.NotResource))...)
+	drift = append(drift, compareValues(key, "Principal",
+		principalValues(expected.Principal), principalValues(actual.Principal))...)
+	drift = append(drift, compareValues(key, "Condition",
+		conditionValues(expected.Condition), conditionValues(actual.Condition))...)
+	return drift
+}
+
+func compareValues(key string, element string, expected []string, actual []string) []PolicyDrift {
+	drift := []PolicyDrift{}
+	for _, value := range difference(expected, actual) {
+		drift = append(drift, PolicyDrift{key, element, PolicyDriftRemoved, value})
+	}
+	for _, value := range difference(actual, expected) {
+		drift = append(drift, PolicyDrift{key, element, PolicyDriftAdded, value})
+	}
+	return drift
+}
+
+// difference returns the sorted values of a that aren't in b.
+func difference(a []string, b []string) []string {
+	inB := map[string]bool{}
+	for _, value := range b {
+		inB[value] = true
+	}
+	result := []string{}
+	for _, value := range a {
+		if !inB[value] {
+			result = append(result, value)
+			inB[value] = true
+		}
+	}
+	sort.Strings(result)
+	return result
+}
+
+// stringValues returns the values of an element that can be a single string or a list of them.
+func stringValues(value interface{}) []string {
+	switch typed := value.(type) {
+	case string:
+		return []string{typed}
+	case []interface{}:
+		values := []string{}
+		for _, item := range typed {
+			values = append(values, fmt.Sprintf("%v", item))
+		}
+		return values
+	case nil:
+		return []string{}
+	}
+	return []string{fmt.Sprintf("%v", value)}
+}
+
+// principalValues returns the principals as 'Type:principal', for example 'Service:ec2.amazonaws.com'.
+func principalValues(principal interface{}) []string {
+	principals, ok := principal.(map[string]interface{})
+	if !ok {
+		return stringValues(principal)
+	}
+	values := []string{}
+	for principalType, value := range principals {
+		for _, item := range stringValues(value) {
+			values = append(values, fmt.Sprintf("%s:%s", principalType, item))
+		}
+	}
+	return values
+}
+
+// conditionValues returns the conditions as 'Operator key=value', with one entry for each value.
+func conditionValues(conditions map[string]map[string]interface{}) []string {
+	values := []string{}
+	for operator, keys := range conditions {
+		for key, value := range keys {
+			for _, item := range stringValues(value) {
+				values = append(values, fmt.Sprintf("%s %s=%s", operator, key, item))
+			}
+		}
+	}
+	return values
+}
+
+// IsAWSManagedPolicy returns true if the policy is managed by AWS, so its document can't drift.
+func IsAWSManagedPolicy(policyARN string) bool {
+	return strings.Contains(policyARN, ":iam::aws:policy/")
+}
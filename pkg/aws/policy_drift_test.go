@@ -0,0 +1,103 @@
+package aws
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("ComparePolicyDocuments", func() {
+	trustPolicy := `{
+		"Version": "2012-10-17",
+		"Statement": [{
+			"Effect": "Allow",
+			"Principal": {"Federated": "arn:aws:iam::123:oidc-provider/oidc.example.com"},
+			"Action": "sts:AssumeRoleWithWebIdentity",
+			"Condition": {"StringEquals": {"oidc.example.com:sub": ["system:serviceaccount:ns:sa"]}}
+		}]
+	}`
+
+	It("Finds no drift in equivalent documents", func() {
+		actual := `{
+			"Version": "2012-10-17",
+			"Statement": {
+				"Effect": "Allow",
+				"Principal": {"Federated": ["arn:aws:iam::123:oidc-provider/oidc.example.com"]},
+				"Action": ["sts:AssumeRoleWithWebIdentity"],
+				"Condition": {"StringEquals": {"oidc.example.com:sub": "system:serviceaccount:ns:sa"}}
+			}
+		}`
+		drift, err := ComparePolicyDocuments(trustPolicy, actual)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(drift).To(BeEmpty())
+	})
+
+	It("Reports added and removed principals, actions and conditions", func() {
+		actual := `{
+			"Version": "2012-10-17",
+			"Statement": [{
+				"Effect": "Allow",
+				"Principal": {
+					"Federated": "arn:aws:iam::123:oidc-provider/oidc.example.com",
+					"AWS": "arn:aws:iam::456:root"
+				},
+				"Action": ["sts:AssumeRoleWithWebIdentity", "sts:AssumeRole"],
+				"Condition": {"StringLike": {"oidc.example.com:sub": "system:serviceaccount:*"}}
+			}]
+		}`
+		drift, err := ComparePolicyDocuments(trustPolicy, actual)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(drift).To(Equal([]PolicyDrift{
+			{"#1", "Action", PolicyDriftAdded, "sts:AssumeRole"},
+			{"#1", "Principal", PolicyDriftAdded, "AWS:arn:aws:iam::456:root"},
+			{"#1", "Condition", PolicyDriftRemoved, "StringEquals oidc.example.com:sub=system:serviceaccount:ns:sa"},
+			{"#1", "Condition", PolicyDriftAdded, "StringLike oidc.example.com:sub=system:serviceaccount:*"},
+		}))
+	})
+
+	It("Reports added and removed resources", func() {
+		expected := `{"Statement": [{
+			"Effect": "Allow",
+			"Action": "s3:GetObject",
+			"Resource": "arn:aws:s3:::bucket/*",
+			"Condition": {"StringEquals": {"aws:ResourceTag/red-hat-managed": "true"}}
+		}]}`
+		actual := `{"Statement": [{
+			"Effect": "Allow",
+			"NotAction": "iam:*",
+			"Resource": "*"
+		}]}`
+		drift, err := ComparePolicyDocuments(expected, actual)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(drift).To(Equal([]PolicyDrift{
+			{"#1", "Action", PolicyDriftRemoved, "s3:GetObject"},
+			{"#1", "NotAction", PolicyDriftAdded, "iam:*"},
+			{"#1", "Resource", PolicyDriftRemoved, "arn:aws:s3:::bucket/*"},
+			{"#1", "Resource", PolicyDriftAdded, "*"},
+			{"#1", "Condition", PolicyDriftRemoved, "StringEquals aws:ResourceTag/red-hat-managed=true"},
+		}))
+	})
+
+	It("Matches statements by Sid", func() {
+		expected := `{"Statement": [
+			{"Sid": "Read", "Effect": "Allow", "Action": ["s3:GetObject"]},
+			{"Sid": "Write", "Effect": "Allow", "Action": ["s3:PutObject"]}
+		]}`
+		actual := `{"Statement": [
+			{"Sid": "Write", "Effect": "Allow", "Action": ["s3:PutObject"]},
+			{"Sid": "Admin", "Effect": "Allow", "Action": "s3:*"}
+		]}`
+		drift, err := ComparePolicyDocuments(expected, actual)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(drift).To(Equal([]PolicyDrift{
+			{"Read", "Effect", PolicyDriftRemoved, "Allow"},
+			{"Read", "Action", PolicyDriftRemoved, "s3:GetObject"},
+			{"Admin", "Effect", PolicyDriftAdded, "Allow"},
+			{"Admin", "Action", PolicyDriftAdded, "s3:*"},
+		}))
+	})
+
+	It("Fails with an invalid document", func() {
+		_, err := ComparePolicyDocuments(trustPolicy, "{")
+		Expect(err).To(MatchError(ContainSubstring("Failed to parse policy document")))
+	})
+})
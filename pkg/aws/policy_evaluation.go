@@ -0,0 +1,123 @@
+package aws
+
+import (
+	"strings"
+)
+
+// Decisions of the evaluation of a policy for a request.
+const (
+	PolicyDecisionAllowed         = "allowed"
+	PolicyDecisionExplicitDeny    = "explicitly denied"
+	PolicyDecisionConditionalDeny = "conditionally denied"
+	PolicyDecisionImplicitDeny    = "not allowed"
+)
+
+// PolicyRequest is an action on a resource, that a policy allows or denies.
+type PolicyRequest struct {
+	Action   string `json:"action"`
+	Resource string `json:"resource"`
+}
+
+// GetAllowedRequests returns the actions allowed by the policy, with the wildcards expanded using the
+// catalog of IAM actions, and each of the resources they are allowed on.
+func (p *PolicyDocument) GetAllowedRequests() []PolicyRequest {
+	found := map[PolicyRequest]bool{}
+	requests := []PolicyRequest{}
+	for _, statement := range p.Statement {
+		if !strings.EqualFold(statement.Effect, "Allow") {
+			continue
+		}
+		resources := stringValues(statement.Resource)
+		if len(resources) == 0 {
+			resources = []string{"*"}
+		}
+		for _, action := range ExpandActions(stringValues(statement.Action)) {
+			for _, resource := range resources {
+				request := PolicyRequest{Action: action, Resource: resource}
+				if !found[request] {
+					found[request] = true
+					requests = append(requests, request)
+				}
+			}
+		}
+	}
+	return requests
+}
+
+// EvaluateRequest evaluates the policy locally, as IAM does for an identity policy or a permissions
+// boundary: the request is denied when any statement denies it, allowed when a statement allows it, and
+// implicitly denied otherwise. Conditions aren't evaluated, because they depend on the context of the
+// request, so an allowed request that a statement with conditions denies is reported as conditionally
+// denied.
+func (p *PolicyDocument) EvaluateRequest(request PolicyRequest) string {
+	decision := PolicyDecisionImplicitDeny
+	conditionalDeny := false
+	for _, statement := range p.Statement {
+		if !statement.appliesTo(request) {
+			continue
+		}
+		if statement.isDeny() {
+			if statement.Condition == nil {
+				return PolicyDecisionExplicitDeny
+			}
+			conditionalDeny = true
+		} else if strings.EqualFold(statement.Effect, "Allow") {
+			decision = PolicyDecisionAllowed
+		}
+	}
+	if conditionalDeny && decision == PolicyDecisionAllowed {
+		return PolicyDecisionConditionalDeny
+	}
+	return decision
+}
+
+func (p *PolicyStatement) isDeny() bool {
+	return strings.EqualFold(p.Effect, "Deny")
+}
+
+// appliesTo returns true if the action and the resource of the request match the statement. A request on
+// all the resources ('*') only matches a statement that applies to all of them.
+func (p *PolicyStatement) appliesTo(request PolicyRequest) bool {
+	if p.NotAction != nil {
+		if matchesAnyPattern(stringValues(p.NotAction), request.Action, true) {
+			return false
+		}
+	} else if !matchesAnyPattern(stringValues(p.Action), request.Action, true) {
+		return false
+	}
+
+	if p.NotResource != nil {
+		if request.Resource == "*" {
+			return len(stringValues(p.NotResource)) == 0
+		}
+		return !matchesAnyPattern(stringValues(p.NotResource), request.Resource, false)
+	}
+	if p.Resource == nil {
+		return true
+	}
+	if request.Resource == "*" {
+		return matchesAllResources(stringValues(p.Resource))
+	}
+	return matchesAnyPattern(stringValues(p.Resource), request.Resource, false)
+}
+
+// matchesAllResources returns true if any of the patterns matches all the resources, like '*'.
+func matchesAllResources(patterns []string) bool {
+	for _, pattern := range patterns {
+		if pattern != "" && strings.Trim(pattern, "*") == "" {
+			return true
+		}
+	}
+	return false
+}
+
+// matchesAnyPattern returns true if the value matches any of the patterns, which can contain wildcards.
+// Actions are compared ignoring case, resources aren't.
+func matchesAnyPattern(patterns []string, value string, ignoreCase bool) bool {
+	for _, pattern := range patterns {
+		if wildcardRegexp(pattern, ignoreCase).MatchString(value) {
+			return true
+		}
+	}
+	return false
+}
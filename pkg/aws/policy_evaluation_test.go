@@ -0,0 +1,61 @@
+package aws
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("EvaluateRequest", func() {
+	boundary := `{"Version": "2012-10-17", "Statement": [
+		{"Effect": "Allow", "NotAction": ["iam:*", "organizations:*"], "Resource": "*"},
+		{"Effect": "Allow", "Action": "iam:Get*", "Resource": "*"},
+		{"Effect": "Allow", "Action": "iam:PassRole", "Resource": "arn:aws:iam::123:role/team-*"},
+		{"Effect": "Deny", "Action": "ec2:TerminateInstances", "Resource": "*"},
+		{"Effect": "Deny", "Action": "s3:DeleteBucket", "Resource": "arn:aws:s3:::logs"},
+		{"Effect": "Deny", "Action": "kms:*", "NotResource": "arn:aws:kms:*:123:key/*"},
+		{"Effect": "Deny", "Action": "ec2:StartInstances", "Resource": "*",
+			"Condition": {"StringNotEquals": {"aws:RequestedRegion": "us-east-1"}}}
+	]}`
+
+	DescribeTable("Evaluates the request",
+		func(action string, resource string, decision string) {
+			policy, err := ParsePolicyDocument(boundary)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(policy.EvaluateRequest(PolicyRequest{action, resource})).To(Equal(decision))
+		},
+		Entry("Allowed by NotAction", "ec2:RunInstances", "*", PolicyDecisionAllowed),
+		Entry("Excluded by NotAction", "iam:CreateRole", "*", PolicyDecisionImplicitDeny),
+		Entry("Allowed by a wildcard ignoring case", "IAM:getrole", "*", PolicyDecisionAllowed),
+		Entry("Allowed on a resource", "iam:PassRole", "arn:aws:iam::123:role/team-Worker-Role",
+			PolicyDecisionAllowed),
+		Entry("Not allowed on all resources", "iam:PassRole", "*", PolicyDecisionImplicitDeny),
+		Entry("Not allowed on another resource", "iam:PassRole", "arn:aws:iam::123:role/admin",
+			PolicyDecisionImplicitDeny),
+		Entry("Denied", "ec2:TerminateInstances", "*", PolicyDecisionExplicitDeny),
+		Entry("Denied on a resource", "s3:DeleteBucket", "arn:aws:s3:::logs", PolicyDecisionExplicitDeny),
+		Entry("Not denied on all resources", "s3:DeleteBucket", "*", PolicyDecisionAllowed),
+		Entry("Denied by NotResource", "kms:Decrypt", "arn:aws:kms:us-east-1:456:key/1",
+			PolicyDecisionExplicitDeny),
+		Entry("Not denied by NotResource", "kms:Decrypt", "arn:aws:kms:us-east-1:123:key/1",
+			PolicyDecisionAllowed),
+		Entry("Not denied on all resources by NotResource", "kms:Decrypt", "*", PolicyDecisionAllowed),
+		Entry("Denied with conditions", "ec2:StartInstances", "*", PolicyDecisionConditionalDeny),
+	)
+})
+
+var _ = Describe("GetAllowedRequests", func() {
+	It("Expands the actions and lists the resources", func() {
+		policy, err := ParsePolicyDocument(`{"Version": "2012-10-17", "Statement": [
+			{"Effect": "Allow", "Action": ["iam:Pass*", "s3:GetObject"], "Resource": ["arn:aws:s3:::a", "*"]},
+			{"Effect": "Deny", "Action": "ec2:RunInstances", "Resource": "*"},
+			{"Effect": "Allow", "Action": "s3:GetObject", "Resource": "*"}
+		]}`)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(policy.GetAllowedRequests()).To(Equal([]PolicyRequest{
+			{"iam:PassRole", "arn:aws:s3:::a"},
+			{"iam:PassRole", "*"},
+			{"s3:GetObject", "arn:aws:s3:::a"},
+			{"s3:GetObject", "*"},
+		}))
+	})
+})
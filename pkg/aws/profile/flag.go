@@ -22,6 +22,8 @@ import (
 	"os"
 
 	"github.com/spf13/pflag"
+
+	"github.com/openshift/rosa/pkg/config"
 )
 
 // AddFlag adds the debug flag to the given set of command line flags.
@@ -34,7 +36,8 @@ func AddFlag(flags *pflag.FlagSet) {
 	)
 }
 
-// Profile returns a string with the name of the AWS profile being used.
+// Profile returns a string with the name of the AWS profile being used. The default profile of the
+// selected context is used when neither the flag nor the environment variable are set.
 func Profile() string {
 	if profile != "" {
 		return profile
@@ -43,7 +46,7 @@ func Profile() string {
 	if awsProfile != "" {
 		return awsProfile
 	}
-	return ""
+	return config.AWSProfile()
 }
 
 // profile is a string flag that indicates which AWS profile is being used.
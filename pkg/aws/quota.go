@@ -1,13 +1,14 @@
 package aws
 
 import (
-	"context"
 	"fmt"
 	"strings"
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/servicequotas"
 	servicequotastypes "github.com/aws/aws-sdk-go-v2/service/servicequotas/types"
+
+	"github.com/openshift/rosa/pkg/timeout"
 )
 
 type quota struct {
@@ -132,7 +133,7 @@ func ListServiceQuotas(client *awsClient, serviceCode string) ([]servicequotasty
 		})
 
 	for paginator.HasMorePages() {
-		page, err := paginator.NextPage(context.Background())
+		page, err := paginator.NextPage(timeout.Context())
 		if err != nil {
 			return nil, err
 		}
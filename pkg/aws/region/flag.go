@@ -23,6 +23,7 @@ import (
 
 	"github.com/spf13/pflag"
 
+	"github.com/openshift/rosa/pkg/config"
 	"github.com/openshift/rosa/pkg/helper"
 )
 
@@ -36,7 +37,8 @@ func AddFlag(flags *pflag.FlagSet) {
 	)
 }
 
-// Region returns a string with the name of the AWS region being used.
+// Region returns a string with the name of the AWS region being used. The default region of the
+// selected context is used when neither the flag nor the environment variable are set.
 func Region() string {
 	if helper.HandleEscapedEmptyString(region) != "" {
 		return region
@@ -45,7 +47,7 @@ func Region() string {
 	if helper.HandleEscapedEmptyString(awsRegion) != "" {
 		return awsRegion
 	}
-	return ""
+	return config.AWSRegion()
 }
 
 // region is a string flag that indicates which AWS region is being used.
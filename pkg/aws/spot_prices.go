@@ -0,0 +1,142 @@
+package aws
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/ec2"
+	ec2types "github.com/aws/aws-sdk-go-v2/service/ec2/types"
+
+	"github.com/openshift/rosa/pkg/timeout"
+)
+
+// SpotPriceProductDescription is the product of the spot prices of the instances of machine pools.
+const SpotPriceProductDescription = "Linux/UNIX"
+
+// SpotPricePeriod is the period of the spot price history used to compute the average spot prices.
+const SpotPricePeriod = 24 * time.Hour
+
+// Maximum number of instance types requested at once from the spot price history.
+const spotPriceInstanceTypesPageSize = 50
+
+// SpotPrice is the hourly spot price, in US dollars, of an instance type in a set of availability zones.
+type SpotPrice struct {
+	InstanceType string `json:"instance_type"`
+	// Current is the highest of the latest prices of the availability zones.
+	Current float64 `json:"current"`
+	// Average is the average of the prices of the availability zones since the start of the period,
+	// weighted by how long each price was in effect.
+	Average float64 `json:"average"`
+}
+
+// spotPriceChange is a price of the spot price history, in effect from its timestamp until the next one.
+type spotPriceChange struct {
+	timestamp time.Time
+	price     float64
+}
+
+// GetSpotPrices returns the spot prices of the given instance types in the given availability zones,
+// or in all the availability zones of the region if none are given, since the given time. Instance
+// types without spot price history are not included in the result.
+func (c *awsClient) GetSpotPrices(instanceTypes []string, availabilityZones []string,
+	since time.Time) (map[string]*SpotPrice, error) {
+	until := time.Now()
+	history := map[string]map[string][]spotPriceChange{}
+
+	for start := 0; start < len(instanceTypes); start += spotPriceInstanceTypesPageSize {
+		end := start + spotPriceInstanceTypesPageSize
+		if end > len(instanceTypes) {
+			end = len(instanceTypes)
+		}
+		input := &ec2.DescribeSpotPriceHistoryInput{
+			ProductDescriptions: []string{SpotPriceProductDescription},
+			StartTime:           aws.Time(since),
+		}
+		for _, instanceType := range instanceTypes[start:end] {
+			input.InstanceTypes = append(input.InstanceTypes, ec2types.InstanceType(instanceType))
+		}
+		if len(availabilityZones) > 0 {
+			input.Filters = []ec2types.Filter{
+				{
+					Name:   aws.String("availability-zone"),
+					Values: availabilityZones,
+				},
+			}
+		}
+
+		paginator := ec2.NewDescribeSpotPriceHistoryPaginator(c.ec2Client, input)
+		for paginator.HasMorePages() {
+			output, err := paginator.NextPage(timeout.Context())
+			if err != nil {
+				return nil, err
+			}
+			for _, entry := range output.SpotPriceHistory {
+				price, err := strconv.ParseFloat(aws.ToString(entry.SpotPrice), 64)
+				if err != nil {
+					return nil, fmt.Errorf("failed to parse spot price '%s' of instance type '%s': %v",
+						aws.ToString(entry.SpotPrice), entry.InstanceType, err)
+				}
+				instanceType := string(entry.InstanceType)
+				zone := aws.ToString(entry.AvailabilityZone)
+				timestamp := aws.ToTime(entry.Timestamp)
+				if history[instanceType] == nil {
+					history[instanceType] = map[string][]spotPriceChange{}
+				}
+				history[instanceType][zone] = append(history[instanceType][zone],
+					spotPriceChange{timestamp: timestamp, price: price})
+			}
+		}
+	}
+
+	prices := map[string]*SpotPrice{}
+	for instanceType, zones := range history {
+		prices[instanceType] = newSpotPrice(instanceType, zones, since, until)
+	}
+	return prices, nil
+}
+
+// newSpotPrice computes the current and average spot prices of an instance type from the price changes
+// of each availability zone between the given times. The history may start with the price that was in
+// effect before the start of the period, which is then only counted from the start.
+func newSpotPrice(instanceType string, zones map[string][]spotPriceChange, since time.Time,
+	until time.Time) *SpotPrice {
+	spotPrice := &SpotPrice{
+		InstanceType: instanceType,
+	}
+	var total, hours, sum float64
+	var count int
+	for _, changes := range zones {
+		sort.Slice(changes, func(i, j int) bool {
+			return changes[i].timestamp.Before(changes[j].timestamp)
+		})
+		for i, change := range changes {
+			start := change.timestamp
+			if start.Before(since) {
+				start = since
+			}
+			end := until
+			if i+1 < len(changes) {
+				end = changes[i+1].timestamp
+			}
+			if end.After(start) {
+				total += change.price * end.Sub(start).Hours()
+				hours += end.Sub(start).Hours()
+			}
+			sum += change.price
+			count++
+		}
+		if latest := changes[len(changes)-1].price; latest > spotPrice.Current {
+			spotPrice.Current = latest
+		}
+	}
+	// Fall back to the mean of the prices when all of them are outside the period
+	if hours > 0 {
+		spotPrice.Average = total / hours
+	} else {
+		spotPrice.Average = sum / float64(count)
+	}
+	return spotPrice
+}
@@ -56,20 +56,29 @@ type Config struct {
 	TokenURL     string   `json:"token_url,omitempty" doc:"OpenID token URL."`
 	URL          string   `json:"url,omitempty" doc:"URL of the API gateway."`
 	FedRAMP      bool     `json:"fedramp,omitempty" doc:"Indicates FedRAMP."`
+
+	// The settings above belong to the current context. The rest of the contexts are stored here,
+	// see context.go.
+	CurrentContext string                    `json:"current_context,omitempty"`
+	Contexts       map[string]*ContextConfig `json:"contexts,omitempty"`
 }
 
 var DisallowedSetConfigProperties = []string{"scopes"}
 
 func ConfigPropertiesNamesAndDocs() ([]string, []string) {
 	configType := reflect.ValueOf(Config{}).Type()
-	names := make([]string, configType.NumField())
-	docs := make([]string, configType.NumField())
+	names := make([]string, 0, configType.NumField())
+	docs := make([]string, 0, configType.NumField())
 	for i := 0; i < configType.NumField(); i++ {
 		tag := configType.Field(i).Tag
-		propName := strings.Split(tag.Get("json"), ",")[0]
-		names[i] = propName
 		propDoc := tag.Get("doc")
-		docs[i] = propDoc
+		// Fields without documentation, like the contexts, aren't configuration variables
+		if propDoc == "" {
+			continue
+		}
+		propName := strings.Split(tag.Get("json"), ",")[0]
+		names = append(names, propName)
+		docs = append(docs, propDoc)
 	}
 	return names, docs
 }
@@ -99,8 +108,25 @@ func GetAllowedConfigProperties() []string {
 	return allowedProperties
 }
 
-// Loads the configuration from the OS keyring if requested, load from the configuration file if not
+// Loads the configuration from the OS keyring if requested, load from the configuration file if not.
+// When the '--context' flag selects a context other than the current one, the configuration of that
+// context is returned instead, or nil if it doesn't exist.
 func Load() (cfg *Config, err error) {
+	cfg, err = loadStored()
+	if err != nil || cfg == nil || !cfg.isOtherContext() {
+		return
+	}
+	context, ok := cfg.Contexts[contextName]
+	if !ok {
+		return nil, nil
+	}
+	result := context.Config
+	return &result, nil
+}
+
+// loadStored loads the configuration as it is stored, with the settings of the current context and
+// the rest of the contexts.
+func loadStored() (cfg *Config, err error) {
 	if keyring, ok := IsKeyringManaged(); ok {
 		return loadFromOS(keyring)
 	}
@@ -161,8 +187,31 @@ func loadFromFile() (cfg *Config, err error) {
 	return
 }
 
-// Save saves the given configuration to the configuration file.
+// Save saves the given configuration to the configuration file. The settings are saved to the context
+// selected with the '--context' flag, or to the current one, preserving the rest of the contexts.
 func Save(cfg *Config) error {
+	stored, err := loadStored()
+	if err != nil {
+		return err
+	}
+	if stored == nil {
+		stored = &Config{}
+	}
+	// The first context saved becomes the current one
+	if contextName != "" && stored.CurrentContext == "" && stored.isEmpty() {
+		stored.CurrentContext = contextName
+	}
+	if stored.isOtherContext() {
+		stored.context(contextName).Config = cfg.settings()
+	} else {
+		stored.setSettings(cfg.settings())
+	}
+	return saveStored(stored)
+}
+
+// saveStored saves the configuration as it is stored, with the settings of the current context and the
+// rest of the contexts.
+func saveStored(cfg *Config) error {
 	file, err := Location()
 	if err != nil {
 		return err
@@ -193,8 +242,18 @@ func Save(cfg *Config) error {
 	return nil
 }
 
-// Remove removes the configuration file.
+// Remove removes the configuration file. When there are contexts, only the one selected with the
+// '--context' flag, or the current one, is removed.
 func Remove() error {
+	stored, err := loadStored()
+	if err == nil && stored != nil && len(stored.Contexts) > 0 {
+		return removeContext(stored)
+	}
+	return removeStored()
+}
+
+// removeStored removes the stored configuration, including all the contexts.
+func removeStored() error {
 	if keyring, ok := IsKeyringManaged(); ok {
 		err := RemoveConfigFromKeyring(keyring)
 		if err != nil {
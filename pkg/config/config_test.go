@@ -199,6 +199,7 @@ var _ = Describe("Config Keyring", func() {
 				}
 				mockSpy := &mockSpy{}
 				UpsertConfigToKeyring = mockSpy.MockUpsertConfigToKeyring
+				GetConfigFromKeyring = mockSpy.MockGetConfigFromKeyring
 
 				err := Save(data)
 				Expect(err).To(BeNil())
@@ -212,6 +213,7 @@ var _ = Describe("Config Keyring", func() {
 				mockSpy := &mockSpy{}
 				mockSpy.upsertErr = fmt.Errorf("error")
 				UpsertConfigToKeyring = mockSpy.MockUpsertConfigToKeyring
+				GetConfigFromKeyring = mockSpy.MockGetConfigFromKeyring
 
 				err := Save(data)
 				Expect(err).NotTo(BeNil())
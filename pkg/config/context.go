@@ -0,0 +1,277 @@
+/*
+Copyright (c) 2024 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// This file contains the types and functions used to manage contexts, named sets of settings that
+// allow switching between environments and organizations without logging in again, and the
+// '--context' command line option.
+//
+// The settings of the current context are stored at the top level of the configuration, where
+// other tools reading the same file expect them, while the entry of the current context only keeps
+// its AWS defaults. Switching contexts swaps the settings.
+
+package config
+
+import (
+	"fmt"
+	"reflect"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/spf13/pflag"
+)
+
+const (
+	ContextFlagName = "context"
+
+	// DefaultContextName is the name given to the settings of a login that doesn't belong to a context
+	// when switching to another one.
+	DefaultContextName = "default"
+)
+
+// ContextConfig contains the settings of a context and the AWS defaults used with them.
+type ContextConfig struct {
+	Config
+	AWSProfile string `json:"aws_profile,omitempty"`
+	AWSRegion  string `json:"aws_region,omitempty"`
+}
+
+// contextName is the name of the context selected with the '--context' flag.
+var contextName string
+
+// AddContextFlag adds the context flag to the given set of command line flags.
+func AddContextFlag(flags *pflag.FlagSet) {
+	flags.StringVar(
+		&contextName,
+		ContextFlagName,
+		"",
+		"Use the settings of the given context instead of the current one. See 'rosa config get-contexts'.",
+	)
+}
+
+// ContextName returns the name of the context selected with the '--context' flag, if any.
+func ContextName() string {
+	return contextName
+}
+
+func SetContextName(name string) {
+	contextName = name
+	selected = nil
+	selectedOnce = sync.Once{}
+}
+
+// GetContexts returns the contexts and the name of the current one. The returned contexts include
+// the settings of the current one.
+func GetContexts() (contexts map[string]*ContextConfig, current string, err error) {
+	stored, err := loadStored()
+	if err != nil || stored == nil {
+		return
+	}
+	contexts = map[string]*ContextConfig{}
+	for name, context := range stored.Contexts {
+		copied := *context
+		contexts[name] = &copied
+	}
+	current = stored.CurrentContext
+	if current != "" {
+		context := &ContextConfig{}
+		if existing, ok := contexts[current]; ok {
+			context = existing
+		}
+		context.Config = stored.settings()
+		contexts[current] = context
+	}
+	return
+}
+
+// SortedContextNames returns the names of the given contexts in alphabetical order.
+func SortedContextNames(contexts map[string]*ContextConfig) []string {
+	names := make([]string, 0, len(contexts))
+	for name := range contexts {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+	return names
+}
+
+// UseContext makes the given context the current one. If the current settings don't belong to a
+// context yet they are saved as the 'default' one, so that they aren't lost.
+func UseContext(name string) error {
+	stored, err := loadStored()
+	if err != nil {
+		return err
+	}
+	if stored == nil || stored.Contexts[name] == nil && stored.CurrentContext != name {
+		return contextNotFound(name, stored)
+	}
+	if stored.CurrentContext == name {
+		return nil
+	}
+	if stored.CurrentContext == "" && !stored.isEmpty() {
+		if _, ok := stored.Contexts[DefaultContextName]; ok {
+			return fmt.Errorf("The current settings don't belong to a context and there is already a "+
+				"'%s' context. Name them with 'rosa config set-context' before switching", DefaultContextName)
+		}
+		stored.CurrentContext = DefaultContextName
+	}
+	if stored.CurrentContext != "" {
+		stored.context(stored.CurrentContext).Config = stored.settings()
+	}
+	target := stored.Contexts[name]
+	stored.setSettings(target.Config)
+	target.Config = Config{}
+	stored.CurrentContext = name
+	return saveStored(stored)
+}
+
+// SetContext creates the given context, or updates it if it already exists, calling the given function
+// to change it. When there is no current context the current settings become the given context, so
+// that the first call names the existing login.
+func SetContext(name string, update func(*ContextConfig)) (created bool, err error) {
+	if name == "" || strings.ContainsAny(name, " \t\n") {
+		return false, fmt.Errorf("Invalid context name '%s'", name)
+	}
+	stored, err := loadStored()
+	if err != nil {
+		return false, err
+	}
+	if stored == nil {
+		stored = &Config{}
+	}
+	_, exists := stored.Contexts[name]
+	created = !exists && stored.CurrentContext != name
+	if stored.CurrentContext == "" {
+		stored.CurrentContext = name
+	}
+	update(stored.context(name))
+	return created, saveStored(stored)
+}
+
+// removeContext removes the context selected with the '--context' flag, or the current one, and
+// removes the configuration when there is nothing else left.
+func removeContext(stored *Config) error {
+	name := contextName
+	if name == "" || name == stored.CurrentContext {
+		stored.setSettings(Config{})
+		delete(stored.Contexts, stored.CurrentContext)
+		stored.CurrentContext = ""
+	} else {
+		if _, ok := stored.Contexts[name]; !ok {
+			return contextNotFound(name, stored)
+		}
+		delete(stored.Contexts, name)
+	}
+	if len(stored.Contexts) == 0 && stored.isEmpty() {
+		return removeStored()
+	}
+	return saveStored(stored)
+}
+
+func contextNotFound(name string, stored *Config) error {
+	names := []string{}
+	if stored != nil {
+		contexts := map[string]*ContextConfig{}
+		for name, context := range stored.Contexts {
+			contexts[name] = context
+		}
+		if stored.CurrentContext != "" {
+			contexts[stored.CurrentContext] = nil
+		}
+		names = SortedContextNames(contexts)
+	}
+	if len(names) == 0 {
+		return fmt.Errorf("Context '%s' doesn't exist, there are no contexts", name)
+	}
+	return fmt.Errorf("Context '%s' doesn't exist. Available contexts are: %s", name, strings.Join(names, ", "))
+}
+
+// isOtherContext returns true if the '--context' flag selects a context other than the current one.
+func (c *Config) isOtherContext() bool {
+	return contextName != "" && contextName != c.CurrentContext
+}
+
+// settings returns a copy of the settings of the current context.
+func (c *Config) settings() Config {
+	if c == nil {
+		return Config{}
+	}
+	result := *c
+	result.CurrentContext = ""
+	result.Contexts = nil
+	return result
+}
+
+// setSettings replaces the settings of the current context, preserving the contexts.
+func (c *Config) setSettings(settings Config) {
+	current, contexts := c.CurrentContext, c.Contexts
+	*c = settings
+	c.CurrentContext, c.Contexts = current, contexts
+}
+
+func (c *Config) isEmpty() bool {
+	return reflect.DeepEqual(c.settings(), Config{})
+}
+
+// context returns the entry of the given context, creating it if it doesn't exist.
+func (c *Config) context(name string) *ContextConfig {
+	if c.Contexts == nil {
+		c.Contexts = map[string]*ContextConfig{}
+	}
+	context, ok := c.Contexts[name]
+	if !ok {
+		context = &ContextConfig{}
+		c.Contexts[name] = context
+	}
+	return context
+}
+
+var (
+	selected     *ContextConfig
+	selectedOnce sync.Once
+)
+
+// selectedContext returns the entry of the context selected with the '--context' flag, or of the
+// current one. It is loaded only once, as it is used for defaults that are read often.
+func selectedContext() *ContextConfig {
+	selectedOnce.Do(func() {
+		stored, err := loadStored()
+		if err != nil || stored == nil {
+			return
+		}
+		name := contextName
+		if name == "" {
+			name = stored.CurrentContext
+		}
+		selected = stored.Contexts[name]
+	})
+	return selected
+}
+
+// AWSProfile returns the default AWS profile of the selected context, if any.
+func AWSProfile() string {
+	if context := selectedContext(); context != nil {
+		return context.AWSProfile
+	}
+	return ""
+}
+
+// AWSRegion returns the default AWS region of the selected context, if any.
+func AWSRegion() string {
+	if context := selectedContext(); context != nil {
+		return context.AWSRegion
+	}
+	return ""
+}
@@ -0,0 +1,110 @@
+package config
+
+import (
+	"os"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Contexts", Ordered, func() {
+	BeforeAll(func() {
+		tmpdir, err := os.MkdirTemp("/tmp", ".ocm-config-*")
+		Expect(err).To(BeNil())
+		os.Setenv("OCM_CONFIG", tmpdir+"/ocm_config.json")
+		DeferCleanup(func() {
+			os.Setenv("OCM_CONFIG", "")
+			os.RemoveAll(tmpdir)
+		})
+	})
+
+	AfterEach(func() {
+		SetContextName("")
+	})
+
+	It("Names the existing login", func() {
+		Expect(Save(&Config{URL: "https://api.openshift.com", AccessToken: "prod"})).To(Succeed())
+
+		created, err := SetContext("prod", func(context *ContextConfig) {
+			context.AWSProfile = "prod-profile"
+		})
+		Expect(err).To(BeNil())
+		Expect(created).To(BeTrue())
+
+		contexts, current, err := GetContexts()
+		Expect(err).To(BeNil())
+		Expect(current).To(Equal("prod"))
+		Expect(contexts).To(HaveLen(1))
+		Expect(contexts["prod"].AccessToken).To(Equal("prod"))
+		Expect(contexts["prod"].AWSProfile).To(Equal("prod-profile"))
+	})
+
+	It("Logs in to another context without switching", func() {
+		SetContextName("stage")
+		cfg, err := Load()
+		Expect(err).To(BeNil())
+		Expect(cfg).To(BeNil())
+		Expect(Save(&Config{URL: "https://api.stage.openshift.com", AccessToken: "stage"})).To(Succeed())
+
+		cfg, err = Load()
+		Expect(err).To(BeNil())
+		Expect(cfg.AccessToken).To(Equal("stage"))
+
+		SetContextName("")
+		cfg, err = Load()
+		Expect(err).To(BeNil())
+		Expect(cfg.AccessToken).To(Equal("prod"))
+		Expect(cfg.CurrentContext).To(Equal("prod"))
+	})
+
+	It("Switches contexts", func() {
+		Expect(UseContext("stage")).To(Succeed())
+
+		cfg, err := Load()
+		Expect(err).To(BeNil())
+		Expect(cfg.AccessToken).To(Equal("stage"))
+		Expect(cfg.URL).To(Equal("https://api.stage.openshift.com"))
+		Expect(cfg.Contexts["prod"].AccessToken).To(Equal("prod"))
+		Expect(cfg.Contexts["prod"].AWSProfile).To(Equal("prod-profile"))
+		Expect(cfg.Contexts["stage"].AccessToken).To(BeEmpty())
+
+		SetContextName("prod")
+		Expect(AWSProfile()).To(Equal("prod-profile"))
+		cfg, err = Load()
+		Expect(err).To(BeNil())
+		Expect(cfg.AccessToken).To(Equal("prod"))
+	})
+
+	It("Saves refreshed tokens to the selected context", func() {
+		SetContextName("prod")
+		Expect(Save(&Config{URL: "https://api.openshift.com", AccessToken: "refreshed"})).To(Succeed())
+
+		SetContextName("")
+		Expect(UseContext("prod")).To(Succeed())
+		cfg, err := Load()
+		Expect(err).To(BeNil())
+		Expect(cfg.AccessToken).To(Equal("refreshed"))
+		Expect(cfg.Contexts["stage"].AccessToken).To(Equal("stage"))
+	})
+
+	It("Fails to switch to unknown contexts", func() {
+		Expect(UseContext("fedramp")).To(MatchError(
+			"Context 'fedramp' doesn't exist. Available contexts are: prod, stage"))
+	})
+
+	It("Removes only the selected context", func() {
+		SetContextName("stage")
+		Expect(Remove()).To(Succeed())
+
+		contexts, current, err := GetContexts()
+		Expect(err).To(BeNil())
+		Expect(current).To(Equal("prod"))
+		Expect(SortedContextNames(contexts)).To(Equal([]string{"prod"}))
+
+		SetContextName("")
+		Expect(Remove()).To(Succeed())
+		cfg, err := Load()
+		Expect(err).To(BeNil())
+		Expect(cfg).To(BeNil())
+	})
+})
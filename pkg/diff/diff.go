@@ -0,0 +1,202 @@
+/*
+Copyright (c) 2024 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package diff
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"io"
+	"reflect"
+	"sort"
+	"strings"
+)
+
+// Field is a single field that differs between the desired and the current state of a resource.
+type Field struct {
+	Path    string
+	Current interface{}
+	Desired interface{}
+}
+
+// Fields that are only compared as a whole, so that removing an entry is detected as a change.
+var replacedFields = map[string]bool{
+	"labels": true,
+	"tags":   true,
+}
+
+// IsReplaced returns true if the given field is compared, and updated, as a whole instead of key by key.
+func IsReplaced(field string) bool {
+	return replacedFields[field]
+}
+
+// Fields that describe the type of an object, at any level, rather than its state.
+var ignoredFields = map[string]bool{
+	"kind": true,
+	"href": true,
+}
+
+// Fields returns the fields set in the desired resource that differ from the current one. Fields
+// that are not set in the desired resource are left alone, so that an OCM patch object can be
+// compared with the complete resource it will be applied to.
+func Fields(desired, current map[string]interface{}) []Field {
+	return fields("", desired, current)
+}
+
+func fields(path string, desired, current map[string]interface{}) []Field {
+	keys := make([]string, 0, len(desired))
+	for key := range desired {
+		// The identifier of nested objects, like the version, is part of the state of the resource
+		if ignoredFields[key] || path == "" && key == "id" {
+			continue
+		}
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+
+	changes := []Field{}
+	for _, key := range keys {
+		fieldPath := key
+		if path != "" {
+			fieldPath = path + "." + key
+		}
+		desiredValue := desired[key]
+		currentValue := current[key]
+		if desiredMap, ok := desiredValue.(map[string]interface{}); ok && !replacedFields[key] {
+			currentMap, _ := currentValue.(map[string]interface{})
+			changes = append(changes, fields(fieldPath, desiredMap, currentMap)...)
+			continue
+		}
+		if isEmpty(desiredValue) && isEmpty(currentValue) {
+			continue
+		}
+		if !reflect.DeepEqual(desiredValue, currentValue) {
+			changes = append(changes, Field{
+				Path:    fieldPath,
+				Current: currentValue,
+				Desired: desiredValue,
+			})
+		}
+	}
+	return changes
+}
+
+// Changed returns the fields set in the desired resource that differ from the current one, with the same
+// structure as the desired resource, so that it can be sent as the body of an update that leaves the
+// other fields, including the immutable ones, alone. The fields are compared the same way as in Fields.
+func Changed(desired, current map[string]interface{}) map[string]interface{} {
+	return changed(true, desired, current)
+}
+
+func changed(topLevel bool, desired, current map[string]interface{}) map[string]interface{} {
+	result := map[string]interface{}{}
+	for key, desiredValue := range desired {
+		if ignoredFields[key] || topLevel && key == "id" {
+			continue
+		}
+		currentValue := current[key]
+		if desiredMap, ok := desiredValue.(map[string]interface{}); ok && !replacedFields[key] {
+			currentMap, _ := currentValue.(map[string]interface{})
+			nested := changed(false, desiredMap, currentMap)
+			if len(nested) > 0 {
+				result[key] = nested
+			}
+			continue
+		}
+		if isEmpty(desiredValue) && isEmpty(currentValue) {
+			continue
+		}
+		if !reflect.DeepEqual(desiredValue, currentValue) {
+			result[key] = desiredValue
+		}
+	}
+	return result
+}
+
+func isEmpty(value interface{}) bool {
+	switch v := value.(type) {
+	case nil:
+		return true
+	case map[string]interface{}:
+		return len(v) == 0
+	case []interface{}:
+		return len(v) == 0
+	}
+	return false
+}
+
+// Of returns the JSON representation of an OCM object as a generic map. The argument is usually a
+// closure around one of the 'Marshal...' functions of the OCM SDK.
+func Of(marshal func(w io.Writer) error) (map[string]interface{}, error) {
+	var b bytes.Buffer
+	err := marshal(&b)
+	if err != nil {
+		return nil, err
+	}
+	result := map[string]interface{}{}
+	err = json.Unmarshal(b.Bytes(), &result)
+	if err != nil {
+		return nil, err
+	}
+	return result, nil
+}
+
+// Objects marshals the desired and current OCM objects and returns the fields that differ.
+func Objects(desired, current func(w io.Writer) error) ([]Field, error) {
+	desiredFields, err := Of(desired)
+	if err != nil {
+		return nil, err
+	}
+	currentFields, err := Of(current)
+	if err != nil {
+		return nil, err
+	}
+	return Fields(desiredFields, currentFields), nil
+}
+
+// Format returns the changed fields one per line, with the given indentation.
+func Format(changes []Field, indent string) string {
+	var b strings.Builder
+	for _, f := range changes {
+		fmt.Fprintf(&b, "%s%s: %s -> %s\n", indent, f.Path, FormatValue(f.Current), FormatValue(f.Desired))
+	}
+	return b.String()
+}
+
+// FormatValue returns the value as compact JSON, or '<none>' when it isn't set.
+func FormatValue(value interface{}) string {
+	if value == nil {
+		return "<none>"
+	}
+	data, err := json.Marshal(value)
+	if err != nil {
+		return fmt.Sprintf("%v", value)
+	}
+	return string(data)
+}
+
+// Print writes the changes that would be made to the given resource to the standard output and
+// returns the exit code that the command should use: zero when there are no changes and
+// ChangesExitCode otherwise.
+func Print(resource string, changes []Field) int {
+	if len(changes) == 0 {
+		fmt.Printf("No changes to %s\n", resource)
+		return 0
+	}
+	fmt.Printf("Changes to %s:\n\n%s", resource, Format(changes, "  "))
+	return ChangesExitCode
+}
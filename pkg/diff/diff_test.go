@@ -0,0 +1,118 @@
+package diff
+
+import (
+	"io"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+)
+
+var _ = Describe("Diff", func() {
+	Context("Fields", func() {
+		It("OK: only compares the fields that are set in the desired object", func() {
+			changes := Fields(
+				map[string]interface{}{
+					"id":       "workers",
+					"replicas": float64(5),
+					"autoscaling": map[string]interface{}{
+						"min_replicas": float64(2),
+					},
+					"labels": map[string]interface{}{"a": "b"},
+					"taints": []interface{}{},
+				},
+				map[string]interface{}{
+					"id":            "workers",
+					"replicas":      float64(3),
+					"instance_type": "m5.xlarge",
+					"autoscaling": map[string]interface{}{
+						"min_replicas": float64(2),
+						"max_replicas": float64(6),
+					},
+					"labels": map[string]interface{}{"a": "b", "c": "d"},
+				},
+			)
+			Expect(changes).To(Equal([]Field{
+				{
+					Path:    "labels",
+					Current: map[string]interface{}{"a": "b", "c": "d"},
+					Desired: map[string]interface{}{"a": "b"},
+				},
+				{Path: "replicas", Current: float64(3), Desired: float64(5)},
+			}))
+			Expect(Format(changes, "  ")).To(Equal("  labels: {\"a\":\"b\",\"c\":\"d\"} -> {\"a\":\"b\"}\n" +
+				"  replicas: 3 -> 5\n"))
+		})
+
+		It("OK: reports nested fields with their full path", func() {
+			changes := Fields(
+				map[string]interface{}{"api": map[string]interface{}{"listening": "internal"}},
+				map[string]interface{}{},
+			)
+			Expect(changes).To(HaveLen(1))
+			Expect(changes[0].Path).To(Equal("api.listening"))
+			Expect(FormatValue(changes[0].Current)).To(Equal("<none>"))
+		})
+	})
+
+	Context("Changed", func() {
+		It("OK: keeps only the fields that differ, with their structure", func() {
+			changed := Changed(
+				map[string]interface{}{
+					"kind":          "MachinePool",
+					"id":            "workers",
+					"instance_type": "m5.xlarge",
+					"replicas":      float64(5),
+					"autoscaling": map[string]interface{}{
+						"min_replicas": float64(2),
+						"max_replicas": float64(8),
+					},
+					"labels": map[string]interface{}{"a": "b"},
+					"taints": []interface{}{},
+				},
+				map[string]interface{}{
+					"id":            "workers",
+					"instance_type": "m5.xlarge",
+					"replicas":      float64(3),
+					"autoscaling": map[string]interface{}{
+						"min_replicas": float64(2),
+						"max_replicas": float64(6),
+					},
+					"labels": map[string]interface{}{"a": "b", "c": "d"},
+				},
+			)
+			Expect(changed).To(Equal(map[string]interface{}{
+				"replicas":    float64(5),
+				"autoscaling": map[string]interface{}{"max_replicas": float64(8)},
+				"labels":      map[string]interface{}{"a": "b"},
+			}))
+		})
+	})
+
+	Context("Objects", func() {
+		It("OK: compares an OCM patch with the current object", func() {
+			current, err := cmv1.NewMachinePool().ID("workers").Replicas(3).InstanceType("m5.xlarge").Build()
+			Expect(err).NotTo(HaveOccurred())
+			patch, err := cmv1.NewMachinePool().ID("workers").Replicas(3).Build()
+			Expect(err).NotTo(HaveOccurred())
+
+			changes, err := Objects(
+				func(w io.Writer) error { return cmv1.MarshalMachinePool(patch, w) },
+				func(w io.Writer) error { return cmv1.MarshalMachinePool(current, w) },
+			)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(changes).To(BeEmpty())
+
+			patch, err = cmv1.NewMachinePool().ID("workers").
+				Autoscaling(cmv1.NewMachinePoolAutoscaling().MinReplicas(3).MaxReplicas(6)).Build()
+			Expect(err).NotTo(HaveOccurred())
+			changes, err = Objects(
+				func(w io.Writer) error { return cmv1.MarshalMachinePool(patch, w) },
+				func(w io.Writer) error { return cmv1.MarshalMachinePool(current, w) },
+			)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(Format(changes, "")).To(Equal("autoscaling.max_replicas: <none> -> 6\n" +
+				"autoscaling.min_replicas: <none> -> 3\n"))
+		})
+	})
+})
@@ -0,0 +1,61 @@
+/*
+Copyright (c) 2024 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// This file contains functions used to implement the '--dry-run' and '--plan' command line options.
+
+package diff
+
+import (
+	"github.com/spf13/pflag"
+)
+
+const (
+	DryRunFlag = "dry-run"
+	PlanFlag   = "plan"
+
+	// ChangesExitCode is the exit code used when a dry run finds changes, so that scripts can tell
+	// apart a resource that is already up to date from one that would be modified.
+	ChangesExitCode = 2
+)
+
+// AddFlag adds the dry run flag, and its alias, to the given set of command line flags.
+func AddFlag(flags *pflag.FlagSet) {
+	flags.BoolVar(
+		&enabled,
+		DryRunFlag,
+		false,
+		"Print the changes that would be made, field by field, without applying them. "+
+			"Exits with code 2 when there are changes.",
+	)
+	flags.BoolVar(
+		&enabled,
+		PlanFlag,
+		false,
+		"Alias for --dry-run.",
+	)
+}
+
+// Enabled returns a boolean flag that indicates if the dry run mode is enabled.
+func Enabled() bool {
+	return enabled
+}
+
+func SetEnabled(dryRunEnabled bool) {
+	enabled = dryRunEnabled
+}
+
+// enabled is a boolean flag that indicates that the dry run mode is enabled.
+var enabled bool
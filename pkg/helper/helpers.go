@@ -15,6 +15,7 @@ import (
 	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
 
 	"github.com/openshift/rosa/pkg/reporter"
+	"github.com/openshift/rosa/pkg/timeout"
 )
 
 var r *rand.Rand
@@ -156,16 +157,16 @@ func RemoveStrFromSlice(s []string, str string) []string {
 	return s
 }
 
-func DisplaySpinnerWithDelay(reporter *reporter.Object, infoMessage string, delay time.Duration) {
+// DisplaySpinnerWithDelay waits for the given delay, showing a spinner in terminals. It returns the
+// reason of the cancellation if the command is cancelled while waiting.
+func DisplaySpinnerWithDelay(reporter *reporter.Object, infoMessage string, delay time.Duration) error {
 	if reporter.IsTerminal() {
 		spin := spinner.New(spinner.CharSets[9], 100*time.Millisecond)
 		reporter.Infof(infoMessage)
 		spin.Start()
-		time.Sleep(delay)
-		spin.Stop()
-	} else {
-		time.Sleep(delay)
+		defer spin.Stop()
 	}
+	return timeout.Sleep(delay)
 }
 
 func SaveDocument(doc, filename string) error {
@@ -3,6 +3,7 @@ package machinepools
 import (
 	"fmt"
 	"os"
+	"slices"
 	"strconv"
 	"strings"
 
@@ -14,6 +15,7 @@ import (
 	"github.com/spf13/cobra"
 
 	"github.com/openshift/rosa/pkg/interactive"
+	"github.com/openshift/rosa/pkg/reporter"
 	"github.com/openshift/rosa/pkg/rosa"
 )
 
@@ -28,8 +30,19 @@ const (
 		nodeDrainUnitHour + "|" + nodeDrainUnitHours
 	MaxNodeDrainTimeInMinutes = 10080
 	MaxNodeDrainTimeInHours   = 168
+
+	// NodePoolNameMaxLength is the maximum length of the names of the node pools of Hosted Control
+	// Plane clusters.
+	NodePoolNameMaxLength = 15
 )
 
+// Effects supported by Kubernetes for node taints
+var taintEffects = []string{"NoSchedule", "PreferNoSchedule", "NoExecute"}
+
+// Domains, and their subdomains like 'node-role.kubernetes.io', that can't be used as the prefix of
+// machine pool labels
+var reservedLabelDomains = []string{"kubernetes.io", "k8s.io"}
+
 func MinNodePoolReplicaValidator(autoscaling bool) interactive.Validator {
 	return func(val interface{}) error {
 		minReplicas, err := strconv.Atoi(fmt.Sprintf("%v", val))
@@ -72,7 +85,7 @@ func ParseLabels(labels string) (map[string]string, error) {
 		if !strings.Contains(label, "=") {
 			return nil, fmt.Errorf("Expected key=value format for labels")
 		}
-		tokens := strings.Split(label, "=")
+		tokens := strings.SplitN(label, "=", 2)
 		err := ValidateLabelKeyValuePair(tokens[0], tokens[1])
 		if err != nil {
 			return nil, err
@@ -112,13 +125,13 @@ func GetTaints(cmd *cobra.Command, r *rosa.Runtime, existingTaints []*cmv1.Taint
 		})
 		if err != nil {
 			r.Reporter.Errorf("Expected a valid comma-separated list of attributes: %s", err)
-			os.Exit(1)
+			os.Exit(reporter.ExitCode())
 		}
 	}
 	taintBuilders, err := ParseTaints(inputTaints)
 	if err != nil {
 		r.Reporter.Errorf("%s", err)
-		os.Exit(1)
+		os.Exit(reporter.ExitCode())
 	}
 	return taintBuilders
 }
@@ -129,25 +142,21 @@ func ParseTaints(taints string) ([]*cmv1.TaintBuilder, error) {
 		return taintBuilders, nil
 	}
 	var errs []error
+	// Taints must be unique by key and effect
+	seen := map[string]bool{}
 	for _, taint := range strings.Split(taints, ",") {
 		if !strings.Contains(taint, "=") || !strings.Contains(taint, ":") {
 			return nil, fmt.Errorf("Expected key=value:scheduleType format for taints. Got '%s'", taint)
 		}
 		// First split effect
-		splitEffect := strings.Split(taint, ":")
+		splitEffect := strings.SplitN(taint, ":", 2)
 		// Then split key and value
-		splitKeyValue := strings.Split(splitEffect[0], "=")
+		splitKeyValue := strings.SplitN(splitEffect[0], "=", 2)
 		newTaintBuilder := cmv1.NewTaint().Key(splitKeyValue[0]).Value(splitKeyValue[1]).Effect(splitEffect[1])
-		newTaint, _ := newTaintBuilder.Build()
-		if err := ValidateTaintKeyValuePair(newTaint.Key(), newTaint.Value()); err != nil {
+		if err := validateTaint(splitKeyValue[0], splitKeyValue[1], splitEffect[1], seen); err != nil {
 			errs = append(errs, err)
 			continue
 		}
-		if newTaint.Effect() == "" {
-			// Note: an empty effect means any effect. For the moment this is not supported
-			errs = append(errs, fmt.Errorf("Expected a not empty effect"))
-			continue
-		}
 		taintBuilders = append(taintBuilders, newTaintBuilder)
 	}
 
@@ -158,6 +167,28 @@ func ParseTaints(taints string) ([]*cmv1.TaintBuilder, error) {
 	return taintBuilders, nil
 }
 
+// validateTaint checks the key, value and effect of a taint, and that no other taint with the same
+// key and effect was seen before.
+func validateTaint(key, value, effect string, seen map[string]bool) error {
+	if err := ValidateTaintKeyValuePair(key, value); err != nil {
+		return err
+	}
+	if effect == "" {
+		// Note: an empty effect means any effect. For the moment this is not supported
+		return fmt.Errorf("Expected a not empty effect")
+	}
+	if !slices.Contains(taintEffects, effect) {
+		return fmt.Errorf("Invalid taint effect '%s' at key '%s': expected one of '%s'",
+			effect, key, strings.Join(taintEffects, "', '"))
+	}
+	keyEffect := key + ":" + effect
+	if seen[keyEffect] {
+		return fmt.Errorf("Duplicated taint key '%s' used with effect '%s'", key, effect)
+	}
+	seen[keyEffect] = true
+	return nil
+}
+
 func ValidateTaintKeyValuePair(key, value string) error {
 	return ValidateKeyValuePair(key, value, "taint")
 }
@@ -166,6 +197,32 @@ func ValidateLabelKeyValuePair(key, value string) error {
 	return ValidateKeyValuePair(key, value, "label")
 }
 
+// ValidatePoolLabels checks that the labels to set on a machine pool don't use the prefixes reserved
+// for the labels managed by the cluster. Labels that the machine pool already has are kept as they are.
+func ValidatePoolLabels(labels map[string]string, existingLabels map[string]string) error {
+	keys := make([]string, 0, len(labels))
+	for key := range labels {
+		keys = append(keys, key)
+	}
+	slices.Sort(keys)
+	for _, key := range keys {
+		if value, ok := existingLabels[key]; ok && value == labels[key] {
+			continue
+		}
+		prefix, _, found := strings.Cut(key, "/")
+		if !found {
+			continue
+		}
+		for _, domain := range reservedLabelDomains {
+			if prefix == domain || strings.HasSuffix(prefix, "."+domain) {
+				return fmt.Errorf("Invalid label key '%s': the '%s' prefix is reserved for Kubernetes",
+					key, prefix)
+			}
+		}
+	}
+	return nil
+}
+
 func ValidateKeyValuePair(key, value string, resourceName string) error {
 	if errs := validation.IsQualifiedName(key); len(errs) != 0 {
 		return fmt.Errorf("Invalid %s key '%s': %s", resourceName, key, strings.Join(errs, "; "))
@@ -211,17 +268,41 @@ func GetLabelMap(cmd *cobra.Command, r *rosa.Runtime, existingLabels map[string]
 		})
 		if err != nil {
 			r.Reporter.Errorf("Expected a valid comma-separated list of attributes: %s", err)
-			os.Exit(1)
+			os.Exit(reporter.ExitCode())
 		}
 	}
 	labelMap, err := ParseLabels(inputLabels)
+	if err == nil {
+		err = ValidatePoolLabels(labelMap, existingLabels)
+	}
 	if err != nil {
 		r.Reporter.Errorf("%s", err)
-		os.Exit(1)
+		os.Exit(reporter.ExitCode())
 	}
 	return labelMap
 }
 
+// AddLabels returns the existing labels of a machine pool with the given comma-separated labels added,
+// replacing the values of the keys that the machine pool already has.
+func AddLabels(existingLabels map[string]string, labels string) (map[string]string, error) {
+	addedLabels, err := ParseLabels(labels)
+	if err != nil {
+		return nil, err
+	}
+	err = ValidatePoolLabels(addedLabels, existingLabels)
+	if err != nil {
+		return nil, err
+	}
+	labelMap := make(map[string]string, len(existingLabels)+len(addedLabels))
+	for key, value := range existingLabels {
+		labelMap[key] = value
+	}
+	for key, value := range addedLabels {
+		labelMap[key] = value
+	}
+	return labelMap, nil
+}
+
 func LabelValidator(val interface{}) error {
 	if labels, ok := val.(string); ok {
 		_, err := ParseLabels(labels)
@@ -233,11 +314,48 @@ func LabelValidator(val interface{}) error {
 	return fmt.Errorf("can only validate strings, got %v", val)
 }
 
+// SetFlagFromFile sets the flag to the comma-separated entries of the file given in the file flag,
+// if any, so that large sets of labels or taints can be given one per line.
+func SetFlagFromFile(cmd *cobra.Command, flagName string, fileFlagName string) error {
+	if !cmd.Flags().Changed(fileFlagName) {
+		return nil
+	}
+	if cmd.Flags().Changed(flagName) {
+		return fmt.Errorf("The '--%s' and '--%s' flags can't be used together", flagName, fileFlagName)
+	}
+	path := cmd.Flags().Lookup(fileFlagName).Value.String()
+	entries, err := ReadListFile(path)
+	if err != nil {
+		return err
+	}
+	return cmd.Flags().Set(flagName, strings.Join(entries, ","))
+}
+
+// ReadListFile reads a file with one entry per line, ignoring empty lines and lines starting with '#'.
+func ReadListFile(path string) ([]string, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("Failed to read file '%s': %v", path, err)
+	}
+	entries := []string{}
+	for i, line := range strings.Split(string(data), "\n") {
+		line = strings.TrimSpace(line)
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		if strings.Contains(line, ",") {
+			return nil, fmt.Errorf("Expected a single entry in line %d of file '%s', got '%s'", i+1, path, line)
+		}
+		entries = append(entries, line)
+	}
+	return entries, nil
+}
+
 func HostedClusterOnlyFlag(r *rosa.Runtime, cmd *cobra.Command, flagName string) {
 	isFlagSet := cmd.Flags().Changed(flagName)
 	if isFlagSet {
 		r.Reporter.Errorf("Setting the `%s` flag is only supported for hosted clusters", flagName)
-		os.Exit(1)
+		os.Exit(reporter.ExitCode())
 	}
 }
 
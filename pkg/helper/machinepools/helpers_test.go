@@ -1,8 +1,13 @@
 package machinepools
 
 import (
+	"os"
+	"path/filepath"
+	"strings"
+
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	"github.com/spf13/cobra"
 )
 
 var _ = Describe("MachinePool", func() {
@@ -41,9 +46,22 @@ var _ = Describe("MachinePool", func() {
 			"node-role.kubernetes.io/infra=:NoSchedule",
 			"", 1),
 		Entry(
-			"Empty value taint good format",
-			"node-role.kubernetes.io/infra=val:NoSchedule,node-role.kubernetes.io/infra=:NoSchedule",
+			"Same key with different effects",
+			"node-role.kubernetes.io/infra=val:NoSchedule,node-role.kubernetes.io/infra=:NoExecute",
 			"", 2),
+		Entry(
+			"Duplicated key and effect -> KO",
+			"node-role.kubernetes.io/infra=val:NoSchedule,node-role.kubernetes.io/infra=:NoSchedule",
+			"Duplicated taint key 'node-role.kubernetes.io/infra' used with effect 'NoSchedule'", 0),
+		Entry(
+			"Invalid effect -> KO",
+			"foo=bar:NoEffect",
+			"Invalid taint effect 'NoEffect' at key 'foo': expected one of 'NoSchedule', 'PreferNoSchedule', "+
+				"'NoExecute'", 0),
+		Entry(
+			"Separator in value -> KO",
+			"foo=bar=baz:NoSchedule",
+			"Invalid taint value 'bar=baz'", 0),
 		Entry(
 			"Empty effect taint -> KO",
 			"node-role.kubernetes.io/infra=:",
@@ -85,8 +103,78 @@ var _ = Describe("MachinePool", func() {
 		Entry("Malformed labels are not supported",
 			"com.example.foo,com.example.bar=bob", "Expected key=value format for labels", 0,
 		),
+		Entry("Separator in label value is not supported",
+			"com.example.foo=bar=baz", "Invalid label value 'bar=baz'", 0,
+		),
+		Entry("Reserved Kubernetes prefix is parsed correctly",
+			"kubernetes.io/hostname=foo", "", 1,
+		),
+		Entry("Label name longer than 63 characters is not supported",
+			"com.example/"+strings.Repeat("a", 64)+"=bar", "must be no more than 63 characters", 0,
+		),
+		Entry("Label prefix that is not a DNS subdomain is not supported",
+			"Example_com/foo=bar", "prefix part a lowercase RFC 1123 subdomain", 0,
+		),
+	)
+
+})
+
+var _ = Describe("Machine pool labels", func() {
+	DescribeTable("Validate pool labels", func(labels, existingLabels map[string]string, expectedError string) {
+		err := ValidatePoolLabels(labels, existingLabels)
+		if expectedError == "" {
+			Expect(err).ToNot(HaveOccurred())
+		} else {
+			Expect(err).To(MatchError(ContainSubstring(expectedError)))
+		}
+	},
+		Entry("Labels without prefix are supported",
+			map[string]string{"foo": "bar"}, nil, "",
+		),
+		Entry("Labels with a custom prefix are supported",
+			map[string]string{"example.com/foo": "bar"}, nil, "",
+		),
+		Entry("Reserved Kubernetes prefix is not supported",
+			map[string]string{"kubernetes.io/hostname": "foo"}, nil,
+			"the 'kubernetes.io' prefix is reserved for Kubernetes",
+		),
+		Entry("Reserved Kubernetes subdomain prefix is not supported",
+			map[string]string{"node-role.kubernetes.io/infra": ""}, nil,
+			"the 'node-role.kubernetes.io' prefix is reserved for Kubernetes",
+		),
+		Entry("Reserved K8s prefix is not supported",
+			map[string]string{"k8s.io/foo": "bar"}, nil,
+			"the 'k8s.io' prefix is reserved for Kubernetes",
+		),
+		Entry("Existing labels with a reserved prefix are kept",
+			map[string]string{"node-role.kubernetes.io/infra": "", "foo": "bar"},
+			map[string]string{"node-role.kubernetes.io/infra": ""}, "",
+		),
+		Entry("Existing labels with a reserved prefix can't be changed",
+			map[string]string{"node-role.kubernetes.io/infra": "yes"},
+			map[string]string{"node-role.kubernetes.io/infra": ""},
+			"the 'node-role.kubernetes.io' prefix is reserved for Kubernetes",
+		),
 	)
+})
+
+var _ = Describe("Add labels", func() {
+	It("Keeps the existing labels and replaces the values of the given keys", func() {
+		labels, err := AddLabels(map[string]string{"env": "prod", "team": "a"}, "team=b,tier=db")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(labels).To(Equal(map[string]string{"env": "prod", "team": "b", "tier": "db"}))
+	})
 
+	It("Keeps the existing labels with a reserved prefix", func() {
+		labels, err := AddLabels(map[string]string{"node-role.kubernetes.io/infra": ""}, "team=a")
+		Expect(err).ToNot(HaveOccurred())
+		Expect(labels).To(Equal(map[string]string{"node-role.kubernetes.io/infra": "", "team": "a"}))
+	})
+
+	It("Rejects new labels with a reserved prefix", func() {
+		_, err := AddLabels(map[string]string{"env": "prod"}, "kubernetes.io/hostname=foo")
+		Expect(err).To(MatchError(ContainSubstring("the 'kubernetes.io' prefix is reserved for Kubernetes")))
+	})
 })
 
 var _ = Describe("Machine pool for hosted clusters", func() {
@@ -330,3 +418,48 @@ var _ = Describe("Validate node drain grace period", func() {
 		),
 	)
 })
+
+var _ = Describe("Labels and taints files", func() {
+	var cmd *cobra.Command
+	var labels string
+	var labelsFile string
+
+	writeFile := func(content string) string {
+		path := filepath.Join(GinkgoT().TempDir(), "labels.txt")
+		Expect(os.WriteFile(path, []byte(content), 0600)).To(Succeed())
+		return path
+	}
+
+	BeforeEach(func() {
+		cmd = &cobra.Command{}
+		cmd.Flags().StringVar(&labels, "labels", "", "")
+		cmd.Flags().StringVar(&labelsFile, "labels-file", "", "")
+	})
+
+	It("Sets the flag to the entries of the file", func() {
+		path := writeFile("# Team labels\nteam=payments\n\n  env=prod  \n")
+		Expect(cmd.Flags().Set("labels-file", path)).To(Succeed())
+		Expect(SetFlagFromFile(cmd, "labels", "labels-file")).To(Succeed())
+		Expect(cmd.Flags().Changed("labels")).To(BeTrue())
+		Expect(labels).To(Equal("team=payments,env=prod"))
+	})
+
+	It("Doesn't set the flag without a file", func() {
+		Expect(SetFlagFromFile(cmd, "labels", "labels-file")).To(Succeed())
+		Expect(cmd.Flags().Changed("labels")).To(BeFalse())
+	})
+
+	It("Fails with both the flag and the file", func() {
+		Expect(cmd.Flags().Set("labels", "team=payments")).To(Succeed())
+		Expect(cmd.Flags().Set("labels-file", writeFile("env=prod"))).To(Succeed())
+		err := SetFlagFromFile(cmd, "labels", "labels-file")
+		Expect(err).To(MatchError("The '--labels' and '--labels-file' flags can't be used together"))
+	})
+
+	It("Fails with several entries in a line", func() {
+		path := writeFile("team=payments\nenv=prod,tier=web\n")
+		_, err := ReadListFile(path)
+		Expect(err).To(MatchError("Expected a single entry in line 2 of file '" + path +
+			"', got 'env=prod,tier=web'"))
+	})
+})
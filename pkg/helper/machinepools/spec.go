@@ -0,0 +1,340 @@
+/*
+Copyright (c) 2024 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package machinepools
+
+import (
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strconv"
+
+	"github.com/ghodss/yaml"
+	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+	"k8s.io/apimachinery/pkg/util/errors"
+
+	ocmOutput "github.com/openshift/rosa/pkg/ocm/output"
+)
+
+// PoolsFile is the document written by 'rosa export machinepools' and read by
+// 'rosa create machinepools --from-file'. It uses the same fields for the machine pools of classic
+// and Hosted Control Plane clusters, so that they can be recreated on a cluster of either kind.
+type PoolsFile struct {
+	Cluster       string             `json:"cluster,omitempty"`
+	KubeletConfig *KubeletConfigSpec `json:"kubelet_config,omitempty"`
+	MachinePools  []*PoolSpec        `json:"machine_pools"`
+}
+
+type KubeletConfigSpec struct {
+	PodPidsLimit int `json:"pod_pids_limit"`
+}
+
+type PoolSpec struct {
+	Name                 string            `json:"name"`
+	InstanceType         string            `json:"instance_type"`
+	Replicas             *int              `json:"replicas,omitempty"`
+	Autoscaling          *AutoscalingSpec  `json:"autoscaling,omitempty"`
+	Labels               map[string]string `json:"labels,omitempty"`
+	Taints               []*TaintSpec      `json:"taints,omitempty"`
+	Spot                 *SpotSpec         `json:"spot,omitempty"`
+	AvailabilityZones    []string          `json:"availability_zones,omitempty"`
+	Subnets              []string          `json:"subnets,omitempty"`
+	TuningConfigs        []string          `json:"tuning_configs,omitempty"`
+	NodeDrainGracePeriod string            `json:"node_drain_grace_period,omitempty"`
+	Autorepair           *bool             `json:"autorepair,omitempty"`
+}
+
+type AutoscalingSpec struct {
+	MinReplicas int `json:"min_replicas"`
+	MaxReplicas int `json:"max_replicas"`
+}
+
+type TaintSpec struct {
+	Key    string `json:"key"`
+	Value  string `json:"value,omitempty"`
+	Effect string `json:"effect"`
+}
+
+// SpotSpec enables spot instances. Without a maximum price the on-demand price is used.
+type SpotSpec struct {
+	MaxPrice *float64 `json:"max_price,omitempty"`
+}
+
+// ParsePoolsFile parses a machine pools file in YAML or JSON format, rejecting unknown fields so that
+// typos aren't silently ignored.
+func ParsePoolsFile(data []byte) (*PoolsFile, error) {
+	body, err := yaml.YAMLToJSON(data)
+	if err != nil {
+		return nil, err
+	}
+	decoder := json.NewDecoder(bytes.NewReader(body))
+	decoder.DisallowUnknownFields()
+	file := &PoolsFile{}
+	err = decoder.Decode(file)
+	if err != nil {
+		return nil, err
+	}
+	for i, pool := range file.MachinePools {
+		if pool == nil || pool.Name == "" {
+			return nil, fmt.Errorf("Machine pool %d doesn't have a name", i+1)
+		}
+		if pool.InstanceType == "" {
+			return nil, fmt.Errorf("Machine pool '%s' doesn't have an instance type", pool.Name)
+		}
+		if pool.Replicas == nil && pool.Autoscaling == nil {
+			return nil, fmt.Errorf("Machine pool '%s' must set either replicas or autoscaling", pool.Name)
+		}
+		err = pool.validateLabelsAndTaints()
+		if err != nil {
+			return nil, fmt.Errorf("Invalid labels or taints of machine pool '%s': %v", pool.Name, err)
+		}
+	}
+	return file, nil
+}
+
+// validateLabelsAndTaints checks the labels and taints of the definition with the same rules as the
+// values of the '--labels' and '--taints' flags.
+func (s *PoolSpec) validateLabelsAndTaints() error {
+	var errs []error
+	keys := make([]string, 0, len(s.Labels))
+	for key := range s.Labels {
+		keys = append(keys, key)
+	}
+	slices.Sort(keys)
+	for _, key := range keys {
+		if err := ValidateLabelKeyValuePair(key, s.Labels[key]); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	if err := ValidatePoolLabels(s.Labels, nil); err != nil {
+		errs = append(errs, err)
+	}
+	seen := map[string]bool{}
+	for _, taint := range s.Taints {
+		if taint == nil {
+			errs = append(errs, fmt.Errorf("Expected key, value and effect for taints"))
+			continue
+		}
+		if err := validateTaint(taint.Key, taint.Value, taint.Effect, seen); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	return errors.NewAggregate(errs)
+}
+
+// PoolSpecFromMachinePool returns the definition of a machine pool of a classic cluster. The
+// availability zones and subnets are only kept for single AZ machine pools, as multi AZ machine pools
+// use the ones of the cluster.
+func PoolSpecFromMachinePool(machinePool *cmv1.MachinePool) *PoolSpec {
+	spec := &PoolSpec{
+		Name:         machinePool.ID(),
+		InstanceType: machinePool.InstanceType(),
+		Labels:       machinePool.Labels(),
+		Taints:       taintSpecs(machinePool.Taints()),
+	}
+	if autoscaling, ok := machinePool.GetAutoscaling(); ok {
+		spec.Autoscaling = &AutoscalingSpec{
+			MinReplicas: autoscaling.MinReplicas(),
+			MaxReplicas: autoscaling.MaxReplicas(),
+		}
+	} else {
+		replicas := machinePool.Replicas()
+		spec.Replicas = &replicas
+	}
+	if spot, ok := machinePool.AWS().GetSpotMarketOptions(); ok {
+		spec.Spot = &SpotSpec{}
+		if maxPrice, ok := spot.GetMaxPrice(); ok {
+			spec.Spot.MaxPrice = &maxPrice
+		}
+	}
+	if len(machinePool.AvailabilityZones()) == 1 {
+		spec.AvailabilityZones = machinePool.AvailabilityZones()
+		spec.Subnets = machinePool.Subnets()
+	}
+	return spec
+}
+
+// PoolSpecFromNodePool returns the definition of a machine pool of a Hosted Control Plane cluster.
+func PoolSpecFromNodePool(nodePool *cmv1.NodePool) *PoolSpec {
+	spec := &PoolSpec{
+		Name:                 nodePool.ID(),
+		InstanceType:         nodePool.AWSNodePool().InstanceType(),
+		Labels:               nodePool.Labels(),
+		Taints:               taintSpecs(nodePool.Taints()),
+		TuningConfigs:        nodePool.TuningConfigs(),
+		NodeDrainGracePeriod: ocmOutput.PrintNodeDrainGracePeriod(nodePool.NodeDrainGracePeriod()),
+	}
+	if autoscaling, ok := nodePool.GetAutoscaling(); ok {
+		spec.Autoscaling = &AutoscalingSpec{
+			MinReplicas: autoscaling.MinReplica(),
+			MaxReplicas: autoscaling.MaxReplica(),
+		}
+	} else {
+		replicas := nodePool.Replicas()
+		spec.Replicas = &replicas
+	}
+	if zone := nodePool.AvailabilityZone(); zone != "" {
+		spec.AvailabilityZones = []string{zone}
+	}
+	if subnet := nodePool.Subnet(); subnet != "" {
+		spec.Subnets = []string{subnet}
+	}
+	if autorepair, ok := nodePool.GetAutoRepair(); ok {
+		spec.Autorepair = &autorepair
+	}
+	return spec
+}
+
+func taintSpecs(taints []*cmv1.Taint) []*TaintSpec {
+	var specs []*TaintSpec
+	for _, taint := range taints {
+		specs = append(specs, &TaintSpec{
+			Key:    taint.Key(),
+			Value:  taint.Value(),
+			Effect: taint.Effect(),
+		})
+	}
+	return specs
+}
+
+func (s *PoolSpec) taintBuilders() []*cmv1.TaintBuilder {
+	builders := []*cmv1.TaintBuilder{}
+	for _, taint := range s.Taints {
+		builder := cmv1.NewTaint().Key(taint.Key).Effect(taint.Effect)
+		if taint.Value != "" {
+			builder.Value(taint.Value)
+		}
+		builders = append(builders, builder)
+	}
+	return builders
+}
+
+// HostedOnlyFields returns the fields of the definition that are only supported by the machine pools
+// of Hosted Control Plane clusters.
+func (s *PoolSpec) HostedOnlyFields() []string {
+	fields := []string{}
+	if len(s.TuningConfigs) > 0 {
+		fields = append(fields, "tuning_configs")
+	}
+	if s.NodeDrainGracePeriod != "" {
+		fields = append(fields, "node_drain_grace_period")
+	}
+	if s.Autorepair != nil {
+		fields = append(fields, "autorepair")
+	}
+	return fields
+}
+
+// ClassicOnlyFields returns the fields of the definition that are only supported by the machine pools
+// of classic clusters.
+func (s *PoolSpec) ClassicOnlyFields() []string {
+	fields := []string{}
+	if s.Spot != nil {
+		fields = append(fields, "spot")
+	}
+	if len(s.Subnets) > 1 || len(s.AvailabilityZones) > 1 {
+		fields = append(fields, "more than one subnet or availability zone")
+	}
+	return fields
+}
+
+// MachinePool builds the machine pool of a classic cluster described by the definition.
+func (s *PoolSpec) MachinePool() (*cmv1.MachinePool, error) {
+	builder := cmv1.NewMachinePool().
+		ID(s.Name).
+		InstanceType(s.InstanceType).
+		Labels(s.Labels).
+		Taints(s.taintBuilders()...)
+	if s.Autoscaling != nil {
+		builder.Autoscaling(cmv1.NewMachinePoolAutoscaling().
+			MinReplicas(s.Autoscaling.MinReplicas).
+			MaxReplicas(s.Autoscaling.MaxReplicas))
+	} else {
+		builder.Replicas(*s.Replicas)
+	}
+	if s.Spot != nil {
+		spot := cmv1.NewAWSSpotMarketOptions()
+		if s.Spot.MaxPrice != nil {
+			spot.MaxPrice(*s.Spot.MaxPrice)
+		}
+		builder.AWS(cmv1.NewAWSMachinePool().SpotMarketOptions(spot))
+	}
+	// The availability zone is given by the subnet when there is one
+	if len(s.Subnets) > 0 {
+		builder.Subnets(s.Subnets...)
+	} else if len(s.AvailabilityZones) > 0 {
+		builder.AvailabilityZones(s.AvailabilityZones...)
+	}
+	return builder.Build()
+}
+
+// NodePool builds the machine pool of a Hosted Control Plane cluster described by the definition. The
+// availability zone is given by the subnet.
+func (s *PoolSpec) NodePool() (*cmv1.NodePool, error) {
+	builder := cmv1.NewNodePool().
+		ID(s.Name).
+		AWSNodePool(cmv1.NewAWSNodePool().InstanceType(s.InstanceType)).
+		Labels(s.Labels).
+		Taints(s.taintBuilders()...)
+	if s.Autoscaling != nil {
+		builder.Autoscaling(cmv1.NewNodePoolAutoscaling().
+			MinReplica(s.Autoscaling.MinReplicas).
+			MaxReplica(s.Autoscaling.MaxReplicas))
+	} else {
+		builder.Replicas(*s.Replicas)
+	}
+	if len(s.Subnets) > 0 {
+		builder.Subnet(s.Subnets[0])
+	}
+	if len(s.TuningConfigs) > 0 {
+		builder.TuningConfigs(s.TuningConfigs...)
+	}
+	if s.NodeDrainGracePeriod != "" {
+		period, err := CreateNodeDrainGracePeriodBuilder(s.NodeDrainGracePeriod)
+		if err != nil {
+			return nil, fmt.Errorf("Invalid node drain grace period of machine pool '%s': %v", s.Name, err)
+		}
+		builder.NodeDrainGracePeriod(period)
+	}
+	if s.Autorepair != nil {
+		builder.AutoRepair(*s.Autorepair)
+	}
+	return builder.Build()
+}
+
+// Remap replaces the subnets and availability zones of the definition with the ones given in the
+// mappings, for the ones that have an entry.
+func (s *PoolSpec) Remap(subnets map[string]string, availabilityZones map[string]string) {
+	for i, subnet := range s.Subnets {
+		if mapped, ok := subnets[subnet]; ok {
+			s.Subnets[i] = mapped
+		}
+	}
+	for i, zone := range s.AvailabilityZones {
+		if mapped, ok := availabilityZones[zone]; ok {
+			s.AvailabilityZones[i] = mapped
+		}
+	}
+}
+
+// String returns a short description of the size of the machine pool, like '3 x m5.xlarge'.
+func (s *PoolSpec) String() string {
+	if s.Autoscaling != nil {
+		return fmt.Sprintf("%d-%d x %s", s.Autoscaling.MinReplicas, s.Autoscaling.MaxReplicas, s.InstanceType)
+	}
+	return strconv.Itoa(*s.Replicas) + " x " + s.InstanceType
+}
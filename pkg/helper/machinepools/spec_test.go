@@ -0,0 +1,152 @@
+/*
+Copyright (c) 2024 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package machinepools
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+)
+
+var _ = Describe("Machine pools file", func() {
+	It("Round trips the machine pools of classic clusters", func() {
+		machinePool, err := cmv1.NewMachinePool().
+			ID("spot").
+			InstanceType("m5.xlarge").
+			Autoscaling(cmv1.NewMachinePoolAutoscaling().MinReplicas(1).MaxReplicas(3)).
+			Labels(map[string]string{"app": "batch"}).
+			Taints(cmv1.NewTaint().Key("batch").Value("true").Effect("NoSchedule")).
+			AWS(cmv1.NewAWSMachinePool().SpotMarketOptions(cmv1.NewAWSSpotMarketOptions().MaxPrice(0.5))).
+			AvailabilityZones("us-east-1a").
+			Subnets("subnet-1").
+			Build()
+		Expect(err).ToNot(HaveOccurred())
+
+		spec := PoolSpecFromMachinePool(machinePool)
+		Expect(spec.Name).To(Equal("spot"))
+		Expect(spec.Replicas).To(BeNil())
+		Expect(*spec.Autoscaling).To(Equal(AutoscalingSpec{MinReplicas: 1, MaxReplicas: 3}))
+		Expect(spec.Taints).To(ConsistOf(&TaintSpec{Key: "batch", Value: "true", Effect: "NoSchedule"}))
+		Expect(*spec.Spot.MaxPrice).To(Equal(0.5))
+		Expect(spec.HostedOnlyFields()).To(BeEmpty())
+		Expect(spec.ClassicOnlyFields()).To(Equal([]string{"spot"}))
+
+		spec.Remap(map[string]string{"subnet-1": "subnet-2"}, map[string]string{"us-east-1a": "us-west-2a"})
+		created, err := spec.MachinePool()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(created.ID()).To(Equal("spot"))
+		Expect(created.InstanceType()).To(Equal("m5.xlarge"))
+		Expect(created.Autoscaling().MaxReplicas()).To(Equal(3))
+		Expect(created.Labels()).To(Equal(map[string]string{"app": "batch"}))
+		Expect(created.Taints()[0].Effect()).To(Equal("NoSchedule"))
+		Expect(created.AWS().SpotMarketOptions().MaxPrice()).To(Equal(0.5))
+		Expect(created.Subnets()).To(Equal([]string{"subnet-2"}))
+		// The availability zone is given by the subnet
+		Expect(created.AvailabilityZones()).To(BeEmpty())
+	})
+
+	It("Doesn't keep the availability zones of multi AZ machine pools", func() {
+		machinePool, err := cmv1.NewMachinePool().
+			ID("worker").
+			InstanceType("m5.xlarge").
+			Replicas(3).
+			AvailabilityZones("us-east-1a", "us-east-1b", "us-east-1c").
+			Build()
+		Expect(err).ToNot(HaveOccurred())
+
+		spec := PoolSpecFromMachinePool(machinePool)
+		Expect(*spec.Replicas).To(Equal(3))
+		Expect(spec.AvailabilityZones).To(BeEmpty())
+		Expect(spec.String()).To(Equal("3 x m5.xlarge"))
+	})
+
+	It("Round trips the machine pools of hosted clusters", func() {
+		nodePool, err := cmv1.NewNodePool().
+			ID("workers").
+			AWSNodePool(cmv1.NewAWSNodePool().InstanceType("m5.2xlarge")).
+			Replicas(2).
+			Subnet("subnet-1").
+			AvailabilityZone("us-east-1a").
+			TuningConfigs("tuned").
+			NodeDrainGracePeriod(cmv1.NewValue().Value(90).Unit("minutes")).
+			AutoRepair(false).
+			Build()
+		Expect(err).ToNot(HaveOccurred())
+
+		spec := PoolSpecFromNodePool(nodePool)
+		Expect(spec.InstanceType).To(Equal("m5.2xlarge"))
+		Expect(spec.NodeDrainGracePeriod).To(Equal("90 minutes"))
+		Expect(spec.HostedOnlyFields()).To(Equal([]string{"tuning_configs", "node_drain_grace_period", "autorepair"}))
+
+		created, err := spec.NodePool()
+		Expect(err).ToNot(HaveOccurred())
+		Expect(created.Replicas()).To(Equal(2))
+		Expect(created.Subnet()).To(Equal("subnet-1"))
+		Expect(created.TuningConfigs()).To(Equal([]string{"tuned"}))
+		Expect(created.NodeDrainGracePeriod().Value()).To(Equal(90.0))
+		Expect(created.AutoRepair()).To(BeFalse())
+	})
+
+	It("Parses the YAML written by the export", func() {
+		file, err := ParsePoolsFile([]byte(`
+cluster: mycluster
+kubelet_config:
+  pod_pids_limit: 8192
+machine_pools:
+- name: mp-1
+  instance_type: m5.xlarge
+  replicas: 2
+  labels:
+    app: web
+`))
+		Expect(err).ToNot(HaveOccurred())
+		Expect(file.KubeletConfig.PodPidsLimit).To(Equal(8192))
+		Expect(file.MachinePools).To(HaveLen(1))
+		Expect(*file.MachinePools[0].Replicas).To(Equal(2))
+		Expect(file.MachinePools[0].Labels).To(Equal(map[string]string{"app": "web"}))
+	})
+
+	DescribeTable("Rejects invalid files",
+		func(data string, expectedError string) {
+			_, err := ParsePoolsFile([]byte(data))
+			Expect(err).To(MatchError(ContainSubstring(expectedError)))
+		},
+		Entry("Unknown field",
+			"machine_pools:\n- name: mp-1\n  instance_typ: m5.xlarge\n", `unknown field "instance_typ"`),
+		Entry("Missing name",
+			"machine_pools:\n- instance_type: m5.xlarge\n  replicas: 1\n", "Machine pool 1 doesn't have a name"),
+		Entry("Missing instance type",
+			"machine_pools:\n- name: mp-1\n  replicas: 1\n", "Machine pool 'mp-1' doesn't have an instance type"),
+		Entry("Missing size",
+			"machine_pools:\n- name: mp-1\n  instance_type: m5.xlarge\n", "must set either replicas or autoscaling"),
+		Entry("Invalid label key",
+			"machine_pools:\n- name: mp-1\n  instance_type: m5.xlarge\n  replicas: 1\n  labels:\n    bad key: web\n",
+			"Invalid label key 'bad key'"),
+		Entry("Reserved label prefix",
+			"machine_pools:\n- name: mp-1\n  instance_type: m5.xlarge\n  replicas: 1\n  labels:\n"+
+				"    kubernetes.io/hostname: web\n",
+			"the 'kubernetes.io' prefix is reserved for Kubernetes"),
+		Entry("Invalid taint effect",
+			"machine_pools:\n- name: mp-1\n  instance_type: m5.xlarge\n  replicas: 1\n  taints:\n"+
+				"  - key: app\n    effect: Never\n",
+			"Invalid taint effect 'Never' at key 'app'"),
+		Entry("Duplicated taint",
+			"machine_pools:\n- name: mp-1\n  instance_type: m5.xlarge\n  replicas: 1\n  taints:\n"+
+				"  - key: app\n    effect: NoSchedule\n  - key: app\n    value: web\n    effect: NoSchedule\n",
+			"Duplicated taint key 'app' used with effect 'NoSchedule'"),
+	)
+})
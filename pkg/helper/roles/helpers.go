@@ -17,6 +17,7 @@ import (
 	"github.com/openshift/rosa/pkg/interactive"
 	"github.com/openshift/rosa/pkg/interactive/confirm"
 	"github.com/openshift/rosa/pkg/ocm"
+	"github.com/openshift/rosa/pkg/reporter"
 	"github.com/openshift/rosa/pkg/rosa"
 )
 
@@ -187,7 +188,7 @@ func CreateMissingRoles(
 			err = createOperatorRole(mode, r, cluster, prefix, missingRolesInCS, policies, unifiedPath, managedPolicies)
 			if err != nil {
 				r.Reporter.Errorf("%s", err)
-				os.Exit(1)
+				os.Exit(reporter.ExitCode())
 			}
 			createdMissingRoles++
 		}
@@ -212,7 +213,10 @@ func createOperatorRole(
 		if err != nil {
 			return err
 		}
-		helper.DisplaySpinnerWithDelay(r.Reporter, "Waiting for operator roles to reconcile", 5*time.Second)
+		err = helper.DisplaySpinnerWithDelay(r.Reporter, "Waiting for operator roles to reconcile", 5*time.Second)
+		if err != nil {
+			return err
+		}
 	case interactive.ModeManual:
 		commands, err := BuildMissingOperatorRoleCommand(
 			missingRoles, cluster, accountID, r, policies, unifiedPath, prefix, managedPolicies)
@@ -225,7 +229,7 @@ func createOperatorRole(
 		fmt.Println(commands)
 	default:
 		r.Reporter.Errorf("Invalid mode. Allowed values are %s", interactive.Modes)
-		os.Exit(1)
+		os.Exit(reporter.ExitCode())
 	}
 	return nil
 }
@@ -141,6 +141,39 @@ func GetMinimalHostedMachinePoolVersion(controlPlaneVersion string) (string, err
 	return version, nil
 }
 
+// GetNodePoolDrift describes how far the version of a hosted machine pool is behind the version of
+// the control plane, and whether it is within the supported skew of minor versions.
+func GetNodePoolDrift(controlPlaneVersion, nodePoolVersion string) (string, bool, error) {
+	cpVersion, err := ver.NewVersion(controlPlaneVersion)
+	if err != nil {
+		return "", false, err
+	}
+	npVersion, err := ver.NewVersion(nodePoolVersion)
+	if err != nil {
+		return "", false, err
+	}
+	cpSegments := cpVersion.Segments()
+	npSegments := npVersion.Segments()
+	if npVersion.GreaterThan(cpVersion) {
+		return "Newer than control plane", false, nil
+	}
+	if npSegments[0] != cpSegments[0] {
+		return "Different major version", false, nil
+	}
+	minors := cpSegments[1] - npSegments[1]
+	switch {
+	case minors > MinorVersionsSupported:
+		return fmt.Sprintf("%d minor versions behind, outside supported skew", minors), false, nil
+	case minors > 1:
+		return fmt.Sprintf("%d minor versions behind", minors), true, nil
+	case minors == 1:
+		return "1 minor version behind", true, nil
+	case npVersion.LessThan(cpVersion):
+		return "Patch version behind", true, nil
+	}
+	return "Up to date", true, nil
+}
+
 func IsGreaterThanOrEqual(version1, version2 string) (bool, error) {
 	v1, err := version.NewVersion(strings.TrimPrefix(version1, ocm.VersionPrefix))
 	if err != nil {
@@ -93,6 +93,28 @@ var _ = Describe("Validates Format Major Minor Patch", func() {
 	)
 })
 
+var _ = Describe("Node pool drift", func() {
+	DescribeTable("Compares node pool and control plane versions",
+		func(controlPlaneVersion string, nodePoolVersion string, expected string, supported bool) {
+			drift, supportedSkew, err := GetNodePoolDrift(controlPlaneVersion, nodePoolVersion)
+			Expect(err).ToNot(HaveOccurred())
+			Expect(drift).To(Equal(expected))
+			Expect(supportedSkew).To(Equal(supported))
+		},
+		Entry("Same version", "4.15.3", "4.15.3", "Up to date", true),
+		Entry("Patch behind", "4.15.3", "4.15.1", "Patch version behind", true),
+		Entry("One minor behind", "4.15.3", "4.14.10", "1 minor version behind", true),
+		Entry("Two minors behind", "4.15.3", "4.13.0", "2 minor versions behind", true),
+		Entry("Outside skew", "4.16.0", "4.13.5", "3 minor versions behind, outside supported skew", false),
+		Entry("Newer than control plane", "4.15.3", "4.15.4", "Newer than control plane", false),
+	)
+
+	It("Fails on invalid versions", func() {
+		_, _, err := GetNodePoolDrift("4.15.3", "latest")
+		Expect(err).To(HaveOccurred())
+	})
+})
+
 var _ = Describe("Get default version", func() {
 	versionHostedDefault, err := v1.NewVersion().ROSAEnabled(true).
 		RawID("4.14.9").Enabled(true).ChannelGroup("stable").
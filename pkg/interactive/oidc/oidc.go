@@ -8,6 +8,7 @@ import (
 	"github.com/spf13/cobra"
 
 	. "github.com/openshift/rosa/pkg/interactive"
+	"github.com/openshift/rosa/pkg/reporter"
 	"github.com/openshift/rosa/pkg/rosa"
 )
 
@@ -34,7 +35,7 @@ func GetOidcConfigID(r *rosa.Runtime, cmd *cobra.Command) string {
 	})
 	if err != nil {
 		r.Reporter.Errorf("Expected a valid OIDC Config ID: %s", err)
-		os.Exit(1)
+		os.Exit(reporter.ExitCode())
 	}
 	return strings.TrimSpace(strings.Split(oidcConfigId, "|")[0])
 }
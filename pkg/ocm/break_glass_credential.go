@@ -1,13 +1,14 @@
 package ocm
 
 import (
-	"context"
 	"fmt"
 	"net/http"
 	"time"
 
 	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
 	errors "github.com/zgalor/weberr"
+
+	"github.com/openshift/rosa/pkg/timeout"
 )
 
 const pollKubeconfigInterval = 200 * time.Second
@@ -58,7 +59,7 @@ func (c *Client) DeleteBreakGlassCredentials(clusterID string) error {
 }
 
 func (c *Client) PollKubeconfig(clusterID string, credentialID string) (kubeconfig string, err error) {
-	ctx, cancel := context.WithTimeout(context.Background(), time.Hour)
+	ctx, cancel := timeout.WithDefault(time.Hour)
 	defer func() {
 		cancel()
 	}()
@@ -70,7 +71,7 @@ func (c *Client) PollKubeconfig(clusterID string, credentialID string) (kubeconf
 		StartContext(ctx)
 	if err != nil {
 		err = fmt.Errorf("Failed to poll kubeconfig for cluster '%s' with break glass credential '%s': %v",
-			clusterID, credentialID, err)
+			clusterID, credentialID, timeout.Error(err))
 		if response.Status() == http.StatusNotFound {
 			err = errors.NotFound.UserErrorf("Failed to poll kubeconfig for cluster '%s' with break glass credential '%s'",
 				clusterID, credentialID)
@@ -18,6 +18,7 @@ package ocm
 
 import (
 	"fmt"
+	"net/http"
 	"os"
 	"strings"
 	"time"
@@ -29,7 +30,8 @@ import (
 	"github.com/openshift/rosa/pkg/fedramp"
 	"github.com/openshift/rosa/pkg/info"
 	"github.com/openshift/rosa/pkg/logging"
-	"github.com/openshift/rosa/pkg/reporter"
+	rprtr "github.com/openshift/rosa/pkg/reporter"
+	"github.com/openshift/rosa/pkg/timeout"
 )
 
 type Client struct {
@@ -55,13 +57,13 @@ func NewClientWithConnection(connection *sdk.Connection) *Client {
 	}
 }
 
-func CreateNewClientOrExit(logger *logrus.Logger, reporter *reporter.Object) *Client {
+func CreateNewClientOrExit(logger *logrus.Logger, reporter *rprtr.Object) *Client {
 	client, err := NewClient().
 		Logger(logger).
 		Build()
 	if err != nil {
 		reporter.Errorf("Failed to create OCM connection: %v", err)
-		os.Exit(1)
+		os.Exit(rprtr.ExitCode())
 	}
 
 	return client
@@ -142,6 +144,9 @@ func (b *ClientBuilder) Build() (result *Client, err error) {
 		builder.Tokens(tokens...)
 	}
 	builder.Insecure(b.cfg.Insecure)
+	builder.TransportWrapper(func(wrapped http.RoundTripper) http.RoundTripper {
+		return &contextTransport{wrapped: wrapped}
+	})
 
 	// Create the connection:
 	conn, err := builder.Build()
@@ -161,6 +166,20 @@ func (b *ClientBuilder) Build() (result *Client, err error) {
 	}, nil
 }
 
+// contextTransport attaches the context of the command to the requests sent without one, which are most
+// of them, so that they are cancelled when the command times out or is interrupted.
+type contextTransport struct {
+	wrapped http.RoundTripper
+}
+
+func (t *contextTransport) RoundTrip(request *http.Request) (*http.Response, error) {
+	if request.Context().Done() == nil {
+		request = request.WithContext(timeout.Context())
+	}
+	response, err := t.wrapped.RoundTrip(request)
+	return response, timeout.Error(err)
+}
+
 func (c *Client) Close() error {
 	return c.ocm.Close()
 }
@@ -19,6 +19,7 @@ package ocm
 import (
 	"fmt"
 	"net/http"
+	"strconv"
 
 	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
 )
@@ -106,6 +107,56 @@ func BuildClusterAutoscaler(config *AutoscalerConfig) *cmv1.ClusterAutoscalerBui
 			DelayAfterFailure(config.ScaleDown.DelayAfterFailure))
 }
 
+// AutoscalerConfigFrom converts an autoscaler returned by the API back into the configuration used to
+// create or update it.
+func AutoscalerConfigFrom(autoscaler *cmv1.ClusterAutoscaler) (*AutoscalerConfig, error) {
+	config := &AutoscalerConfig{
+		BalanceSimilarNodeGroups:    autoscaler.BalanceSimilarNodeGroups(),
+		SkipNodesWithLocalStorage:   autoscaler.SkipNodesWithLocalStorage(),
+		LogVerbosity:                autoscaler.LogVerbosity(),
+		MaxPodGracePeriod:           autoscaler.MaxPodGracePeriod(),
+		PodPriorityThreshold:        autoscaler.PodPriorityThreshold(),
+		IgnoreDaemonsetsUtilization: autoscaler.IgnoreDaemonsetsUtilization(),
+		MaxNodeProvisionTime:        autoscaler.MaxNodeProvisionTime(),
+		BalancingIgnoredLabels:      autoscaler.BalancingIgnoredLabels(),
+		ResourceLimits: ResourceLimits{
+			MaxNodesTotal: autoscaler.ResourceLimits().MaxNodesTotal(),
+			Cores: ResourceRange{
+				Min: autoscaler.ResourceLimits().Cores().Min(),
+				Max: autoscaler.ResourceLimits().Cores().Max(),
+			},
+			Memory: ResourceRange{
+				Min: autoscaler.ResourceLimits().Memory().Min(),
+				Max: autoscaler.ResourceLimits().Memory().Max(),
+			},
+		},
+		ScaleDown: ScaleDownConfig{
+			Enabled:           autoscaler.ScaleDown().Enabled(),
+			UnneededTime:      autoscaler.ScaleDown().UnneededTime(),
+			DelayAfterAdd:     autoscaler.ScaleDown().DelayAfterAdd(),
+			DelayAfterDelete:  autoscaler.ScaleDown().DelayAfterDelete(),
+			DelayAfterFailure: autoscaler.ScaleDown().DelayAfterFailure(),
+		},
+	}
+	for _, gpuLimit := range autoscaler.ResourceLimits().GPUS() {
+		config.ResourceLimits.GPULimits = append(config.ResourceLimits.GPULimits, GPULimit{
+			Type: gpuLimit.Type(),
+			Range: ResourceRange{
+				Min: gpuLimit.Range().Min(),
+				Max: gpuLimit.Range().Max(),
+			},
+		})
+	}
+	if threshold := autoscaler.ScaleDown().UtilizationThreshold(); threshold != "" {
+		utilizationThreshold, err := strconv.ParseFloat(threshold, 64)
+		if err != nil {
+			return nil, fmt.Errorf("Invalid scale down utilization threshold '%s': %v", threshold, err)
+		}
+		config.ScaleDown.UtilizationThreshold = utilizationThreshold
+	}
+	return config, nil
+}
+
 func (c *Client) GetClusterAutoscaler(clusterID string) (*cmv1.ClusterAutoscaler, error) {
 	response, err := c.ocm.ClustersMgmt().V1().Clusters().Cluster(clusterID).Autoscaler().Get().Send()
 
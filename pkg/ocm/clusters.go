@@ -37,6 +37,7 @@ import (
 	"github.com/openshift/rosa/pkg/interactive/consts"
 	"github.com/openshift/rosa/pkg/properties"
 	rprtr "github.com/openshift/rosa/pkg/reporter"
+	"github.com/openshift/rosa/pkg/timeout"
 )
 
 const (
@@ -289,6 +290,41 @@ func (c *Client) GetClustersUsingAccountRole(aws *aws.Creator, role aws.Role, co
 	return c.queryClusters(query, count)
 }
 
+// clusterPageSize is the number of clusters requested in every page by ListClusters.
+const clusterPageSize = 100
+
+// ListClusters returns all the clusters of the creator, or of the organization when the creator is nil,
+// that use the given account role, if any, and match the given search expression, if any. All the pages
+// are retrieved.
+func (c *Client) ListClusters(creator *aws.Creator, role *aws.Role, search string) ([]*cmv1.Cluster, error) {
+	query := getClusterFilter(creator)
+	if role != nil {
+		var err error
+		query, err = getAccountRoleClusterFilter(creator, *role)
+		if err != nil {
+			return nil, err
+		}
+	}
+	if search != "" {
+		query = fmt.Sprintf("%s AND (%s)", query, search)
+	}
+
+	clusters := []*cmv1.Cluster{}
+	request := c.ocm.ClustersMgmt().V1().Clusters().List().Search(query).Size(clusterPageSize)
+	for page := 1; ; page++ {
+		response, err := request.Page(page).Send()
+		if err != nil {
+			return nil, handleErr(response.Error(), err)
+		}
+		clusters = append(clusters, response.Items().Slice()...)
+		// The server can return fewer items than requested, so the total tells when to stop
+		if response.Items().Len() == 0 || len(clusters) >= response.Total() {
+			break
+		}
+	}
+	return clusters, nil
+}
+
 func (c *Client) queryClusters(query string, count int) (clusters []*cmv1.Cluster, err error) {
 
 	if count < 0 {
@@ -565,6 +601,26 @@ func (c *Client) UpdateCluster(clusterKey string, creator *aws.Creator, config S
 		return err
 	}
 
+	clusterSpec, err := c.BuildClusterUpdate(config)
+	if err != nil {
+		return err
+	}
+
+	response, err := c.ocm.ClustersMgmt().V1().Clusters().
+		Cluster(cluster.ID()).
+		Update().
+		Body(clusterSpec).
+		Send()
+	if err != nil {
+		return handleErr(response.Error(), err)
+	}
+
+	return nil
+}
+
+// BuildClusterUpdate returns the patch that UpdateCluster sends for the given spec. Only the fields
+// that are set in the spec are set in the patch.
+func (c *Client) BuildClusterUpdate(config Spec) (*cmv1.Cluster, error) {
 	clusterBuilder := cmv1.NewCluster()
 
 	// Update expiration timestamp
@@ -636,21 +692,7 @@ func (c *Client) UpdateCluster(clusterKey string, creator *aws.Creator, config S
 		clusterBuilder.AWS(awsBuilder)
 	}
 
-	clusterSpec, err := clusterBuilder.Build()
-	if err != nil {
-		return err
-	}
-
-	response, err := c.ocm.ClustersMgmt().V1().Clusters().
-		Cluster(cluster.ID()).
-		Update().
-		Body(clusterSpec).
-		Send()
-	if err != nil {
-		return handleErr(response.Error(), err)
-	}
-
-	return nil
+	return clusterBuilder.Build()
 }
 
 func (c *Client) DeleteCluster(clusterKey string, bestEffort bool,
@@ -701,19 +743,22 @@ func (c *Client) EnsureNoPendingClusters(awsCreator *aws.Creator) error {
 		pendingCluster, err := c.GetPendingClusterForARN(awsCreator)
 		if err != nil {
 			reporter.Errorf("Error getting cluster using ARN '%s'", awsCreator.ARN)
-			os.Exit(1)
+			os.Exit(rprtr.ExitCode())
 		}
 		if time.Now().After(deadline) {
 			reporter.Errorf("Timeout waiting for the cluster '%s' installation. Try again in a few minutes",
 				pendingCluster.ID())
-			os.Exit(1)
+			os.Exit(rprtr.ExitCode())
 		}
 		if pendingCluster == nil {
 			break
 		} else {
 			reporter.Infof("Waiting for cluster '%s' with the same creator ARN to start installing",
 				pendingCluster.ID())
-			time.Sleep(30 * time.Second)
+			err = timeout.Sleep(30 * time.Second)
+			if err != nil {
+				return err
+			}
 		}
 	}
 	return nil
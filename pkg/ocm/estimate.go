@@ -0,0 +1,171 @@
+/*
+Copyright (c) 2024 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ocm
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	amsv1 "github.com/openshift-online/ocm-sdk-go/accountsmgmt/v1"
+	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+)
+
+const computeNodeResourceType = "compute.node"
+
+// computeQuotaSearch selects the quota that nodes of the given resource name, like 't4-gpu-4', consume,
+// whatever the kind of quota, like the GPU or accelerated computing quotas.
+func computeQuotaSearch(resourceName string) string {
+	return fmt.Sprintf("related_resources.resource_type='%s' AND related_resources.resource_name IN ('%s', '%s')",
+		computeNodeResourceType, strings.ReplaceAll(resourceName, "'", "''"), ANY)
+}
+
+// MachinePoolEstimate contains the compute capacity and the subscription quota of a machine pool with its
+// minimum and maximum number of replicas, which are the same when autoscaling isn't enabled.
+type MachinePoolEstimate struct {
+	InstanceType  string           `json:"instance_type"`
+	MinReplicas   int              `json:"min_replicas"`
+	MaxReplicas   int              `json:"max_replicas"`
+	VCPUPerNode   int              `json:"vcpu_per_node"`
+	MemoryPerNode float64          `json:"memory_gib_per_node"`
+	MinVCPU       int              `json:"min_vcpu"`
+	MaxVCPU       int              `json:"max_vcpu"`
+	MinMemory     float64          `json:"min_memory_gib"`
+	MaxMemory     float64          `json:"max_memory_gib"`
+	Quota         []*QuotaEstimate `json:"quota,omitempty"`
+	Spot          *SpotEstimate    `json:"spot,omitempty"`
+}
+
+// QuotaEstimate is the subscription quota of the organization that the nodes of a machine pool consume.
+type QuotaEstimate struct {
+	QuotaID     string `json:"quota_id"`
+	CostPerNode int    `json:"cost_per_node"`
+	MinCost     int    `json:"min_cost"`
+	MaxCost     int    `json:"max_cost"`
+	Allowed     int    `json:"allowed"`
+	Consumed    int    `json:"consumed"`
+}
+
+// Available returns the quota that the organization hasn't consumed yet.
+func (q *QuotaEstimate) Available() int {
+	return q.Allowed - q.Consumed
+}
+
+// SpotEstimate describes the price limits of a machine pool that uses spot instances: the maximum hourly
+// price of an instance and the resulting maximum hourly cost of the machine pool. When there is no
+// maximum price, instances are charged the spot price up to the on-demand price. The actual cost is only
+// known approximately, as it depends on the spot price, which changes over time.
+type SpotEstimate struct {
+	MaxPrice     *float64 `json:"max_price,omitempty"`
+	MinHourlyCap *float64 `json:"min_hourly_cap,omitempty"`
+	MaxHourlyCap *float64 `json:"max_hourly_cap,omitempty"`
+}
+
+// EstimateMachinePool returns the capacity and the quota of a classic machine pool.
+func (c *Client) EstimateMachinePool(machinePool *cmv1.MachinePool) (*MachinePoolEstimate, error) {
+	minReplicas, maxReplicas := machinePool.Replicas(), machinePool.Replicas()
+	if autoscaling, ok := machinePool.GetAutoscaling(); ok {
+		minReplicas, maxReplicas = autoscaling.MinReplicas(), autoscaling.MaxReplicas()
+	}
+	return c.estimate(machinePool.InstanceType(), minReplicas, maxReplicas,
+		machinePool.AWS().SpotMarketOptions())
+}
+
+// EstimateNodePool returns the capacity and the quota of a machine pool of a Hosted Control Plane
+// cluster.
+func (c *Client) EstimateNodePool(nodePool *cmv1.NodePool) (*MachinePoolEstimate, error) {
+	minReplicas, maxReplicas := nodePool.Replicas(), nodePool.Replicas()
+	if autoscaling, ok := nodePool.GetAutoscaling(); ok {
+		minReplicas, maxReplicas = autoscaling.MinReplica(), autoscaling.MaxReplica()
+	}
+	return c.estimate(nodePool.AWSNodePool().InstanceType(), minReplicas, maxReplicas, nil)
+}
+
+func (c *Client) estimate(instanceType string, minReplicas int, maxReplicas int,
+	spot *cmv1.AWSSpotMarketOptions) (*MachinePoolEstimate, error) {
+	machineTypes, err := c.GetMachineTypes()
+	if err != nil {
+		return nil, err
+	}
+	machineType := machineTypes.Find(instanceType)
+	if machineType == nil {
+		return nil, fmt.Errorf("Machine type '%s' not found", instanceType)
+	}
+	quotaCosts, err := c.getQuotaCosts(computeQuotaSearch(machineType.MachineType.GenericName()))
+	if err != nil {
+		return nil, err
+	}
+	return NewMachinePoolEstimate(machineType.MachineType, minReplicas, maxReplicas, spot, quotaCosts), nil
+}
+
+// NewMachinePoolEstimate calculates the capacity of the given number of nodes of the machine type, and the
+// quota they consume from the given quota costs.
+func NewMachinePoolEstimate(machineType *cmv1.MachineType, minReplicas int, maxReplicas int,
+	spot *cmv1.AWSSpotMarketOptions, quotaCosts *amsv1.QuotaCostList) *MachinePoolEstimate {
+	vcpu := int(machineType.CPU().Value())
+	memory := machineType.Memory().Value() / (1 << 30)
+	estimate := &MachinePoolEstimate{
+		InstanceType:  machineType.ID(),
+		MinReplicas:   minReplicas,
+		MaxReplicas:   maxReplicas,
+		VCPUPerNode:   vcpu,
+		MemoryPerNode: memory,
+		MinVCPU:       vcpu * minReplicas,
+		MaxVCPU:       vcpu * maxReplicas,
+		MinMemory:     memory * float64(minReplicas),
+		MaxMemory:     memory * float64(maxReplicas),
+	}
+
+	quotaCosts.Each(func(quotaCost *amsv1.QuotaCost) bool {
+		for _, relatedResource := range quotaCost.RelatedResources() {
+			if relatedResource.ResourceType() != computeNodeResourceType || !isCompatible(relatedResource) ||
+				relatedResource.Cost() == 0 {
+				continue
+			}
+			name := relatedResource.ResourceName()
+			if name != ANY && name != machineType.GenericName() {
+				continue
+			}
+			estimate.Quota = append(estimate.Quota, &QuotaEstimate{
+				QuotaID:     quotaCost.QuotaID(),
+				CostPerNode: relatedResource.Cost(),
+				MinCost:     relatedResource.Cost() * minReplicas,
+				MaxCost:     relatedResource.Cost() * maxReplicas,
+				Allowed:     quotaCost.Allowed(),
+				Consumed:    quotaCost.Consumed(),
+			})
+			// The same quota can list the resource for several billing models or zone types
+			break
+		}
+		return true
+	})
+	sort.Slice(estimate.Quota, func(i, j int) bool {
+		return estimate.Quota[i].QuotaID < estimate.Quota[j].QuotaID
+	})
+
+	if spot != nil {
+		estimate.Spot = &SpotEstimate{}
+		if maxPrice, ok := spot.GetMaxPrice(); ok {
+			minCost := maxPrice * float64(minReplicas)
+			maxCost := maxPrice * float64(maxReplicas)
+			estimate.Spot.MaxPrice = &maxPrice
+			estimate.Spot.MinHourlyCap = &minCost
+			estimate.Spot.MaxHourlyCap = &maxCost
+		}
+	}
+	return estimate
+}
@@ -0,0 +1,90 @@
+/*
+Copyright (c) 2024 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ocm
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	amsv1 "github.com/openshift-online/ocm-sdk-go/accountsmgmt/v1"
+	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+)
+
+var _ = Describe("Machine pool estimate", func() {
+	var machineType *cmv1.MachineType
+	var quotaCosts *amsv1.QuotaCostList
+
+	BeforeEach(func() {
+		var err error
+		machineType, err = cmv1.NewMachineType().ID("g4dn.xlarge").GenericName("t4-gpu-4").
+			CPU(cmv1.NewValue().Value(4).Unit("vCPU")).
+			Memory(cmv1.NewValue().Value(16 * (1 << 30)).Unit("B")).
+			Build()
+		Expect(err).NotTo(HaveOccurred())
+		quotaCosts, err = amsv1.NewQuotaCostList().Items(
+			amsv1.NewQuotaCost().QuotaID("compute.node|gpu|byoc|moa").Allowed(20).Consumed(8).RelatedResources(
+				amsv1.NewRelatedResource().ResourceType("compute.node").ResourceName("t4-gpu-4").
+					Product("MOA").CloudProvider("aws").BYOC("byoc").Cost(2),
+				amsv1.NewRelatedResource().ResourceType("compute.node").ResourceName("t4-gpu-4").
+					Product("MOA").CloudProvider("aws").BYOC("byoc").Cost(2).AvailabilityZoneType("multi"),
+			),
+			amsv1.NewQuotaCost().QuotaID("compute.node|cpu|byoc|moa").Allowed(100).RelatedResources(
+				amsv1.NewRelatedResource().ResourceType("compute.node").ResourceName("m5-xlarge").
+					Product("MOA").CloudProvider("aws").BYOC("byoc").Cost(1),
+			),
+			amsv1.NewQuotaCost().QuotaID("compute.node|any|byoc|osd").Allowed(100).RelatedResources(
+				amsv1.NewRelatedResource().ResourceType("compute.node").ResourceName("any").
+					Product("OSD").CloudProvider("aws").BYOC("byoc").Cost(1),
+			),
+		).Build()
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	It("Calculates the capacity and quota between the autoscaling limits", func() {
+		estimate := NewMachinePoolEstimate(machineType, 2, 5, nil, quotaCosts)
+		Expect(estimate.MinVCPU).To(Equal(8))
+		Expect(estimate.MaxVCPU).To(Equal(20))
+		Expect(estimate.MinMemory).To(Equal(32.0))
+		Expect(estimate.MaxMemory).To(Equal(80.0))
+		Expect(estimate.Spot).To(BeNil())
+		Expect(estimate.Quota).To(HaveLen(1))
+		quota := estimate.Quota[0]
+		Expect(quota.QuotaID).To(Equal("compute.node|gpu|byoc|moa"))
+		Expect(quota.MinCost).To(Equal(4))
+		Expect(quota.MaxCost).To(Equal(10))
+		Expect(quota.Available()).To(Equal(12))
+	})
+
+	It("Calculates the hourly cost limit of spot instances", func() {
+		spot, err := cmv1.NewAWSSpotMarketOptions().MaxPrice(0.5).Build()
+		Expect(err).NotTo(HaveOccurred())
+		estimate := NewMachinePoolEstimate(machineType, 2, 4, spot, quotaCosts)
+		Expect(*estimate.Spot.MaxPrice).To(Equal(0.5))
+		Expect(*estimate.Spot.MinHourlyCap).To(Equal(1.0))
+		Expect(*estimate.Spot.MaxHourlyCap).To(Equal(2.0))
+
+		spot, err = cmv1.NewAWSSpotMarketOptions().Build()
+		Expect(err).NotTo(HaveOccurred())
+		estimate = NewMachinePoolEstimate(machineType, 2, 4, spot, quotaCosts)
+		Expect(estimate.Spot).NotTo(BeNil())
+		Expect(estimate.Spot.MaxPrice).To(BeNil())
+	})
+	It("Searches the quota of the resource name of the instance type", func() {
+		Expect(computeQuotaSearch("t4-gpu-4")).To(Equal("related_resources.resource_type='compute.node' AND " +
+			"related_resources.resource_name IN ('t4-gpu-4', 'any')"))
+		Expect(computeQuotaSearch("it's")).To(ContainSubstring("IN ('it''s', 'any')"))
+	})
+})
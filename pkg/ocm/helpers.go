@@ -201,7 +201,11 @@ func handleErr(res *ocmerrors.Error, err error) error {
 			"Once you accept the terms, you will need to retry the action that was blocked."
 	}
 	errType := errors.ErrorType(res.Status())
-	return errType.Set(errors.Errorf("%s", msg))
+	return errors.AddDetails(errType.Set(errors.Errorf("%s", msg)), reporter.OCMError{
+		OperationID: res.OperationID(),
+		Status:      res.Status(),
+		Code:        res.Code(),
+	})
 }
 
 func (c *Client) GetDefaultClusterFlavors(flavour string) (dMachinecidr *net.IPNet, dPodcidr *net.IPNet,
@@ -17,13 +17,14 @@ limitations under the License.
 package ocm
 
 import (
-	"context"
 	"fmt"
 	"net/http"
 	"time"
 
 	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
 	errors "github.com/zgalor/weberr"
+
+	"github.com/openshift/rosa/pkg/timeout"
 )
 
 const interval = 15 * time.Second
@@ -67,7 +68,7 @@ func (c *Client) GetUninstallLogs(clusterID string, tail int) (logs *cmv1.Log, e
 }
 
 func (c *Client) PollInstallLogs(clusterID string, cb func(*cmv1.LogGetResponse) bool) (logs *cmv1.Log, err error) {
-	ctx, cancel := context.WithTimeout(context.Background(), time.Hour)
+	ctx, cancel := timeout.WithDefault(time.Hour)
 	defer func() {
 		cancel()
 	}()
@@ -82,7 +83,7 @@ func (c *Client) PollInstallLogs(clusterID string, cb func(*cmv1.LogGetResponse)
 		Predicate(cb).
 		StartContext(ctx)
 	if err != nil {
-		err = fmt.Errorf("Failed to poll logs for cluster '%s': %v", clusterID, err)
+		err = fmt.Errorf("Failed to poll logs for cluster '%s': %v", clusterID, timeout.Error(err))
 		if response.Status() == http.StatusNotFound {
 			err = errors.NotFound.UserErrorf("Failed to poll logs for cluster '%s'", clusterID)
 		}
@@ -94,7 +95,7 @@ func (c *Client) PollInstallLogs(clusterID string, cb func(*cmv1.LogGetResponse)
 
 func (c *Client) PollUninstallLogs(clusterID string,
 	cb func(*cmv1.LogGetResponse) bool) (logs *cmv1.Log, err error) {
-	ctx, cancel := context.WithTimeout(context.Background(), time.Hour)
+	ctx, cancel := timeout.WithDefault(time.Hour)
 	defer func() {
 		cancel()
 	}()
@@ -109,7 +110,7 @@ func (c *Client) PollUninstallLogs(clusterID string,
 		Predicate(cb).
 		StartContext(ctx)
 	if err != nil {
-		err = fmt.Errorf("Failed to poll logs for cluster '%s': %v", clusterID, err)
+		err = fmt.Errorf("Failed to poll logs for cluster '%s': %v", clusterID, timeout.Error(err))
 		if response.Status() == http.StatusNotFound {
 			err = errors.NotFound.UserErrorf("Failed to poll logs for cluster '%s'", clusterID)
 		}
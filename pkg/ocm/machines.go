@@ -141,7 +141,7 @@ func (c *Client) GetAvailableMachineTypesInRegion(region string, availabilityZon
 	machineTypes.Region = region
 	machineTypes.AvailabilityZones = availabilityZones
 
-	quotaCosts, err := c.getQuotaCosts()
+	quotaCosts, err := c.getQuotaCosts(gpuQuotaSearch)
 	if err != nil {
 		return MachineTypeList{}, err
 	}
@@ -156,7 +156,7 @@ func (c *Client) GetAvailableMachineTypes() (MachineTypeList, error) {
 		return MachineTypeList{}, err
 	}
 
-	quotaCosts, err := c.getQuotaCosts()
+	quotaCosts, err := c.getQuotaCosts(gpuQuotaSearch)
 	if err != nil {
 		return MachineTypeList{}, err
 	}
@@ -165,7 +165,10 @@ func (c *Client) GetAvailableMachineTypes() (MachineTypeList, error) {
 	return machineTypes, nil
 }
 
-func (c *Client) getQuotaCosts() (*amsv1.QuotaCostList, error) {
+// gpuQuotaSearch selects the quota of the accelerated computing instance types.
+const gpuQuotaSearch = "quota_id~='gpu'"
+
+func (c *Client) getQuotaCosts(search string) (*amsv1.QuotaCostList, error) {
 	acctResponse, err := c.ocm.AccountsMgmt().V1().CurrentAccount().
 		Get().
 		Send()
@@ -178,7 +181,7 @@ func (c *Client) getQuotaCosts() (*amsv1.QuotaCostList, error) {
 		QuotaCost().
 		List().
 		Parameter("fetchRelatedResources", true).
-		Parameter("search", "quota_id~='gpu'").
+		Parameter("search", search).
 		Page(1).
 		Size(-1).
 		Send()
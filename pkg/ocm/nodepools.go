@@ -1,6 +1,10 @@
 package ocm
 
-import cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+import (
+	"fmt"
+
+	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+)
 
 func (c *Client) CreateNodePool(clusterID string, nodePool *cmv1.NodePool) (*cmv1.NodePool, error) {
 	response, err := c.ocm.ClustersMgmt().V1().
@@ -65,3 +69,15 @@ func (c *Client) DeleteNodePool(clusterID string, nodePoolID string) error {
 	}
 	return nil
 }
+
+// NodePoolReplicasStatus returns whether the current replicas of the node pool match the desired ones, and a
+// description of them.
+func NodePoolReplicasStatus(nodePool *cmv1.NodePool) (bool, string) {
+	current := nodePool.Status().CurrentReplicas()
+	if autoscaling, ok := nodePool.GetAutoscaling(); ok {
+		ready := current >= autoscaling.MinReplica() && current <= autoscaling.MaxReplica()
+		return ready, fmt.Sprintf("%d replicas, autoscaling between %d and %d",
+			current, autoscaling.MinReplica(), autoscaling.MaxReplica())
+	}
+	return current == nodePool.Replicas(), fmt.Sprintf("%d/%d replicas", current, nodePool.Replicas())
+}
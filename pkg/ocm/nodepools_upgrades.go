@@ -60,7 +60,7 @@ func (c *Client) CancelNodePoolUpgrade(clusterID, nodePoolID string, upgradeID s
 	return true, nil
 }
 
-func (c *Client) getNodePoolUpgradePolicies(clusterID string, nodePoolID string) (
+func (c *Client) GetNodePoolUpgradePolicies(clusterID string, nodePoolID string) (
 	nodePoolUpgradePolicies []*cmv1.NodePoolUpgradePolicy,
 	err error) {
 	collection := c.ocm.ClustersMgmt().V1().
@@ -95,7 +95,7 @@ func (c *Client) GetHypershiftNodePoolUpgrades(clusterID, clusterKey,
 		return nil, nil, fmt.Errorf("Machine pool '%s' does not exist for hosted cluster '%s'", nodePoolID, clusterKey)
 	}
 
-	scheduledUpgrades, err := c.getNodePoolUpgradePolicies(clusterID, nodePoolID)
+	scheduledUpgrades, err := c.GetNodePoolUpgradePolicies(clusterID, nodePoolID)
 	if err != nil {
 		return nil, nil, fmt.Errorf("Failed to get scheduled upgrades for machine pool '%s': %v", nodePoolID, err)
 	}
@@ -0,0 +1,75 @@
+/*
+Copyright (c) 2024 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package output
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/openshift/rosa/pkg/ocm"
+)
+
+// PrintMachinePoolEstimate returns the capacity, quota and spot price limits of a machine pool, with the
+// values aligned at the given column like the other details of 'rosa describe machinepool'.
+func PrintMachinePoolEstimate(estimate *ocm.MachinePoolEstimate, column int) string {
+	var result strings.Builder
+	line := func(label string, value string) {
+		value = strings.ReplaceAll(value, "\n", "\n"+strings.Repeat(" ", column))
+		fmt.Fprintf(&result, "%-*s%s\n", column, label+":", value)
+	}
+	line("Estimated replicas", printRange(estimate.MinReplicas, estimate.MaxReplicas, "%d"))
+	line("Estimated vCPU", fmt.Sprintf("%s (%d per node)",
+		printRange(estimate.MinVCPU, estimate.MaxVCPU, "%d"), estimate.VCPUPerNode))
+	line("Estimated memory", fmt.Sprintf("%s (%g GiB per node)",
+		printRange(estimate.MinMemory, estimate.MaxMemory, "%g GiB"), estimate.MemoryPerNode))
+	line("Subscription quota", printQuotaEstimate(estimate.Quota))
+	if estimate.Spot != nil {
+		line("Approximate spot cost", printSpotEstimate(estimate.Spot))
+	}
+	return result.String()
+}
+
+func printQuotaEstimate(quota []*ocm.QuotaEstimate) string {
+	if len(quota) == 0 {
+		return "None consumed"
+	}
+	lines := make([]string, len(quota))
+	for i, q := range quota {
+		lines[i] = fmt.Sprintf("%s of %d available from '%s'",
+			printRange(q.MinCost, q.MaxCost, "%d"), q.Available(), q.QuotaID)
+	}
+	return strings.Join(lines, "\n")
+}
+
+func printSpotEstimate(spot *ocm.SpotEstimate) string {
+	if spot.MaxPrice == nil {
+		return "Up to the on-demand price per instance, depending on the spot price. Instances are " +
+			"interrupted when AWS reclaims the capacity"
+	}
+	return fmt.Sprintf("Up to $%g per hour per instance, at most %s per hour for the machine pool, "+
+		"depending on the spot price. Instances are interrupted when the spot price exceeds the limit",
+		*spot.MaxPrice, printRange(*spot.MinHourlyCap, *spot.MaxHourlyCap, "$%.2f"))
+}
+
+// printRange returns a single value when the minimum and the maximum are equal, as happens when autoscaling
+// isn't enabled.
+func printRange[T comparable](low T, high T, format string) string {
+	if low == high {
+		return fmt.Sprintf(format, low)
+	}
+	return fmt.Sprintf(format+"-"+format, low, high)
+}
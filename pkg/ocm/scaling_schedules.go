@@ -0,0 +1,167 @@
+/*
+Copyright (c) 2024 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ocm
+
+import (
+	"encoding/json"
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+	"time"
+
+	amsv1 "github.com/openshift-online/ocm-sdk-go/accountsmgmt/v1"
+	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+	"github.com/robfig/cron/v3"
+	errors "github.com/zgalor/weberr"
+)
+
+// ScalingScheduleLabelPrefix is the prefix of the labels of the subscription of a cluster that store
+// its scaling schedules, followed by the name of the schedule.
+const ScalingScheduleLabelPrefix = "rosa_scaling_schedule_"
+
+var scalingScheduleNameRE = regexp.MustCompile(`^[a-z]([-a-z0-9]{0,38}[a-z0-9])?$`)
+
+var cronParser = cron.NewParser(cron.Minute | cron.Hour | cron.Dom | cron.Month | cron.Dow)
+
+// ScalingSchedule sets the replicas of a machine pool every time its cron expression is due. It is
+// stored as JSON in a label of the subscription of the cluster.
+type ScalingSchedule struct {
+	Name        string     `json:"name"`
+	MachinePool string     `json:"machine_pool"`
+	Cron        string     `json:"cron"`
+	Replicas    int        `json:"replicas"`
+	CreatedAt   time.Time  `json:"created_at"`
+	LastRun     *time.Time `json:"last_run,omitempty"`
+}
+
+// ValidateScalingScheduleName checks that the name can be used as part of a label key.
+func ValidateScalingScheduleName(name string) error {
+	if !scalingScheduleNameRE.MatchString(name) {
+		return errors.BadRequest.Errorf("Invalid scaling schedule name '%s'. It must contain only lowercase "+
+			"letters, digits and dashes, start with a letter and have at most 40 characters", name)
+	}
+	return nil
+}
+
+// ParseScalingScheduleCron parses a standard cron expression with five fields. Expressions are
+// evaluated in UTC unless they start with 'CRON_TZ=<zone>'.
+func ParseScalingScheduleCron(expression string) (cron.Schedule, error) {
+	if !strings.HasPrefix(expression, "CRON_TZ=") && !strings.HasPrefix(expression, "TZ=") {
+		expression = "CRON_TZ=UTC " + expression
+	}
+	schedule, err := cronParser.Parse(expression)
+	if err != nil {
+		return nil, errors.BadRequest.Errorf("Invalid cron expression: %v", err)
+	}
+	return schedule, nil
+}
+
+// LastActivation returns the last time, not after the given one, that the schedule was due since
+// it last ran, or since it was created if it never ran. The result is zero if it isn't due.
+func (s *ScalingSchedule) LastActivation(now time.Time) (time.Time, error) {
+	schedule, err := ParseScalingScheduleCron(s.Cron)
+	if err != nil {
+		return time.Time{}, err
+	}
+	since := s.CreatedAt
+	if s.LastRun != nil {
+		since = *s.LastRun
+	}
+	var last time.Time
+	for next := schedule.Next(since); !next.IsZero() && !next.After(now); next = schedule.Next(next) {
+		last = next
+	}
+	return last, nil
+}
+
+func (s *ScalingSchedule) label() (*amsv1.Label, error) {
+	value, err := json.Marshal(s)
+	if err != nil {
+		return nil, err
+	}
+	return amsv1.NewLabel().Key(ScalingScheduleLabelPrefix + s.Name).Value(string(value)).Build()
+}
+
+// GetScalingSchedules returns the scaling schedules of the cluster, sorted by name.
+func (c *Client) GetScalingSchedules(cluster *cmv1.Cluster) ([]*ScalingSchedule, error) {
+	collection := c.ocm.AccountsMgmt().V1().Subscriptions().Subscription(cluster.Subscription().ID()).Labels()
+	schedules := []*ScalingSchedule{}
+	page := 1
+	size := 100
+	for {
+		response, err := collection.List().Page(page).Size(size).Send()
+		if err != nil {
+			return nil, handleErr(response.Error(), err)
+		}
+		for _, label := range response.Items().Slice() {
+			if !strings.HasPrefix(label.Key(), ScalingScheduleLabelPrefix) {
+				continue
+			}
+			schedule := &ScalingSchedule{}
+			err = json.Unmarshal([]byte(label.Value()), schedule)
+			if err != nil {
+				return nil, fmt.Errorf("Failed to parse scaling schedule label '%s': %v", label.Key(), err)
+			}
+			schedule.Name = strings.TrimPrefix(label.Key(), ScalingScheduleLabelPrefix)
+			schedules = append(schedules, schedule)
+		}
+		if response.Size() < size {
+			break
+		}
+		page++
+	}
+	sort.Slice(schedules, func(i, j int) bool {
+		return schedules[i].Name < schedules[j].Name
+	})
+	return schedules, nil
+}
+
+func (c *Client) CreateScalingSchedule(cluster *cmv1.Cluster, schedule *ScalingSchedule) error {
+	label, err := schedule.label()
+	if err != nil {
+		return err
+	}
+	response, err := c.ocm.AccountsMgmt().V1().Subscriptions().Subscription(cluster.Subscription().ID()).
+		Labels().Add().Body(label).Send()
+	if err != nil {
+		return handleErr(response.Error(), err)
+	}
+	return nil
+}
+
+func (c *Client) UpdateScalingSchedule(cluster *cmv1.Cluster, schedule *ScalingSchedule) error {
+	label, err := schedule.label()
+	if err != nil {
+		return err
+	}
+	response, err := c.ocm.AccountsMgmt().V1().Subscriptions().Subscription(cluster.Subscription().ID()).
+		Labels().Labels(label.Key()).Update().Body(label).Send()
+	if err != nil {
+		return handleErr(response.Error(), err)
+	}
+	return nil
+}
+
+func (c *Client) DeleteScalingSchedule(cluster *cmv1.Cluster, name string) error {
+	response, err := c.ocm.AccountsMgmt().V1().Subscriptions().Subscription(cluster.Subscription().ID()).
+		Labels().Labels(ScalingScheduleLabelPrefix + name).Delete().Send()
+	if err != nil {
+		return handleErr(response.Error(), err)
+	}
+	return nil
+}
@@ -0,0 +1,81 @@
+/*
+Copyright (c) 2024 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ocm
+
+import (
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("Scaling schedules", func() {
+	createdAt := time.Date(2024, 5, 6, 12, 0, 0, 0, time.UTC)
+
+	DescribeTable("Validates names",
+		func(name string, valid bool) {
+			err := ValidateScalingScheduleName(name)
+			if valid {
+				Expect(err).NotTo(HaveOccurred())
+			} else {
+				Expect(err).To(HaveOccurred())
+			}
+		},
+		Entry("lowercase with dashes", "nightly-down", true),
+		Entry("single letter", "a", true),
+		Entry("uppercase", "Nightly", false),
+		Entry("leading digit", "1-nightly", false),
+		Entry("trailing dash", "nightly-", false),
+		Entry("underscore", "nightly_down", false),
+		Entry("empty", "", false),
+	)
+
+	It("Rejects invalid cron expressions", func() {
+		_, err := ParseScalingScheduleCron("0 20 * *")
+		Expect(err).To(MatchError(ContainSubstring("Invalid cron expression")))
+	})
+
+	It("Evaluates cron expressions in UTC unless a zone is given", func() {
+		schedule, err := ParseScalingScheduleCron("0 20 * * *")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(schedule.Next(createdAt)).To(Equal(time.Date(2024, 5, 6, 20, 0, 0, 0, time.UTC)))
+
+		schedule, err = ParseScalingScheduleCron("CRON_TZ=Europe/Madrid 0 20 * * *")
+		Expect(err).NotTo(HaveOccurred())
+		Expect(schedule.Next(createdAt).UTC()).To(Equal(time.Date(2024, 5, 6, 18, 0, 0, 0, time.UTC)))
+	})
+
+	It("Isn't due before its first activation after creation", func() {
+		schedule := &ScalingSchedule{Cron: "0 20 * * *", CreatedAt: createdAt}
+		activation, err := schedule.LastActivation(createdAt.Add(7 * time.Hour))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(activation.IsZero()).To(BeTrue())
+	})
+
+	It("Returns the last activation since it last ran", func() {
+		lastRun := time.Date(2024, 5, 6, 20, 0, 0, 0, time.UTC)
+		schedule := &ScalingSchedule{Cron: "0 20 * * *", CreatedAt: createdAt, LastRun: &lastRun}
+
+		activation, err := schedule.LastActivation(lastRun.Add(time.Hour))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(activation.IsZero()).To(BeTrue())
+
+		activation, err = schedule.LastActivation(lastRun.Add(50 * time.Hour))
+		Expect(err).NotTo(HaveOccurred())
+		Expect(activation).To(Equal(time.Date(2024, 5, 8, 20, 0, 0, 0, time.UTC)))
+	})
+})
@@ -0,0 +1,150 @@
+/*
+Copyright (c) 2024 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ocm
+
+import (
+	"fmt"
+	"net/http"
+	"time"
+
+	cmv1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
+
+	"github.com/openshift/rosa/pkg/timeout"
+)
+
+// WaitTimeout is the maximum time that resources are polled when the timeout flag isn't set.
+const WaitTimeout = 2 * time.Hour
+
+// PollCluster calls the given function with the cluster every interval, or with nil once the cluster
+// doesn't exist, until it returns true.
+func (c *Client) PollCluster(clusterID string, interval time.Duration, cb func(*cmv1.Cluster) bool) error {
+	ctx, cancel := timeout.WithDefault(WaitTimeout)
+	defer cancel()
+
+	done := false
+	response, err := c.ocm.ClustersMgmt().V1().Clusters().
+		Cluster(clusterID).
+		Poll().
+		Interval(interval).
+		Status(http.StatusOK).
+		Status(http.StatusNotFound).
+		Predicate(func(response *cmv1.ClusterGetResponse) bool {
+			done = pollStatus(response.Status(), response.Body(), cb)
+			return done
+		}).
+		StartContext(ctx)
+	return pollResult(done, response.Status(), err, fmt.Sprintf("cluster '%s'", clusterID))
+}
+
+// PollNodePool calls the given function with the node pool every interval, or with nil once the node
+// pool doesn't exist, until it returns true.
+func (c *Client) PollNodePool(clusterID string, nodePoolID string, interval time.Duration,
+	cb func(*cmv1.NodePool) bool) error {
+	ctx, cancel := timeout.WithDefault(WaitTimeout)
+	defer cancel()
+
+	done := false
+	response, err := c.ocm.ClustersMgmt().V1().Clusters().
+		Cluster(clusterID).
+		NodePools().
+		NodePool(nodePoolID).
+		Poll().
+		Interval(interval).
+		Status(http.StatusOK).
+		Status(http.StatusNotFound).
+		Predicate(func(response *cmv1.NodePoolGetResponse) bool {
+			done = pollStatus(response.Status(), response.Body(), cb)
+			return done
+		}).
+		StartContext(ctx)
+	return pollResult(done, response.Status(), err, fmt.Sprintf("machine pool '%s'", nodePoolID))
+}
+
+// PollUpgradePolicyState calls the given function with the state of the upgrade policy every interval,
+// or with nil once the policy doesn't exist, until it returns true.
+func (c *Client) PollUpgradePolicyState(clusterID string, upgradePolicyID string, interval time.Duration,
+	cb func(*cmv1.UpgradePolicyState) bool) error {
+	ctx, cancel := timeout.WithDefault(WaitTimeout)
+	defer cancel()
+
+	done := false
+	response, err := c.ocm.ClustersMgmt().V1().Clusters().
+		Cluster(clusterID).
+		UpgradePolicies().
+		UpgradePolicy(upgradePolicyID).
+		State().
+		Poll().
+		Interval(interval).
+		Status(http.StatusOK).
+		Status(http.StatusNotFound).
+		Predicate(func(response *cmv1.UpgradePolicyStateGetResponse) bool {
+			done = pollStatus(response.Status(), response.Body(), cb)
+			return done
+		}).
+		StartContext(ctx)
+	return pollResult(done, response.Status(), err, fmt.Sprintf("upgrade of cluster '%s'", clusterID))
+}
+
+// PollControlPlaneUpgradePolicy calls the given function with the control plane upgrade policy every
+// interval, or with nil once the policy doesn't exist, until it returns true.
+func (c *Client) PollControlPlaneUpgradePolicy(clusterID string, upgradePolicyID string, interval time.Duration,
+	cb func(*cmv1.ControlPlaneUpgradePolicy) bool) error {
+	ctx, cancel := timeout.WithDefault(WaitTimeout)
+	defer cancel()
+
+	done := false
+	response, err := c.ocm.ClustersMgmt().V1().Clusters().
+		Cluster(clusterID).
+		ControlPlane().
+		UpgradePolicies().
+		ControlPlaneUpgradePolicy(upgradePolicyID).
+		Poll().
+		Interval(interval).
+		Status(http.StatusOK).
+		Status(http.StatusNotFound).
+		Predicate(func(response *cmv1.ControlPlaneUpgradePolicyGetResponse) bool {
+			done = pollStatus(response.Status(), response.Body(), cb)
+			return done
+		}).
+		StartContext(ctx)
+	return pollResult(done, response.Status(), err, fmt.Sprintf("upgrade of cluster '%s'", clusterID))
+}
+
+// pollStatus calls the callback of a poll with the object, or with nil when it doesn't exist. Other
+// failed responses, like temporary server errors, are ignored so that polling continues.
+func pollStatus[T any](status int, body *T, cb func(*T) bool) bool {
+	switch status {
+	case http.StatusOK:
+		return cb(body)
+	case http.StatusNotFound:
+		return cb(nil)
+	}
+	return false
+}
+
+// pollResult returns the error of a poll that was stopped before the callback returned true. The
+// polling functions of the SDK only stop on failed requests without response, like when the context
+// is cancelled, or when the deadline doesn't leave time for another attempt.
+func pollResult(done bool, status int, err error, description string) error {
+	if done {
+		return nil
+	}
+	if err != nil && status == 0 {
+		return fmt.Errorf("Failed to poll %s: %w", description, timeout.Error(err))
+	}
+	return fmt.Errorf("Stopped waiting for %s: %w", description, timeout.Exceeded(WaitTimeout))
+}
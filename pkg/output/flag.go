@@ -27,6 +27,7 @@ import (
 const (
 	JSON           = "json"
 	YAML           = "yaml"
+	CSV            = "csv"
 	FLAG_NAME      = "output"
 	FLAG_SHORTHAND = "o"
 )
@@ -48,6 +49,24 @@ func AddFlag(cmd *cobra.Command) {
 	cmd.RegisterFlagCompletionFunc(FLAG_NAME, completion)
 }
 
+// AddFlagWithCSV adds the output flag to commands whose tables can also be printed as CSV.
+func AddFlagWithCSV(cmd *cobra.Command) {
+	tableFormats := append([]string{}, formats...)
+	tableFormats = append(tableFormats, CSV)
+	cmd.Flags().StringVarP(
+		&o,
+		FLAG_NAME,
+		FLAG_SHORTHAND,
+		"",
+		fmt.Sprintf("Output format. Allowed formats are %s", tableFormats),
+	)
+
+	cmd.RegisterFlagCompletionFunc(FLAG_NAME, func(_ *cobra.Command, _ []string,
+		_ string) ([]string, cobra.ShellCompDirective) {
+		return tableFormats, cobra.ShellCompDirectiveDefault
+	})
+}
+
 func completion(_ *cobra.Command, _ []string, _ string) ([]string, cobra.ShellCompDirective) {
 	return formats, cobra.ShellCompDirectiveDefault
 }
@@ -31,6 +31,15 @@ var _ = Describe("Output flag", func() {
 		Expect(flag.Usage).To(Equal("Output format. Allowed formats are [json yaml]"))
 	})
 
+	It("Adds flag with CSV to command", func() {
+		cmd := &cobra.Command{}
+		AddFlagWithCSV(cmd)
+
+		flag := cmd.Flag(FLAG_NAME)
+		Expect(flag).NotTo(BeNil())
+		Expect(flag.Usage).To(Equal("Output format. Allowed formats are [json yaml csv]"))
+	})
+
 	It("Has a completion function", func() {
 		args, directive := completion(nil, nil, "")
 		Expect(len(args)).To(Equal(2))
@@ -0,0 +1,271 @@
+/*
+Copyright (c) 2024 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// This file contains functions used to implement the '--error-format' command line option and the
+// classification of errors into stable codes and exit codes.
+
+package reporter
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"strings"
+
+	"github.com/aws/smithy-go"
+	"github.com/spf13/cobra"
+	"github.com/zgalor/weberr"
+
+	"github.com/openshift/rosa/pkg/timeout"
+)
+
+const (
+	ErrorFormatFlag = "error-format"
+	TextErrorFormat = "text"
+	JSONErrorFormat = "json"
+)
+
+var errorFormats = []string{TextErrorFormat, JSONErrorFormat}
+
+var errorFormat = TextErrorFormat
+
+// Error codes reported in the 'code' field of the JSON errors. Scripts rely on them, so existing values
+// must never change.
+const (
+	ErrorCodeGeneric          = "error"
+	ErrorCodeValidation       = "validation"
+	ErrorCodeNotFound         = "not_found"
+	ErrorCodePermissionDenied = "permission_denied"
+	ErrorCodeQuotaExceeded    = "quota_exceeded"
+	ErrorCodeTimeout          = "timeout"
+	ErrorCodeInterrupted      = "interrupted"
+)
+
+// Exit codes of every error code. Exit code 2 is skipped because it is used by '--dry-run' to signal that
+// there are changes. Interruptions use the exit code of shells for processes killed by SIGINT.
+var exitCodes = map[string]int{
+	ErrorCodeGeneric:          1,
+	ErrorCodeValidation:       3,
+	ErrorCodeNotFound:         4,
+	ErrorCodePermissionDenied: 5,
+	ErrorCodeQuotaExceeded:    6,
+	ErrorCodeTimeout:          7,
+	ErrorCodeInterrupted:      130,
+}
+
+// AWS error codes, or suffixes of them, that belong to each error code.
+var awsErrorCodes = map[string][]string{
+	ErrorCodeNotFound: {"NoSuchEntity", "NoSuchBucket", "NotFound", "NotFoundException"},
+	ErrorCodePermissionDenied: {"AccessDenied", "AccessDeniedException", "UnauthorizedOperation",
+		"InvalidClientTokenId", "ExpiredToken", "SignatureDoesNotMatch"},
+	ErrorCodeValidation: {"ValidationError", "ValidationException", "MalformedPolicyDocument",
+		"InvalidParameterValue", "InvalidParameterCombination", "InvalidInput"},
+	ErrorCodeQuotaExceeded: {"LimitExceeded", "QuotaExceeded", "QuotaExceededException"},
+}
+
+// OCMError contains the details of a failed request to the OCM API. It is added to the errors returned
+// by the OCM client, so that they can be reported.
+type OCMError struct {
+	OperationID string `json:"operation_id,omitempty"`
+	Status      int    `json:"status,omitempty"`
+	Code        string `json:"code,omitempty"`
+}
+
+// AWSError contains the details of a failed request to the AWS API.
+type AWSError struct {
+	Code string `json:"code"`
+}
+
+// Error is the machine readable representation of an error printed with '--error-format=json'.
+type Error struct {
+	Code     string    `json:"code"`
+	Message  string    `json:"message"`
+	Command  string    `json:"command,omitempty"`
+	ExitCode int       `json:"exit_code"`
+	OCM      *OCMError `json:"ocm,omitempty"`
+	AWS      *AWSError `json:"aws,omitempty"`
+	// CreatedResources are the resources that the command created before it was cancelled.
+	CreatedResources []string `json:"created_resources,omitempty"`
+}
+
+// command is the path of the command being executed, like 'rosa describe cluster'.
+var command string
+
+// lastError is the last error reported, used to calculate the exit code of the process.
+var lastError *Error
+
+// AddErrorFormatFlag adds the error format flag to the given command.
+func AddErrorFormatFlag(cmd *cobra.Command) {
+	cmd.PersistentFlags().StringVar(
+		&errorFormat,
+		ErrorFormatFlag,
+		TextErrorFormat,
+		fmt.Sprintf("Format of the error messages. Allowed options are %s. The '%s' format prints errors "+
+			"with a stable code and details of the failed OCM or AWS request", errorFormats, JSONErrorFormat),
+	)
+
+	cmd.RegisterFlagCompletionFunc(ErrorFormatFlag, errorFormatCompletion)
+}
+
+func errorFormatCompletion(cmd *cobra.Command, args []string, toComplete string) ([]string,
+	cobra.ShellCompDirective) {
+	return errorFormats, cobra.ShellCompDirectiveDefault
+}
+
+// ValidateErrorFormat checks that the value of the error format flag is supported.
+func ValidateErrorFormat() error {
+	for _, format := range errorFormats {
+		if errorFormat == format {
+			return nil
+		}
+	}
+	// Report it as text, as the requested format isn't known
+	format := errorFormat
+	errorFormat = TextErrorFormat
+	return weberr.BadRequest.Errorf("Invalid error format '%s'. Allowed options are %s", format, errorFormats)
+}
+
+// JSONErrors returns true when errors should be printed in JSON format.
+func JSONErrors() bool {
+	return errorFormat == JSONErrorFormat
+}
+
+func SetErrorFormat(format string) {
+	errorFormat = format
+}
+
+// SetCommand sets the path of the command being executed, reported with the errors.
+func SetCommand(path string) {
+	command = path
+}
+
+// ExitCode returns the exit code that corresponds to the last reported error, or 1 if no error has been
+// reported.
+func ExitCode() int {
+	if lastError == nil {
+		return exitCodes[ErrorCodeGeneric]
+	}
+	return lastError.ExitCode
+}
+
+// ExitCodeOf returns the exit code that corresponds to the given error.
+func ExitCodeOf(err error) int {
+	return exitCodes[newError("", []interface{}{err}).Code]
+}
+
+// newError classifies the error described by the given message, using the details of the errors
+// included in the arguments used to format it.
+func newError(message string, args []interface{}) *Error {
+	result := &Error{
+		Code:    ErrorCodeGeneric,
+		Message: message,
+		Command: command,
+	}
+	for _, arg := range args {
+		err, ok := arg.(error)
+		if !ok || err == nil {
+			continue
+		}
+		if code := classify(err, result); code != ErrorCodeGeneric {
+			result.Code = code
+		}
+	}
+	result.ExitCode = exitCodes[result.Code]
+	if timeout.Err() != nil {
+		result.CreatedResources = timeout.CreatedResources()
+	}
+	return result
+}
+
+// classify returns the error code of the given error, and fills the details of the failed OCM or AWS
+// request.
+func classify(err error, result *Error) string {
+	code := ErrorCodeGeneric
+
+	var apiErr smithy.APIError
+	if errors.As(err, &apiErr) {
+		result.AWS = &AWSError{Code: apiErr.ErrorCode()}
+		code = classifyAWSError(apiErr.ErrorCode())
+	}
+
+	switch {
+	case errors.Is(err, timeout.ErrInterrupted):
+		return ErrorCodeInterrupted
+	case errors.Is(err, context.DeadlineExceeded):
+		return ErrorCodeTimeout
+	}
+
+	// Errors of the OCM client are wrapped with 'weberr', which doesn't support 'errors.As'
+	for current := err; current != nil; current = unwrap(current) {
+		for _, detail := range weberr.GetDetails(current) {
+			if ocmErr, ok := detail.(OCMError); ok {
+				result.OCM = &ocmErr
+			}
+		}
+		if code == ErrorCodeGeneric {
+			code = classifyStatus(int(weberr.GetType(current)), err.Error())
+		}
+	}
+	return code
+}
+
+func unwrap(err error) error {
+	if cause, ok := err.(interface{ Cause() error }); ok {
+		return cause.Cause()
+	}
+	return errors.Unwrap(err)
+}
+
+func classifyStatus(status int, message string) string {
+	switch status {
+	case http.StatusBadRequest, http.StatusUnprocessableEntity:
+		return ErrorCodeValidation
+	case http.StatusNotFound:
+		return ErrorCodeNotFound
+	case http.StatusPaymentRequired:
+		return ErrorCodeQuotaExceeded
+	case http.StatusUnauthorized, http.StatusForbidden:
+		// OCM rejects requests that exceed the quota of the organization as forbidden
+		if strings.Contains(strings.ToLower(message), "quota") {
+			return ErrorCodeQuotaExceeded
+		}
+		return ErrorCodePermissionDenied
+	}
+	return ErrorCodeGeneric
+}
+
+func classifyAWSError(awsCode string) string {
+	for code, values := range awsErrorCodes {
+		for _, value := range values {
+			if awsCode == value || strings.HasSuffix(awsCode, value) || strings.HasPrefix(awsCode, value) {
+				return code
+			}
+		}
+	}
+	return ErrorCodeGeneric
+}
+
+func writeJSONError(w io.Writer, err *Error) {
+	data, jsonErr := json.Marshal(err)
+	if jsonErr != nil {
+		_, _ = fmt.Fprintf(w, "%s%s\n", errorPrefix, err.Message)
+		return
+	}
+	_, _ = fmt.Fprintf(w, "%s\n", data)
+}
@@ -60,13 +60,25 @@ func (r *Object) Warnf(format string, args ...interface{}) {
 
 // Errorf prints an error message with the given format and arguments. It also return an error
 // containing the same information, which will be usually discarded, except when the caller needs to
-// report the error and also return it.
+// report the error and also return it. The errors included in the arguments are used to classify it,
+// see ExitCode.
 func (r *Object) Errorf(format string, args ...interface{}) error {
 	message := fmt.Sprintf(format, args...)
-	if color.UseColor() {
-		_, _ = fmt.Fprintf(os.Stderr, "%s%s\n", errorColorPrefix, message)
+	lastError = newError(message, args)
+	if JSONErrors() {
+		writeJSONError(os.Stderr, lastError)
 	} else {
-		_, _ = fmt.Fprintf(os.Stderr, "%s%s\n", errorPrefix, message)
+		if color.UseColor() {
+			_, _ = fmt.Fprintf(os.Stderr, "%s%s\n", errorColorPrefix, message)
+		} else {
+			_, _ = fmt.Fprintf(os.Stderr, "%s%s\n", errorPrefix, message)
+		}
+		if len(lastError.CreatedResources) > 0 {
+			r.Warnf("The command was cancelled after creating these resources, which may be incomplete:")
+			for _, resource := range lastError.CreatedResources {
+				_, _ = fmt.Fprintf(os.Stderr, " - %s\n", resource)
+			}
+		}
 	}
 	return errors.New(message)
 }
@@ -1,15 +1,21 @@
 package reporter
 
 import (
+	"errors"
+	"fmt"
 	"io"
 	"os"
 	"testing"
+	"time"
 
+	"github.com/aws/smithy-go"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	"github.com/zgalor/weberr"
 
 	"github.com/openshift/rosa/pkg/color"
 	"github.com/openshift/rosa/pkg/debug"
+	"github.com/openshift/rosa/pkg/timeout"
 )
 
 func TestReporter(t *testing.T) {
@@ -28,6 +34,8 @@ var _ = Describe("Reporter Tests", func() {
 	AfterEach(func() {
 		color.SetColor("auto")
 		debug.SetEnabled(false)
+		SetErrorFormat(TextErrorFormat)
+		SetCommand("")
 	})
 
 	It("Returns a reporter", func() {
@@ -109,6 +117,72 @@ var _ = Describe("Reporter Tests", func() {
 		})
 	})
 
+	Context("JSON errors", func() {
+		BeforeEach(func() {
+			SetErrorFormat(JSONErrorFormat)
+			SetCommand("rosa describe cluster")
+		})
+
+		It("Prints an error without details", func() {
+			stdOut, stdErr := captureStdOutAndStdError(func() {
+				reporter.Errorf("Hello %s", "World")
+			})
+			Expect(stdOut).To(BeEmpty())
+			Expect(stdErr).To(Equal(`{"code":"error","message":"Hello World","command":"rosa describe cluster",` +
+				`"exit_code":1}` + "\n"))
+			Expect(ExitCode()).To(Equal(1))
+		})
+
+		It("Prints the details of OCM errors", func() {
+			err := weberr.AddDetails(weberr.NotFound.Errorf("Cluster not found"), OCMError{
+				OperationID: "1234", Status: 404, Code: "CLUSTERS-MGMT-404",
+			})
+			_, stdErr := captureStdOutAndStdError(func() {
+				reporter.Errorf("Failed to get cluster: %v", err)
+			})
+			Expect(stdErr).To(Equal(`{"code":"not_found","message":"Failed to get cluster: Cluster not found",` +
+				`"command":"rosa describe cluster","exit_code":4,` +
+				`"ocm":{"operation_id":"1234","status":404,"code":"CLUSTERS-MGMT-404"}}` + "\n"))
+			Expect(ExitCode()).To(Equal(4))
+		})
+
+		It("Prints the code of AWS errors", func() {
+			err := fmt.Errorf("operation error IAM: GetRole: %w",
+				&smithy.GenericAPIError{Code: "AccessDenied", Message: "Not allowed"})
+			_, stdErr := captureStdOutAndStdError(func() {
+				reporter.Errorf("Failed to get role: %v", err)
+			})
+			Expect(stdErr).To(ContainSubstring(`"code":"permission_denied"`))
+			Expect(stdErr).To(ContainSubstring(`"aws":{"code":"AccessDenied"}`))
+			Expect(ExitCode()).To(Equal(5))
+		})
+	})
+
+	Context("Exit codes", func() {
+		DescribeTable("Classifies errors",
+			func(err error, expected int) {
+				Expect(ExitCodeOf(err)).To(Equal(expected))
+			},
+			Entry("plain error", errors.New("failed"), 1),
+			Entry("bad request", weberr.BadRequest.Errorf("invalid"), 3),
+			Entry("not found", weberr.NotFound.Errorf("missing"), 4),
+			Entry("forbidden", weberr.Forbidden.Errorf("denied"), 5),
+			Entry("quota", weberr.Forbidden.Errorf("Cluster exceeds the quota of the organization"), 6),
+			Entry("AWS not found", &smithy.GenericAPIError{Code: "NoSuchEntity"}, 4),
+			Entry("AWS validation", &smithy.GenericAPIError{Code: "InvalidParameterValue"}, 3),
+			Entry("AWS quota", &smithy.GenericAPIError{Code: "VpcLimitExceeded"}, 6),
+			Entry("wrapped", weberr.Wrapf(weberr.NotFound.Errorf("missing"), "wrapped"), 4),
+			Entry("timeout", fmt.Errorf("Stopped waiting: %w", timeout.Exceeded(time.Minute)), 7),
+			Entry("interrupted", fmt.Errorf("%w: context canceled", timeout.ErrInterrupted), 130),
+		)
+
+		It("Rejects unknown formats", func() {
+			SetErrorFormat("xml")
+			Expect(ValidateErrorFormat()).To(MatchError("Invalid error format 'xml'. Allowed options are [text json]"))
+			Expect(JSONErrors()).To(BeFalse())
+		})
+	})
+
 	Context("Debug", func() {
 		It("Does not print if debug is not enabled", func() {
 			debug.SetEnabled(false)
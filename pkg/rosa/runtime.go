@@ -1,6 +1,7 @@
 package rosa
 
 import (
+	"context"
 	"os"
 	"time"
 
@@ -12,9 +13,13 @@ import (
 	"github.com/openshift/rosa/pkg/logging"
 	"github.com/openshift/rosa/pkg/ocm"
 	"github.com/openshift/rosa/pkg/reporter"
+	"github.com/openshift/rosa/pkg/timeout"
 )
 
 type Runtime struct {
+	// Ctx is the context of the command. It is cancelled when the '--timeout' expires or when the user
+	// interrupts the command.
+	Ctx        context.Context
 	Reporter   *reporter.Object
 	Logger     *logrus.Logger
 	OCMClient  *ocm.Client
@@ -29,7 +34,7 @@ func NewRuntime() *Runtime {
 	reporter := reporter.CreateReporter()
 	logger := logging.NewLogger()
 	spinner := spinner.New(spinner.CharSets[9], 100*time.Millisecond)
-	return &Runtime{Reporter: reporter, Logger: logger, Spinner: spinner}
+	return &Runtime{Ctx: timeout.Context(), Reporter: reporter, Logger: logger, Spinner: spinner}
 }
 
 // Adds an OCM client to the runtime. Requires a deferred call to `.Cleanup()` to close connections.
@@ -47,7 +52,7 @@ func (r *Runtime) WithAWS() *Runtime {
 	err := r.OCMClient.ValidateAwsClientRegion()
 	if err != nil {
 		r.Reporter.Errorf("%v", err)
-		os.Exit(1)
+		os.Exit(reporter.ExitCode())
 	}
 	if r.AWSClient == nil {
 		r.AWSClient = aws.CreateNewClientOrExit(r.Logger, r.Reporter)
@@ -57,7 +62,7 @@ func (r *Runtime) WithAWS() *Runtime {
 		r.Creator, err = r.AWSClient.GetCreator()
 		if err != nil {
 			r.Reporter.Errorf("Failed to get AWS creator: %v", err)
-			os.Exit(1)
+			os.Exit(reporter.ExitCode())
 		}
 	}
 	return r
@@ -76,7 +81,7 @@ func (r *Runtime) GetClusterKey() string {
 	clusterKey, err := ocm.GetClusterKey()
 	if err != nil {
 		r.Reporter.Errorf("%s", err)
-		os.Exit(1)
+		os.Exit(reporter.ExitCode())
 	}
 	r.ClusterKey = clusterKey
 	return clusterKey
@@ -90,7 +95,7 @@ func (r *Runtime) FetchCluster() *cmv1.Cluster {
 	// We don't want to lazy init the OCM client since it requires cleanup
 	if r.OCMClient == nil {
 		r.Reporter.Errorf("Tried to fetch a cluster without initializing the OCM client, exiting.")
-		os.Exit(1)
+		os.Exit(reporter.ExitCode())
 	}
 	if r.ClusterKey == "" {
 		r.GetClusterKey()
@@ -103,7 +108,7 @@ func (r *Runtime) FetchCluster() *cmv1.Cluster {
 	cluster, err := r.OCMClient.GetCluster(r.ClusterKey, r.Creator)
 	if err != nil {
 		r.Reporter.Errorf("Failed to get cluster '%s': %v", r.ClusterKey, err)
-		os.Exit(1)
+		os.Exit(reporter.ExitCode())
 	}
 	r.Cluster = cluster
 	return cluster
@@ -14,6 +14,7 @@ import (
 	. "github.com/onsi/gomega"
 	"github.com/onsi/gomega/ghttp"
 	sdk "github.com/openshift-online/ocm-sdk-go"
+	amsv1 "github.com/openshift-online/ocm-sdk-go/accountsmgmt/v1"
 	v1 "github.com/openshift-online/ocm-sdk-go/clustersmgmt/v1"
 	"github.com/openshift-online/ocm-sdk-go/logging"
 	. "github.com/openshift-online/ocm-sdk-go/testing"
@@ -251,11 +252,105 @@ func FormatNodePoolUpgradePolicyList(upgrades []*v1.NodePoolUpgradePolicy) strin
 	}`, len(upgrades), len(upgrades), outputJson.String())
 }
 
+func FormatNodePoolList(nodePools []*v1.NodePool) string {
+	var outputJson bytes.Buffer
+
+	v1.MarshalNodePoolList(nodePools, &outputJson)
+
+	return fmt.Sprintf(`
+	{
+		"kind": "NodePoolList",
+		"page": 1,
+		"size": %d,
+		"total": %d,
+		"items": %s
+	}`, len(nodePools), len(nodePools), outputJson.String())
+}
+
+func FormatMachinePoolList(machinePools []*v1.MachinePool) string {
+	var outputJson bytes.Buffer
+
+	v1.MarshalMachinePoolList(machinePools, &outputJson)
+
+	return fmt.Sprintf(`
+	{
+		"kind": "MachinePoolList",
+		"page": 1,
+		"size": %d,
+		"total": %d,
+		"items": %s
+	}`, len(machinePools), len(machinePools), outputJson.String())
+}
+
+func FormatLimitedSupportReasonList(reasons []*v1.LimitedSupportReason) string {
+	var outputJson bytes.Buffer
+
+	v1.MarshalLimitedSupportReasonList(reasons, &outputJson)
+
+	return fmt.Sprintf(`
+	{
+		"kind": "LimitedSupportReasonList",
+		"page": 1,
+		"size": %d,
+		"total": %d,
+		"items": %s
+	}`, len(reasons), len(reasons), outputJson.String())
+}
+
+func FormatInflightCheckList(inflightChecks []*v1.InflightCheck) string {
+	var outputJson bytes.Buffer
+
+	v1.MarshalInflightCheckList(inflightChecks, &outputJson)
+
+	return fmt.Sprintf(`
+	{
+		"kind": "InflightCheckList",
+		"page": 1,
+		"size": %d,
+		"total": %d,
+		"items": %s
+	}`, len(inflightChecks), len(inflightChecks), outputJson.String())
+}
+
+func FormatOidcConfigList(oidcConfigs []*v1.OidcConfig) string {
+	var outputJson bytes.Buffer
+
+	v1.MarshalOidcConfigList(oidcConfigs, &outputJson)
+
+	return fmt.Sprintf(`
+	{
+		"kind": "OidcConfigList",
+		"page": 1,
+		"size": %d,
+		"total": %d,
+		"items": %s
+	}`, len(oidcConfigs), len(oidcConfigs), outputJson.String())
+}
+
+func FormatLabelList(labels []*amsv1.Label) string {
+	var outputJson bytes.Buffer
+
+	amsv1.MarshalLabelList(labels, &outputJson)
+
+	return fmt.Sprintf(`
+	{
+		"kind": "LabelList",
+		"page": 1,
+		"size": %d,
+		"total": %d,
+		"items": %s
+	}`, len(labels), len(labels), outputJson.String())
+}
+
 // FormatResource wraps the SDK marshalling and returns a string starting from an object
 func FormatResource(resource interface{}) string {
 	var outputJson bytes.Buffer
 	var err error
 	switch reflect.TypeOf(resource).String() {
+	case "*v1.Cluster":
+		if res, ok := resource.(*v1.Cluster); ok {
+			err = v1.MarshalCluster(res, &outputJson)
+		}
 	case "*v1.Version":
 		if res, ok := resource.(*v1.Version); ok {
 			err = v1.MarshalVersion(res, &outputJson)
@@ -268,6 +363,10 @@ func FormatResource(resource interface{}) string {
 		if res, ok := resource.(*v1.MachinePool); ok {
 			err = v1.MarshalMachinePool(res, &outputJson)
 		}
+	case "*v1.KubeletConfig":
+		if res, ok := resource.(*v1.KubeletConfig); ok {
+			err = v1.MarshalKubeletConfig(res, &outputJson)
+		}
 	case "*v1.ClusterAutoscaler":
 		if res, ok := resource.(*v1.ClusterAutoscaler); ok {
 			err = v1.MarshalClusterAutoscaler(res, &outputJson)
@@ -276,6 +375,10 @@ func FormatResource(resource interface{}) string {
 		if res, ok := resource.(*v1.ControlPlaneUpgradePolicy); ok {
 			err = v1.MarshalControlPlaneUpgradePolicy(res, &outputJson)
 		}
+	case "*v1.UpgradePolicyState":
+		if res, ok := resource.(*v1.UpgradePolicyState); ok {
+			err = v1.MarshalUpgradePolicyState(res, &outputJson)
+		}
 	case "*v1.ExternalAuth":
 		if res, ok := resource.(*v1.ExternalAuth); ok {
 			err = v1.MarshalExternalAuth(res, &outputJson)
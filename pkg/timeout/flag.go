@@ -0,0 +1,179 @@
+/*
+Copyright (c) 2024 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// This file contains functions used to implement the '--timeout' command line option and the
+// cancellation of the running command when the user presses Ctrl-C.
+
+package timeout
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"os"
+	"os/signal"
+	"sync"
+	"syscall"
+	"time"
+
+	"github.com/spf13/pflag"
+)
+
+const FlagName = "timeout"
+
+// ErrInterrupted is the cause of the cancellation of the context when the user interrupts the command.
+var ErrInterrupted = errors.New("Interrupted by the user")
+
+// timeout is the maximum duration of the command, or zero if it can run forever.
+var timeout time.Duration
+
+// interruptedExitCode is the exit code of the process when the user interrupts the command twice, the
+// one used by shells for processes terminated by SIGINT.
+const interruptedExitCode = 130
+
+var (
+	once     sync.Once
+	ctx      context.Context
+	cancel   context.CancelCauseFunc
+	deadline time.Time
+)
+
+// exceededError is the cause of the cancellation of the context when the timeout expires. It matches
+// context.DeadlineExceeded, so that callers can tell it apart from other failures.
+type exceededError struct {
+	duration time.Duration
+}
+
+func (e *exceededError) Error() string {
+	return fmt.Sprintf("Timeout of %s exceeded", e.duration)
+}
+
+func (e *exceededError) Is(target error) bool {
+	return target == context.DeadlineExceeded
+}
+
+// AddFlag adds the timeout flag to the given set of command line flags.
+func AddFlag(flags *pflag.FlagSet) {
+	flags.DurationVar(
+		&timeout,
+		FlagName,
+		0,
+		"Maximum time the command can run, like '30m' or '2h'. When it expires, or when the command is "+
+			"interrupted with Ctrl-C, the pending requests to OCM and AWS are cancelled, and the AWS resources "+
+			"created so far are listed. By default there is no limit.",
+	)
+}
+
+// Duration returns the value of the timeout flag, or zero if it hasn't been set.
+func Duration() time.Duration {
+	return timeout
+}
+
+func SetDuration(value time.Duration) {
+	timeout = value
+}
+
+// Start creates the context of the running command from the given parent. It is meant to be called
+// once by the root command, which then passes the context down to the command. The context is cancelled
+// when the timeout expires or when the user interrupts the command. The first interrupt only cancels the
+// context, so that the command can report what it has done so far; a second one exits immediately.
+func Start(parent context.Context) context.Context {
+	once.Do(func() {
+		ctx, cancel = context.WithCancelCause(parent)
+		if timeout > 0 {
+			deadline = time.Now().Add(timeout)
+			time.AfterFunc(timeout, func() {
+				cancel(&exceededError{duration: timeout})
+			})
+		}
+
+		interrupts := make(chan os.Signal, 2)
+		signal.Notify(interrupts, os.Interrupt, syscall.SIGTERM)
+		go func() {
+			<-interrupts
+			_, _ = fmt.Fprintf(os.Stderr, "\nInterrupted, waiting for the current operation to stop. "+
+				"Press Ctrl-C again to exit immediately\n")
+			cancel(ErrInterrupted)
+			<-interrupts
+			os.Exit(interruptedExitCode)
+		}()
+	})
+	return ctx
+}
+
+// Context returns the context of the running command, or a context that is never cancelled if the
+// command hasn't been started by the root command, like in tests.
+func Context() context.Context {
+	if ctx == nil {
+		return context.Background()
+	}
+	return ctx
+}
+
+// WithDefault returns a context that is cancelled with the context of the command, and after the
+// given duration if the timeout flag hasn't been set. It is meant for operations, like polling, that
+// had a fixed limit before the flag existed. The returned context always has a deadline, as the
+// polling functions of the OCM SDK require it.
+func WithDefault(duration time.Duration) (context.Context, context.CancelFunc) {
+	parent := Context()
+	if timeout > 0 {
+		return context.WithDeadline(parent, deadline)
+	}
+	return context.WithTimeout(parent, duration)
+}
+
+// Exceeded returns the error reported when an operation started with WithDefault doesn't finish in
+// time, using the given duration if the timeout flag hasn't been set. The error matches
+// context.DeadlineExceeded.
+func Exceeded(duration time.Duration) error {
+	if timeout > 0 {
+		duration = timeout
+	}
+	return &exceededError{duration: duration}
+}
+
+// Sleep pauses for the given duration, or until the context of the command is cancelled, in which
+// case it returns the reason.
+func Sleep(duration time.Duration) error {
+	timer := time.NewTimer(duration)
+	defer timer.Stop()
+	select {
+	case <-timer.C:
+		return nil
+	case <-Context().Done():
+		return Err()
+	}
+}
+
+// Err returns the reason why the context of the command has been cancelled, like the timeout or the
+// user interrupting the command, or nil if it hasn't been cancelled.
+func Err() error {
+	if ctx == nil || ctx.Err() == nil {
+		return nil
+	}
+	return context.Cause(ctx)
+}
+
+// Error replaces the generic error returned by cancelled requests, like 'context canceled', with the
+// reason of the cancellation.
+func Error(err error) error {
+	if err != nil && (errors.Is(err, context.Canceled) || errors.Is(err, context.DeadlineExceeded)) {
+		if cause := Err(); cause != nil {
+			return fmt.Errorf("%w: %v", cause, err)
+		}
+	}
+	return err
+}
@@ -0,0 +1,59 @@
+package timeout_test
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/openshift/rosa/pkg/timeout"
+)
+
+var _ = Describe("Timeout", Ordered, func() {
+	// The context of the command is created only once, so the expiration of the timeout is the last thing
+	// tested
+	BeforeAll(func() {
+		timeout.SetDuration(200 * time.Millisecond)
+	})
+
+	It("Isn't cancelled before the command starts", func() {
+		Expect(timeout.Context()).To(Equal(context.Background()))
+		Expect(timeout.Err()).To(BeNil())
+	})
+
+	It("Starts the context of the command once", func() {
+		ctx := timeout.Start(context.Background())
+		Expect(timeout.Context()).To(BeIdenticalTo(ctx))
+		Expect(timeout.Start(context.Background())).To(BeIdenticalTo(ctx))
+	})
+
+	It("Sleeps while the command isn't cancelled", func() {
+		Expect(timeout.Sleep(time.Millisecond)).To(Succeed())
+		Expect(timeout.Err()).To(BeNil())
+	})
+
+	It("Doesn't change errors of commands that haven't been cancelled", func() {
+		err := errors.New("failed")
+		Expect(timeout.Error(err)).To(Equal(err))
+		Expect(timeout.Error(context.Canceled)).To(Equal(context.Canceled))
+	})
+
+	It("Replaces the default limit with the timeout", func() {
+		ctx, cancel := timeout.WithDefault(time.Millisecond)
+		defer cancel()
+		Consistently(ctx.Done(), 20*time.Millisecond).ShouldNot(BeClosed())
+		_, ok := ctx.Deadline()
+		Expect(ok).To(BeTrue())
+		Expect(timeout.Exceeded(time.Hour)).To(MatchError("Timeout of 200ms exceeded"))
+	})
+
+	It("Cancels the context when the timeout expires", func() {
+		Eventually(timeout.Context().Done()).Should(BeClosed())
+		Expect(timeout.Err()).To(MatchError("Timeout of 200ms exceeded"))
+		Expect(timeout.Sleep(time.Hour)).To(MatchError("Timeout of 200ms exceeded"))
+		Expect(timeout.Error(context.Canceled)).To(MatchError("Timeout of 200ms exceeded: context canceled"))
+		Expect(timeout.Error(context.Canceled)).To(MatchError(context.DeadlineExceeded))
+	})
+})
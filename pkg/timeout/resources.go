@@ -0,0 +1,42 @@
+/*
+Copyright (c) 2024 Red Hat, Inc.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+  http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package timeout
+
+import (
+	"fmt"
+	"sync"
+)
+
+var (
+	createdLock sync.Mutex
+	created     []string
+)
+
+// AddCreatedResource records a resource created by the command, like an IAM role, so that it can be
+// reported if the command is cancelled before it finishes and leaves it half configured.
+func AddCreatedResource(format string, args ...interface{}) {
+	createdLock.Lock()
+	defer createdLock.Unlock()
+	created = append(created, fmt.Sprintf(format, args...))
+}
+
+// CreatedResources returns the resources created by the command, in the order they were created.
+func CreatedResources() []string {
+	createdLock.Lock()
+	defer createdLock.Unlock()
+	return append([]string{}, created...)
+}
@@ -0,0 +1,19 @@
+package timeout_test
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/openshift/rosa/pkg/timeout"
+)
+
+var _ = Describe("Created resources", func() {
+	It("Returns the resources in the order they were created", func() {
+		timeout.AddCreatedResource("IAM role '%s'", "arn:aws:iam::123:role/a")
+		timeout.AddCreatedResource("IAM policy '%s'", "arn:aws:iam::123:policy/b")
+		Expect(timeout.CreatedResources()).To(Equal([]string{
+			"IAM role 'arn:aws:iam::123:role/a'",
+			"IAM policy 'arn:aws:iam::123:policy/b'",
+		}))
+	})
+})